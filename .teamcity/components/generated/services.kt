@@ -20,6 +20,7 @@ var services = mapOf(
         "communication" to "Communication",
         "compute" to "Compute",
         "consumption" to "Consumption",
+        "containerapps" to "Container Apps",
         "containers" to "Container Services",
         "cosmos" to "CosmosDB",
         "costmanagement" to "Cost Management",
@@ -36,6 +37,7 @@ var services = mapOf(
         "devtestlabs" to "Dev Test",
         "digitaltwins" to "Digital Twins",
         "domainservices" to "DomainServices",
+        "elastic" to "Elastic",
         "eventgrid" to "EventGrid",
         "eventhub" to "EventHub",
         "firewall" to "Firewall",
@@ -48,6 +50,7 @@ var services = mapOf(
         "iothub" to "IoT Hub",
         "keyvault" to "KeyVault",
         "kusto" to "Kusto",
+        "labservice" to "Lab Service",
         "lighthouse" to "Lighthouse",
         "loadbalancer" to "Load Balancer",
         "loganalytics" to "Log Analytics",
@@ -67,12 +70,14 @@ var services = mapOf(
         "mysql" to "MySQL",
         "netapp" to "NetApp",
         "network" to "Network",
+        "networkfunction" to "Network Function",
         "notificationhub" to "Notification Hub",
         "policy" to "Policy",
         "portal" to "Portal",
         "postgres" to "PostgreSQL",
         "powerbi" to "PowerBI",
         "privatedns" to "Private DNS",
+        "privatednsresolver" to "Private DNS Resolver",
         "purview" to "Purview",
         "recoveryservices" to "Recovery Services",
         "redis" to "Redis",
@@ -85,8 +90,10 @@ var services = mapOf(
         "sentinel" to "Sentinel",
         "servicefabric" to "Service Fabric",
         "servicebus" to "ServiceBus",
+        "servicenetworking" to "Service Networking",
         "signalr" to "SignalR",
         "springcloud" to "Spring Cloud",
+        "standbypool" to "Standby Pool",
         "storage" to "Storage",
         "streamanalytics" to "Stream Analytics",
         "subscription" to "Subscription",
@@ -50,3 +50,6 @@ require (
 )
 
 go 1.16
+
+// the authentication package contains changes which haven't been released upstream yet
+replace github.com/hashicorp/go-azure-helpers => ./third_party/go-azure-helpers
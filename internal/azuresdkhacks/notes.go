@@ -13,3 +13,21 @@ package azuresdkhacks
 //
 // It's worth noting that these hacks are a last resort and the Swagger/API/SDK should almost always be
 // fixed instead.
+//
+// Separately, some services contain a workaround client (within `internal/services/{service}/azuresdkhacks`) which
+// sends requests using a newer API Version than the version of the Azure SDK for Go used by that service, so that
+// fields which aren't available in the SDK can be set/retrieved. These wrap the SDK client, reusing its Preparers and
+// Senders whilst overriding the `api-version` and adding the additional fields to the request body. Since these are
+// a stop-gap until the service is updated to use a newer version of the SDK, new usages of these should:
+//
+// * only be used when creating/updating a resource if the additional fields are set or have changed - otherwise the
+//   SDK client should be used, so that the newer API Version is only used when necessary.
+// * when updating, retrieve the resource using the newer API Version and send this back (using the same API Version)
+//   with only the additional fields changed - rather than sending the SDK model using the newer API Version, which
+//   omits (and so can reset) any other fields added in the newer API Version.
+// * always retrieve the additional fields (so that changes made outside of Terraform, and imports, are detected), but
+//   tolerate the newer API Version being unavailable (e.g. in a National Cloud) by retaining the existing value(s) -
+//   so that reading a resource doesn't require the newer API Version.
+//
+// Each workaround client documents the fields it's used for and the API Version which introduced them, and should be
+// removed once the service is updated to use an API Version which includes these fields.
@@ -167,6 +167,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The password associated with the Client Certificate. For use when authenticating as a Service Principal using a Client Certificate",
 			},
 
+			"client_certificate_key_vault_secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_KEY_VAULT_SECRET_ID", ""),
+				Description: "The ID of the Key Vault Secret containing the Client Certificate associated with the Service Principal, which is retrieved using the Client Secret or Managed Service Identity. For use instead of `client_certificate_path` when authenticating as a Service Principal using a Client Certificate.",
+			},
+
 			// Client Secret specific fields
 			"client_secret": {
 				Type:        schema.TypeString,
@@ -175,6 +182,51 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The Client Secret which should be used. For use When authenticating as a Service Principal using a Client Secret.",
 			},
 
+			"client_secret_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_CLIENT_SECRET_FILE_PATH", "ARM_CLIENT_SECRET_FILE"}, ""),
+				Description: "The path to a file containing the Client Secret which should be used. For use When authenticating as a Service Principal using a Client Secret.",
+			},
+
+			// OIDC specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+				Description: "Allow OIDC to be used for Authentication.",
+			},
+
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_TOKEN_FILE_PATH", "AZURE_FEDERATED_TOKEN_FILE"}, ""),
+				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
+				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, ""),
+				Description: "The bearer token for the request to the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
 			// Managed Service Identity specific fields
 			"use_msi": {
 				Type:        schema.TypeBool,
@@ -271,28 +323,42 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		}
 
 		builder := &authentication.Builder{
-			SubscriptionID:     d.Get("subscription_id").(string),
-			ClientID:           d.Get("client_id").(string),
-			ClientSecret:       d.Get("client_secret").(string),
-			TenantID:           d.Get("tenant_id").(string),
-			AuxiliaryTenantIDs: auxTenants,
-			Environment:        d.Get("environment").(string),
-			MetadataHost:       metadataHost,
-			MsiEndpoint:        d.Get("msi_endpoint").(string),
-			ClientCertPassword: d.Get("client_certificate_password").(string),
-			ClientCertPath:     d.Get("client_certificate_path").(string),
+			SubscriptionID:           d.Get("subscription_id").(string),
+			ClientID:                 d.Get("client_id").(string),
+			ClientSecret:             d.Get("client_secret").(string),
+			ClientSecretFilePath:     d.Get("client_secret_file_path").(string),
+			TenantID:                 d.Get("tenant_id").(string),
+			AuxiliaryTenantIDs:       auxTenants,
+			Environment:              d.Get("environment").(string),
+			MetadataHost:             metadataHost,
+			MsiEndpoint:              d.Get("msi_endpoint").(string),
+			ClientCertPassword:       d.Get("client_certificate_password").(string),
+			ClientCertPath:           d.Get("client_certificate_path").(string),
+			ClientCertificateVaultID: d.Get("client_certificate_key_vault_secret_id").(string),
+			OIDCToken:                d.Get("oidc_token").(string),
+			OIDCTokenFilePath:        d.Get("oidc_token_file_path").(string),
+			IDTokenRequestURL:        d.Get("oidc_request_url").(string),
+			IDTokenRequestToken:      d.Get("oidc_request_token").(string),
 
 			// Feature Toggles
 			SupportsClientCertAuth:         true,
 			SupportsClientSecretAuth:       true,
 			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
+			SupportsOIDCAuth:               d.Get("use_oidc").(bool),
 			SupportsAzureCliToken:          true,
 			SupportsAuxiliaryTenants:       len(auxTenants) > 0,
 
+			// tokens are requested for the same endpoints by many clients, so these are reused where possible - the
+			// cache is specific to this provider block and tokens are cached per identity as well as per endpoint
+			TokenCache: authentication.NewInMemoryTokenCache(),
+
 			// Doc Links
 			ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/service_principal_client_secret",
+
+			Logger: authenticationLogger{},
 		}
 
+		logAuthenticationSources(d)
 		config, err := builder.Build()
 		if err != nil {
 			return nil, diag.FromErr(fmt.Errorf("building AzureRM Client: %s", err))
@@ -356,6 +422,47 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	}
 }
 
+// authenticationLogger logs which authentication method was selected (and how it's configured) at the DEBUG level
+type authenticationLogger struct{}
+
+func (authenticationLogger) Debugf(format string, args ...interface{}) {
+	log.Printf("[DEBUG] "+format, args...)
+}
+
+// logAuthenticationSources logs whether each of the authentication fields which are set was sourced from the
+// Provider block or an Environment Variable - the values themselves are never logged since some are secrets
+func logAuthenticationSources(d *schema.ResourceData) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return
+	}
+
+	for _, key := range []string{
+		"subscription_id",
+		"client_id",
+		"tenant_id",
+		"client_certificate_path",
+		"client_certificate_password",
+		"client_certificate_key_vault_secret_id",
+		"client_secret",
+		"client_secret_file_path",
+		"oidc_token",
+		"oidc_token_file_path",
+		"oidc_request_url",
+		"oidc_request_token",
+	} {
+		if d.Get(key).(string) == "" || !rawConfig.Type().HasAttribute(key) {
+			continue
+		}
+
+		source := "an Environment Variable"
+		if !rawConfig.GetAttr(key).IsNull() {
+			source = "the Provider block"
+		}
+		log.Printf("[DEBUG] `%s` was sourced from %s", key, source)
+	}
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
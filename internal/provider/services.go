@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement"
@@ -38,6 +39,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall"
@@ -51,6 +53,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iottimeseriesinsights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/lighthouse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics"
@@ -70,12 +73,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/portal"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/powerbi"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis"
@@ -88,9 +93,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabric"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmesh"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription"
@@ -139,6 +146,7 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		cognitive.Registration{},
 		communication.Registration{},
 		compute.Registration{},
+		containerapps.Registration{},
 		containers.Registration{},
 		consumption.Registration{},
 		cosmos.Registration{},
@@ -157,6 +165,7 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		digitaltwins.Registration{},
 		dns.Registration{},
 		domainservices.Registration{},
+		elastic.Registration{},
 		eventgrid.Registration{},
 		eventhub.Registration{},
 		firewall.Registration{},
@@ -169,6 +178,7 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		iotcentral.Registration{},
 		keyvault.Registration{},
 		kusto.Registration{},
+		labservice.Registration{},
 		loadbalancer.Registration{},
 		loganalytics.Registration{},
 		logic.Registration{},
@@ -188,12 +198,14 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		mysql.Registration{},
 		netapp.Registration{},
 		network.Registration{},
+		networkfunction.Registration{},
 		notificationhub.Registration{},
 		policy.Registration{},
 		portal.Registration{},
 		postgres.Registration{},
 		powerbi.Registration{},
 		privatedns.Registration{},
+		privatednsresolver.Registration{},
 		purview.Registration{},
 		recoveryservices.Registration{},
 		redis.Registration{},
@@ -206,8 +218,10 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		servicebus.Registration{},
 		servicefabric.Registration{},
 		servicefabricmesh.Registration{},
+		servicenetworking.Registration{},
 		signalr.Registration{},
 		sql.Registration{},
+		standbypool.Registration{},
 		storage.Registration{},
 		streamanalytics.Registration{},
 		subscription.Registration{},
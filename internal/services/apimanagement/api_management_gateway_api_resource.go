@@ -7,10 +7,10 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2020-12-01/apimanagement"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -39,13 +39,13 @@ func resourceApiManagementGatewayApi() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.ApiID,
 			},
 			"gateway_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.GatewayID,
 			},
 		},
 	}
@@ -53,6 +53,7 @@ func resourceApiManagementGatewayApi() *pluginsdk.Resource {
 
 func resourceApiManagementGatewayApiCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ApiManagement.GatewayApisClient
+	apiClient := meta.(*clients.Client).ApiManagement.ApiClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -66,6 +67,19 @@ func resourceApiManagementGatewayApiCreate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("parsing `gateway_id`: %v", err)
 	}
 
+	apimId := parse.NewApiManagementID(apiID.SubscriptionId, apiID.ResourceGroup, apiID.ServiceName)
+	gatewayApimId := parse.NewApiManagementID(gatewayID.SubscriptionId, gatewayID.ResourceGroup, gatewayID.ServiceName)
+	if apimId.ID() != gatewayApimId.ID() {
+		return fmt.Errorf("the API %q must exist within the same API Management Service as the Gateway (%s) but was in %s", apiID.Name, gatewayApimId, apimId)
+	}
+
+	if resp, err := apiClient.Get(ctx, apiID.ResourceGroup, apiID.ServiceName, apiID.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found within %s", apiID, apimId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", apiID, err)
+	}
+
 	exists, err := client.GetEntityTag(ctx, gatewayID.ResourceGroup, gatewayID.ServiceName, gatewayID.Name, apiID.Name)
 	if err != nil {
 		if !utils.ResponseWasStatusCode(exists, http.StatusNoContent) {
@@ -2,13 +2,18 @@ package apimanagement
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2020-12-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -30,6 +35,33 @@ func dataSourceApiManagementGateway() *pluginsdk.Resource {
 				ValidateFunc: validate.ApiManagementID,
 			},
 
+			"token_expiry": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"token_key_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.Primary),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.Primary),
+					string(apimanagement.Secondary),
+				}, false),
+			},
+
+			"configuration_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"token": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"description": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -65,6 +97,7 @@ func dataSourceApiManagementGateway() *pluginsdk.Resource {
 
 func dataSourceApiManagementGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ApiManagement.GatewayClient
+	serviceClient := meta.(*clients.Client).ApiManagement.ServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -99,5 +132,68 @@ func dataSourceApiManagementGatewayRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("location_data", flattenApiManagementGatewayLocationData(properties.LocationData))
 	}
 
+	service, err := serviceClient.Get(ctx, apimId.ResourceGroup, apimId.ServiceName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", apimId, err)
+	}
+
+	configurationEndpoint := ""
+	if props := service.ServiceProperties; props != nil && props.ManagementAPIURL != nil {
+		configurationEndpoint = apiManagementGatewayConfigurationEndpoint(*props.ManagementAPIURL)
+	}
+	d.Set("configuration_endpoint", configurationEndpoint)
+
+	token := ""
+	if v := d.Get("token_expiry").(string); v != "" {
+		expiry, err := parseApiManagementGatewayTokenExpiry(v, time.Now())
+		if err != nil {
+			return err
+		}
+
+		parameters := apimanagement.GatewayTokenRequestContract{
+			KeyType: apimanagement.KeyType(d.Get("token_key_type").(string)),
+			Expiry:  &date.Time{Time: expiry},
+		}
+		resp, err := client.GenerateToken(ctx, id.ResourceGroup, id.ServiceName, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("generating token for %s: %+v", id, err)
+		}
+		if resp.Value != nil {
+			token = *resp.Value
+		}
+	}
+	d.Set("token", token)
+
 	return nil
 }
+
+// apiManagementGatewayConfigurationEndpoint returns the endpoint which a Self-Hosted Gateway retrieves its
+// configuration from, which is the Management API host (e.g. `example.management.azure-api.net`) with
+// the `management` label replaced by `configuration`
+func apiManagementGatewayConfigurationEndpoint(managementApiUrl string) string {
+	host := managementApiUrl
+	if u, err := url.Parse(managementApiUrl); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	return strings.Replace(host, ".management.", ".configuration.", 1)
+}
+
+// parseApiManagementGatewayTokenExpiry parses the expiry of a Gateway Token, which must be in the future and
+// can be at most 30 days from now
+func parseApiManagementGatewayTokenExpiry(input string, now time.Time) (time.Time, error) {
+	expiry, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing `token_expiry` %q: %+v", input, err)
+	}
+
+	if !expiry.After(now) {
+		return time.Time{}, fmt.Errorf("`token_expiry` must be in the future but got %q", input)
+	}
+
+	if expiry.After(now.Add(30 * 24 * time.Hour)) {
+		return time.Time{}, fmt.Errorf("`token_expiry` can be at most 30 days in the future but got %q", input)
+	}
+
+	return expiry.UTC(), nil
+}
@@ -26,6 +26,21 @@ func TestAccDataSourceApiManagementGateway_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceApiManagementGateway_token(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_gateway", "test")
+	r := ApiManagementGatewayDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.token(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("configuration_endpoint").Exists(),
+				check.That(data.ResourceName).Key("token").Exists(),
+			),
+		},
+	})
+}
+
 func (ApiManagementGatewayDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -61,3 +76,41 @@ data "azurerm_api_management_gateway" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (ApiManagementGatewayDataSource) token(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Developer_1"
+}
+
+resource "azurerm_api_management_gateway" "test" {
+  name              = "acctestAMGateway-%d"
+  api_management_id = azurerm_api_management.test.id
+
+  location_data {
+    name = "test"
+  }
+}
+
+data "azurerm_api_management_gateway" "test" {
+  name              = azurerm_api_management_gateway.test.name
+  api_management_id = azurerm_api_management_gateway.test.api_management_id
+  token_key_type    = "secondary"
+  token_expiry      = timeadd(timestamp(), "24h")
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
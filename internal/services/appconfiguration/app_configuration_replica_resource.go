@@ -0,0 +1,196 @@
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2023-03-01/replicas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ReplicaResource struct {
+}
+
+var _ sdk.Resource = ReplicaResource{}
+
+type ReplicaResourceModel struct {
+	ConfigurationStoreId string `tfschema:"configuration_store_id"`
+	Name                 string `tfschema:"name"`
+	Location             string `tfschema:"location"`
+	Endpoint             string `tfschema:"endpoint"`
+}
+
+func (r ReplicaResource) ResourceType() string {
+	return "azurerm_app_configuration_replica"
+}
+
+func (r ReplicaResource) ModelObject() interface{} {
+	return &ReplicaResourceModel{}
+}
+
+func (r ReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ReplicaID
+}
+
+func (r ReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ConfigurationStoreID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ConfigurationStoreReplicaName,
+		},
+
+		"location": location.Schema(),
+	}
+}
+
+func (r ReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.AppConfiguration.ReplicasClient
+			storesClient := metadata.Client.AppConfiguration.ConfigurationStoresClient
+
+			storeId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			id := replicas.NewReplicaID(storeId.SubscriptionId, storeId.ResourceGroup, storeId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			store, err := storesClient.Get(ctx, *storeId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *storeId, err)
+			}
+			if store.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *storeId)
+			}
+
+			// replicas are only supported for the Standard SKU
+			if !strings.EqualFold(store.Model.Sku.Name, "standard") {
+				return fmt.Errorf("replicas can only be created for App Configurations using the `standard` sku but %s uses %q", *storeId, store.Model.Sku.Name)
+			}
+
+			replicaLocation := location.Normalize(model.Location)
+			if replicaLocation == location.Normalize(store.Model.Location) {
+				return fmt.Errorf("the `location` of %s cannot be the same as the location of the App Configuration (%q)", id, replicaLocation)
+			}
+
+			existingReplicas, err := client.ListByConfigurationStoreComplete(ctx, replicas.NewConfigurationStoreID(storeId.SubscriptionId, storeId.ResourceGroup, storeId.Name))
+			if err != nil {
+				return fmt.Errorf("listing the existing replicas for %s: %+v", *storeId, err)
+			}
+			for _, item := range existingReplicas.Items {
+				if location.NormalizeNilable(item.Location) == replicaLocation {
+					return fmt.Errorf("the replica %q already exists in the location %q - only a single replica is supported per location", utils.NormalizeNilableString(item.Name), replicaLocation)
+				}
+			}
+
+			parameters := replicas.Replica{
+				Location: utils.String(replicaLocation),
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func (r ReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.ReplicasClient
+
+			id, err := replicas.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ReplicaResourceModel{
+				ConfigurationStoreId: configurationstores.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroup, id.ConfigurationStoreName).ID(),
+				Name:                 id.Name,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.Endpoint = utils.NormalizeNilableString(props.Endpoint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.ReplicasClient
+
+			id, err := replicas.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
@@ -0,0 +1,131 @@
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2023-03-01/replicas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationReplicaResource struct {
+}
+
+func TestAccAppConfigurationReplica_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_replica", "test")
+	r := AppConfigurationReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("endpoint").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationReplica_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_replica", "test")
+	r := AppConfigurationReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationReplica_sameLocationAsConfigurationStore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_replica", "test")
+	r := AppConfigurationReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.sameLocationAsConfigurationStore(data),
+			ExpectError: regexp.MustCompile("cannot be the same as the location of the App Configuration"),
+		},
+	})
+}
+
+func (t AppConfigurationReplicaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := replicas.ParseReplicaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppConfiguration.ReplicasClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (AppConfigurationReplicaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testacc-appconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r AppConfigurationReplicaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_replica" "test" {
+  name                   = "replica%d"
+  configuration_store_id = azurerm_app_configuration.test.id
+  location               = "%s"
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r AppConfigurationReplicaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_replica" "import" {
+  name                   = azurerm_app_configuration_replica.test.name
+  configuration_store_id = azurerm_app_configuration_replica.test.configuration_store_id
+  location               = azurerm_app_configuration_replica.test.location
+}
+`, r.basic(data))
+}
+
+func (r AppConfigurationReplicaResource) sameLocationAsConfigurationStore(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_replica" "test" {
+  name                   = "replica%d"
+  configuration_store_id = azurerm_app_configuration.test.id
+  location               = azurerm_app_configuration.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
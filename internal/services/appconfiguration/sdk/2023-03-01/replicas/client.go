@@ -0,0 +1,15 @@
+package replicas
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ReplicasClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewReplicasClientWithBaseURI(endpoint string) ReplicasClient {
+	return ReplicasClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
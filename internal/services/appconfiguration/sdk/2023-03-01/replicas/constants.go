@@ -0,0 +1,11 @@
+package replicas
+
+type ReplicaProvisioningState string
+
+const (
+	ReplicaProvisioningStateCanceled  ReplicaProvisioningState = "Canceled"
+	ReplicaProvisioningStateCreating  ReplicaProvisioningState = "Creating"
+	ReplicaProvisioningStateDeleting  ReplicaProvisioningState = "Deleting"
+	ReplicaProvisioningStateFailed    ReplicaProvisioningState = "Failed"
+	ReplicaProvisioningStateSucceeded ReplicaProvisioningState = "Succeeded"
+)
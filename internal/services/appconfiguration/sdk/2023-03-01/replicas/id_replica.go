@@ -0,0 +1,126 @@
+package replicas
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ReplicaId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ConfigurationStoreName string
+	Name                   string
+}
+
+func NewReplicaID(subscriptionId, resourceGroup, configurationStoreName, name string) ReplicaId {
+	return ReplicaId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ConfigurationStoreName: configurationStoreName,
+		Name:                   name,
+	}
+}
+
+func (id ReplicaId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Configuration Store Name %q", id.ConfigurationStoreName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Replica", segmentsStr)
+}
+
+func (id ReplicaId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppConfiguration/configurationStores/%s/replicas/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ConfigurationStoreName, id.Name)
+}
+
+// ParseReplicaID parses a Replica ID into an ReplicaId struct
+func ParseReplicaID(input string) (*ReplicaId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ReplicaId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ConfigurationStoreName, err = id.PopSegment("configurationStores"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("replicas"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseReplicaIDInsensitively parses an Replica ID into an ReplicaId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseReplicaID method should be used instead for validation etc.
+func ParseReplicaIDInsensitively(input string) (*ReplicaId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ReplicaId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'configurationStores' segment
+	configurationStoresKey := "configurationStores"
+	for key := range id.Path {
+		if strings.EqualFold(key, configurationStoresKey) {
+			configurationStoresKey = key
+			break
+		}
+	}
+	if resourceId.ConfigurationStoreName, err = id.PopSegment(configurationStoresKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'replicas' segment
+	replicasKey := "replicas"
+	for key := range id.Path {
+		if strings.EqualFold(key, replicasKey) {
+			replicasKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(replicasKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
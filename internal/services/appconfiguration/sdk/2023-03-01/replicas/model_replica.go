@@ -0,0 +1,9 @@
+package replicas
+
+type Replica struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ReplicaProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
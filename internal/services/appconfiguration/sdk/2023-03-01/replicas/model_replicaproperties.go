@@ -0,0 +1,6 @@
+package replicas
+
+type ReplicaProperties struct {
+	Endpoint          *string                   `json:"endpoint,omitempty"`
+	ProvisioningState *ReplicaProvisioningState `json:"provisioningState,omitempty"`
+}
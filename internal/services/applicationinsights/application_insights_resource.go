@@ -1,6 +1,7 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -37,6 +38,12 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 			0: migration.ComponentUpgradeV0ToV1{},
 		}),
 
+		// a classic Application Insights component can be migrated to a workspace-based component, however it's not
+		// possible to migrate a workspace-based component back to a classic component
+		CustomizeDiff: pluginsdk.ForceNewIfChange("workspace_id", func(ctx context.Context, old, new, _ interface{}) bool {
+			return old.(string) != "" && new.(string) == ""
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -156,6 +163,12 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			"force_customer_storage_for_profiler": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -190,6 +203,7 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 	samplingPercentage := utils.Float(d.Get("sampling_percentage").(float64))
 	disableIpMasking := d.Get("disable_ip_masking").(bool)
 	localAuthenticationDisabled := d.Get("local_authentication_disabled").(bool)
+	forceCustomerStorageForProfiler := d.Get("force_customer_storage_for_profiler").(bool)
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
@@ -209,6 +223,7 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		SamplingPercentage:              samplingPercentage,
 		DisableIPMasking:                utils.Bool(disableIpMasking),
 		DisableLocalAuth:                utils.Bool(localAuthenticationDisabled),
+		ForceCustomerStorageForProfiler: utils.Bool(forceCustomerStorageForProfiler),
 		PublicNetworkAccessForIngestion: internetIngestionEnabled,
 		PublicNetworkAccessForQuery:     internetQueryEnabled,
 	}
@@ -319,6 +334,7 @@ func resourceApplicationInsightsRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("disable_ip_masking", props.DisableIPMasking)
 		d.Set("connection_string", props.ConnectionString)
 		d.Set("local_authentication_disabled", props.DisableLocalAuth)
+		d.Set("force_customer_storage_for_profiler", props.ForceCustomerStorageForProfiler)
 
 		d.Set("internet_ingestion_enabled", resp.PublicNetworkAccessForIngestion == insights.PublicNetworkAccessTypeEnabled)
 		d.Set("internet_query_enabled", resp.PublicNetworkAccessForQuery == insights.PublicNetworkAccessTypeEnabled)
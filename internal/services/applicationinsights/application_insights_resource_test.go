@@ -177,6 +177,29 @@ func (t AppInsightsResource) Exists(ctx context.Context, clients *clients.Client
 	return utils.Bool(resp.ApplicationInsightsComponentProperties != nil), nil
 }
 
+func TestAccApplicationInsights_migrateToWorkspaceMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights", "test")
+	r := AppInsightsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "web"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic_workspace_mode(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApplicationInsights_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_application_insights", "test")
 	r := AppInsightsResource{}
@@ -192,6 +215,7 @@ func TestAccApplicationInsights_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("daily_data_cap_in_gb").HasValue("50"),
 				check.That(data.ResourceName).Key("daily_data_cap_notifications_disabled").HasValue("true"),
 				check.That(data.ResourceName).Key("local_authentication_disabled").HasValue("true"),
+				check.That(data.ResourceName).Key("force_customer_storage_for_profiler").HasValue("true"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.Hello").HasValue("World"),
 			),
@@ -329,6 +353,7 @@ resource "azurerm_application_insights" "test" {
   daily_data_cap_notifications_disabled = true
   disable_ip_masking                    = true
   local_authentication_disabled         = true
+  force_customer_storage_for_profiler   = true
 
   tags = {
     Hello = "World"
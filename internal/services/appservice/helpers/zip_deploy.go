@@ -0,0 +1,111 @@
+package helpers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+)
+
+// RunFromPackageAppSettingName is the App Setting used to mount a deployed package as the content of the Web App
+const RunFromPackageAppSettingName = "WEBSITE_RUN_FROM_PACKAGE"
+
+// ZipDeployFileHash returns the hex encoded SHA256 hash of the contents of the specified file, which is used to detect
+// when the package to be deployed has changed since it isn't possible to retrieve the deployed package from the API
+func ZipDeployFileHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ValidateZipDeployAppSettings ensures the App Settings don't point `WEBSITE_RUN_FROM_PACKAGE` at a remote package,
+// since that would take precedence over the package deployed using `zip_deploy_file`
+func ValidateZipDeployAppSettings(appSettings map[string]string) error {
+	if v, ok := appSettings[RunFromPackageAppSettingName]; ok && v != "1" {
+		return fmt.Errorf("`app_settings.%s` must be set to `1` (or omitted) when `zip_deploy_file` is specified, got %q", RunFromPackageAppSettingName, v)
+	}
+
+	return nil
+}
+
+// GetCredentialsAndPublish deploys the specified zip file to the Web App via the Kudu (SCM) `zipdeploy` endpoint,
+// using the Site Publishing Credentials for authentication
+func GetCredentialsAndPublish(ctx context.Context, client *web.AppsClient, id parse.WebAppId, zipFile string) error {
+	site, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if site.SiteProperties == nil || site.SiteProperties.HostNameSslStates == nil {
+		return fmt.Errorf("retrieving %s: `properties.hostNameSslStates` was nil", id)
+	}
+
+	scmHost := ""
+	for _, v := range *site.SiteProperties.HostNameSslStates {
+		if v.HostType == web.HostTypeRepository && v.Name != nil {
+			scmHost = *v.Name
+			break
+		}
+	}
+	if scmHost == "" {
+		return fmt.Errorf("determining the SCM Host Name for %s", id)
+	}
+
+	credentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return fmt.Errorf("listing Site Publishing Credentials for %s: %+v", id, err)
+	}
+	if err := credentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Site Publishing Credentials for %s: %+v", id, err)
+	}
+	credentials, err := credentialsFuture.Result(*client)
+	if err != nil {
+		return fmt.Errorf("reading Site Publishing Credentials for %s: %+v", id, err)
+	}
+	if credentials.UserProperties == nil || credentials.UserProperties.PublishingUserName == nil || credentials.UserProperties.PublishingPassword == nil {
+		return fmt.Errorf("reading Site Publishing Credentials for %s: `properties` was nil", id)
+	}
+
+	f, err := os.Open(zipFile)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", zipFile, err)
+	}
+	defer f.Close()
+
+	publishEndpoint := fmt.Sprintf("https://%s/api/zipdeploy", scmHost)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, publishEndpoint, f)
+	if err != nil {
+		return fmt.Errorf("building zip deployment request for %s: %+v", id, err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.SetBasicAuth(*credentials.UserProperties.PublishingUserName, *credentials.UserProperties.PublishingPassword)
+
+	resp, err := client.Client.Send(req)
+	if err != nil {
+		return fmt.Errorf("deploying %q to %s: %+v", zipFile, id, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("deploying %q to %s: unexpected status %d: %s", zipFile, id, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
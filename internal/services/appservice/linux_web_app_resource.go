@@ -49,12 +49,16 @@ type LinuxWebAppModel struct {
 	PossibleOutboundIPAddresses   string                     `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string                   `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential   `tfschema:"site_credential"`
+	ZipDeployFile                 string                     `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                     `tfschema:"zip_deploy_file_hash"`
 }
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppResource{}
 
 var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
+
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -133,6 +137,12 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"tags": tags.Schema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
 	}
 }
 
@@ -192,6 +202,11 @@ func (r LinuxWebAppResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -297,6 +312,12 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				siteEnvelope.Identity = identity
 			}
 
+			if webApp.ZipDeployFile != "" {
+				if err := helpers.ValidateZipDeployAppSettings(webApp.AppSettings); err != nil {
+					return err
+				}
+			}
+
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, siteEnvelope)
 			if err != nil {
 				return fmt.Errorf("creating Linux %s: %+v", id, err)
@@ -354,6 +375,12 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if webApp.ZipDeployFile != "" {
+				if err := helpers.GetCredentialsAndPublish(ctx, client, id, webApp.ZipDeployFile); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -503,6 +530,20 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
+
+			// the deployed package can't be retrieved from the API, so these are taken from the existing state
+			state.ZipDeployFile = metadata.ResourceData.Get("zip_deploy_file").(string)
+			state.ZipDeployFileHash = metadata.ResourceData.Get("zip_deploy_file_hash").(string)
+
+			// when zip deploying the platform may set `WEBSITE_RUN_FROM_PACKAGE` itself, which we ignore unless it's
+			// been explicitly configured in `app_settings` so that it doesn't show as a diff
+			if state.ZipDeployFile != "" {
+				configuredAppSettings := metadata.ResourceData.Get("app_settings").(map[string]interface{})
+				if _, ok := configuredAppSettings[helpers.RunFromPackageAppSettingName]; !ok {
+					delete(state.AppSettings, helpers.RunFromPackageAppSettingName)
+				}
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -552,6 +593,12 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if state.ZipDeployFile != "" {
+				if err := helpers.ValidateZipDeployAppSettings(state.AppSettings); err != nil {
+					return err
+				}
+			}
+
 			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("reading Linux %s: %v", id, err)
@@ -603,6 +650,18 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 			// (@jackofallops) - App Settings can clobber logs configuration so must be updated before we send any Log updates
 			if metadata.ResourceData.HasChange("app_settings") {
 				appSettingsUpdate := helpers.ExpandAppSettings(state.AppSettings)
+				if state.ZipDeployFile != "" {
+					// App Settings are replaced wholesale, so retain any `WEBSITE_RUN_FROM_PACKAGE` set by the platform for the deployed package
+					if _, ok := state.AppSettings[helpers.RunFromPackageAppSettingName]; !ok {
+						existingAppSettings, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+						if err != nil {
+							return fmt.Errorf("reading App Settings for Linux %s: %+v", id, err)
+						}
+						if v, ok := existingAppSettings.Properties[helpers.RunFromPackageAppSettingName]; ok && v != nil {
+							appSettingsUpdate.Properties[helpers.RunFromPackageAppSettingName] = v
+						}
+					}
+				}
 				if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, *appSettingsUpdate); err != nil {
 					return fmt.Errorf("updating App Settings for Linux %s: %+v", id, err)
 				}
@@ -655,6 +714,55 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			// the package is deployed last, since App Settings updates restart the site
+			if state.ZipDeployFile != "" && metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash") {
+				if err := helpers.GetCredentialsAndPublish(ctx, client, *id, state.ZipDeployFile); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LinuxWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("zip_deploy_file") {
+				return rd.SetNewComputed("zip_deploy_file_hash")
+			}
+
+			zipDeployFile := rd.Get("zip_deploy_file").(string)
+			if zipDeployFile == "" {
+				if rd.Get("zip_deploy_file_hash").(string) != "" {
+					return rd.SetNew("zip_deploy_file_hash", "")
+				}
+				return nil
+			}
+
+			if rd.NewValueKnown("app_settings") {
+				appSettings := make(map[string]string)
+				for k, v := range rd.Get("app_settings").(map[string]interface{}) {
+					appSettings[k] = v.(string)
+				}
+				if err := helpers.ValidateZipDeployAppSettings(appSettings); err != nil {
+					return err
+				}
+			}
+
+			// the file name may not change between deployments, so the contents are hashed to trigger a redeployment
+			hash, err := helpers.ZipDeployFileHash(zipDeployFile)
+			if err != nil {
+				return fmt.Errorf("hashing `zip_deploy_file`: %+v", err)
+			}
+			if hash != rd.Get("zip_deploy_file_hash").(string) {
+				return rd.SetNew("zip_deploy_file_hash", hash)
+			}
+
 			return nil
 		},
 	}
@@ -0,0 +1,88 @@
+package authorization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// the Built-In Role Definitions used to grant access to the data plane of an Azure Managed Grafana instance
+const (
+	managedGrafanaAdminRoleDefinitionId  = "22926164-76b3-42b3-bc55-97df8dab3e41"
+	managedGrafanaEditorRoleDefinitionId = "a79a5197-3a5c-4973-a920-486035ffd60f"
+	managedGrafanaViewerRoleDefinitionId = "60921a7e-fef1-4a43-9b16-a26c52ad4769"
+)
+
+func dataSourceArmManagedGrafanaRoleDefinitions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmManagedGrafanaRoleDefinitionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"admin_role_definition_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"editor_role_definition_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"viewer_role_definition_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmManagedGrafanaRoleDefinitionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+	if scope == "" {
+		scope = fmt.Sprintf("/subscriptions/%s", subscriptionId)
+	}
+
+	roles := map[string]string{
+		"admin_role_definition_id":  managedGrafanaAdminRoleDefinitionId,
+		"editor_role_definition_id": managedGrafanaEditorRoleDefinitionId,
+		"viewer_role_definition_id": managedGrafanaViewerRoleDefinitionId,
+	}
+
+	for key, roleDefinitionId := range roles {
+		// whilst these are Built-In Role Definitions, we look them up to ensure they're available within this scope
+		role, err := client.Get(ctx, scope, roleDefinitionId)
+		if err != nil {
+			return fmt.Errorf("retrieving Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+		}
+		if role.ID == nil {
+			return fmt.Errorf("retrieving Role Definition %q (Scope %q): `id` was nil", roleDefinitionId, scope)
+		}
+
+		d.Set(key, role.ID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/providers/Microsoft.Dashboard/roleDefinitions", scope))
+	d.Set("scope", scope)
+
+	return nil
+}
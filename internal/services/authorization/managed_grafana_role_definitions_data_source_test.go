@@ -0,0 +1,37 @@
+package authorization_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagedGrafanaRoleDefinitionsDataSource struct{}
+
+func TestAccManagedGrafanaRoleDefinitionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_managed_grafana_role_definitions", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ManagedGrafanaRoleDefinitionsDataSource{}.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("scope").Exists(),
+				check.That(data.ResourceName).Key("admin_role_definition_id").MatchesRegex(regexp.MustCompile("/providers/Microsoft.Authorization/roleDefinitions/22926164-76b3-42b3-bc55-97df8dab3e41$")),
+				check.That(data.ResourceName).Key("editor_role_definition_id").MatchesRegex(regexp.MustCompile("/providers/Microsoft.Authorization/roleDefinitions/a79a5197-3a5c-4973-a920-486035ffd60f$")),
+				check.That(data.ResourceName).Key("viewer_role_definition_id").MatchesRegex(regexp.MustCompile("/providers/Microsoft.Authorization/roleDefinitions/60921a7e-fef1-4a43-9b16-a26c52ad4769$")),
+			),
+		},
+	})
+}
+
+func (d ManagedGrafanaRoleDefinitionsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_managed_grafana_role_definitions" "test" {}
+`
+}
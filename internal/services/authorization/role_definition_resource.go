@@ -8,12 +8,17 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	subscriptionValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -56,9 +61,10 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 			},
 
 			"scope": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: roleDefinitionScopeValidation(),
 			},
 
 			"description": {
@@ -72,25 +78,31 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						// the API doesn't guarantee the ordering of the actions, so these are Sets to avoid a diff
 						"actions": {
-							Type:     pluginsdk.TypeList,
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
+							Set: pluginsdk.HashString,
 						},
 						"not_actions": {
-							Type:     pluginsdk.TypeList,
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
+							Set: pluginsdk.HashString,
 						},
 						"data_actions": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 							Set: pluginsdk.HashString,
 						},
@@ -98,7 +110,8 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 							Set: pluginsdk.HashString,
 						},
@@ -111,7 +124,8 @@ func resourceArmRoleDefinition() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				Elem: &pluginsdk.Schema{
-					Type: pluginsdk.TypeString,
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: roleDefinitionScopeValidation(),
 				},
 			},
 
@@ -409,52 +423,29 @@ func expandRoleDefinitionPermissions(input []interface{}) []authorization.Permis
 		}
 
 		raw := v.(map[string]interface{})
-		permission := authorization.Permission{}
-
-		actionsOutput := make([]string, 0)
-		actions := raw["actions"].([]interface{})
-		for _, a := range actions {
-			if a == nil {
-				continue
-			}
-			actionsOutput = append(actionsOutput, a.(string))
+		permission := authorization.Permission{
+			Actions:        expandRoleDefinitionActions(raw["actions"].(*pluginsdk.Set).List()),
+			DataActions:    expandRoleDefinitionActions(raw["data_actions"].(*pluginsdk.Set).List()),
+			NotActions:     expandRoleDefinitionActions(raw["not_actions"].(*pluginsdk.Set).List()),
+			NotDataActions: expandRoleDefinitionActions(raw["not_data_actions"].(*pluginsdk.Set).List()),
 		}
-		permission.Actions = &actionsOutput
 
-		dataActionsOutput := make([]string, 0)
-		dataActions := raw["data_actions"].(*pluginsdk.Set)
-		for _, a := range dataActions.List() {
-			if a == nil {
-				continue
-			}
-			dataActionsOutput = append(dataActionsOutput, a.(string))
-		}
-		permission.DataActions = &dataActionsOutput
+		output = append(output, permission)
+	}
 
-		notActionsOutput := make([]string, 0)
-		notActions := raw["not_actions"].([]interface{})
-		for _, a := range notActions {
-			if a == nil {
-				continue
-			}
-			notActionsOutput = append(notActionsOutput, a.(string))
-		}
-		permission.NotActions = &notActionsOutput
+	return output
+}
 
-		notDataActionsOutput := make([]string, 0)
-		notDataActions := raw["not_data_actions"].(*pluginsdk.Set)
-		for _, a := range notDataActions.List() {
-			if a == nil {
-				continue
-			}
-			notDataActionsOutput = append(notDataActionsOutput, a.(string))
+func expandRoleDefinitionActions(input []interface{}) *[]string {
+	output := make([]string, 0)
+	for _, a := range input {
+		if a == nil {
+			continue
 		}
-		permission.NotDataActions = &notDataActionsOutput
-
-		output = append(output, permission)
+		output = append(output, a.(string))
 	}
 
-	return output
+	return &output
 }
 
 func expandRoleDefinitionAssignableScopes(d *pluginsdk.ResourceData) []string {
@@ -504,6 +495,16 @@ func flattenRoleDefinitionAssignableScopes(input *[]string) []interface{} {
 	return scopes
 }
 
+// roleDefinitionScopeValidation validates that a scope is a Management Group, Subscription, Resource Group or Resource ID
+func roleDefinitionScopeValidation() pluginsdk.SchemaValidateFunc {
+	return validation.Any(
+		managementGroupValidate.ManagementGroupID,
+		subscriptionValidate.SubscriptionID,
+		resourceValidate.ResourceGroupID,
+		azure.ValidateResourceID,
+	)
+}
+
 func roleDefinitionUpdateStateRefreshFunc(ctx context.Context, client *authorization.RoleDefinitionsClient, roleDefinitionId string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := client.GetByID(ctx, roleDefinitionId)
@@ -3,6 +3,7 @@ package authorization_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -87,6 +88,52 @@ func TestAccRoleDefinition_update(t *testing.T) {
 	})
 }
 
+func TestAccRoleDefinition_updateDataActions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dataActions(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.actions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions.0.data_actions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions.0.not_data_actions.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.data_actions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleDefinition_invalidAssignableScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
+	r := RoleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidAssignableScope(uuid.New().String(), data),
+			ExpectError: regexp.MustCompile("Can not parse \"assignable_scopes.0\" as a resource id"),
+		},
+	})
+}
+
 func TestAccRoleDefinition_updateEmptyId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
 
@@ -305,6 +352,66 @@ resource "azurerm_role_definition" "test" {
 `, id, data.RandomInteger)
 }
 
+func (RoleDefinitionResource) dataActions(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = data.azurerm_subscription.primary.id
+
+  permissions {
+    actions = [
+      "Microsoft.Storage/storageAccounts/read",
+      "Microsoft.Resources/subscriptions/resourceGroups/read",
+    ]
+    data_actions = [
+      "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+      "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+    ]
+    not_data_actions = [
+      "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete",
+    ]
+  }
+
+  assignable_scopes = [
+    data.azurerm_subscription.primary.id,
+  ]
+}
+`, id, data.RandomInteger)
+}
+
+func (RoleDefinitionResource) invalidAssignableScope(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = data.azurerm_subscription.primary.id
+
+  permissions {
+    actions = ["*"]
+  }
+
+  assignable_scopes = [
+    "not-a-scope",
+  ]
+}
+`, id, data.RandomInteger)
+}
+
 func (RoleDefinitionResource) emptyId(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
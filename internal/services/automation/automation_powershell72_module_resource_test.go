@@ -0,0 +1,188 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationPowerShell72ModuleResource struct{}
+
+func TestAccAutomationPowerShell72Module_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_powershell72_module", "test")
+	r := AutomationPowerShell72ModuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep("content_link"),
+	})
+}
+
+func TestAccAutomationPowerShell72Module_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_powershell72_module", "test")
+	r := AutomationPowerShell72ModuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationPowerShell72Module_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_powershell72_module", "test")
+	r := AutomationPowerShell72ModuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content_link"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content_link"),
+	})
+}
+
+func TestAccAutomationPowerShell72Module_importFailed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_powershell72_module", "test")
+	r := AutomationPowerShell72ModuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidContent(data),
+			ExpectError: regexp.MustCompile("importing the content failed"),
+		},
+	})
+}
+
+func (t AutomationPowerShell72ModuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PowerShell72ModuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewRuntimePackagesWorkaroundClient(clients.Automation.ModuleClient, azuresdkhacks.RuntimePackageTypePowerShell72Module)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ModuleProperties != nil), nil
+}
+
+func (AutomationPowerShell72ModuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r AutomationPowerShell72ModuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_powershell72_module" "test" {
+  name                  = "xActiveDirectory"
+  automation_account_id = azurerm_automation_account.test.id
+
+  content_link {
+    uri = "https://devopsgallerystorage.blob.core.windows.net/packages/xactivedirectory.2.19.0.nupkg"
+  }
+}
+`, r.template(data))
+}
+
+func (r AutomationPowerShell72ModuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_powershell72_module" "test" {
+  name                  = "xActiveDirectory"
+  automation_account_id = azurerm_automation_account.test.id
+
+  content_link {
+    uri     = "https://www.powershellgallery.com/api/v2/package/xActiveDirectory/3.0.0.0"
+    version = "3.0.0.0"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r AutomationPowerShell72ModuleResource) invalidContent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_powershell72_module" "test" {
+  name                  = "acctest-invalid"
+  automation_account_id = azurerm_automation_account.test.id
+
+  content_link {
+    uri = "https://devopsgallerystorage.blob.core.windows.net/packages/xactivedirectory.2.19.0.nupkg"
+
+    hash {
+      algorithm = "SHA256"
+      value     = "0000000000000000000000000000000000000000000000000000000000000000"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r AutomationPowerShell72ModuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_powershell72_module" "import" {
+  name                  = azurerm_automation_powershell72_module.test.name
+  automation_account_id = azurerm_automation_powershell72_module.test.automation_account_id
+
+  content_link {
+    uri = "https://devopsgallerystorage.blob.core.windows.net/packages/xactivedirectory.2.19.0.nupkg"
+  }
+}
+`, r.basic(data))
+}
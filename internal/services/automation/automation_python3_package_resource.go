@@ -0,0 +1,168 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAutomationPython3Package() *pluginsdk.Resource {
+	resource := &pluginsdk.Resource{
+		Create: resourceAutomationPython3PackageCreateUpdate,
+		Read:   resourceAutomationPython3PackageRead,
+		Update: resourceAutomationPython3PackageCreateUpdate,
+		Delete: resourceAutomationPython3PackageDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.Python3PackageID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"automation_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccountID,
+			},
+
+			"content_link": automationRuntimePackageContentLinkSchema(validate.Python3PackageContentUri),
+
+			"tags": tags.Schema(),
+		},
+	}
+
+	for k, v := range automationRuntimePackageComputedSchema() {
+		resource.Schema[k] = v
+	}
+
+	return resource
+}
+
+func resourceAutomationPython3PackageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewRuntimePackagesWorkaroundClient(meta.(*clients.Client).Automation.ModuleClient, azuresdkhacks.RuntimePackageTypePython3Package)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Python 3 Package creation.")
+
+	accountId, err := parse.AutomationAccountID(d.Get("automation_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewPython3PackageID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_automation_python3_package", id.ID())
+		}
+	}
+
+	parameters := automation.ModuleCreateOrUpdateParameters{
+		ModuleCreateOrUpdateProperties: &automation.ModuleCreateOrUpdateProperties{
+			ContentLink: expandAutomationRuntimePackageContentLink(d.Get("content_link").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	timeout := d.Timeout(pluginsdk.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	}
+	if err := waitForAutomationRuntimePackageToBeImported(ctx, client, id.ResourceGroup, id.AutomationAccountName, id.Name, timeout); err != nil {
+		return fmt.Errorf("waiting for %s to finish importing: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationPython3PackageRead(d, meta)
+}
+
+func resourceAutomationPython3PackageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewRuntimePackagesWorkaroundClient(meta.(*clients.Client).Automation.ModuleClient, azuresdkhacks.RuntimePackageTypePython3Package)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.Python3PackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("automation_account_id", parse.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName).ID())
+
+	flattenAutomationRuntimePackageProperties(d, resp.ModuleProperties)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceAutomationPython3PackageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewRuntimePackagesWorkaroundClient(meta.(*clients.Client).Automation.ModuleClient, azuresdkhacks.RuntimePackageTypePython3Package)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.Python3PackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
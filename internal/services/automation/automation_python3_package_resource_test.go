@@ -0,0 +1,188 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationPython3PackageResource struct{}
+
+func TestAccAutomationPython3Package_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep("content_link"),
+	})
+}
+
+func TestAccAutomationPython3Package_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationPython3Package_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content_link"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content_link"),
+	})
+}
+
+func TestAccAutomationPython3Package_importFailed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_python3_package", "test")
+	r := AutomationPython3PackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidContent(data),
+			ExpectError: regexp.MustCompile("importing the content failed"),
+		},
+	})
+}
+
+func (t AutomationPython3PackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.Python3PackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewRuntimePackagesWorkaroundClient(clients.Automation.ModuleClient, azuresdkhacks.RuntimePackageTypePython3Package)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ModuleProperties != nil), nil
+}
+
+func (AutomationPython3PackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r AutomationPython3PackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "test" {
+  name                  = "requests"
+  automation_account_id = azurerm_automation_account.test.id
+
+  content_link {
+    uri = "https://files.pythonhosted.org/packages/70/8e/0e2d847013cb52cd35b38c009bb167a1a26b2ce6cd6965bf26b47bc0bf44/requests-2.31.0-py3-none-any.whl"
+  }
+}
+`, r.template(data))
+}
+
+func (r AutomationPython3PackageResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "test" {
+  name                  = "requests"
+  automation_account_id = azurerm_automation_account.test.id
+
+  content_link {
+    uri     = "https://files.pythonhosted.org/packages/9d/be/10918a2eac4ae9f02f6cfe6414b7a155ccd8f7f9d4380d62fd5b955065c3/requests-2.31.0.tar.gz"
+    version = "2.31.0"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r AutomationPython3PackageResource) invalidContent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "test" {
+  name                  = "acctest-invalid"
+  automation_account_id = azurerm_automation_account.test.id
+
+  content_link {
+    uri = "https://files.pythonhosted.org/packages/70/8e/0e2d847013cb52cd35b38c009bb167a1a26b2ce6cd6965bf26b47bc0bf44/requests-2.31.0-py3-none-any.whl"
+
+    hash {
+      algorithm = "SHA256"
+      value     = "0000000000000000000000000000000000000000000000000000000000000000"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r AutomationPython3PackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_python3_package" "import" {
+  name                  = azurerm_automation_python3_package.test.name
+  automation_account_id = azurerm_automation_python3_package.test.automation_account_id
+
+  content_link {
+    uri = "https://files.pythonhosted.org/packages/70/8e/0e2d847013cb52cd35b38c009bb167a1a26b2ce6cd6965bf26b47bc0bf44/requests-2.31.0-py3-none-any.whl"
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,194 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// PowerShell 7.2 Modules and Python 3 Packages share the same API surface, so the schema and the logic
+// used to wait for the content to be imported are shared between both resources.
+
+func automationRuntimePackageContentLinkSchema(uriValidateFunc pluginsdk.SchemaValidateFunc) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"uri": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: uriValidateFunc,
+				},
+
+				"version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"hash": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"algorithm": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"value": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func automationRuntimePackageComputedSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"activity_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func expandAutomationRuntimePackageContentLink(input []interface{}) *automation.ContentLink {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := automation.ContentLink{
+		URI: utils.String(raw["uri"].(string)),
+	}
+
+	if v := raw["version"].(string); v != "" {
+		output.Version = utils.String(v)
+	}
+
+	if hashes := raw["hash"].([]interface{}); len(hashes) > 0 && hashes[0] != nil {
+		hash := hashes[0].(map[string]interface{})
+		output.ContentHash = &automation.ContentHash{
+			Algorithm: utils.String(hash["algorithm"].(string)),
+			Value:     utils.String(hash["value"].(string)),
+		}
+	}
+
+	return &output
+}
+
+func flattenAutomationRuntimePackageProperties(d *pluginsdk.ResourceData, props *automation.ModuleProperties) {
+	activityCount := 0
+	provisioningState := ""
+	sizeInBytes := 0
+	version := ""
+
+	if props != nil {
+		if props.ActivityCount != nil {
+			activityCount = int(*props.ActivityCount)
+		}
+		provisioningState = string(props.ProvisioningState)
+		if props.SizeInBytes != nil {
+			sizeInBytes = int(*props.SizeInBytes)
+		}
+		if props.Version != nil {
+			version = *props.Version
+		}
+	}
+
+	d.Set("activity_count", activityCount)
+	d.Set("provisioning_state", provisioningState)
+	d.Set("size_in_bytes", sizeInBytes)
+	d.Set("version", version)
+}
+
+// waitForAutomationRuntimePackageToBeImported waits for the content of the module/package to be imported, since the
+// API returns once the content has been accepted rather than once it's been imported - returning the error surfaced
+// by the API when the import fails.
+func waitForAutomationRuntimePackageToBeImported(ctx context.Context, client azuresdkhacks.RuntimePackagesWorkaroundClient, resourceGroup, automationAccountName, name string, timeout time.Duration) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(automation.ModuleProvisioningStateActivitiesStored),
+			string(automation.ModuleProvisioningStateConnectionTypeImported),
+			string(automation.ModuleProvisioningStateContentDownloaded),
+			string(automation.ModuleProvisioningStateContentRetrieved),
+			string(automation.ModuleProvisioningStateContentStored),
+			string(automation.ModuleProvisioningStateContentValidated),
+			string(automation.ModuleProvisioningStateCreated),
+			string(automation.ModuleProvisioningStateCreating),
+			string(automation.ModuleProvisioningStateModuleDataStored),
+			string(automation.ModuleProvisioningStateModuleImportRunbookComplete),
+			string(automation.ModuleProvisioningStateRunningImportModuleRunbook),
+			string(automation.ModuleProvisioningStateStartingImportModuleRunbook),
+			string(automation.ModuleProvisioningStateUpdating),
+		},
+		Target: []string{
+			string(automation.ModuleProvisioningStateSucceeded),
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, resourceGroup, automationAccountName, name)
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving %q (Automation Account %q / Resource Group %q): %+v", name, automationAccountName, resourceGroup, err)
+			}
+
+			props := resp.ModuleProperties
+			if props == nil {
+				return resp, "Unknown", nil
+			}
+
+			if props.Error != nil && props.Error.Message != nil && *props.Error.Message != "" {
+				code := ""
+				if props.Error.Code != nil {
+					code = *props.Error.Code
+				}
+				return resp, string(props.ProvisioningState), fmt.Errorf("importing the content failed with the provisioning state %q (code %q): %s", string(props.ProvisioningState), code, *props.Error.Message)
+			}
+
+			switch props.ProvisioningState {
+			case automation.ModuleProvisioningStateFailed, automation.ModuleProvisioningStateCancelled:
+				return resp, string(props.ProvisioningState), fmt.Errorf("importing the content failed with the provisioning state %q", string(props.ProvisioningState))
+			}
+
+			return resp, string(props.ProvisioningState), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// PowerShell 7.2 Modules and Python 3 Packages are only available from API Version `2023-11-01`, these share the same
+// models as (PowerShell 5.1) Modules so the Module Client is reused with the path updated (see `internal/azuresdkhacks`)
+const runtimePackagesApiVersion = "2023-11-01"
+
+type RuntimePackageType string
+
+const (
+	RuntimePackageTypePowerShell72Module RuntimePackageType = "powerShell72Modules"
+	RuntimePackageTypePython3Package     RuntimePackageType = "python3Packages"
+)
+
+type RuntimePackagesWorkaroundClient struct {
+	sdkClient   *automation.ModuleClient
+	packageType RuntimePackageType
+}
+
+func NewRuntimePackagesWorkaroundClient(client *automation.ModuleClient, packageType RuntimePackageType) RuntimePackagesWorkaroundClient {
+	return RuntimePackagesWorkaroundClient{
+		sdkClient:   client,
+		packageType: packageType,
+	}
+}
+
+// CreateOrUpdate creates or updates (and then imports) the PowerShell 7.2 Module or Python 3 Package.
+// Parameters:
+// resourceGroupName - name of an Azure Resource group.
+// automationAccountName - the name of the automation account.
+// name - the name of the module or package.
+// parameters - the create or update parameters for the module or package.
+func (client RuntimePackagesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, automationAccountName string, name string, parameters automation.ModuleCreateOrUpdateParameters) (result automation.Module, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, automationAccountName, name, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withRuntimePackageType(client.packageType))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Get retrieves the PowerShell 7.2 Module or Python 3 Package.
+// Parameters:
+// resourceGroupName - name of an Azure Resource group.
+// automationAccountName - the name of the automation account.
+// name - the name of the module or package.
+func (client RuntimePackagesWorkaroundClient) Get(ctx context.Context, resourceGroupName string, automationAccountName string, name string) (result automation.Module, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, automationAccountName, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withRuntimePackageType(client.packageType))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.sdkClient.GetResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Delete deletes the PowerShell 7.2 Module or Python 3 Package.
+// Parameters:
+// resourceGroupName - name of an Azure Resource group.
+// automationAccountName - the name of the automation account.
+// name - the name of the module or package.
+func (client RuntimePackagesWorkaroundClient) Delete(ctx context.Context, resourceGroupName string, automationAccountName string, name string) (result autorest.Response, err error) {
+	req, err := client.sdkClient.DeletePreparer(ctx, resourceGroupName, automationAccountName, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withRuntimePackageType(client.packageType))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.DeleteSender(req)
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.sdkClient.DeleteResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "automation.ModuleClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// withRuntimePackageType replaces the `modules` segment of the request path with the specified package type
+// and updates the API Version used for the request to one which supports it.
+func withRuntimePackageType(packageType RuntimePackageType) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			index := strings.LastIndex(r.URL.Path, "/modules/")
+			if index == -1 {
+				return r, fmt.Errorf("expected the request path %q to contain a `modules` segment", r.URL.Path)
+			}
+			r.URL.Path = fmt.Sprintf("%s/%s/%s", r.URL.Path[:index], packageType, r.URL.Path[index+len("/modules/"):])
+			r.URL.RawPath = ""
+
+			query := r.URL.Query()
+			query.Set("api-version", runtimePackagesApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
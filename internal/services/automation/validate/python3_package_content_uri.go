@@ -0,0 +1,35 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Python3PackageContentUri validates that the content of a Python 3 Package is either a
+// wheel (`.whl`) or source distribution (`.tar.gz`) which is accessible over HTTP(S)
+func Python3PackageContentUri(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	uri, err := url.Parse(v)
+	if err != nil || uri.Host == "" {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid URL, got %q", k, v))
+		return
+	}
+
+	if uri.Scheme != "http" && uri.Scheme != "https" {
+		errors = append(errors, fmt.Errorf("expected %q to have a scheme of `http` or `https`, got %q", k, v))
+		return
+	}
+
+	path := strings.ToLower(uri.Path)
+	if !strings.HasSuffix(path, ".whl") && !strings.HasSuffix(path, ".tar.gz") {
+		errors = append(errors, fmt.Errorf("expected %q to reference a `.whl` or `.tar.gz` file, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package validate
+
+import "testing"
+
+func TestPython3PackageContentUri(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// not a url
+			input:    "requests-2.31.0-py3-none-any.whl",
+			expected: false,
+		},
+		{
+			// unsupported scheme
+			input:    "ftp://example.com/requests-2.31.0-py3-none-any.whl",
+			expected: false,
+		},
+		{
+			// unsupported file type
+			input:    "https://example.com/requests-2.31.0.zip",
+			expected: false,
+		},
+		{
+			// wheel
+			input:    "https://files.pythonhosted.org/packages/70/8e/0e2d847013cb52cd35b38c009bb167a1a26b2ce6cd6965bf26b47bc0bf44/requests-2.31.0-py3-none-any.whl",
+			expected: true,
+		},
+		{
+			// source distribution with a query string
+			input:    "https://example.blob.core.windows.net/packages/requests-2.31.0.tar.gz?sv=2021-08-06&sig=abc",
+			expected: true,
+		},
+		{
+			// upper case extension
+			input:    "http://example.com/REQUESTS-2.31.0-PY3-NONE-ANY.WHL",
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := Python3PackageContentUri(v.input, "uri")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
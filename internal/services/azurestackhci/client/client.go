@@ -3,17 +3,35 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/azurestackhci/mgmt/2020-10-01/azurestackhci"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/storagecontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
 )
 
 type Client struct {
-	ClusterClient *azurestackhci.ClustersClient
+	ClusterClient                *azurestackhci.ClustersClient
+	LogicalNetworksClient        *logicalnetworks.LogicalNetworksClient
+	StorageContainersClient      *storagecontainers.StorageContainersClient
+	VirtualMachineInstanceClient *virtualmachineinstances.VirtualMachineInstancesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	clusterClient := azurestackhci.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&clusterClient.Client, o.ResourceManagerAuthorizer)
 
+	logicalNetworksClient := logicalnetworks.NewLogicalNetworksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&logicalNetworksClient.Client, o.ResourceManagerAuthorizer)
+
+	storageContainersClient := storagecontainers.NewStorageContainersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&storageContainersClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualMachineInstanceClient := virtualmachineinstances.NewVirtualMachineInstancesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachineInstanceClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ClusterClient: &clusterClient,
+		ClusterClient:                &clusterClient,
+		LogicalNetworksClient:        &logicalNetworksClient,
+		StorageContainersClient:      &storageContainersClient,
+		VirtualMachineInstanceClient: &virtualMachineInstanceClient,
 	}
 }
@@ -24,6 +24,9 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_stack_hci_cluster": resourceArmStackHCICluster(),
+		"azurerm_stack_hci_cluster":                  resourceArmStackHCICluster(),
+		"azurerm_stack_hci_logical_network":          resourceArmStackHCILogicalNetwork(),
+		"azurerm_stack_hci_storage_path":             resourceArmStackHCIStoragePath(),
+		"azurerm_stack_hci_virtual_machine_instance": resourceArmStackHCIVirtualMachineInstance(),
 	}
 }
@@ -0,0 +1,108 @@
+package logicalnetworks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type LogicalNetworkId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewLogicalNetworkID(subscriptionId, resourceGroup, name string) LogicalNetworkId {
+	return LogicalNetworkId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id LogicalNetworkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Logical Network", segmentsStr)
+}
+
+func (id LogicalNetworkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureStackHCI/logicalNetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLogicalNetworkID parses a LogicalNetwork ID into an LogicalNetworkId struct
+func ParseLogicalNetworkID(input string) (*LogicalNetworkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LogicalNetworkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("logicalNetworks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseLogicalNetworkIDInsensitively parses an LogicalNetwork ID into an LogicalNetworkId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseLogicalNetworkID method should be used instead for validation etc.
+func ParseLogicalNetworkIDInsensitively(input string) (*LogicalNetworkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LogicalNetworkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'logicalNetworks' segment
+	logicalNetworksKey := "logicalNetworks"
+	for key := range id.Path {
+		if strings.EqualFold(key, logicalNetworksKey) {
+			logicalNetworksKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(logicalNetworksKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
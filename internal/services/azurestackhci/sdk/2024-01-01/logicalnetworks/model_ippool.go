@@ -0,0 +1,7 @@
+package logicalnetworks
+
+type IPPool struct {
+	End   *string `json:"end,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Start *string `json:"start,omitempty"`
+}
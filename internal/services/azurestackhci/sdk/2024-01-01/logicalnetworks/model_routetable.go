@@ -0,0 +1,5 @@
+package logicalnetworks
+
+type RouteTable struct {
+	Properties *RouteTablePropertiesFormat `json:"properties,omitempty"`
+}
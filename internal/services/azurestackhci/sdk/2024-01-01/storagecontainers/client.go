@@ -0,0 +1,15 @@
+package storagecontainers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StorageContainersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStorageContainersClientWithBaseURI(endpoint string) StorageContainersClient {
+	return StorageContainersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
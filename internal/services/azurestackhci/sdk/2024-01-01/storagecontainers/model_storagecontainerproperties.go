@@ -0,0 +1,6 @@
+package storagecontainers
+
+type StorageContainerProperties struct {
+	Path              string                 `json:"path"`
+	ProvisioningState *ProvisioningStateEnum `json:"provisioningState,omitempty"`
+}
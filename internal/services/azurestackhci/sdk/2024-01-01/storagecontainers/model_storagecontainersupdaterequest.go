@@ -0,0 +1,5 @@
+package storagecontainers
+
+type StorageContainersUpdateRequest struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,46 @@
+package virtualmachineinstances
+
+type ExtendedLocationTypes string
+
+const (
+	ExtendedLocationTypesCustomLocation ExtendedLocationTypes = "CustomLocation"
+)
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumAccepted   ProvisioningStateEnum = "Accepted"
+	ProvisioningStateEnumCanceled   ProvisioningStateEnum = "Canceled"
+	ProvisioningStateEnumDeleting   ProvisioningStateEnum = "Deleting"
+	ProvisioningStateEnumFailed     ProvisioningStateEnum = "Failed"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+)
+
+type VMSizeEnum string
+
+const (
+	VMSizeEnumCustom         VMSizeEnum = "Custom"
+	VMSizeEnumDefault        VMSizeEnum = "Default"
+	VMSizeEnumStandardA2v2   VMSizeEnum = "Standard_A2_v2"
+	VMSizeEnumStandardA4v2   VMSizeEnum = "Standard_A4_v2"
+	VMSizeEnumStandardD16sv3 VMSizeEnum = "Standard_D16s_v3"
+	VMSizeEnumStandardD2sv3  VMSizeEnum = "Standard_D2s_v3"
+	VMSizeEnumStandardD32sv3 VMSizeEnum = "Standard_D32s_v3"
+	VMSizeEnumStandardD4sv3  VMSizeEnum = "Standard_D4s_v3"
+	VMSizeEnumStandardD8sv3  VMSizeEnum = "Standard_D8s_v3"
+	VMSizeEnumStandardDS13v2 VMSizeEnum = "Standard_DS13_v2"
+	VMSizeEnumStandardDS2v2  VMSizeEnum = "Standard_DS2_v2"
+	VMSizeEnumStandardDS3v2  VMSizeEnum = "Standard_DS3_v2"
+	VMSizeEnumStandardDS4v2  VMSizeEnum = "Standard_DS4_v2"
+	VMSizeEnumStandardDS5v2  VMSizeEnum = "Standard_DS5_v2"
+	VMSizeEnumStandardK8S2v1 VMSizeEnum = "Standard_K8S2_v1"
+	VMSizeEnumStandardK8S3v1 VMSizeEnum = "Standard_K8S3_v1"
+	VMSizeEnumStandardK8S4v1 VMSizeEnum = "Standard_K8S4_v1"
+	VMSizeEnumStandardK8S5v1 VMSizeEnum = "Standard_K8S5_v1"
+	VMSizeEnumStandardK8Sv1  VMSizeEnum = "Standard_K8S_v1"
+	VMSizeEnumStandardNK12   VMSizeEnum = "Standard_NK12"
+	VMSizeEnumStandardNK6    VMSizeEnum = "Standard_NK6"
+	VMSizeEnumStandardNV12   VMSizeEnum = "Standard_NV12"
+	VMSizeEnumStandardNV6    VMSizeEnum = "Standard_NV6"
+)
@@ -0,0 +1,126 @@
+package virtualmachineinstances
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type VirtualMachineInstanceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	MachineName    string
+	Name           string
+}
+
+func NewVirtualMachineInstanceID(subscriptionId, resourceGroup, machineName, name string) VirtualMachineInstanceId {
+	return VirtualMachineInstanceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		MachineName:    machineName,
+		Name:           name,
+	}
+}
+
+func (id VirtualMachineInstanceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Machine Name %q", id.MachineName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine Instance", segmentsStr)
+}
+
+func (id VirtualMachineInstanceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/providers/Microsoft.AzureStackHCI/virtualMachineInstances/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MachineName, id.Name)
+}
+
+// ParseVirtualMachineInstanceID parses a VirtualMachineInstance ID into an VirtualMachineInstanceId struct
+func ParseVirtualMachineInstanceID(input string) (*VirtualMachineInstanceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineInstanceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MachineName, err = id.PopSegment("machines"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("virtualMachineInstances"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseVirtualMachineInstanceIDInsensitively parses an VirtualMachineInstance ID into an VirtualMachineInstanceId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseVirtualMachineInstanceID method should be used instead for validation etc.
+func ParseVirtualMachineInstanceIDInsensitively(input string) (*VirtualMachineInstanceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineInstanceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'machines' segment
+	machinesKey := "machines"
+	for key := range id.Path {
+		if strings.EqualFold(key, machinesKey) {
+			machinesKey = key
+			break
+		}
+	}
+	if resourceId.MachineName, err = id.PopSegment(machinesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'virtualMachineInstances' segment
+	virtualMachineInstancesKey := "virtualMachineInstances"
+	for key := range id.Path {
+		if strings.EqualFold(key, virtualMachineInstancesKey) {
+			virtualMachineInstancesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(virtualMachineInstancesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
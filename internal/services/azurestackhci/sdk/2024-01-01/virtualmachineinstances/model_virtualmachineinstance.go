@@ -0,0 +1,9 @@
+package virtualmachineinstances
+
+type VirtualMachineInstance struct {
+	ExtendedLocation *ExtendedLocation                 `json:"extendedLocation,omitempty"`
+	Id               *string                           `json:"id,omitempty"`
+	Name             *string                           `json:"name,omitempty"`
+	Properties       *VirtualMachineInstanceProperties `json:"properties,omitempty"`
+	Type             *string                           `json:"type,omitempty"`
+}
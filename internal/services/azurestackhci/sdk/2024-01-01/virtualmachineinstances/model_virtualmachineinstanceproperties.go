@@ -0,0 +1,10 @@
+package virtualmachineinstances
+
+type VirtualMachineInstanceProperties struct {
+	HardwareProfile   *VirtualMachineInstancePropertiesHardwareProfile `json:"hardwareProfile,omitempty"`
+	NetworkProfile    *VirtualMachineInstancePropertiesNetworkProfile  `json:"networkProfile,omitempty"`
+	OsProfile         *VirtualMachineInstancePropertiesOsProfile       `json:"osProfile,omitempty"`
+	ProvisioningState *ProvisioningStateEnum                           `json:"provisioningState,omitempty"`
+	StorageProfile    *VirtualMachineInstancePropertiesStorageProfile  `json:"storageProfile,omitempty"`
+	VMId              *string                                          `json:"vmId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesOsProfile struct {
+	AdminPassword *string `json:"adminPassword,omitempty"`
+	AdminUsername *string `json:"adminUsername,omitempty"`
+	ComputerName  *string `json:"computerName,omitempty"`
+}
@@ -0,0 +1,7 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesStorageProfile struct {
+	DataDisks             *[]VirtualMachineInstancePropertiesStorageProfileDataDisksInlined `json:"dataDisks,omitempty"`
+	ImageReference        *VirtualMachineInstancePropertiesStorageProfileImageReference     `json:"imageReference,omitempty"`
+	VMConfigStoragePathId *string                                                           `json:"vmConfigStoragePathId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesStorageProfileDataDisksInlined struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesStorageProfileImageReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,412 @@
+package azurestackhci
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmStackHCILogicalNetwork() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmStackHCILogicalNetworkCreate,
+		Read:   resourceArmStackHCILogicalNetworkRead,
+		Update: resourceArmStackHCILogicalNetworkUpdate,
+		Delete: resourceArmStackHCILogicalNetworkDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := logicalnetworks.ParseLogicalNetworkID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogicalNetworkName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"custom_location_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomLocationID,
+			},
+
+			"virtual_switch_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subnet": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_allocation_method": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(logicalnetworks.IPAllocationMethodEnumDynamic),
+								string(logicalnetworks.IPAllocationMethodEnumStatic),
+							}, false),
+						},
+
+						"address_prefix": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+
+						"vlan_id": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 4094),
+						},
+
+						"route": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"address_prefix": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsCIDR,
+									},
+
+									"next_hop_ip_address": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsIPv4Address,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"dns_servers": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmStackHCILogicalNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.LogicalNetworksClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := logicalnetworks.NewLogicalNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_stack_hci_logical_network", id.ID())
+	}
+
+	subnets, err := expandStackHCILogicalNetworkSubnets(d.Get("subnet").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	extendedLocationType := logicalnetworks.ExtendedLocationTypesCustomLocation
+	parameters := logicalnetworks.LogicalNetworks{
+		Location: location.Normalize(d.Get("location").(string)),
+		ExtendedLocation: &logicalnetworks.ExtendedLocation{
+			Name: utils.String(d.Get("custom_location_id").(string)),
+			Type: &extendedLocationType,
+		},
+		Properties: &logicalnetworks.LogicalNetworkProperties{
+			Subnets:      subnets,
+			VMSwitchName: utils.String(d.Get("virtual_switch_name").(string)),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("dns_servers").([]interface{}); len(v) > 0 {
+		parameters.Properties.DhcpOptions = &logicalnetworks.LogicalNetworkPropertiesDhcpOptions{
+			DnsServers: utils.ExpandStringSlice(v),
+		}
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmStackHCILogicalNetworkRead(d, meta)
+}
+
+func resourceArmStackHCILogicalNetworkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.LogicalNetworksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := logicalnetworks.ParseLogicalNetworkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		customLocationId := ""
+		if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+			parsed, err := parse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+			if err != nil {
+				return err
+			}
+			customLocationId = parsed.ID()
+		}
+		d.Set("custom_location_id", customLocationId)
+
+		if props := model.Properties; props != nil {
+			d.Set("virtual_switch_name", utils.NormalizeNilableString(props.VMSwitchName))
+
+			dnsServers := make([]interface{}, 0)
+			if props.DhcpOptions != nil {
+				dnsServers = utils.FlattenStringSlice(props.DhcpOptions.DnsServers)
+			}
+			if err := d.Set("dns_servers", dnsServers); err != nil {
+				return fmt.Errorf("setting `dns_servers`: %+v", err)
+			}
+
+			if err := d.Set("subnet", flattenStackHCILogicalNetworkSubnets(props.Subnets)); err != nil {
+				return fmt.Errorf("setting `subnet`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStackHCILogicalNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.LogicalNetworksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := logicalnetworks.ParseLogicalNetworkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := logicalnetworks.LogicalNetworksUpdateRequest{}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceArmStackHCILogicalNetworkRead(d, meta)
+}
+
+func resourceArmStackHCILogicalNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.LogicalNetworksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := logicalnetworks.ParseLogicalNetworkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStackHCILogicalNetworkSubnets(input []interface{}) (*[]logicalnetworks.Subnet, error) {
+	results := make([]logicalnetworks.Subnet, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		ipAllocationMethod := logicalnetworks.IPAllocationMethodEnum(v["ip_allocation_method"].(string))
+		addressPrefix := v["address_prefix"].(string)
+		if ipAllocationMethod == logicalnetworks.IPAllocationMethodEnumStatic && addressPrefix == "" {
+			return nil, fmt.Errorf("`subnet.0.address_prefix` must be specified when `subnet.0.ip_allocation_method` is `%s`", ipAllocationMethod)
+		}
+
+		props := logicalnetworks.SubnetPropertiesFormat{
+			IPAllocationMethod: &ipAllocationMethod,
+		}
+
+		if vlanId := v["vlan_id"].(int); vlanId > 0 {
+			props.Vlan = utils.Int64(int64(vlanId))
+		}
+
+		if addressPrefix != "" {
+			props.AddressPrefix = utils.String(addressPrefix)
+		}
+
+		if routesRaw := v["route"].([]interface{}); len(routesRaw) > 0 {
+			routes := make([]logicalnetworks.Route, 0)
+			for _, routeRaw := range routesRaw {
+				if routeRaw == nil {
+					continue
+				}
+				r := routeRaw.(map[string]interface{})
+
+				route := logicalnetworks.Route{
+					Properties: &logicalnetworks.RoutePropertiesFormat{
+						AddressPrefix:    utils.String(r["address_prefix"].(string)),
+						NextHopIPAddress: utils.String(r["next_hop_ip_address"].(string)),
+					},
+				}
+				if name := r["name"].(string); name != "" {
+					route.Name = utils.String(name)
+				}
+
+				routes = append(routes, route)
+			}
+
+			props.RouteTable = &logicalnetworks.RouteTable{
+				Properties: &logicalnetworks.RouteTablePropertiesFormat{
+					Routes: &routes,
+				},
+			}
+		}
+
+		results = append(results, logicalnetworks.Subnet{
+			Properties: &props,
+		})
+	}
+
+	return &results, nil
+}
+
+func flattenStackHCILogicalNetworkSubnets(input *[]logicalnetworks.Subnet) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		props := item.Properties
+		if props == nil {
+			continue
+		}
+
+		ipAllocationMethod := ""
+		if props.IPAllocationMethod != nil {
+			ipAllocationMethod = string(*props.IPAllocationMethod)
+		}
+
+		vlanId := 0
+		if props.Vlan != nil {
+			vlanId = int(*props.Vlan)
+		}
+
+		routes := make([]interface{}, 0)
+		if props.RouteTable != nil && props.RouteTable.Properties != nil && props.RouteTable.Properties.Routes != nil {
+			for _, route := range *props.RouteTable.Properties.Routes {
+				addressPrefix := ""
+				nextHopIpAddress := ""
+				if route.Properties != nil {
+					addressPrefix = utils.NormalizeNilableString(route.Properties.AddressPrefix)
+					nextHopIpAddress = utils.NormalizeNilableString(route.Properties.NextHopIPAddress)
+				}
+
+				routes = append(routes, map[string]interface{}{
+					"name":                utils.NormalizeNilableString(route.Name),
+					"address_prefix":      addressPrefix,
+					"next_hop_ip_address": nextHopIpAddress,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"ip_allocation_method": ipAllocationMethod,
+			"address_prefix":       utils.NormalizeNilableString(props.AddressPrefix),
+			"vlan_id":              vlanId,
+			"route":                routes,
+		})
+	}
+
+	return results
+}
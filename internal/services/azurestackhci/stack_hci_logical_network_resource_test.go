@@ -0,0 +1,175 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCILogicalNetworkResource struct{}
+
+func TestAccStackHCILogicalNetwork_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+	r := StackHCILogicalNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_static(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+	r := StackHCILogicalNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.static(data, "foo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.static(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+	r := StackHCILogicalNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StackHCILogicalNetworkResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := logicalnetworks.ParseLogicalNetworkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.LogicalNetworksClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCILogicalNetworkResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-hci-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StackHCILogicalNetworkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  virtual_switch_name = "ConvergedSwitch(managementcompute)"
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
+
+func (r StackHCILogicalNetworkResource) static(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  virtual_switch_name = "ConvergedSwitch(managementcompute)"
+  dns_servers         = ["10.0.0.7", "10.0.0.8"]
+
+  subnet {
+    ip_allocation_method = "Static"
+    address_prefix       = "10.0.0.0/24"
+    vlan_id              = 123
+
+    route {
+      name                = "test-route"
+      address_prefix      = "0.0.0.0/0"
+      next_hop_ip_address = "10.0.0.1"
+    }
+  }
+
+  tags = {
+    env = %q
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), tag)
+}
+
+func (r StackHCILogicalNetworkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "import" {
+  name                = azurerm_stack_hci_logical_network.test.name
+  resource_group_name = azurerm_stack_hci_logical_network.test.resource_group_name
+  location            = azurerm_stack_hci_logical_network.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+  virtual_switch_name = azurerm_stack_hci_logical_network.test.virtual_switch_name
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,200 @@
+package azurestackhci
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/storagecontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmStackHCIStoragePath() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmStackHCIStoragePathCreate,
+		Read:   resourceArmStackHCIStoragePathRead,
+		Update: resourceArmStackHCIStoragePathUpdate,
+		Delete: resourceArmStackHCIStoragePathDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := storagecontainers.ParseStorageContainerID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StoragePathName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"custom_location_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomLocationID,
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmStackHCIStoragePathCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.StorageContainersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := storagecontainers.NewStorageContainerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_stack_hci_storage_path", id.ID())
+	}
+
+	extendedLocationType := storagecontainers.ExtendedLocationTypesCustomLocation
+	parameters := storagecontainers.StorageContainers{
+		Location: location.Normalize(d.Get("location").(string)),
+		ExtendedLocation: &storagecontainers.ExtendedLocation{
+			Name: utils.String(d.Get("custom_location_id").(string)),
+			Type: &extendedLocationType,
+		},
+		Properties: &storagecontainers.StorageContainerProperties{
+			Path: d.Get("path").(string),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmStackHCIStoragePathRead(d, meta)
+}
+
+func resourceArmStackHCIStoragePathRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.StorageContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := storagecontainers.ParseStorageContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		customLocationId := ""
+		if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+			parsed, err := parse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+			if err != nil {
+				return err
+			}
+			customLocationId = parsed.ID()
+		}
+		d.Set("custom_location_id", customLocationId)
+
+		if props := model.Properties; props != nil {
+			d.Set("path", props.Path)
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStackHCIStoragePathUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.StorageContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := storagecontainers.ParseStorageContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := storagecontainers.StorageContainersUpdateRequest{}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceArmStackHCIStoragePathRead(d, meta)
+}
+
+func resourceArmStackHCIStoragePathDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.StorageContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := storagecontainers.ParseStorageContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,469 @@
+package azurestackhci
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Virtual Machine Instance is an extension resource of an Arc Machine, of which there can only be one
+const stackHCIVirtualMachineInstanceName = "default"
+
+func resourceArmStackHCIVirtualMachineInstance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmStackHCIVirtualMachineInstanceCreate,
+		Read:   resourceArmStackHCIVirtualMachineInstanceRead,
+		Delete: resourceArmStackHCIVirtualMachineInstanceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := virtualmachineinstances.ParseVirtualMachineInstanceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"arc_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.HybridMachineID,
+			},
+
+			"custom_location_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomLocationID,
+			},
+
+			"hardware_profile": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"vm_size": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(stackHCIVirtualMachineInstanceSizes(), false),
+						},
+
+						"processor_count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"memory_in_mb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"os_profile": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"admin_username": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"admin_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"computer_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"storage_profile": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"image_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"vm_config_storage_path_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.StoragePathID,
+						},
+
+						"data_disk_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStackHCIVirtualMachineInstanceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.VirtualMachineInstanceClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	machineId, err := computeParse.HybridMachineID(d.Get("arc_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := virtualmachineinstances.NewVirtualMachineInstanceID(machineId.SubscriptionId, machineId.ResourceGroup, machineId.MachineName, stackHCIVirtualMachineInstanceName)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_stack_hci_virtual_machine_instance", id.ID())
+	}
+
+	networkInterfaces := make([]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined, 0)
+	for _, v := range d.Get("network_interface_ids").([]interface{}) {
+		networkInterfaces = append(networkInterfaces, virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined{
+			Id: utils.String(v.(string)),
+		})
+	}
+
+	extendedLocationType := virtualmachineinstances.ExtendedLocationTypesCustomLocation
+	parameters := virtualmachineinstances.VirtualMachineInstance{
+		ExtendedLocation: &virtualmachineinstances.ExtendedLocation{
+			Name: utils.String(d.Get("custom_location_id").(string)),
+			Type: &extendedLocationType,
+		},
+		Properties: &virtualmachineinstances.VirtualMachineInstanceProperties{
+			HardwareProfile: expandStackHCIVirtualMachineInstanceHardwareProfile(d.Get("hardware_profile").([]interface{})),
+			NetworkProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile{
+				NetworkInterfaces: &networkInterfaces,
+			},
+			OsProfile:      expandStackHCIVirtualMachineInstanceOsProfile(d.Get("os_profile").([]interface{})),
+			StorageProfile: expandStackHCIVirtualMachineInstanceStorageProfile(d.Get("storage_profile").([]interface{})),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmStackHCIVirtualMachineInstanceRead(d, meta)
+}
+
+func resourceArmStackHCIVirtualMachineInstanceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.VirtualMachineInstanceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("arc_machine_id", computeParse.NewHybridMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName).ID())
+
+	if model := resp.Model; model != nil {
+		customLocationId := ""
+		if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+			parsed, err := parse.CustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+			if err != nil {
+				return err
+			}
+			customLocationId = parsed.ID()
+		}
+		d.Set("custom_location_id", customLocationId)
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("hardware_profile", flattenStackHCIVirtualMachineInstanceHardwareProfile(props.HardwareProfile)); err != nil {
+				return fmt.Errorf("setting `hardware_profile`: %+v", err)
+			}
+
+			networkInterfaceIds := make([]interface{}, 0)
+			if props.NetworkProfile != nil && props.NetworkProfile.NetworkInterfaces != nil {
+				for _, nic := range *props.NetworkProfile.NetworkInterfaces {
+					if nic.Id != nil {
+						networkInterfaceIds = append(networkInterfaceIds, *nic.Id)
+					}
+				}
+			}
+			if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+				return fmt.Errorf("setting `network_interface_ids`: %+v", err)
+			}
+
+			if err := d.Set("os_profile", flattenStackHCIVirtualMachineInstanceOsProfile(props.OsProfile, d.Get("os_profile").([]interface{}))); err != nil {
+				return fmt.Errorf("setting `os_profile`: %+v", err)
+			}
+
+			if err := d.Set("storage_profile", flattenStackHCIVirtualMachineInstanceStorageProfile(props.StorageProfile)); err != nil {
+				return fmt.Errorf("setting `storage_profile`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStackHCIVirtualMachineInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AzureStackHCI.VirtualMachineInstanceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func stackHCIVirtualMachineInstanceSizes() []string {
+	return []string{
+		string(virtualmachineinstances.VMSizeEnumCustom),
+		string(virtualmachineinstances.VMSizeEnumDefault),
+		string(virtualmachineinstances.VMSizeEnumStandardA2v2),
+		string(virtualmachineinstances.VMSizeEnumStandardA4v2),
+		string(virtualmachineinstances.VMSizeEnumStandardD16sv3),
+		string(virtualmachineinstances.VMSizeEnumStandardD2sv3),
+		string(virtualmachineinstances.VMSizeEnumStandardD32sv3),
+		string(virtualmachineinstances.VMSizeEnumStandardD4sv3),
+		string(virtualmachineinstances.VMSizeEnumStandardD8sv3),
+		string(virtualmachineinstances.VMSizeEnumStandardDS13v2),
+		string(virtualmachineinstances.VMSizeEnumStandardDS2v2),
+		string(virtualmachineinstances.VMSizeEnumStandardDS3v2),
+		string(virtualmachineinstances.VMSizeEnumStandardDS4v2),
+		string(virtualmachineinstances.VMSizeEnumStandardDS5v2),
+		string(virtualmachineinstances.VMSizeEnumStandardK8S2v1),
+		string(virtualmachineinstances.VMSizeEnumStandardK8S3v1),
+		string(virtualmachineinstances.VMSizeEnumStandardK8S4v1),
+		string(virtualmachineinstances.VMSizeEnumStandardK8S5v1),
+		string(virtualmachineinstances.VMSizeEnumStandardK8Sv1),
+		string(virtualmachineinstances.VMSizeEnumStandardNK12),
+		string(virtualmachineinstances.VMSizeEnumStandardNK6),
+		string(virtualmachineinstances.VMSizeEnumStandardNV12),
+		string(virtualmachineinstances.VMSizeEnumStandardNV6),
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceHardwareProfile(input []interface{}) *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	vmSize := virtualmachineinstances.VMSizeEnum(v["vm_size"].(string))
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile{
+		VMSize: &vmSize,
+	}
+
+	if processors := v["processor_count"].(int); processors > 0 {
+		output.Processors = utils.Int64(int64(processors))
+	}
+
+	if memory := v["memory_in_mb"].(int); memory > 0 {
+		output.MemoryMB = utils.Int64(int64(memory))
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceHardwareProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	vmSize := ""
+	if input.VMSize != nil {
+		vmSize = string(*input.VMSize)
+	}
+
+	processors := 0
+	if input.Processors != nil {
+		processors = int(*input.Processors)
+	}
+
+	memory := 0
+	if input.MemoryMB != nil {
+		memory = int(*input.MemoryMB)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vm_size":         vmSize,
+			"processor_count": processors,
+			"memory_in_mb":    memory,
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceOsProfile(input []interface{}) *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	return &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile{
+		AdminUsername: utils.String(v["admin_username"].(string)),
+		AdminPassword: utils.String(v["admin_password"].(string)),
+		ComputerName:  utils.String(v["computer_name"].(string)),
+	}
+}
+
+func flattenStackHCIVirtualMachineInstanceOsProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the API doesn't return the admin password, so we pull it from the existing state
+	adminPassword := ""
+	if len(existing) > 0 && existing[0] != nil {
+		adminPassword = existing[0].(map[string]interface{})["admin_password"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"admin_username": utils.NormalizeNilableString(input.AdminUsername),
+			"admin_password": adminPassword,
+			"computer_name":  utils.NormalizeNilableString(input.ComputerName),
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceStorageProfile(input []interface{}) *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile{
+		ImageReference: &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileImageReference{
+			Id: utils.String(v["image_id"].(string)),
+		},
+	}
+
+	if storagePathId := v["vm_config_storage_path_id"].(string); storagePathId != "" {
+		output.VMConfigStoragePathId = utils.String(storagePathId)
+	}
+
+	dataDisks := make([]virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined, 0)
+	for _, diskId := range v["data_disk_ids"].([]interface{}) {
+		dataDisks = append(dataDisks, virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined{
+			Id: utils.String(diskId.(string)),
+		})
+	}
+	output.DataDisks = &dataDisks
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceStorageProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	imageId := ""
+	if input.ImageReference != nil {
+		imageId = utils.NormalizeNilableString(input.ImageReference.Id)
+	}
+
+	dataDiskIds := make([]interface{}, 0)
+	if input.DataDisks != nil {
+		for _, disk := range *input.DataDisks {
+			if disk.Id != nil {
+				dataDiskIds = append(dataDiskIds, *disk.Id)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"image_id":                  imageId,
+			"vm_config_storage_path_id": utils.NormalizeNilableString(input.VMConfigStoragePathId),
+			"data_disk_ids":             dataDiskIds,
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+func TestAccStackHCIVirtualMachineInstance_basic(t *testing.T) {
+	for _, v := range []string{"ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID", "ARM_TEST_STACK_HCI_ARC_MACHINE_ID", "ARM_TEST_STACK_HCI_IMAGE_ID", "ARM_TEST_STACK_HCI_NETWORK_INTERFACE_ID"} {
+		if os.Getenv(v) == "" {
+			t.Skipf("Skipping as `%s` was not specified", v)
+		}
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.VirtualMachineInstanceClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCIVirtualMachineInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id        = %q
+  custom_location_id    = %q
+  network_interface_ids = [%q]
+
+  hardware_profile {
+    vm_size         = "Custom"
+    processor_count = 2
+    memory_in_mb    = 8192
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctest%s"
+  }
+
+  storage_profile {
+    image_id = %q
+  }
+}
+`, os.Getenv("ARM_TEST_STACK_HCI_ARC_MACHINE_ID"), os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), os.Getenv("ARM_TEST_STACK_HCI_NETWORK_INTERFACE_ID"), data.RandomString, os.Getenv("ARM_TEST_STACK_HCI_IMAGE_ID"))
+}
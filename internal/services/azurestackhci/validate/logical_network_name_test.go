@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogicalNetworkName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "a",
+			expected: true,
+		},
+		{
+			input:    "test-abc.1_2",
+			expected: true,
+		},
+		{
+			input:    "-test",
+			expected: false,
+		},
+		{
+			input:    "test-",
+			expected: false,
+		},
+		{
+			input:    "test_",
+			expected: true,
+		},
+		{
+			input:    "test abc",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("s", 64),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("s", 65),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := LogicalNetworkName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StoragePathName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]$|^[a-zA-Z0-9][-._a-zA-Z0-9]{0,78}[_a-zA-Z0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 80 characters, begin with an alphanumeric character, end with an alphanumeric character or underscore and may only contain alphanumeric characters, periods, dashes and underscores", k))
+	}
+
+	return warnings, errors
+}
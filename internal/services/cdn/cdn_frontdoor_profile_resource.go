@@ -0,0 +1,375 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2024-02-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type cdnFrontDoorProfileIdentity = identity.SystemAssignedUserAssigned
+
+func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorProfileCreate,
+		Read:   resourceCdnFrontDoorProfileRead,
+		Update: resourceCdnFrontDoorProfileUpdate,
+		Delete: resourceCdnFrontDoorProfileDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := profiles.ParseProfileID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileName(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(profiles.SkuNamePremiumAzureFrontDoor),
+					string(profiles.SkuNameStandardAzureFrontDoor),
+				}, false),
+			},
+
+			"identity": cdnFrontDoorProfileIdentity{}.Schema(),
+
+			"log_scrubbing_rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"match_variable": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(profiles.ScrubbingRuleEntryMatchVariableQueryStringArgNames),
+								string(profiles.ScrubbingRuleEntryMatchVariableRequestIPAddress),
+								string(profiles.ScrubbingRuleEntryMatchVariableRequestUri),
+							}, false),
+						},
+
+						"operator": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(profiles.ScrubbingRuleEntryMatchOperatorEqualsAny),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(profiles.ScrubbingRuleEntryMatchOperatorEqualsAny),
+							}, false),
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"response_timeout_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validation.IntBetween(16, 240),
+			},
+
+			"resource_guid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceCdnFrontDoorProfileCustomizeDiff),
+	}
+}
+
+func resourceCdnFrontDoorProfileCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	// each of the match variables can only be scrubbed by a single rule
+	matchVariables := make(map[string]struct{})
+	for _, raw := range diff.Get("log_scrubbing_rule").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		rule := raw.(map[string]interface{})
+
+		matchVariable := rule["match_variable"].(string)
+		if matchVariable == "" {
+			// the value isn't known until apply time
+			continue
+		}
+		if _, exists := matchVariables[matchVariable]; exists {
+			return fmt.Errorf("only one `log_scrubbing_rule` can be specified for the `match_variable` %q", matchVariable)
+		}
+		matchVariables[matchVariable] = struct{}{}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := profiles.NewProfileID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_profile", id.ID())
+	}
+
+	identity, err := expandCdnFrontDoorProfileIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	skuName := profiles.SkuName(d.Get("sku_name").(string))
+	parameters := profiles.Profile{
+		Identity: identity,
+		// Front Door Profiles are a global resource
+		Location: "global",
+		Properties: &profiles.ProfileProperties{
+			OriginResponseTimeoutSeconds: utils.Int64(int64(d.Get("response_timeout_seconds").(int))),
+		},
+		Sku: profiles.Sku{
+			Name: &skuName,
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if rules := d.Get("log_scrubbing_rule").([]interface{}); len(rules) > 0 {
+		parameters.Properties.LogScrubbing = expandCdnFrontDoorProfileLogScrubbing(rules)
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCdnFrontDoorProfileRead(d, meta)
+}
+
+func resourceCdnFrontDoorProfileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		skuName := ""
+		if model.Sku.Name != nil {
+			skuName = string(*model.Sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
+		if err := d.Set("identity", flattenCdnFrontDoorProfileIdentity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		logScrubbingRules := make([]interface{}, 0)
+		if props := model.Properties; props != nil {
+			d.Set("resource_guid", props.FrontDoorId)
+			d.Set("response_timeout_seconds", props.OriginResponseTimeoutSeconds)
+			logScrubbingRules = flattenCdnFrontDoorProfileLogScrubbing(props.LogScrubbing)
+		}
+		if err := d.Set("log_scrubbing_rule", logScrubbingRules); err != nil {
+			return fmt.Errorf("setting `log_scrubbing_rule`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorProfileUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := profiles.ProfileUpdateParameters{
+		Properties: &profiles.ProfilePropertiesUpdateParameters{},
+	}
+
+	if d.HasChange("identity") {
+		identity, err := expandCdnFrontDoorProfileIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = identity
+	}
+
+	if d.HasChange("log_scrubbing_rule") {
+		parameters.Properties.LogScrubbing = expandCdnFrontDoorProfileLogScrubbing(d.Get("log_scrubbing_rule").([]interface{}))
+	}
+
+	if d.HasChange("response_timeout_seconds") {
+		parameters.Properties.OriginResponseTimeoutSeconds = utils.Int64(int64(d.Get("response_timeout_seconds").(int)))
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorProfileRead(d, meta)
+}
+
+func resourceCdnFrontDoorProfileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorProfileIdentity(input []interface{}) (*identity.SystemUserAssignedIdentityMap, error) {
+	expanded, err := cdnFrontDoorProfileIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := identity.SystemUserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
+}
+
+func flattenCdnFrontDoorProfileIdentity(input *identity.SystemUserAssignedIdentityMap) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	config := input.ToExpandedConfig()
+	return cdnFrontDoorProfileIdentity{}.Flatten(&config)
+}
+
+func expandCdnFrontDoorProfileLogScrubbing(input []interface{}) *profiles.ProfileLogScrubbing {
+	// removing all of the rules disables log scrubbing for the profile
+	state := profiles.ProfileScrubbingStateDisabled
+	rules := make([]profiles.ProfileScrubbingRules, 0)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		ruleState := profiles.ScrubbingRuleEntryStateDisabled
+		if v["enabled"].(bool) {
+			ruleState = profiles.ScrubbingRuleEntryStateEnabled
+		}
+
+		rules = append(rules, profiles.ProfileScrubbingRules{
+			MatchVariable:         profiles.ScrubbingRuleEntryMatchVariable(v["match_variable"].(string)),
+			SelectorMatchOperator: profiles.ScrubbingRuleEntryMatchOperator(v["operator"].(string)),
+			State:                 &ruleState,
+		})
+		state = profiles.ProfileScrubbingStateEnabled
+	}
+
+	return &profiles.ProfileLogScrubbing{
+		ScrubbingRules: &rules,
+		State:          &state,
+	}
+}
+
+func flattenCdnFrontDoorProfileLogScrubbing(input *profiles.ProfileLogScrubbing) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.ScrubbingRules == nil {
+		return output
+	}
+
+	if input.State != nil && *input.State == profiles.ProfileScrubbingStateDisabled {
+		return output
+	}
+
+	for _, v := range *input.ScrubbingRules {
+		enabled := true
+		if v.State != nil {
+			enabled = *v.State == profiles.ScrubbingRuleEntryStateEnabled
+		}
+
+		output = append(output, map[string]interface{}{
+			"enabled":        enabled,
+			"match_variable": string(v.MatchVariable),
+			"operator":       string(v.SelectorMatchOperator),
+		})
+	}
+
+	return output
+}
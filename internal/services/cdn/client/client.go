@@ -3,12 +3,15 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2024-02-01/profiles"
 )
 
 type Client struct {
 	CustomDomainsClient *cdn.CustomDomainsClient
 	EndpointsClient     *cdn.EndpointsClient
 	ProfilesClient      *cdn.ProfilesClient
+
+	FrontDoorProfilesClient *profiles.ProfilesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +24,14 @@ func NewClient(o *common.ClientOptions) *Client {
 	profilesClient := cdn.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&profilesClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorProfilesClient := profiles.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&frontDoorProfilesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CustomDomainsClient: &customDomainsClient,
 		EndpointsClient:     &endpointsClient,
 		ProfilesClient:      &profilesClient,
+
+		FrontDoorProfilesClient: &frontDoorProfilesClient,
 	}
 }
@@ -30,6 +30,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_cdn_endpoint":               resourceCdnEndpoint(),
 		"azurerm_cdn_endpoint_custom_domain": resourceArmCdnEndpointCustomDomain(),
+		"azurerm_cdn_frontdoor_profile":      resourceCdnFrontDoorProfile(),
 		"azurerm_cdn_profile":                resourceCdnProfile(),
 	}
 }
@@ -0,0 +1,45 @@
+package profiles
+
+type ProfileResourceState string
+
+const (
+	ProfileResourceStateActive   ProfileResourceState = "Active"
+	ProfileResourceStateCreating ProfileResourceState = "Creating"
+	ProfileResourceStateDeleting ProfileResourceState = "Deleting"
+	ProfileResourceStateDisabled ProfileResourceState = "Disabled"
+)
+
+type ProfileScrubbingState string
+
+const (
+	ProfileScrubbingStateDisabled ProfileScrubbingState = "Disabled"
+	ProfileScrubbingStateEnabled  ProfileScrubbingState = "Enabled"
+)
+
+type ScrubbingRuleEntryMatchOperator string
+
+const (
+	ScrubbingRuleEntryMatchOperatorEqualsAny ScrubbingRuleEntryMatchOperator = "EqualsAny"
+)
+
+type ScrubbingRuleEntryMatchVariable string
+
+const (
+	ScrubbingRuleEntryMatchVariableQueryStringArgNames ScrubbingRuleEntryMatchVariable = "QueryStringArgNames"
+	ScrubbingRuleEntryMatchVariableRequestIPAddress    ScrubbingRuleEntryMatchVariable = "RequestIPAddress"
+	ScrubbingRuleEntryMatchVariableRequestUri          ScrubbingRuleEntryMatchVariable = "RequestUri"
+)
+
+type ScrubbingRuleEntryState string
+
+const (
+	ScrubbingRuleEntryStateDisabled ScrubbingRuleEntryState = "Disabled"
+	ScrubbingRuleEntryStateEnabled  ScrubbingRuleEntryState = "Enabled"
+)
+
+type SkuName string
+
+const (
+	SkuNamePremiumAzureFrontDoor  SkuName = "Premium_AzureFrontDoor"
+	SkuNameStandardAzureFrontDoor SkuName = "Standard_AzureFrontDoor"
+)
@@ -0,0 +1,6 @@
+package profiles
+
+type ProfileLogScrubbing struct {
+	ScrubbingRules *[]ProfileScrubbingRules `json:"scrubbingRules,omitempty"`
+	State          *ProfileScrubbingState   `json:"state,omitempty"`
+}
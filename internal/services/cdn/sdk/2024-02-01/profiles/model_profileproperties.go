@@ -0,0 +1,9 @@
+package profiles
+
+type ProfileProperties struct {
+	FrontDoorId                  *string               `json:"frontDoorId,omitempty"`
+	LogScrubbing                 *ProfileLogScrubbing  `json:"logScrubbing,omitempty"`
+	OriginResponseTimeoutSeconds *int64                `json:"originResponseTimeoutSeconds,omitempty"`
+	ProvisioningState            *string               `json:"provisioningState,omitempty"`
+	ResourceState                *ProfileResourceState `json:"resourceState,omitempty"`
+}
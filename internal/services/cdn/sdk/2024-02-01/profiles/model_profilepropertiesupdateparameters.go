@@ -0,0 +1,6 @@
+package profiles
+
+type ProfilePropertiesUpdateParameters struct {
+	LogScrubbing                 *ProfileLogScrubbing `json:"logScrubbing,omitempty"`
+	OriginResponseTimeoutSeconds *int64               `json:"originResponseTimeoutSeconds,omitempty"`
+}
@@ -0,0 +1,8 @@
+package profiles
+
+type ProfileScrubbingRules struct {
+	MatchVariable         ScrubbingRuleEntryMatchVariable `json:"matchVariable"`
+	Selector              *string                         `json:"selector,omitempty"`
+	SelectorMatchOperator ScrubbingRuleEntryMatchOperator `json:"selectorMatchOperator"`
+	State                 *ScrubbingRuleEntryState        `json:"state,omitempty"`
+}
@@ -0,0 +1,11 @@
+package profiles
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type ProfileUpdateParameters struct {
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Properties *ProfilePropertiesUpdateParameters      `json:"properties,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package profiles
+
+type Sku struct {
+	Name *SkuName `json:"name,omitempty"`
+}
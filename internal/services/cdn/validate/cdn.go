@@ -75,3 +75,10 @@ func RuleActionUrlRewriteDestination() pluginsdk.SchemaValidateFunc {
 		"The Url Rewrite Destination must start with a slash and can not have more than 260 characters.",
 	)
 }
+
+func FrontDoorProfileName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[\da-zA-Z][-\da-zA-Z]{0,258}[\da-zA-Z]$`),
+		"The Front Door Profile Name must be between 2 and 260 characters, begin and end with a letter or number and may only contain letters, numbers and hyphens.",
+	)
+}
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -328,3 +329,62 @@ func TestRuleActionUrlRewriteSourceDestination(t *testing.T) {
 		})
 	}
 }
+
+func TestFrontDoorProfileName(t *testing.T) {
+	cases := []struct {
+		Name        string
+		ShouldError bool
+	}{
+		{
+			Name:        "",
+			ShouldError: true,
+		},
+		{
+			Name:        "a",
+			ShouldError: true,
+		},
+		{
+			Name:        "ab",
+			ShouldError: false,
+		},
+		{
+			Name:        "example-profile1",
+			ShouldError: false,
+		},
+		{
+			Name:        "-example",
+			ShouldError: true,
+		},
+		{
+			Name:        "example-",
+			ShouldError: true,
+		},
+		{
+			Name:        "example_profile",
+			ShouldError: true,
+		},
+		{
+			Name:        strings.Repeat("a", 260),
+			ShouldError: false,
+		},
+		{
+			Name:        strings.Repeat("a", 261),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := FrontDoorProfileName()(tc.Name, "name")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Name)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Name, len(errors))
+			}
+		})
+	}
+}
@@ -3,10 +3,14 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2021-04-30/cognitiveservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/commitmentplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/commitmenttiers"
 )
 
 type Client struct {
 	AccountsClient        *cognitiveservices.AccountsClient
+	CommitmentPlansClient *commitmentplans.CommitmentPlansClient
+	CommitmentTiersClient *commitmenttiers.CommitmentTiersClient
 	DeletedAccountsClient *cognitiveservices.DeletedAccountsClient
 }
 
@@ -14,11 +18,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := cognitiveservices.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	commitmentPlansClient := commitmentplans.NewCommitmentPlansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&commitmentPlansClient.Client, o.ResourceManagerAuthorizer)
+
+	commitmentTiersClient := commitmenttiers.NewCommitmentTiersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&commitmentTiersClient.Client, o.ResourceManagerAuthorizer)
+
 	deletedAccountsClient := cognitiveservices.NewDeletedAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deletedAccountsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AccountsClient:        &accountsClient,
+		CommitmentPlansClient: &commitmentPlansClient,
+		CommitmentTiersClient: &commitmentTiersClient,
 		DeletedAccountsClient: &deletedAccountsClient,
 	}
 }
@@ -0,0 +1,441 @@
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/commitmentplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/commitmenttiers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCognitiveAccountCommitmentPlan() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCognitiveAccountCommitmentPlanCreate,
+		Read:   resourceCognitiveAccountCommitmentPlanRead,
+		Update: resourceCognitiveAccountCommitmentPlanUpdate,
+		Delete: resourceCognitiveAccountCommitmentPlanDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := commitmentplans.ParseCommitmentPlanID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CognitiveServicesCommitmentPlanName(),
+			},
+
+			"cognitive_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"hosting_model": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(commitmentplans.HostingModelConnectedContainer),
+					string(commitmentplans.HostingModelDisconnectedContainer),
+					string(commitmentplans.HostingModelWeb),
+				}, false),
+			},
+
+			"plan_type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"current_tier": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"current_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"auto_renew": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"commitment_period": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"start_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"end_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"quota_quantity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"quota_unit": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"next_renewal": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"tier": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"start_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCognitiveAccountCommitmentPlanCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.CommitmentPlansClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("cognitive_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := commitmentplans.NewCommitmentPlanID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+
+	locks.ByName(accountId.Name, "azurerm_cognitive_account")
+	defer locks.UnlockByName(accountId.Name, "azurerm_cognitive_account")
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cognitive_account_commitment_plan", id.ID())
+	}
+
+	if err := validateCognitiveAccountCommitmentPlan(ctx, meta, *accountId, d); err != nil {
+		return err
+	}
+
+	hostingModel := commitmentplans.HostingModel(d.Get("hosting_model").(string))
+	parameters := commitmentplans.CommitmentPlan{
+		Properties: &commitmentplans.CommitmentPlanProperties{
+			AutoRenew:    utils.Bool(d.Get("auto_renew").(bool)),
+			HostingModel: &hostingModel,
+			PlanType:     utils.String(d.Get("plan_type").(string)),
+			Current: &commitmentplans.CommitmentPeriod{
+				Tier:  utils.String(d.Get("current_tier").(string)),
+				Count: utils.Int64(int64(d.Get("current_count").(int))),
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCognitiveAccountCommitmentPlanRead(d, meta)
+}
+
+func resourceCognitiveAccountCommitmentPlanRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.CommitmentPlansClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commitmentplans.ParseCommitmentPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("cognitive_account_id", parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			autoRenew := false
+			if props.AutoRenew != nil {
+				autoRenew = *props.AutoRenew
+			}
+			d.Set("auto_renew", autoRenew)
+
+			hostingModel := ""
+			if props.HostingModel != nil {
+				hostingModel = string(*props.HostingModel)
+			}
+			d.Set("hosting_model", hostingModel)
+			d.Set("plan_type", props.PlanType)
+
+			currentTier := ""
+			currentCount := 0
+			if current := props.Current; current != nil {
+				currentTier = utils.NormalizeNilableString(current.Tier)
+				if current.Count != nil {
+					currentCount = int(*current.Count)
+				}
+			}
+			d.Set("current_tier", currentTier)
+			d.Set("current_count", currentCount)
+
+			if err := d.Set("commitment_period", flattenCognitiveAccountCommitmentPlanPeriod(props.Current)); err != nil {
+				return fmt.Errorf("setting `commitment_period`: %+v", err)
+			}
+
+			if err := d.Set("next_renewal", flattenCognitiveAccountCommitmentPlanNextRenewal(props.Next)); err != nil {
+				return fmt.Errorf("setting `next_renewal`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCognitiveAccountCommitmentPlanUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.CommitmentPlansClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commitmentplans.ParseCommitmentPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	if d.HasChange("current_tier") {
+		accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+		if err := validateCognitiveAccountCommitmentPlan(ctx, meta, accountId, d); err != nil {
+			return err
+		}
+	}
+
+	// the read-only properties can't be sent back to the API
+	payload := commitmentplans.CommitmentPlan{
+		Properties: &commitmentplans.CommitmentPlanProperties{
+			AutoRenew:    utils.Bool(d.Get("auto_renew").(bool)),
+			HostingModel: existing.Model.Properties.HostingModel,
+			PlanType:     existing.Model.Properties.PlanType,
+			Current: &commitmentplans.CommitmentPeriod{
+				Tier:  utils.String(d.Get("current_tier").(string)),
+				Count: utils.Int64(int64(d.Get("current_count").(int))),
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCognitiveAccountCommitmentPlanRead(d, meta)
+}
+
+func resourceCognitiveAccountCommitmentPlanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.CommitmentPlansClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commitmentplans.ParseCommitmentPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// validateCognitiveAccountCommitmentPlan confirms that the `plan_type`, `hosting_model` and `current_tier` are available
+// for the kind of the Cognitive Account, since the API otherwise returns a fairly opaque error
+func validateCognitiveAccountCommitmentPlan(ctx context.Context, meta interface{}, accountId parse.AccountId, d *pluginsdk.ResourceData) error {
+	accountsClient := meta.(*clients.Client).Cognitive.AccountsClient
+	tiersClient := meta.(*clients.Client).Cognitive.CommitmentTiersClient
+
+	account, err := accountsClient.Get(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+	if account.Kind == nil || account.Location == nil {
+		return fmt.Errorf("retrieving %s: `kind` or `location` was nil", accountId)
+	}
+	kind := *account.Kind
+
+	locationId := commitmenttiers.NewLocationID(accountId.SubscriptionId, azure.NormalizeLocation(*account.Location))
+	tiers, err := tiersClient.ListComplete(ctx, locationId)
+	if err != nil {
+		return fmt.Errorf("listing the Commitment Tiers available in %s: %+v", locationId, err)
+	}
+
+	planType := d.Get("plan_type").(string)
+	hostingModel := d.Get("hosting_model").(string)
+	currentTier := d.Get("current_tier").(string)
+
+	availablePlanTypes := make(map[string]struct{})
+	availableTiers := make(map[string]struct{})
+	for _, item := range tiers.Items {
+		if !strings.EqualFold(utils.NormalizeNilableString(item.Kind), kind) {
+			continue
+		}
+		if item.HostingModel == nil || !strings.EqualFold(string(*item.HostingModel), hostingModel) {
+			continue
+		}
+
+		itemPlanType := utils.NormalizeNilableString(item.PlanType)
+		availablePlanTypes[itemPlanType] = struct{}{}
+		if strings.EqualFold(itemPlanType, planType) {
+			availableTiers[utils.NormalizeNilableString(item.Tier)] = struct{}{}
+		}
+	}
+
+	if len(availableTiers) == 0 {
+		return fmt.Errorf("the `plan_type` %q is not available for a Cognitive Account of kind %q with the `hosting_model` %q - possible values are %s", planType, kind, hostingModel, cognitiveAccountCommitmentPlanJoinKeys(availablePlanTypes))
+	}
+
+	if _, ok := availableTiers[currentTier]; !ok {
+		return fmt.Errorf("the `current_tier` %q is not available for the `plan_type` %q on a Cognitive Account of kind %q - possible values are %s", currentTier, planType, kind, cognitiveAccountCommitmentPlanJoinKeys(availableTiers))
+	}
+
+	return nil
+}
+
+func cognitiveAccountCommitmentPlanJoinKeys(input map[string]struct{}) string {
+	if len(input) == 0 {
+		return "[]"
+	}
+
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, fmt.Sprintf("%q", k))
+	}
+	sort.Strings(keys)
+
+	return strings.Join(keys, ", ")
+}
+
+func flattenCognitiveAccountCommitmentPlanPeriod(input *commitmentplans.CommitmentPeriod) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	quotaQuantity := 0
+	quotaUnit := ""
+	if quota := input.Quota; quota != nil {
+		if quota.Quantity != nil {
+			quotaQuantity = int(*quota.Quantity)
+		}
+		quotaUnit = utils.NormalizeNilableString(quota.Unit)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"start_date":     utils.NormalizeNilableString(input.StartDate),
+			"end_date":       utils.NormalizeNilableString(input.EndDate),
+			"quota_quantity": quotaQuantity,
+			"quota_unit":     quotaUnit,
+		},
+	}
+}
+
+func flattenCognitiveAccountCommitmentPlanNextRenewal(input *commitmentplans.CommitmentPeriod) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	count := 0
+	if input.Count != nil {
+		count = int(*input.Count)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"tier":       utils.NormalizeNilableString(input.Tier),
+			"count":      count,
+			"start_date": utils.NormalizeNilableString(input.StartDate),
+		},
+	}
+}
@@ -0,0 +1,186 @@
+package cognitive_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2022-10-01/commitmentplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CognitiveAccountCommitmentPlanResource struct {
+}
+
+func TestAccCognitiveAccountCommitmentPlan_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commitment_period.#").HasValue("1"),
+				check.That(data.ResourceName).Key("commitment_period.0.end_date").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCognitiveAccountCommitmentPlan_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCognitiveAccountCommitmentPlan_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoRenew(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_renew").HasValue("true"),
+				check.That(data.ResourceName).Key("next_renewal.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_renew").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCognitiveAccountCommitmentPlan_invalidPlanType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidPlanType(data),
+			ExpectError: regexp.MustCompile("is not available for a Cognitive Account of kind"),
+		},
+	})
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commitmentplans.ParseCommitmentPlanID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cognitive.CommitmentPlansClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CognitiveAccountCommitmentPlanResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "test" {
+  name                 = "acctest-cp-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  hosting_model        = "Web"
+  plan_type            = "TA"
+  current_tier         = "T1"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CognitiveAccountCommitmentPlanResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "import" {
+  name                 = azurerm_cognitive_account_commitment_plan.test.name
+  cognitive_account_id = azurerm_cognitive_account_commitment_plan.test.cognitive_account_id
+  hosting_model        = azurerm_cognitive_account_commitment_plan.test.hosting_model
+  plan_type            = azurerm_cognitive_account_commitment_plan.test.plan_type
+  current_tier         = azurerm_cognitive_account_commitment_plan.test.current_tier
+}
+`, r.basic(data))
+}
+
+func (r CognitiveAccountCommitmentPlanResource) autoRenew(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "test" {
+  name                 = "acctest-cp-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  hosting_model        = "Web"
+  plan_type            = "TA"
+  current_tier         = "T1"
+  auto_renew           = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CognitiveAccountCommitmentPlanResource) invalidPlanType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "test" {
+  name                 = "acctest-cp-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  hosting_model        = "Web"
+  plan_type            = "STT"
+  current_tier         = "T1"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (CognitiveAccountCommitmentPlanResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cognitive-%d"
+  location = "%s"
+}
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctestcogacc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "TextAnalytics"
+  sku_name            = "S"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
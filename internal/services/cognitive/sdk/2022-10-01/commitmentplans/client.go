@@ -0,0 +1,15 @@
+package commitmentplans
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CommitmentPlansClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommitmentPlansClientWithBaseURI(endpoint string) CommitmentPlansClient {
+	return CommitmentPlansClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
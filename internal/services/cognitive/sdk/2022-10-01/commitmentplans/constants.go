@@ -0,0 +1,21 @@
+package commitmentplans
+
+type CommitmentPlanProvisioningState string
+
+const (
+	CommitmentPlanProvisioningStateAccepted  CommitmentPlanProvisioningState = "Accepted"
+	CommitmentPlanProvisioningStateCanceled  CommitmentPlanProvisioningState = "Canceled"
+	CommitmentPlanProvisioningStateCreating  CommitmentPlanProvisioningState = "Creating"
+	CommitmentPlanProvisioningStateDeleting  CommitmentPlanProvisioningState = "Deleting"
+	CommitmentPlanProvisioningStateFailed    CommitmentPlanProvisioningState = "Failed"
+	CommitmentPlanProvisioningStateMoving    CommitmentPlanProvisioningState = "Moving"
+	CommitmentPlanProvisioningStateSucceeded CommitmentPlanProvisioningState = "Succeeded"
+)
+
+type HostingModel string
+
+const (
+	HostingModelConnectedContainer    HostingModel = "ConnectedContainer"
+	HostingModelDisconnectedContainer HostingModel = "DisconnectedContainer"
+	HostingModelWeb                   HostingModel = "Web"
+)
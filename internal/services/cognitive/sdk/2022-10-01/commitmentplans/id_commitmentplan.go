@@ -0,0 +1,126 @@
+package commitmentplans
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type CommitmentPlanId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+func NewCommitmentPlanID(subscriptionId, resourceGroup, accountName, name string) CommitmentPlanId {
+	return CommitmentPlanId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+func (id CommitmentPlanId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Commitment Plan", segmentsStr)
+}
+
+func (id CommitmentPlanId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CognitiveServices/accounts/%s/commitmentPlans/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseCommitmentPlanID parses a CommitmentPlan ID into an CommitmentPlanId struct
+func ParseCommitmentPlanID(input string) (*CommitmentPlanId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CommitmentPlanId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("commitmentPlans"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseCommitmentPlanIDInsensitively parses an CommitmentPlan ID into an CommitmentPlanId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseCommitmentPlanID method should be used instead for validation etc.
+func ParseCommitmentPlanIDInsensitively(input string) (*CommitmentPlanId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CommitmentPlanId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'accounts' segment
+	accountsKey := "accounts"
+	for key := range id.Path {
+		if strings.EqualFold(key, accountsKey) {
+			accountsKey = key
+			break
+		}
+	}
+	if resourceId.AccountName, err = id.PopSegment(accountsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'commitmentPlans' segment
+	commitmentPlansKey := "commitmentPlans"
+	for key := range id.Path {
+		if strings.EqualFold(key, commitmentPlansKey) {
+			commitmentPlansKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(commitmentPlansKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,9 @@
+package commitmentplans
+
+type CommitmentPlan struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *CommitmentPlanProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,12 @@
+package commitmentplans
+
+type CommitmentPlanProperties struct {
+	AutoRenew          *bool                            `json:"autoRenew,omitempty"`
+	CommitmentPlanGuid *string                          `json:"commitmentPlanGuid,omitempty"`
+	Current            *CommitmentPeriod                `json:"current,omitempty"`
+	HostingModel       *HostingModel                    `json:"hostingModel,omitempty"`
+	Last               *CommitmentPeriod                `json:"last,omitempty"`
+	Next               *CommitmentPeriod                `json:"next,omitempty"`
+	PlanType           *string                          `json:"planType,omitempty"`
+	ProvisioningState  *CommitmentPlanProvisioningState `json:"provisioningState,omitempty"`
+}
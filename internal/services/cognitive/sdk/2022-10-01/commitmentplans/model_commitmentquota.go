@@ -0,0 +1,6 @@
+package commitmentplans
+
+type CommitmentQuota struct {
+	Quantity *int64  `json:"quantity,omitempty"`
+	Unit     *string `json:"unit,omitempty"`
+}
@@ -0,0 +1,9 @@
+package commitmentplans
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/commitmentplans/%s", defaultApiVersion)
+}
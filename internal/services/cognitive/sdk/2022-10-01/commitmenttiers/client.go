@@ -0,0 +1,15 @@
+package commitmenttiers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CommitmentTiersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommitmentTiersClientWithBaseURI(endpoint string) CommitmentTiersClient {
+	return CommitmentTiersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
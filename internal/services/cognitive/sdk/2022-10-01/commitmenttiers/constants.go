@@ -0,0 +1,9 @@
+package commitmenttiers
+
+type HostingModel string
+
+const (
+	HostingModelConnectedContainer    HostingModel = "ConnectedContainer"
+	HostingModelDisconnectedContainer HostingModel = "DisconnectedContainer"
+	HostingModelWeb                   HostingModel = "Web"
+)
@@ -0,0 +1,6 @@
+package commitmenttiers
+
+type CommitmentCost struct {
+	CommitmentMeterId *string `json:"commitmentMeterId,omitempty"`
+	OverageMeterId    *string `json:"overageMeterId,omitempty"`
+}
@@ -0,0 +1,12 @@
+package commitmenttiers
+
+type CommitmentTier struct {
+	Cost         *CommitmentCost  `json:"cost,omitempty"`
+	HostingModel *HostingModel    `json:"hostingModel,omitempty"`
+	Kind         *string          `json:"kind,omitempty"`
+	MaxCount     *int64           `json:"maxCount,omitempty"`
+	PlanType     *string          `json:"planType,omitempty"`
+	Quota        *CommitmentQuota `json:"quota,omitempty"`
+	SkuName      *string          `json:"skuName,omitempty"`
+	Tier         *string          `json:"tier,omitempty"`
+}
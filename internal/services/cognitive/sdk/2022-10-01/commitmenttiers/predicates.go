@@ -0,0 +1,29 @@
+package commitmenttiers
+
+type CommitmentTierPredicate struct {
+	Kind     *string
+	PlanType *string
+	SkuName  *string
+	Tier     *string
+}
+
+func (p CommitmentTierPredicate) Matches(input CommitmentTier) bool {
+
+	if p.Kind != nil && (input.Kind == nil && *p.Kind != *input.Kind) {
+		return false
+	}
+
+	if p.PlanType != nil && (input.PlanType == nil && *p.PlanType != *input.PlanType) {
+		return false
+	}
+
+	if p.SkuName != nil && (input.SkuName == nil && *p.SkuName != *input.SkuName) {
+		return false
+	}
+
+	if p.Tier != nil && (input.Tier == nil && *p.Tier != *input.Tier) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,9 @@
+package commitmenttiers
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/commitmenttiers/%s", defaultApiVersion)
+}
@@ -13,3 +13,10 @@ func CognitiveServicesAccountName() pluginsdk.SchemaValidateFunc {
 		"The Cognitive Services Account Name can only start with an alphanumeric character, and must only contain alphanumeric characters, periods, dashes or underscores.",
 	)
 }
+
+func CognitiveServicesCommitmentPlanName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^([a-zA-Z0-9]{1}[a-zA-Z0-9_.-]{0,63})$"),
+		"The Cognitive Services Commitment Plan Name can only start with an alphanumeric character, must be between 1 and 64 characters long and must only contain alphanumeric characters, periods, dashes or underscores.",
+	)
+}
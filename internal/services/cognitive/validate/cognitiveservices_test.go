@@ -76,3 +76,56 @@ func TestValidateCognitiveServicesAccountName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCognitiveServicesCommitmentPlanName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "empty name",
+			input: "",
+			valid: false,
+		},
+		{
+			name:  "Valid single character",
+			input: "a",
+			valid: true,
+		},
+		{
+			name:  "Valid name with special characters",
+			input: "plan_-.1",
+			valid: true,
+		},
+		{
+			name:  "Invalid with a dash at the start",
+			input: "-plan",
+			valid: false,
+		},
+		{
+			name:  "Invalid character",
+			input: "plan!",
+			valid: false,
+		},
+		{
+			name:  "Valid 64 characters",
+			input: "a123456789012345678901234567890123456789012345678901234567890123",
+			valid: true,
+		},
+		{
+			name:  "Invalid 65 characters",
+			input: "a1234567890123456789012345678901234567890123456789012345678901234",
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CognitiveServicesCommitmentPlanName()(tt.input, "")
+			valid := err == nil
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for input %s", tt.valid, valid, tt.input)
+			}
+		})
+	}
+}
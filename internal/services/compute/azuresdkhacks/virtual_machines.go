@@ -0,0 +1,199 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `diskControllerType` and `scheduledEventsProfile` fields are only available from API Version `2022-08-01`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const virtualMachinesAdditionalPropertiesApiVersion = "2022-08-01"
+
+type VirtualMachinesWorkaroundClient struct {
+	sdkClient *compute.VirtualMachinesClient
+}
+
+func NewVirtualMachinesWorkaroundClient(client *compute.VirtualMachinesClient) VirtualMachinesWorkaroundClient {
+	return VirtualMachinesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// VirtualMachineAdditionalProperties are the properties of a Virtual Machine which aren't available in the
+// API Version used by the Azure SDK for Go - only those which are non-nil are sent.
+type VirtualMachineAdditionalProperties struct {
+	DiskControllerType     *string
+	ScheduledEventsProfile *VirtualMachineScheduledEventsProfile
+}
+
+// CreateOrUpdate creates or updates a virtual machine, including the additional properties.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// VMName - the name of the virtual machine.
+// parameters - parameters supplied to the Create Virtual Machine operation.
+// additionalProperties - the properties which aren't available in the API Version used by the Azure SDK for Go.
+func (client VirtualMachinesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, VMName string, parameters compute.VirtualMachine, additionalProperties VirtualMachineAdditionalProperties) (result compute.VirtualMachinesCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, VMName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withAdditionalProperties(additionalProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// Update updates a virtual machine, including the additional properties.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// VMName - the name of the virtual machine.
+// parameters - parameters supplied to the Update Virtual Machine operation.
+// additionalProperties - the properties which aren't available in the API Version used by the Azure SDK for Go.
+func (client VirtualMachinesWorkaroundClient) Update(ctx context.Context, resourceGroupName string, VMName string, parameters compute.VirtualMachineUpdate, additionalProperties VirtualMachineAdditionalProperties) (result compute.VirtualMachinesUpdateFuture, err error) {
+	req, err := client.sdkClient.UpdatePreparer(ctx, resourceGroupName, VMName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withAdditionalProperties(additionalProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.UpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "Update", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetAdditionalProperties returns the additional properties of the specified virtual machine.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// VMName - the name of the virtual machine.
+func (client VirtualMachinesWorkaroundClient) GetAdditionalProperties(ctx context.Context, resourceGroupName string, VMName string) (result VirtualMachineWithAdditionalProperties, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, VMName, "")
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "GetAdditionalProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", virtualMachinesAdditionalPropertiesApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "GetAdditionalProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "compute.VirtualMachinesClient", "GetAdditionalProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type VirtualMachineWithAdditionalProperties struct {
+	autorest.Response `json:"-"`
+	Properties        *VirtualMachineAdditionalPropertiesProperties `json:"properties,omitempty"`
+}
+
+type VirtualMachineAdditionalPropertiesProperties struct {
+	StorageProfile         *VirtualMachineDiskControllerTypeStorageProfile `json:"storageProfile,omitempty"`
+	ScheduledEventsProfile *VirtualMachineScheduledEventsProfile           `json:"scheduledEventsProfile,omitempty"`
+}
+
+type VirtualMachineDiskControllerTypeStorageProfile struct {
+	DiskControllerType *string `json:"diskControllerType,omitempty"`
+}
+
+type VirtualMachineScheduledEventsProfile struct {
+	OSImageNotificationProfile   *VirtualMachineScheduledEventNotificationProfile `json:"osImageNotificationProfile,omitempty"`
+	TerminateNotificationProfile *VirtualMachineScheduledEventNotificationProfile `json:"terminateNotificationProfile,omitempty"`
+}
+
+type VirtualMachineScheduledEventNotificationProfile struct {
+	NotBeforeTimeout *string `json:"notBeforeTimeout,omitempty"`
+	Enable           *bool   `json:"enable,omitempty"`
+}
+
+// withAdditionalProperties sets the additional properties which are specified within the request body and updates the
+// API Version used for the request to one which supports these fields.
+func withAdditionalProperties(additionalProperties VirtualMachineAdditionalProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			if additionalProperties.DiskControllerType != nil {
+				storageProfile, ok := properties["storageProfile"].(map[string]interface{})
+				if !ok {
+					storageProfile = make(map[string]interface{})
+				}
+				storageProfile["diskControllerType"] = *additionalProperties.DiskControllerType
+				properties["storageProfile"] = storageProfile
+			}
+			if additionalProperties.ScheduledEventsProfile != nil {
+				properties["scheduledEventsProfile"] = additionalProperties.ScheduledEventsProfile
+			}
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", virtualMachinesAdditionalPropertiesApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -2,9 +2,13 @@ package compute
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
@@ -358,3 +362,158 @@ func flattenVirtualMachineOSDisk(ctx context.Context, disksClient *compute.Disks
 		},
 	}, nil
 }
+
+func virtualMachineDiskControllerTypeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"SCSI",
+			"NVMe",
+		}, false),
+	}
+}
+
+// the NVMe disk controller is only supported by a subset of the (Generation 2) Virtual Machine sizes, see:
+// https://learn.microsoft.com/azure/virtual-machines/nvme-overview
+var virtualMachineNVMeDiskControllerSizes = []*regexp.Regexp{
+	// e.g. Standard_D4s_v6, Standard_E8ads_v6, Standard_F16als_v6
+	regexp.MustCompile(`(?i)^Standard_[A-Z]+\d+[a-z]*_v6$`),
+	// e.g. Standard_E4bs_v5, Standard_E8bds_v5
+	regexp.MustCompile(`(?i)^Standard_E\d+bd?s_v5$`),
+	// e.g. Standard_M176s_3_v3, Standard_M416ds_6_v3
+	regexp.MustCompile(`(?i)^Standard_M\d+[a-z]*(_\d+)?_v3$`),
+	// e.g. Standard_NC40ads_H100_v5, Standard_ND96isr_H100_v5
+	regexp.MustCompile(`(?i)^Standard_N[CD]\d+[a-z]*_H100_v5$`),
+}
+
+func virtualMachineSizeSupportsNVMeDiskController(size string) bool {
+	for _, r := range virtualMachineNVMeDiskControllerSizes {
+		if r.MatchString(size) {
+			return true
+		}
+	}
+	return false
+}
+
+func virtualMachineDiskControllerTypeCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Get("disk_controller_type").(string) != "NVMe" || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	if size := d.Get("size").(string); !virtualMachineSizeSupportsNVMeDiskController(size) {
+		return fmt.Errorf("the Virtual Machine size %q does not support the `NVMe` `disk_controller_type`", size)
+	}
+
+	return nil
+}
+
+func virtualMachineOSImageNotificationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT15M",
+					ValidateFunc: azValidate.ISO8601DurationBetween("PT5M", "PT15M"),
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineTerminationNotificationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"enabled": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+				"timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT5M",
+					ValidateFunc: azValidate.ISO8601DurationBetween("PT5M", "PT15M"),
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScheduledEventsProfile(osImageNotification []interface{}, terminationNotification []interface{}) *azuresdkhacks.VirtualMachineScheduledEventsProfile {
+	// when a block is removed the notification needs to be explicitly disabled, since omitting it leaves it unchanged
+	osImageNotificationProfile := &azuresdkhacks.VirtualMachineScheduledEventNotificationProfile{
+		Enable: utils.Bool(false),
+	}
+	if len(osImageNotification) > 0 && osImageNotification[0] != nil {
+		raw := osImageNotification[0].(map[string]interface{})
+		osImageNotificationProfile = &azuresdkhacks.VirtualMachineScheduledEventNotificationProfile{
+			Enable:           utils.Bool(true),
+			NotBeforeTimeout: utils.String(raw["timeout"].(string)),
+		}
+	}
+
+	terminateNotificationProfile := &azuresdkhacks.VirtualMachineScheduledEventNotificationProfile{
+		Enable: utils.Bool(false),
+	}
+	if len(terminationNotification) > 0 && terminationNotification[0] != nil {
+		raw := terminationNotification[0].(map[string]interface{})
+		terminateNotificationProfile = &azuresdkhacks.VirtualMachineScheduledEventNotificationProfile{
+			Enable:           utils.Bool(raw["enabled"].(bool)),
+			NotBeforeTimeout: utils.String(raw["timeout"].(string)),
+		}
+	}
+
+	return &azuresdkhacks.VirtualMachineScheduledEventsProfile{
+		OSImageNotificationProfile:   osImageNotificationProfile,
+		TerminateNotificationProfile: terminateNotificationProfile,
+	}
+}
+
+func flattenVirtualMachineOSImageNotification(input *azuresdkhacks.VirtualMachineScheduledEventsProfile) []interface{} {
+	if input == nil || input.OSImageNotificationProfile == nil || input.OSImageNotificationProfile.Enable == nil || !*input.OSImageNotificationProfile.Enable {
+		return []interface{}{}
+	}
+
+	timeout := "PT15M"
+	if input.OSImageNotificationProfile.NotBeforeTimeout != nil {
+		timeout = *input.OSImageNotificationProfile.NotBeforeTimeout
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"timeout": timeout,
+		},
+	}
+}
+
+func flattenVirtualMachineTerminationNotification(input *azuresdkhacks.VirtualMachineScheduledEventsProfile) []interface{} {
+	// if enabled is set to false, there will be no TerminateNotificationProfile in response, to avoid plan non empty when
+	// a user explicitly set enabled to false, we need to assign a default block to this field
+	enabled := false
+	if input != nil && input.TerminateNotificationProfile != nil && input.TerminateNotificationProfile.Enable != nil {
+		enabled = *input.TerminateNotificationProfile.Enable
+	}
+
+	timeout := "PT5M"
+	if input != nil && input.TerminateNotificationProfile != nil && input.TerminateNotificationProfile.NotBeforeTimeout != nil {
+		timeout = *input.TerminateNotificationProfile.NotBeforeTimeout
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"timeout": timeout,
+		},
+	}
+}
@@ -398,12 +398,15 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 					Schema: map[string]*pluginsdk.Schema{
 						"enabled": {
 							Type:     pluginsdk.TypeBool,
-							Required: true,
+							Optional: true,
+							Default:  true,
 						},
 
+						// when omitted, boot diagnostics are stored in a Managed Storage Account
 						"storage_uri": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
 						},
 					},
 				},
@@ -1172,17 +1175,23 @@ func flattenAzureRmVirtualMachineDiagnosticsProfile(profile *compute.BootDiagnos
 		return []interface{}{}
 	}
 
-	result := make(map[string]interface{})
-
+	enabled := false
 	if profile.Enabled != nil {
-		result["enabled"] = *profile.Enabled
+		enabled = *profile.Enabled
 	}
 
+	// the Storage URI is returned as null when the Managed Storage Account is used
+	storageUri := ""
 	if profile.StorageURI != nil {
-		result["storage_uri"] = *profile.StorageURI
+		storageUri = *profile.StorageURI
 	}
 
-	return []interface{}{result}
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":     enabled,
+			"storage_uri": storageUri,
+		},
+	}
 }
 
 func flattenAzureRmVirtualMachineAdditionalCapabilities(profile *compute.AdditionalCapabilities) []interface{} {
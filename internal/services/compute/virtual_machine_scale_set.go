@@ -3,6 +3,8 @@ package compute
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -1443,11 +1445,15 @@ func VirtualMachineScaleSetAutomaticRepairsPolicySchema() *pluginsdk.Schema {
 					Required: true,
 				},
 				"grace_period": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT30M",
+					ValidateFunc: azValidate.ISO8601DurationBetween("PT30M", "PT90M"),
+				},
+
+				"service_state": {
 					Type:     pluginsdk.TypeString,
-					Optional: true,
-					Default:  "PT30M",
-					// this field actually has a range from 30m to 90m, is there a function that can do this validation?
-					ValidateFunc: azValidate.ISO8601Duration,
+					Computed: true,
 				},
 			},
 		},
@@ -1467,7 +1473,7 @@ func ExpandVirtualMachineScaleSetAutomaticRepairsPolicy(input []interface{}) *co
 	}
 }
 
-func FlattenVirtualMachineScaleSetAutomaticRepairsPolicy(input *compute.AutomaticRepairsPolicy) []interface{} {
+func FlattenVirtualMachineScaleSetAutomaticRepairsPolicy(input *compute.AutomaticRepairsPolicy, serviceState string) []interface{} {
 	// if enabled is set to false, there will be no AutomaticRepairsPolicy in response, to avoid plan non empty when
 	// a user explicitly set enabled to false, we need to assign a default block to this field
 
@@ -1483,12 +1489,44 @@ func FlattenVirtualMachineScaleSetAutomaticRepairsPolicy(input *compute.Automati
 
 	return []interface{}{
 		map[string]interface{}{
-			"enabled":      enabled,
-			"grace_period": gracePeriod,
+			"enabled":       enabled,
+			"grace_period":  gracePeriod,
+			"service_state": serviceState,
 		},
 	}
 }
 
+// FlattenVirtualMachineScaleSetAutomaticRepairsServiceState returns the state of the Automatic Repairs orchestration
+// service, which is only available from the Instance View of the Virtual Machine Scale Set
+func FlattenVirtualMachineScaleSetAutomaticRepairsServiceState(input *compute.VirtualMachineScaleSetInstanceView) string {
+	if input == nil || input.OrchestrationServices == nil {
+		return ""
+	}
+
+	for _, v := range *input.OrchestrationServices {
+		if v.ServiceName == compute.OrchestrationServiceNamesAutomaticRepairs {
+			return string(v.ServiceState)
+		}
+	}
+
+	return ""
+}
+
+// ValidateVirtualMachineScaleSetAutomaticRepairsHealthSource ensures that either a Health Probe or an Application
+// Health Extension is available when Automatic Instance Repairs are enabled, since otherwise the service returns the error:
+// Automatic repairs is not supported for this Virtual Machine Scale Set because a health probe or health extension was not specified.
+func ValidateVirtualMachineScaleSetAutomaticRepairsHealthSource(input *compute.AutomaticRepairsPolicy, healthProbeId string, hasHealthExtension bool) error {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return nil
+	}
+
+	if healthProbeId == "" && !hasHealthExtension {
+		return fmt.Errorf("`health_probe_id` must be set or an `ApplicationHealthLinux` or `ApplicationHealthWindows` extension must be specified when `automatic_instance_repair` is enabled")
+	}
+
+	return nil
+}
+
 func VirtualMachineScaleSetExtensionsSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeSet,
@@ -1660,11 +1698,92 @@ func expandVirtualMachineScaleSetExtensions(input []interface{}) (extensionProfi
 		extension.VirtualMachineScaleSetExtensionProperties = &extensionProps
 		extensions = append(extensions, extension)
 	}
+
+	if err := validateVirtualMachineScaleSetExtensionProvisioningOrder(virtualMachineScaleSetExtensionDependencies(&extensions)); err != nil {
+		return nil, false, err
+	}
 	extensionProfile.Extensions = &extensions
 
 	return extensionProfile, hasHealthExtension, nil
 }
 
+// virtualMachineScaleSetExtensionDependencies returns a map of Extension Name to the names of the Extensions
+// it should be provisioned after
+func virtualMachineScaleSetExtensionDependencies(input *[]compute.VirtualMachineScaleSetExtension) map[string][]string {
+	output := make(map[string][]string)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Name == nil {
+			continue
+		}
+
+		dependencies := make([]string, 0)
+		if props := v.VirtualMachineScaleSetExtensionProperties; props != nil && props.ProvisionAfterExtensions != nil {
+			dependencies = *props.ProvisionAfterExtensions
+		}
+		output[*v.Name] = dependencies
+	}
+
+	return output
+}
+
+// validateVirtualMachineScaleSetExtensionProvisioningOrder ensures that each Extension referenced within
+// `provision_after_extensions` exists on the Virtual Machine Scale Set and that the ordering doesn't contain a cycle
+func validateVirtualMachineScaleSetExtensionProvisioningOrder(extensions map[string][]string) error {
+	names := make([]string, 0, len(extensions))
+	for name := range extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, dependency := range extensions[name] {
+			if strings.EqualFold(dependency, name) {
+				return fmt.Errorf("the Extension %q cannot be provisioned after itself", name)
+			}
+			if _, ok := extensions[dependency]; !ok {
+				return fmt.Errorf("the Extension %q is configured to be provisioned after the Extension %q which does not exist on the Virtual Machine Scale Set", name, dependency)
+			}
+		}
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("the Extensions contain a cycle within `provision_after_extensions`: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+		for _, dependency := range extensions[name] {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, []string{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func flattenVirtualMachineScaleSetExtensions(input *compute.VirtualMachineScaleSetExtensionProfile, d *pluginsdk.ResourceData) ([]map[string]interface{}, error) {
 	result := make([]map[string]interface{}, 0)
 	if input == nil || input.Extensions == nil {
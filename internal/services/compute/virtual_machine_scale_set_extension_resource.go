@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -149,6 +150,9 @@ func resourceVirtualMachineScaleSetExtensionCreate(d *pluginsdk.ResourceData, me
 
 	provisionAfterExtensionsRaw := d.Get("provision_after_extensions").([]interface{})
 	provisionAfterExtensions := utils.ExpandStringSlice(provisionAfterExtensionsRaw)
+	if err := validateVirtualMachineScaleSetExtensionProvisionAfterExtensions(ctx, meta.(*clients.Client).Compute.VMScaleSetClient, *virtualMachineScaleSetId, name, *provisionAfterExtensions); err != nil {
+		return err
+	}
 
 	protectedSettings := map[string]interface{}{}
 	if protectedSettingsString := d.Get("protected_settings").(string); protectedSettingsString != "" {
@@ -230,6 +234,11 @@ func resourceVirtualMachineScaleSetExtensionUpdate(d *pluginsdk.ResourceData, me
 	if d.HasChange("provision_after_extensions") {
 		provisionAfterExtensionsRaw := d.Get("provision_after_extensions").([]interface{})
 		props.ProvisionAfterExtensions = utils.ExpandStringSlice(provisionAfterExtensionsRaw)
+
+		virtualMachineScaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+		if err := validateVirtualMachineScaleSetExtensionProvisionAfterExtensions(ctx, meta.(*clients.Client).Compute.VMScaleSetClient, virtualMachineScaleSetId, id.ExtensionName, *props.ProvisionAfterExtensions); err != nil {
+			return err
+		}
 	}
 
 	if d.HasChange("publisher") {
@@ -357,3 +366,30 @@ func resourceVirtualMachineScaleSetExtensionDelete(d *pluginsdk.ResourceData, me
 
 	return nil
 }
+
+// validateVirtualMachineScaleSetExtensionProvisionAfterExtensions checks the `provision_after_extensions` for this Extension
+// against the other Extensions deployed to the Virtual Machine Scale Set
+func validateVirtualMachineScaleSetExtensionProvisionAfterExtensions(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, id parse.VirtualMachineScaleSetId, name string, provisionAfterExtensions []string) error {
+	if len(provisionAfterExtensions) == 0 {
+		return nil
+	}
+
+	vmss, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var existing *[]compute.VirtualMachineScaleSetExtension
+	if props := vmss.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil && props.VirtualMachineProfile.ExtensionProfile != nil {
+		existing = props.VirtualMachineProfile.ExtensionProfile.Extensions
+	}
+
+	extensions := virtualMachineScaleSetExtensionDependencies(existing)
+	extensions[name] = provisionAfterExtensions
+
+	if err := validateVirtualMachineScaleSetExtensionProvisioningOrder(extensions); err != nil {
+		return fmt.Errorf("validating `provision_after_extensions` for Extension %q (%s): %+v", name, id, err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -93,6 +94,18 @@ func TestAccVirtualMachineScaleSetExtension_extensionChaining(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineScaleSetExtension_extensionChainingMissingExtension(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
+	r := VirtualMachineScaleSetExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.extensionChainingMissingExtension(data),
+			ExpectError: regexp.MustCompile("which does not exist on the Virtual Machine Scale Set"),
+		},
+	})
+}
+
 func TestAccVirtualMachineScaleSetExtension_forceUpdateTag(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
 	r := VirtualMachineScaleSetExtensionResource{}
@@ -270,6 +283,24 @@ resource "azurerm_virtual_machine_scale_set_extension" "second" {
 `, r.templateLinux(data), data.RandomInteger, data.RandomInteger)
 }
 
+func (r VirtualMachineScaleSetExtensionResource) extensionChainingMissingExtension(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%d"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  settings = jsonencode({
+    "commandToExecute" = "echo $HOSTNAME"
+  })
+  provision_after_extensions = ["acctestMissing-%d"]
+}
+`, r.templateLinux(data), data.RandomInteger, data.RandomInteger)
+}
+
 func (r VirtualMachineScaleSetExtensionResource) forceUpdateTag(data acceptance.TestData, tag string) string {
 	return fmt.Sprintf(`
 %s
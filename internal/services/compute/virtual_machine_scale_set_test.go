@@ -0,0 +1,80 @@
+package compute
+
+import (
+	"testing"
+)
+
+func TestValidateVirtualMachineScaleSetExtensionProvisioningOrder(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Extensions map[string][]string
+		ShouldErr  bool
+	}{
+		{
+			Name:       "no extensions",
+			Extensions: map[string][]string{},
+			ShouldErr:  false,
+		},
+		{
+			Name: "no ordering",
+			Extensions: map[string][]string{
+				"first":  {},
+				"second": {},
+			},
+			ShouldErr: false,
+		},
+		{
+			Name: "chained ordering",
+			Extensions: map[string][]string{
+				"CustomScript": {},
+				"Monitoring":   {"CustomScript"},
+				"Health":       {"CustomScript", "Monitoring"},
+			},
+			ShouldErr: false,
+		},
+		{
+			Name: "missing extension",
+			Extensions: map[string][]string{
+				"Monitoring": {"CustomScript"},
+			},
+			ShouldErr: true,
+		},
+		{
+			Name: "self reference",
+			Extensions: map[string][]string{
+				"CustomScript": {"CustomScript"},
+			},
+			ShouldErr: true,
+		},
+		{
+			Name: "direct cycle",
+			Extensions: map[string][]string{
+				"first":  {"second"},
+				"second": {"first"},
+			},
+			ShouldErr: true,
+		},
+		{
+			Name: "indirect cycle",
+			Extensions: map[string][]string{
+				"first":  {"third"},
+				"second": {"first"},
+				"third":  {"second"},
+				"fourth": {},
+			},
+			ShouldErr: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateVirtualMachineScaleSetExtensionProvisioningOrder(v.Extensions)
+		if v.ShouldErr && err == nil {
+			t.Fatalf("Expected an error but didn't get one for %q", v.Name)
+		}
+		if !v.ShouldErr && err != nil {
+			t.Fatalf("Expected no error but got %+v for %q", err, v.Name)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package compute
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineSizeSupportsNVMeDiskController(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "Standard_DS3_v2",
+			Expected: false,
+		},
+		{
+			Input:    "Standard_D4s_v5",
+			Expected: false,
+		},
+		{
+			Input:    "Standard_D4s_v6",
+			Expected: true,
+		},
+		{
+			Input:    "Standard_E8ads_v6",
+			Expected: true,
+		},
+		{
+			Input:    "Standard_E2bds_v5",
+			Expected: true,
+		},
+		{
+			Input:    "standard_e4bs_v5",
+			Expected: true,
+		},
+		{
+			Input:    "Standard_M416ds_6_v3",
+			Expected: true,
+		},
+		{
+			Input:    "Standard_ND96isr_H100_v5",
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		actual := virtualMachineSizeSupportsNVMeDiskController(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t for %q", v.Expected, actual, v.Input)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -40,6 +41,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			return err
 		}, importVirtualMachine(compute.OperatingSystemTypesWindows, "azurerm_windows_virtual_machine")),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachineDiskControllerTypeCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -151,6 +154,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"disk_controller_type": virtualMachineDiskControllerTypeSchema(),
+
 			"encryption_at_host_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -212,6 +217,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"os_image_notification": virtualMachineOSImageNotificationSchema(),
+
 			"plan": planSchema(),
 
 			"priority": {
@@ -255,6 +262,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 
 			"source_image_reference": sourceImageReferenceSchema(true),
 
+			"termination_notification": virtualMachineTerminationNotificationSchema(),
+
 			"tags": tags.Schema(),
 
 			"timezone": {
@@ -536,7 +545,22 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	additionalProperties := azuresdkhacks.VirtualMachineAdditionalProperties{}
+	if v, ok := d.GetOk("disk_controller_type"); ok {
+		additionalProperties.DiskControllerType = utils.String(v.(string))
+	}
+	osImageNotificationRaw := d.Get("os_image_notification").([]interface{})
+	terminationNotificationRaw := d.Get("termination_notification").([]interface{})
+	if len(osImageNotificationRaw) > 0 || len(terminationNotificationRaw) > 0 {
+		additionalProperties.ScheduledEventsProfile = expandVirtualMachineScheduledEventsProfile(osImageNotificationRaw, terminationNotificationRaw)
+	}
+
+	var future compute.VirtualMachinesCreateOrUpdateFuture
+	if additionalProperties.DiskControllerType != nil || additionalProperties.ScheduledEventsProfile != nil {
+		future, err = azuresdkhacks.NewVirtualMachinesWorkaroundClient(client).CreateOrUpdate(ctx, resourceGroup, name, params, additionalProperties)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	}
 	if err != nil {
 		return fmt.Errorf("creating Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -730,6 +754,34 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 	}
 	d.Set("encryption_at_host_enabled", encryptionAtHostEnabled)
 
+	// these are retrieved using a newer API Version, which may not be available (e.g. in some National Clouds) - in
+	// which case the existing values are retained rather than failing to read the Virtual Machine
+	additionalPropertiesResp, err := azuresdkhacks.NewVirtualMachinesWorkaroundClient(client).GetAdditionalProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasBadRequest(additionalPropertiesResp.Response) && !utils.ResponseWasNotFound(additionalPropertiesResp.Response) {
+			return fmt.Errorf("retrieving the additional properties for Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] unable to retrieve the additional properties for Windows Virtual Machine %q (Resource Group %q) - retaining the existing values: %+v", id.Name, id.ResourceGroup, err)
+	} else {
+		diskControllerType := ""
+		var scheduledEventsProfile *azuresdkhacks.VirtualMachineScheduledEventsProfile
+		if props := additionalPropertiesResp.Properties; props != nil {
+			if props.StorageProfile != nil && props.StorageProfile.DiskControllerType != nil {
+				diskControllerType = *props.StorageProfile.DiskControllerType
+			}
+			scheduledEventsProfile = props.ScheduledEventsProfile
+		}
+		d.Set("disk_controller_type", diskControllerType)
+
+		if err := d.Set("os_image_notification", flattenVirtualMachineOSImageNotification(scheduledEventsProfile)); err != nil {
+			return fmt.Errorf("setting `os_image_notification`: %+v", err)
+		}
+
+		if err := d.Set("termination_notification", flattenVirtualMachineTerminationNotification(scheduledEventsProfile)); err != nil {
+			return fmt.Errorf("setting `termination_notification`: %+v", err)
+		}
+	}
+
 	d.Set("virtual_machine_id", props.VMID)
 
 	zone := ""
@@ -1006,6 +1058,17 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.VirtualMachineProperties.AdditionalCapabilities = expandVirtualMachineAdditionalCapabilities(additionalCapabilitiesRaw)
 	}
 
+	if d.HasChange("disk_controller_type") {
+		shouldUpdate = true
+		// the Disk Controller Type can only be changed when the Virtual Machine is deallocated
+		shouldShutDown = true
+		shouldDeallocate = true
+	}
+
+	if d.HasChanges("os_image_notification", "termination_notification") {
+		shouldUpdate = true
+	}
+
 	if d.HasChange("encryption_at_host_enabled") {
 		shouldUpdate = true
 		shouldDeallocate = true // API returns the following error if not deallocate: 'securityProfile.encryptionAtHost' can be updated only when VM is in deallocated state
@@ -1153,7 +1216,20 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 
 	if shouldUpdate {
 		log.Printf("[DEBUG] Updating Windows Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
-		future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+		additionalProperties := azuresdkhacks.VirtualMachineAdditionalProperties{}
+		if d.HasChange("disk_controller_type") {
+			additionalProperties.DiskControllerType = utils.String(d.Get("disk_controller_type").(string))
+		}
+		if d.HasChanges("os_image_notification", "termination_notification") {
+			additionalProperties.ScheduledEventsProfile = expandVirtualMachineScheduledEventsProfile(d.Get("os_image_notification").([]interface{}), d.Get("termination_notification").([]interface{}))
+		}
+
+		var future compute.VirtualMachinesUpdateFuture
+		if additionalProperties.DiskControllerType != nil || additionalProperties.ScheduledEventsProfile != nil {
+			future, err = azuresdkhacks.NewVirtualMachinesWorkaroundClient(client).Update(ctx, id.ResourceGroup, id.Name, update, additionalProperties)
+		} else {
+			future, err = client.Update(ctx, id.ResourceGroup, id.Name, update)
+		}
 		if err != nil {
 			return fmt.Errorf("updating Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
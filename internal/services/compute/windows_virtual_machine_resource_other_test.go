@@ -813,6 +813,94 @@ func TestAccWindowsVirtualMachine_otherWinRMHTTPS(t *testing.T) {
 	})
 }
 
+func TestAccWindowsVirtualMachine_otherDiskControllerType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine", "test")
+	r := WindowsVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.otherDiskControllerType(data, "Standard_E2bds_v5", "NVMe"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disk_controller_type").HasValue("NVMe"),
+			),
+		},
+		data.ImportStep(
+			"admin_password",
+		),
+		{
+			Config: r.otherDiskControllerType(data, "Standard_E2bds_v5", "SCSI"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disk_controller_type").HasValue("SCSI"),
+			),
+		},
+		data.ImportStep(
+			"admin_password",
+		),
+	})
+}
+
+func TestAccWindowsVirtualMachine_otherDiskControllerTypeNVMeUnsupportedSize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine", "test")
+	r := WindowsVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.otherDiskControllerType(data, "Standard_DS3_v2", "NVMe"),
+			ExpectError: regexp.MustCompile("does not support the `NVMe` `disk_controller_type`"),
+		},
+	})
+}
+
+func TestAccWindowsVirtualMachine_otherScheduledEvents(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine", "test")
+	r := WindowsVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.otherScheduledEvents(data, "PT15M", "PT5M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("os_image_notification.0.timeout").HasValue("PT15M"),
+				check.That(data.ResourceName).Key("termination_notification.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("termination_notification.0.timeout").HasValue("PT5M"),
+			),
+		},
+		data.ImportStep("admin_password"),
+		{
+			Config: r.otherScheduledEvents(data, "PT10M", "PT15M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("os_image_notification.0.timeout").HasValue("PT10M"),
+				check.That(data.ResourceName).Key("termination_notification.0.timeout").HasValue("PT15M"),
+			),
+		},
+		data.ImportStep("admin_password"),
+		{
+			Config: r.otherScheduledEventsDisabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("os_image_notification.#").HasValue("0"),
+				check.That(data.ResourceName).Key("termination_notification.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAccWindowsVirtualMachine_otherScheduledEventsInvalidTimeout(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine", "test")
+	r := WindowsVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.otherScheduledEvents(data, "PT15M", "PT20M"),
+			ExpectError: regexp.MustCompile("to be in the range"),
+		},
+	})
+}
+
 func TestAccWindowsVirtualMachine_otherEncryptionAtHostEnabled(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_windows_virtual_machine", "test")
 	r := WindowsVirtualMachineResource{}
@@ -2362,6 +2450,111 @@ resource "azurerm_windows_virtual_machine" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r WindowsVirtualMachineResource) otherDiskControllerType(data acceptance.TestData, size, diskControllerType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = local.vm_name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  size                = %q
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-g2"
+    version   = "latest"
+  }
+
+  disk_controller_type = %q
+}
+`, r.template(data), size, diskControllerType)
+}
+
+func (r WindowsVirtualMachineResource) otherScheduledEvents(data acceptance.TestData, osImageTimeout, terminationTimeout string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = local.vm_name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  size                = "Standard_F2"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  os_image_notification {
+    timeout = %q
+  }
+
+  termination_notification {
+    enabled = true
+    timeout = %q
+  }
+}
+`, r.template(data), osImageTimeout, terminationTimeout)
+}
+
+func (r WindowsVirtualMachineResource) otherScheduledEventsDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = local.vm_name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  size                = "Standard_F2"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  termination_notification {
+    enabled = false
+  }
+}
+`, r.template(data))
+}
+
 func (r WindowsVirtualMachineResource) otherEncryptionAtHostEnabled(data acceptance.TestData, enabled bool) string {
 	return fmt.Sprintf(`
 %s
@@ -508,6 +508,9 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 	scaleInPolicy := d.Get("scale_in_policy").(string)
 	automaticRepairsPolicyRaw := d.Get("automatic_instance_repair").([]interface{})
 	automaticRepairsPolicy := ExpandVirtualMachineScaleSetAutomaticRepairsPolicy(automaticRepairsPolicyRaw)
+	if err := ValidateVirtualMachineScaleSetAutomaticRepairsHealthSource(automaticRepairsPolicy, healthProbeId, hasHealthExtension); err != nil {
+		return err
+	}
 
 	props := compute.VirtualMachineScaleSet{
 		Location: utils.String(location),
@@ -823,6 +826,19 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 		updateProps.VirtualMachineProfile.LicenseType = &license
 	}
 
+	// removing the Health Probe or Application Health Extension whilst Automatic Instance Repairs are enabled is rejected by the API
+	if d.HasChanges("automatic_instance_repair", "extension", "health_probe_id") {
+		_, hasHealthExtension, err := expandVirtualMachineScaleSetExtensions(d.Get("extension").(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+
+		automaticRepairsPolicy := ExpandVirtualMachineScaleSetAutomaticRepairsPolicy(d.Get("automatic_instance_repair").([]interface{}))
+		if err := ValidateVirtualMachineScaleSetAutomaticRepairsHealthSource(automaticRepairsPolicy, d.Get("health_probe_id").(string), hasHealthExtension); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("automatic_instance_repair") {
 		automaticRepairsPolicyRaw := d.Get("automatic_instance_repair").([]interface{})
 		automaticRepairsPolicy := ExpandVirtualMachineScaleSetAutomaticRepairsPolicy(automaticRepairsPolicyRaw)
@@ -955,7 +971,15 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("setting `additional_capabilities`: %+v", props.AdditionalCapabilities)
 	}
 
-	if err := d.Set("automatic_instance_repair", FlattenVirtualMachineScaleSetAutomaticRepairsPolicy(props.AutomaticRepairsPolicy)); err != nil {
+	automaticRepairsServiceState := ""
+	if props.AutomaticRepairsPolicy != nil && props.AutomaticRepairsPolicy.Enabled != nil && *props.AutomaticRepairsPolicy.Enabled {
+		instanceView, err := client.GetInstanceView(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving Instance View for Windows Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		automaticRepairsServiceState = FlattenVirtualMachineScaleSetAutomaticRepairsServiceState(&instanceView)
+	}
+	if err := d.Set("automatic_instance_repair", FlattenVirtualMachineScaleSetAutomaticRepairsPolicy(props.AutomaticRepairsPolicy, automaticRepairsServiceState)); err != nil {
 		return fmt.Errorf("setting `automatic_instance_repair`: %+v", err)
 	}
 
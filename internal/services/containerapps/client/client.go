@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+)
+
+type Client struct {
+	JobsClient *jobs.JobsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	jobsClient := jobs.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		JobsClient: &jobsClient,
+	}
+}
@@ -0,0 +1,943 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var containerAppJobTriggerConfigs = []string{
+	"event_trigger_config",
+	"manual_trigger_config",
+	"schedule_trigger_config",
+}
+
+func resourceContainerAppJob() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerAppJobCreate,
+		Read:   resourceContainerAppJobRead,
+		Update: resourceContainerAppJobUpdate,
+		Delete: resourceContainerAppJobDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := jobs.ParseJobID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(containerAppJobSecretReferencesCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerAppJobName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"container_app_environment_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"replica_timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"replica_retry_limit": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"secret": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ContainerAppSecretName,
+						},
+
+						"value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"template": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"container": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"image": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"cpu": {
+										Type:         pluginsdk.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0.25, 4),
+									},
+
+									"memory": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"args": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"command": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"env": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"name": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"value": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"secret_name": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.ContainerAppSecretName,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"event_trigger_config": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: containerAppJobTriggerConfigs,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"parallelism": containerAppJobParallelismSchema(),
+
+						"replica_completion_count": containerAppJobReplicaCompletionCountSchema(),
+
+						"scale": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"min_executions": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"max_executions": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										Default:      100,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"polling_interval_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										Default:      30,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"rules": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"name": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												// the KEDA scaler type, e.g. `azure-servicebus` or `azure-queue`
+												"custom_rule_type": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"metadata": {
+													Type:     pluginsdk.TypeMap,
+													Required: true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+
+												"authentication": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"secret_name": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validate.ContainerAppSecretName,
+															},
+
+															"trigger_parameter": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotEmpty,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"manual_trigger_config": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: containerAppJobTriggerConfigs,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"parallelism": containerAppJobParallelismSchema(),
+
+						"replica_completion_count": containerAppJobReplicaCompletionCountSchema(),
+					},
+				},
+			},
+
+			"schedule_trigger_config": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: containerAppJobTriggerConfigs,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cron_expression": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.CronExpression,
+						},
+
+						"parallelism": containerAppJobParallelismSchema(),
+
+						"replica_completion_count": containerAppJobReplicaCompletionCountSchema(),
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func containerAppJobParallelismSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func containerAppJobReplicaCompletionCountSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func resourceContainerAppJobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ContainerApps.JobsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := jobs.NewJobID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_container_app_job", id.ID())
+	}
+
+	parameters := jobs.Job{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &jobs.JobProperties{
+			EnvironmentId: utils.String(d.Get("container_app_environment_id").(string)),
+			Configuration: expandContainerAppJobConfiguration(d),
+			Template:      expandContainerAppJobTemplate(d.Get("template").([]interface{})),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceContainerAppJobRead(d, meta)
+}
+
+func resourceContainerAppJobRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ContainerApps.JobsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := jobs.ParseJobID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			d.Set("container_app_environment_id", props.EnvironmentId)
+
+			if err := d.Set("template", flattenContainerAppJobTemplate(props.Template)); err != nil {
+				return fmt.Errorf("setting `template`: %+v", err)
+			}
+
+			eventTriggerConfig := make([]interface{}, 0)
+			manualTriggerConfig := make([]interface{}, 0)
+			scheduleTriggerConfig := make([]interface{}, 0)
+			replicaRetryLimit := 0
+			replicaTimeout := 0
+			if config := props.Configuration; config != nil {
+				eventTriggerConfig = flattenContainerAppJobEventTriggerConfig(config.EventTriggerConfig)
+				manualTriggerConfig = flattenContainerAppJobManualTriggerConfig(config.ManualTriggerConfig)
+				scheduleTriggerConfig = flattenContainerAppJobScheduleTriggerConfig(config.ScheduleTriggerConfig)
+				if config.ReplicaRetryLimit != nil {
+					replicaRetryLimit = int(*config.ReplicaRetryLimit)
+				}
+				replicaTimeout = int(config.ReplicaTimeout)
+			}
+			d.Set("replica_retry_limit", replicaRetryLimit)
+			d.Set("replica_timeout_in_seconds", replicaTimeout)
+
+			if err := d.Set("event_trigger_config", eventTriggerConfig); err != nil {
+				return fmt.Errorf("setting `event_trigger_config`: %+v", err)
+			}
+			if err := d.Set("manual_trigger_config", manualTriggerConfig); err != nil {
+				return fmt.Errorf("setting `manual_trigger_config`: %+v", err)
+			}
+			if err := d.Set("schedule_trigger_config", scheduleTriggerConfig); err != nil {
+				return fmt.Errorf("setting `schedule_trigger_config`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	// the values of the secrets aren't returned from the GET, so these need to be retrieved separately
+	secretsResp, err := client.ListSecrets(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+	}
+	secrets := make([]interface{}, 0)
+	if model := secretsResp.Model; model != nil {
+		secrets = flattenContainerAppJobSecrets(model.Value)
+	}
+	if err := d.Set("secret", secrets); err != nil {
+		return fmt.Errorf("setting `secret`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceContainerAppJobUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ContainerApps.JobsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := jobs.ParseJobID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	parameters := *existing.Model
+
+	// the secrets aren't returned from the GET and would otherwise be removed, so the configuration is always sent
+	parameters.Properties.Configuration = expandContainerAppJobConfiguration(d)
+
+	if d.HasChange("template") {
+		parameters.Properties.Template = expandContainerAppJobTemplate(d.Get("template").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceContainerAppJobRead(d, meta)
+}
+
+func resourceContainerAppJobDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ContainerApps.JobsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := jobs.ParseJobID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// containerAppJobSecretReferencesCustomizeDiff ensures that the secrets referenced by the environment variables
+// and the authentication for the KEDA scale rules are defined within the `secret` block, since otherwise the
+// API only reports this once the Job has been (partially) provisioned
+func containerAppJobSecretReferencesCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	secretNames := make(map[string]struct{})
+	for _, raw := range diff.Get("secret").([]interface{}) {
+		secret, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := secret["name"].(string)
+		if name == "" {
+			// the name isn't known until apply, so the references can't be validated
+			return nil
+		}
+		secretNames[name] = struct{}{}
+	}
+
+	for _, raw := range diff.Get("template.0.container").([]interface{}) {
+		container, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, rawEnv := range container["env"].([]interface{}) {
+			env, ok := rawEnv.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			secretName := env["secret_name"].(string)
+			if secretName == "" {
+				continue
+			}
+			if _, ok := secretNames[secretName]; !ok {
+				return fmt.Errorf("the environment variable %q within the container %q references the secret %q which isn't defined within a `secret` block", env["name"].(string), container["name"].(string), secretName)
+			}
+		}
+	}
+
+	for _, raw := range diff.Get("event_trigger_config.0.scale.0.rules").([]interface{}) {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, rawAuth := range rule["authentication"].([]interface{}) {
+			auth, ok := rawAuth.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			secretName := auth["secret_name"].(string)
+			if secretName == "" {
+				continue
+			}
+			if _, ok := secretNames[secretName]; !ok {
+				return fmt.Errorf("the authentication for the scale rule %q references the secret %q which isn't defined within a `secret` block", rule["name"].(string), secretName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandContainerAppJobConfiguration(d *pluginsdk.ResourceData) *jobs.JobConfiguration {
+	config := jobs.JobConfiguration{
+		ReplicaRetryLimit: utils.Int64(int64(d.Get("replica_retry_limit").(int))),
+		ReplicaTimeout:    int64(d.Get("replica_timeout_in_seconds").(int)),
+		Secrets:           expandContainerAppJobSecrets(d.Get("secret").([]interface{})),
+	}
+
+	if v := d.Get("event_trigger_config").([]interface{}); len(v) > 0 {
+		config.TriggerType = jobs.TriggerTypeEvent
+		config.EventTriggerConfig = expandContainerAppJobEventTriggerConfig(v)
+	}
+
+	if v := d.Get("manual_trigger_config").([]interface{}); len(v) > 0 {
+		config.TriggerType = jobs.TriggerTypeManual
+		config.ManualTriggerConfig = expandContainerAppJobManualTriggerConfig(v)
+	}
+
+	if v := d.Get("schedule_trigger_config").([]interface{}); len(v) > 0 {
+		config.TriggerType = jobs.TriggerTypeSchedule
+		config.ScheduleTriggerConfig = expandContainerAppJobScheduleTriggerConfig(v)
+	}
+
+	return &config
+}
+
+func expandContainerAppJobSecrets(input []interface{}) *[]jobs.Secret {
+	secrets := make([]jobs.Secret, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		secrets = append(secrets, jobs.Secret{
+			Name:  utils.String(v["name"].(string)),
+			Value: utils.String(v["value"].(string)),
+		})
+	}
+	return &secrets
+}
+
+func flattenContainerAppJobSecrets(input []jobs.Secret) []interface{} {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+		value := ""
+		if item.Value != nil {
+			value = *item.Value
+		}
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+	return output
+}
+
+// expandContainerAppJobTriggerConfig expands the trigger configuration common to all of the trigger types
+func expandContainerAppJobTriggerConfig(input []interface{}) (parallelism *int64, replicaCompletionCount *int64) {
+	// an empty block (e.g. `manual_trigger_config {}`) is returned as a nil item, in which case the defaults apply
+	parallelism = utils.Int64(1)
+	replicaCompletionCount = utils.Int64(1)
+	if len(input) == 0 || input[0] == nil {
+		return
+	}
+
+	v := input[0].(map[string]interface{})
+	parallelism = utils.Int64(int64(v["parallelism"].(int)))
+	replicaCompletionCount = utils.Int64(int64(v["replica_completion_count"].(int)))
+	return
+}
+
+func expandContainerAppJobEventTriggerConfig(input []interface{}) *jobs.JobConfigurationEventTriggerConfig {
+	parallelism, replicaCompletionCount := expandContainerAppJobTriggerConfig(input)
+	output := jobs.JobConfigurationEventTriggerConfig{
+		Parallelism:            parallelism,
+		ReplicaCompletionCount: replicaCompletionCount,
+	}
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		output.Scale = expandContainerAppJobScale(v["scale"].([]interface{}))
+	}
+
+	return &output
+}
+
+func expandContainerAppJobScale(input []interface{}) *jobs.JobScale {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	rules := make([]jobs.JobScaleRule, 0)
+	for _, item := range v["rules"].([]interface{}) {
+		rule := item.(map[string]interface{})
+
+		metadata := make(map[string]string)
+		for k, val := range rule["metadata"].(map[string]interface{}) {
+			metadata[k] = val.(string)
+		}
+
+		auth := make([]jobs.ScaleRuleAuth, 0)
+		for _, rawAuth := range rule["authentication"].([]interface{}) {
+			a := rawAuth.(map[string]interface{})
+			auth = append(auth, jobs.ScaleRuleAuth{
+				SecretRef:        utils.String(a["secret_name"].(string)),
+				TriggerParameter: utils.String(a["trigger_parameter"].(string)),
+			})
+		}
+
+		rules = append(rules, jobs.JobScaleRule{
+			Name:     utils.String(rule["name"].(string)),
+			Type:     utils.String(rule["custom_rule_type"].(string)),
+			Metadata: &metadata,
+			Auth:     &auth,
+		})
+	}
+
+	return &jobs.JobScale{
+		MinExecutions:   utils.Int64(int64(v["min_executions"].(int))),
+		MaxExecutions:   utils.Int64(int64(v["max_executions"].(int))),
+		PollingInterval: utils.Int64(int64(v["polling_interval_in_seconds"].(int))),
+		Rules:           &rules,
+	}
+}
+
+func expandContainerAppJobManualTriggerConfig(input []interface{}) *jobs.JobConfigurationManualTriggerConfig {
+	parallelism, replicaCompletionCount := expandContainerAppJobTriggerConfig(input)
+	return &jobs.JobConfigurationManualTriggerConfig{
+		Parallelism:            parallelism,
+		ReplicaCompletionCount: replicaCompletionCount,
+	}
+}
+
+func expandContainerAppJobScheduleTriggerConfig(input []interface{}) *jobs.JobConfigurationScheduleTriggerConfig {
+	parallelism, replicaCompletionCount := expandContainerAppJobTriggerConfig(input)
+	output := jobs.JobConfigurationScheduleTriggerConfig{
+		Parallelism:            parallelism,
+		ReplicaCompletionCount: replicaCompletionCount,
+	}
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		output.CronExpression = v["cron_expression"].(string)
+	}
+
+	return &output
+}
+
+func flattenContainerAppJobEventTriggerConfig(input *jobs.JobConfigurationEventTriggerConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"parallelism":              flattenContainerAppJobInt64(input.Parallelism),
+			"replica_completion_count": flattenContainerAppJobInt64(input.ReplicaCompletionCount),
+			"scale":                    flattenContainerAppJobScale(input.Scale),
+		},
+	}
+}
+
+func flattenContainerAppJobScale(input *jobs.JobScale) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	rules := make([]interface{}, 0)
+	if input.Rules != nil {
+		for _, rule := range *input.Rules {
+			name := ""
+			if rule.Name != nil {
+				name = *rule.Name
+			}
+			ruleType := ""
+			if rule.Type != nil {
+				ruleType = *rule.Type
+			}
+			metadata := make(map[string]interface{})
+			if rule.Metadata != nil {
+				for k, v := range *rule.Metadata {
+					metadata[k] = v
+				}
+			}
+			auth := make([]interface{}, 0)
+			if rule.Auth != nil {
+				for _, a := range *rule.Auth {
+					secretName := ""
+					if a.SecretRef != nil {
+						secretName = *a.SecretRef
+					}
+					triggerParameter := ""
+					if a.TriggerParameter != nil {
+						triggerParameter = *a.TriggerParameter
+					}
+					auth = append(auth, map[string]interface{}{
+						"secret_name":       secretName,
+						"trigger_parameter": triggerParameter,
+					})
+				}
+			}
+
+			rules = append(rules, map[string]interface{}{
+				"name":             name,
+				"custom_rule_type": ruleType,
+				"metadata":         metadata,
+				"authentication":   auth,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"min_executions":              flattenContainerAppJobInt64(input.MinExecutions),
+			"max_executions":              flattenContainerAppJobInt64(input.MaxExecutions),
+			"polling_interval_in_seconds": flattenContainerAppJobInt64(input.PollingInterval),
+			"rules":                       rules,
+		},
+	}
+}
+
+func flattenContainerAppJobManualTriggerConfig(input *jobs.JobConfigurationManualTriggerConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"parallelism":              flattenContainerAppJobInt64(input.Parallelism),
+			"replica_completion_count": flattenContainerAppJobInt64(input.ReplicaCompletionCount),
+		},
+	}
+}
+
+func flattenContainerAppJobScheduleTriggerConfig(input *jobs.JobConfigurationScheduleTriggerConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cron_expression":          input.CronExpression,
+			"parallelism":              flattenContainerAppJobInt64(input.Parallelism),
+			"replica_completion_count": flattenContainerAppJobInt64(input.ReplicaCompletionCount),
+		},
+	}
+}
+
+func flattenContainerAppJobInt64(input *int64) int {
+	if input == nil {
+		return 0
+	}
+	return int(*input)
+}
+
+func expandContainerAppJobTemplate(input []interface{}) *jobs.JobTemplate {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	containers := make([]jobs.Container, 0)
+	for _, item := range v["container"].([]interface{}) {
+		c := item.(map[string]interface{})
+
+		env := make([]jobs.EnvironmentVar, 0)
+		for _, rawEnv := range c["env"].([]interface{}) {
+			e := rawEnv.(map[string]interface{})
+			envVar := jobs.EnvironmentVar{
+				Name: utils.String(e["name"].(string)),
+			}
+			if value := e["value"].(string); value != "" {
+				envVar.Value = utils.String(value)
+			}
+			if secretName := e["secret_name"].(string); secretName != "" {
+				envVar.SecretRef = utils.String(secretName)
+			}
+			env = append(env, envVar)
+		}
+
+		containers = append(containers, jobs.Container{
+			Name:    utils.String(c["name"].(string)),
+			Image:   utils.String(c["image"].(string)),
+			Args:    utils.ExpandStringSlice(c["args"].([]interface{})),
+			Command: utils.ExpandStringSlice(c["command"].([]interface{})),
+			Env:     &env,
+			Resources: &jobs.ContainerResources{
+				Cpu:    utils.Float(c["cpu"].(float64)),
+				Memory: utils.String(c["memory"].(string)),
+			},
+		})
+	}
+
+	return &jobs.JobTemplate{
+		Containers: &containers,
+	}
+}
+
+func flattenContainerAppJobTemplate(input *jobs.JobTemplate) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	containers := make([]interface{}, 0)
+	if input.Containers != nil {
+		for _, c := range *input.Containers {
+			name := ""
+			if c.Name != nil {
+				name = *c.Name
+			}
+			image := ""
+			if c.Image != nil {
+				image = *c.Image
+			}
+			cpu := 0.0
+			memory := ""
+			if c.Resources != nil {
+				if c.Resources.Cpu != nil {
+					cpu = *c.Resources.Cpu
+				}
+				if c.Resources.Memory != nil {
+					memory = *c.Resources.Memory
+				}
+			}
+			env := make([]interface{}, 0)
+			if c.Env != nil {
+				for _, e := range *c.Env {
+					envName := ""
+					if e.Name != nil {
+						envName = *e.Name
+					}
+					value := ""
+					if e.Value != nil {
+						value = *e.Value
+					}
+					secretName := ""
+					if e.SecretRef != nil {
+						secretName = *e.SecretRef
+					}
+					env = append(env, map[string]interface{}{
+						"name":        envName,
+						"value":       value,
+						"secret_name": secretName,
+					})
+				}
+			}
+
+			containers = append(containers, map[string]interface{}{
+				"name":    name,
+				"image":   image,
+				"cpu":     cpu,
+				"memory":  memory,
+				"args":    utils.FlattenStringSlice(c.Args),
+				"command": utils.FlattenStringSlice(c.Command),
+				"env":     env,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"container": containers,
+		},
+	}
+}
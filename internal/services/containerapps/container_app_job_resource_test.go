@@ -0,0 +1,445 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppJobResource struct{}
+
+func TestAccContainerAppJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manual_trigger_config.0.parallelism").HasValue("1"),
+				check.That(data.ResourceName).Key("manual_trigger_config.0.replica_completion_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppJob_scheduleTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scheduleTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule_trigger_config.0.cron_expression").HasValue("*/5 * * * *"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_eventTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.eventTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("event_trigger_config.0.scale.0.rules.0.custom_rule_type").HasValue("azure-servicebus"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scheduleTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.eventTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_multipleTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.multipleTriggers(data),
+			ExpectError: regexp.MustCompile("only one of `event_trigger_config,manual_trigger_config,schedule_trigger_config` can be specified"),
+		},
+	})
+}
+
+func TestAccContainerAppJob_undefinedScaleRuleSecret(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.undefinedScaleRuleSecret(data),
+			ExpectError: regexp.MustCompile("references the secret \"servicebus-connection-string\" which isn't defined within a `secret` block"),
+		},
+	})
+}
+
+func (r ContainerAppJobResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobs.ParseJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ContainerApps.JobsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// template provisions the Container App Environment using a Template Deployment, since this isn't otherwise
+// available in the Provider
+func (ContainerAppJobResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cajob-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-cae-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.App/managedEnvironments",
+      "apiVersion": "2023-05-01",
+      "name": "acctest-cae-%[1]d",
+      "location": "%[2]s",
+      "properties": {}
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.App/managedEnvironments', 'acctest-cae-%[1]d')]"
+    }
+  }
+}
+TEMPLATE
+}
+
+locals {
+  container_app_environment_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ContainerAppJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-job-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = local.container_app_environment_id
+  replica_timeout_in_seconds   = 10
+
+  template {
+    container {
+      name    = "testcontainer"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["echo"]
+      args    = ["hello"]
+    }
+  }
+
+  manual_trigger_config {}
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "import" {
+  name                         = azurerm_container_app_job.test.name
+  resource_group_name          = azurerm_container_app_job.test.resource_group_name
+  location                     = azurerm_container_app_job.test.location
+  container_app_environment_id = azurerm_container_app_job.test.container_app_environment_id
+  replica_timeout_in_seconds   = 10
+
+  template {
+    container {
+      name    = "testcontainer"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["echo"]
+      args    = ["hello"]
+    }
+  }
+
+  manual_trigger_config {}
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppJobResource) scheduleTrigger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-job-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = local.container_app_environment_id
+  replica_timeout_in_seconds   = 60
+  replica_retry_limit          = 2
+
+  secret {
+    name  = "greeting"
+    value = "hello"
+  }
+
+  template {
+    container {
+      name    = "testcontainer"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["sh", "-c", "echo $GREETING"]
+
+      env {
+        name        = "GREETING"
+        secret_name = "greeting"
+      }
+
+      env {
+        name  = "ENVIRONMENT"
+        value = "test"
+      }
+    }
+  }
+
+  schedule_trigger_config {
+    cron_expression          = "*/5 * * * *"
+    parallelism              = 2
+    replica_completion_count = 2
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) eventTriggerTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-sbn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+  name                = "jobs"
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_servicebus_namespace.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) eventTrigger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-job-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = local.container_app_environment_id
+  replica_timeout_in_seconds   = 60
+
+  secret {
+    name  = "servicebus-connection-string"
+    value = azurerm_servicebus_namespace.test.default_primary_connection_string
+  }
+
+  template {
+    container {
+      name   = "testcontainer"
+      image  = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu    = 0.5
+      memory = "1Gi"
+    }
+  }
+
+  event_trigger_config {
+    parallelism              = 1
+    replica_completion_count = 1
+
+    scale {
+      min_executions              = 0
+      max_executions              = 10
+      polling_interval_in_seconds = 60
+
+      rules {
+        name             = "servicebus-queue"
+        custom_rule_type = "azure-servicebus"
+        metadata = {
+          queueName    = azurerm_servicebus_queue.test.name
+          messageCount = "5"
+        }
+
+        authentication {
+          secret_name       = "servicebus-connection-string"
+          trigger_parameter = "connection"
+        }
+      }
+    }
+  }
+}
+`, r.eventTriggerTemplate(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) multipleTriggers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-job-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = local.container_app_environment_id
+  replica_timeout_in_seconds   = 10
+
+  template {
+    container {
+      name   = "testcontainer"
+      image  = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu    = 0.5
+      memory = "1Gi"
+    }
+  }
+
+  manual_trigger_config {}
+
+  schedule_trigger_config {
+    cron_expression = "*/5 * * * *"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) undefinedScaleRuleSecret(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-job-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = local.container_app_environment_id
+  replica_timeout_in_seconds   = 60
+
+  template {
+    container {
+      name   = "testcontainer"
+      image  = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu    = 0.5
+      memory = "1Gi"
+    }
+  }
+
+  event_trigger_config {
+    scale {
+      rules {
+        name             = "servicebus-queue"
+        custom_rule_type = "azure-servicebus"
+        metadata = {
+          queueName    = azurerm_servicebus_queue.test.name
+          messageCount = "5"
+        }
+
+        authentication {
+          secret_name       = "servicebus-connection-string"
+          trigger_parameter = "connection"
+        }
+      }
+    }
+  }
+}
+`, r.eventTriggerTemplate(data), data.RandomInteger)
+}
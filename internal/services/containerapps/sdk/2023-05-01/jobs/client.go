@@ -0,0 +1,15 @@
+package jobs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type JobsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJobsClientWithBaseURI(endpoint string) JobsClient {
+	return JobsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,19 @@
+package jobs
+
+type JobProvisioningState string
+
+const (
+	JobProvisioningStateCanceled   JobProvisioningState = "Canceled"
+	JobProvisioningStateDeleting   JobProvisioningState = "Deleting"
+	JobProvisioningStateFailed     JobProvisioningState = "Failed"
+	JobProvisioningStateInProgress JobProvisioningState = "InProgress"
+	JobProvisioningStateSucceeded  JobProvisioningState = "Succeeded"
+)
+
+type TriggerType string
+
+const (
+	TriggerTypeEvent    TriggerType = "Event"
+	TriggerTypeManual   TriggerType = "Manual"
+	TriggerTypeSchedule TriggerType = "Schedule"
+)
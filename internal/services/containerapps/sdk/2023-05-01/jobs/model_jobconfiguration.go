@@ -0,0 +1,11 @@
+package jobs
+
+type JobConfiguration struct {
+	EventTriggerConfig    *JobConfigurationEventTriggerConfig    `json:"eventTriggerConfig,omitempty"`
+	ManualTriggerConfig   *JobConfigurationManualTriggerConfig   `json:"manualTriggerConfig,omitempty"`
+	ReplicaRetryLimit     *int64                                 `json:"replicaRetryLimit,omitempty"`
+	ReplicaTimeout        int64                                  `json:"replicaTimeout"`
+	ScheduleTriggerConfig *JobConfigurationScheduleTriggerConfig `json:"scheduleTriggerConfig,omitempty"`
+	Secrets               *[]Secret                              `json:"secrets,omitempty"`
+	TriggerType           TriggerType                            `json:"triggerType"`
+}
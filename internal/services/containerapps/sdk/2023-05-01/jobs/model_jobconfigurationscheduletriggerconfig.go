@@ -0,0 +1,7 @@
+package jobs
+
+type JobConfigurationScheduleTriggerConfig struct {
+	CronExpression         string `json:"cronExpression"`
+	Parallelism            *int64 `json:"parallelism,omitempty"`
+	ReplicaCompletionCount *int64 `json:"replicaCompletionCount,omitempty"`
+}
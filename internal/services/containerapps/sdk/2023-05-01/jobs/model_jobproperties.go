@@ -0,0 +1,8 @@
+package jobs
+
+type JobProperties struct {
+	Configuration     *JobConfiguration     `json:"configuration,omitempty"`
+	EnvironmentId     *string               `json:"environmentId,omitempty"`
+	ProvisioningState *JobProvisioningState `json:"provisioningState,omitempty"`
+	Template          *JobTemplate          `json:"template,omitempty"`
+}
@@ -0,0 +1,5 @@
+package jobs
+
+type JobSecretsCollection struct {
+	Value []Secret `json:"value"`
+}
@@ -0,0 +1,5 @@
+package jobs
+
+type JobTemplate struct {
+	Containers *[]Container `json:"containers,omitempty"`
+}
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ContainerAppJobName validates the name of a Container App Job
+func ContainerAppJobName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z]([a-z0-9-]{0,30}[a-z0-9])?$`).MatchString(v) || regexp.MustCompile(`--`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 32 characters, start with a lowercase letter, end with a lowercase letter or number and may only contain lowercase letters, numbers and non-consecutive hyphens", k))
+	}
+
+	return
+}
+
+// ContainerAppSecretName validates the name of a Secret defined on a Container App Job
+func ContainerAppSecretName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]{0,251}[a-z0-9])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 253 characters, start and end with a lowercase letter or number and may only contain lowercase letters, numbers, hyphens and periods", k))
+	}
+
+	return
+}
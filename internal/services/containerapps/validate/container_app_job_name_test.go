@@ -0,0 +1,106 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContainerAppJobName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "example-job1",
+			Expected: true,
+		},
+		{
+			Input:    "Example-job",
+			Expected: false,
+		},
+		{
+			Input:    "1example",
+			Expected: false,
+		},
+		{
+			Input:    "example-",
+			Expected: false,
+		},
+		{
+			Input:    "example--job",
+			Expected: false,
+		},
+		{
+			Input:    "example_job",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 32),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 33),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := ContainerAppJobName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestContainerAppSecretName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "queue-connection-string",
+			Expected: true,
+		},
+		{
+			Input:    "queue.connection",
+			Expected: true,
+		},
+		{
+			Input:    "Queue-Connection",
+			Expected: false,
+		},
+		{
+			Input:    "queue_connection",
+			Expected: false,
+		},
+		{
+			Input:    "-queue",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := ContainerAppSecretName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CronExpression validates that the value is a cron expression in the five-field format
+// (`minute hour day-of-month month day-of-week`) used by Container App Jobs
+func CronExpression(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if len(strings.Fields(v)) != 5 {
+		errors = append(errors, fmt.Errorf("%q must be a cron expression containing five fields (minute, hour, day of month, month and day of week), got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,41 @@
+package validate
+
+import "testing"
+
+func TestCronExpression(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "*/5 * * * *",
+			Expected: true,
+		},
+		{
+			Input:    "0 2 * * 1-5",
+			Expected: true,
+		},
+		{
+			Input:    "0 0 2 * * *",
+			Expected: false,
+		},
+		{
+			Input:    "@daily",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := CronExpression(v.Input, "cron_expression")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `ingressProfile` (used for Web App Routing) and `securityProfile.imageCleaner` fields are only available from API
+// Version `2024-09-01` and the `bootstrapProfile` field from API Version `2025-01-01` (see `internal/azuresdkhacks`
+// for when this workaround client is used)
+const managedClustersWorkaroundApiVersion = "2025-01-01"
+
+type ManagedClustersWorkaroundClient struct {
+	sdkClient *containerservice.ManagedClustersClient
+}
+
+func NewManagedClustersWorkaroundClient(client *containerservice.ManagedClustersClient) ManagedClustersWorkaroundClient {
+	return ManagedClustersWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// ManagedClusterWorkaroundProperties contains the properties of the Managed Cluster which aren't available in the SDK,
+// any which are nil are omitted from the request.
+type ManagedClusterWorkaroundProperties struct {
+	BootstrapProfile *ManagedClusterBootstrapProfile          `json:"bootstrapProfile,omitempty"`
+	IngressProfile   *ManagedClusterIngressProfile            `json:"ingressProfile,omitempty"`
+	SecurityProfile  *ManagedClusterWorkaroundSecurityProfile `json:"securityProfile,omitempty"`
+}
+
+// ManagedClusterWorkaroundSecurityProfile contains the fields of the `securityProfile` which aren't available in the
+// SDK - these are merged into the `securityProfile` sent by the SDK, rather than replacing it.
+type ManagedClusterWorkaroundSecurityProfile struct {
+	ImageCleaner *ManagedClusterSecurityProfileImageCleaner `json:"imageCleaner,omitempty"`
+}
+
+type ManagedClusterSecurityProfileImageCleaner struct {
+	Enabled       *bool  `json:"enabled,omitempty"`
+	IntervalHours *int32 `json:"intervalHours,omitempty"`
+}
+
+type ManagedClusterBootstrapProfile struct {
+	ArtifactSource      *string `json:"artifactSource,omitempty"`
+	ContainerRegistryId *string `json:"containerRegistryId,omitempty"`
+}
+
+type ManagedClusterIngressProfile struct {
+	WebAppRouting *ManagedClusterIngressProfileWebAppRouting `json:"webAppRouting,omitempty"`
+}
+
+type ManagedClusterIngressProfileWebAppRouting struct {
+	Enabled            *bool                               `json:"enabled,omitempty"`
+	DnsZoneResourceIds *[]string                           `json:"dnsZoneResourceIds,omitempty"`
+	Nginx              *ManagedClusterIngressProfileNginx  `json:"nginx,omitempty"`
+	Identity           *ManagedClusterUserAssignedIdentity `json:"identity,omitempty"`
+}
+
+type ManagedClusterIngressProfileNginx struct {
+	DefaultIngressControllerType *string `json:"defaultIngressControllerType,omitempty"`
+}
+
+type ManagedClusterUserAssignedIdentity struct {
+	ClientId   *string `json:"clientId,omitempty"`
+	ObjectId   *string `json:"objectId,omitempty"`
+	ResourceId *string `json:"resourceId,omitempty"`
+}
+
+// CreateOrUpdate creates or updates a managed cluster, including the properties of the managed cluster which
+// aren't available in the SDK.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// resourceName - the name of the managed cluster resource.
+// parameters - the managed cluster to create or update.
+// workaroundProperties - the properties of the managed cluster which aren't available in the SDK.
+func (client ManagedClustersWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, resourceName string, parameters containerservice.ManagedCluster, workaroundProperties ManagedClusterWorkaroundProperties) (result containerservice.ManagedClustersCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, resourceName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withWorkaroundProperties(workaroundProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetWorkaroundProperties returns the properties of the specified managed cluster which aren't available in the SDK.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// resourceName - the name of the managed cluster resource.
+func (client ManagedClustersWorkaroundClient) GetWorkaroundProperties(ctx context.Context, resourceGroupName string, resourceName string) (result ManagedClusterWorkaroundResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, resourceName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "GetWorkaroundProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", managedClustersWorkaroundApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "GetWorkaroundProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "GetWorkaroundProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// UpdateWorkaroundProperties updates the properties of the specified managed cluster which aren't available in the SDK.
+// The managed cluster is retrieved and sent back using the same (newer) API Version with only the specified properties
+// changed, rather than sending the SDK model (which omits any properties added in newer API Versions) using a newer
+// API Version, which would reset those properties.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// resourceName - the name of the managed cluster resource.
+// workaroundProperties - the properties of the managed cluster which should be updated, any which are nil are unchanged.
+func (client ManagedClustersWorkaroundClient) UpdateWorkaroundProperties(ctx context.Context, resourceGroupName string, resourceName string, workaroundProperties ManagedClusterWorkaroundProperties) (result containerservice.ManagedClustersCreateOrUpdateFuture, err error) {
+	existing, err := client.getRaw(ctx, resourceGroupName, resourceName)
+	if err != nil {
+		return
+	}
+
+	properties, ok := existing["properties"].(map[string]interface{})
+	if !ok {
+		err = fmt.Errorf("`properties` was nil")
+		return
+	}
+	setWorkaroundProperties(properties, workaroundProperties)
+
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, resourceName, containerservice.ManagedCluster{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "UpdateWorkaroundProperties", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, autorest.WithJSON(existing))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "UpdateWorkaroundProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", managedClustersWorkaroundApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "UpdateWorkaroundProperties", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// getRaw returns the specified managed cluster as returned from the API Version supporting the workaround properties,
+// so that it can be sent back without losing any fields which aren't available in the SDK.
+func (client ManagedClustersWorkaroundClient) getRaw(ctx context.Context, resourceGroupName string, resourceName string) (result map[string]interface{}, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, resourceName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "UpdateWorkaroundProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", managedClustersWorkaroundApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "UpdateWorkaroundProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withJSONNumbers(&result),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "containerservice.ManagedClustersClient", "UpdateWorkaroundProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// withJSONNumbers unmarshals the response body into the specified value, retaining numbers as they were returned
+// (rather than converting these to floats) so that these are sent back unchanged.
+func withJSONNumbers(v interface{}) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			if err := r.Respond(resp); err != nil {
+				return err
+			}
+
+			decoder := json.NewDecoder(resp.Body)
+			decoder.UseNumber()
+			if err := decoder.Decode(v); err != nil {
+				return fmt.Errorf("decoding the response body: %+v", err)
+			}
+
+			return nil
+		})
+	}
+}
+
+type ManagedClusterWorkaroundResult struct {
+	autorest.Response `json:"-"`
+	Properties        *ManagedClusterWorkaroundProperties `json:"properties,omitempty"`
+}
+
+// withWorkaroundProperties sets the specified properties within the properties of the request body and updates the
+// API Version used for the request to one which supports these fields.
+func withWorkaroundProperties(input ManagedClusterWorkaroundProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			setWorkaroundProperties(properties, input)
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", managedClustersWorkaroundApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
+
+// setWorkaroundProperties sets the specified properties within the properties of a request body, any which are nil are
+// left as-is - fields within the `securityProfile` are merged into the existing `securityProfile`.
+func setWorkaroundProperties(properties map[string]interface{}, input ManagedClusterWorkaroundProperties) {
+	if input.BootstrapProfile != nil {
+		properties["bootstrapProfile"] = input.BootstrapProfile
+	}
+	if input.IngressProfile != nil {
+		properties["ingressProfile"] = input.IngressProfile
+	}
+	if input.SecurityProfile != nil && input.SecurityProfile.ImageCleaner != nil {
+		securityProfile, ok := properties["securityProfile"].(map[string]interface{})
+		if !ok {
+			securityProfile = make(map[string]interface{})
+		}
+		securityProfile["imageCleaner"] = input.SecurityProfile.ImageCleaner
+		properties["securityProfile"] = securityProfile
+	}
+}
@@ -16,11 +16,17 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	dnsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	dnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
+	laparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	privateDnsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
 	privateDnsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -30,6 +36,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const (
+	bootstrapArtifactSourceCache  = "Cache"
+	bootstrapArtifactSourceDirect = "Direct"
+)
+
+const (
+	webAppRoutingNginxControllerAnnotationControlled = "AnnotationControlled"
+	webAppRoutingNginxControllerExternal             = "External"
+	webAppRoutingNginxControllerInternal             = "Internal"
+	webAppRoutingNginxControllerNone                 = "None"
+)
+
 func resourceKubernetesCluster() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceKubernetesClusterCreate,
@@ -328,11 +346,39 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"image_cleaner_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"image_cleaner_interval_hours": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      48,
+				ValidateFunc: validation.IntBetween(24, 2160),
+			},
+
 			"local_account_disabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 			},
 
+			"microsoft_defender": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"log_analytics_workspace_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+						},
+					},
+				},
+			},
+
 			"maintenance_window": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -785,6 +831,85 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"bootstrap_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"artifact_source": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  bootstrapArtifactSourceDirect,
+							ValidateFunc: validation.StringInSlice([]string{
+								bootstrapArtifactSourceCache,
+								bootstrapArtifactSourceDirect,
+							}, false),
+						},
+
+						"container_registry_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: containerValidate.RegistryID,
+						},
+					},
+				},
+			},
+
+			"web_app_routing": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"dns_zone_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.Any(
+									dnsValidate.DnsZoneID,
+									privateDnsValidate.PrivateDnsZoneID,
+								),
+							},
+						},
+
+						"default_nginx_controller": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  webAppRoutingNginxControllerAnnotationControlled,
+							ValidateFunc: validation.StringInSlice([]string{
+								webAppRoutingNginxControllerAnnotationControlled,
+								webAppRoutingNginxControllerExternal,
+								webAppRoutingNginxControllerInternal,
+								webAppRoutingNginxControllerNone,
+							}, false),
+						},
+
+						"web_app_routing_identity": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"client_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"object_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"user_assigned_identity_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -1080,6 +1205,12 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		Tags: tags.Expand(t),
 	}
 
+	microsoftDefender, err := expandKubernetesClusterMicrosoftDefender(d, d.Get("microsoft_defender").([]interface{}))
+	if err != nil {
+		return err
+	}
+	parameters.ManagedClusterProperties.SecurityProfile = microsoftDefender
+
 	if v := d.Get("automatic_channel_upgrade").(string); v != "" {
 		parameters.ManagedClusterProperties.AutoUpgradeProfile = &containerservice.ManagedClusterAutoUpgradeProfile{
 			UpgradeChannel: containerservice.UpgradeChannel(v),
@@ -1136,7 +1267,31 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.ManagedClusterProperties.DiskEncryptionSetID = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	workaroundProperties := azuresdkhacks.ManagedClusterWorkaroundProperties{}
+	if webAppRoutingRaw := d.Get("web_app_routing").([]interface{}); len(webAppRoutingRaw) > 0 {
+		if err := validateKubernetesClusterWebAppRoutingDnsZones(ctx, meta, webAppRoutingRaw); err != nil {
+			return err
+		}
+		workaroundProperties.IngressProfile = expandKubernetesClusterWebAppRouting(webAppRoutingRaw)
+	}
+	if bootstrapProfileRaw := d.Get("bootstrap_profile").([]interface{}); len(bootstrapProfileRaw) > 0 {
+		if err := validateKubernetesClusterBootstrapProfile(ctx, meta, bootstrapProfileRaw); err != nil {
+			return err
+		}
+		workaroundProperties.BootstrapProfile = expandKubernetesClusterBootstrapProfile(bootstrapProfileRaw)
+	}
+	if d.Get("image_cleaner_enabled").(bool) {
+		workaroundProperties.SecurityProfile = expandKubernetesClusterImageCleaner(d)
+	}
+
+	var future containerservice.ManagedClustersCreateOrUpdateFuture
+	if workaroundProperties.IngressProfile != nil || workaroundProperties.BootstrapProfile != nil || workaroundProperties.SecurityProfile != nil {
+		// the `bootstrapProfile`, `ingressProfile` and `securityProfile.imageCleaner` aren't available in the version of the SDK we're using, so we need to use a workaround client
+		hack := azuresdkhacks.NewManagedClustersWorkaroundClient(client)
+		future, err = hack.CreateOrUpdate(ctx, resGroup, name, parameters, workaroundProperties)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	}
 	if err != nil {
 		return fmt.Errorf("creating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -1344,6 +1499,15 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.DisableLocalAccounts = utils.Bool(d.Get("local_account_disabled").(bool))
 	}
 
+	if d.HasChange("microsoft_defender") {
+		updateCluster = true
+		microsoftDefender, err := expandKubernetesClusterMicrosoftDefender(d, d.Get("microsoft_defender").([]interface{}))
+		if err != nil {
+			return err
+		}
+		existing.ManagedClusterProperties.SecurityProfile = microsoftDefender
+	}
+
 	if d.HasChange("network_profile") {
 		updateCluster = true
 
@@ -1457,6 +1621,40 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Updated the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
 	}
 
+	if d.HasChanges("web_app_routing", "bootstrap_profile", "image_cleaner_enabled", "image_cleaner_interval_hours") {
+		workaroundProperties := azuresdkhacks.ManagedClusterWorkaroundProperties{}
+		if d.HasChange("web_app_routing") {
+			webAppRoutingRaw := d.Get("web_app_routing").([]interface{})
+			if err := validateKubernetesClusterWebAppRoutingDnsZones(ctx, meta, webAppRoutingRaw); err != nil {
+				return err
+			}
+			workaroundProperties.IngressProfile = expandKubernetesClusterWebAppRouting(webAppRoutingRaw)
+		}
+		if d.HasChange("bootstrap_profile") {
+			bootstrapProfileRaw := d.Get("bootstrap_profile").([]interface{})
+			if err := validateKubernetesClusterBootstrapProfile(ctx, meta, bootstrapProfileRaw); err != nil {
+				return err
+			}
+			workaroundProperties.BootstrapProfile = expandKubernetesClusterBootstrapProfile(bootstrapProfileRaw)
+		}
+		if d.HasChanges("image_cleaner_enabled", "image_cleaner_interval_hours") {
+			workaroundProperties.SecurityProfile = expandKubernetesClusterImageCleaner(d)
+		}
+
+		log.Printf("[DEBUG] Updating the Bootstrap Profile, Ingress Profile and Image Cleaner for the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
+		// the `bootstrapProfile`, `ingressProfile` and `securityProfile.imageCleaner` aren't available in the version of the SDK we're using, so we need to use a workaround client
+		hack := azuresdkhacks.NewManagedClustersWorkaroundClient(clusterClient)
+		future, err := hack.UpdateWorkaroundProperties(ctx, id.ResourceGroup, id.ManagedClusterName, workaroundProperties)
+		if err != nil {
+			return fmt.Errorf("updating Bootstrap Profile, Ingress Profile and Image Cleaner for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, clusterClient.Client); err != nil {
+			return fmt.Errorf("waiting for update of Bootstrap Profile, Ingress Profile and Image Cleaner for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated the Bootstrap Profile, Ingress Profile and Image Cleaner for the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
+	}
+
 	// then roll the version of Kubernetes if necessary
 	if d.HasChange("kubernetes_version") {
 		existing, err = clusterClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
@@ -1578,12 +1776,38 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("enable_pod_security_policy", props.EnablePodSecurityPolicy)
 		d.Set("local_account_disabled", props.DisableLocalAccounts)
 
+		microsoftDefender := flattenKubernetesClusterMicrosoftDefender(props.SecurityProfile)
+		if err := d.Set("microsoft_defender", microsoftDefender); err != nil {
+			return fmt.Errorf("setting `microsoft_defender`: %+v", err)
+		}
+
 		upgradeChannel := ""
 		if profile := props.AutoUpgradeProfile; profile != nil && profile.UpgradeChannel != containerservice.UpgradeChannelNone {
 			upgradeChannel = string(profile.UpgradeChannel)
 		}
 		d.Set("automatic_channel_upgrade", upgradeChannel)
 
+		// the `bootstrapProfile`, `ingressProfile` and `securityProfile.imageCleaner` aren't available in the version of the SDK we're using, so we need to use a workaround client
+		// the newer API Version this uses may not be available (e.g. in some National Clouds), in which case the existing values are retained
+		workaroundProperties, err := azuresdkhacks.NewManagedClustersWorkaroundClient(client).GetWorkaroundProperties(ctx, id.ResourceGroup, id.ManagedClusterName)
+		if err != nil {
+			if !utils.ResponseWasBadRequest(workaroundProperties.Response) && !utils.ResponseWasNotFound(workaroundProperties.Response) {
+				return fmt.Errorf("retrieving Bootstrap Profile, Ingress Profile and Image Cleaner for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+			}
+			log.Printf("[DEBUG] unable to retrieve Bootstrap Profile, Ingress Profile and Image Cleaner for Managed Kubernetes Cluster %q (Resource Group %q) - retaining the existing values: %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		} else {
+			if err := d.Set("web_app_routing", flattenKubernetesClusterWebAppRouting(workaroundProperties.Properties)); err != nil {
+				return fmt.Errorf("setting `web_app_routing`: %+v", err)
+			}
+			if err := d.Set("bootstrap_profile", flattenKubernetesClusterBootstrapProfile(workaroundProperties.Properties)); err != nil {
+				return fmt.Errorf("setting `bootstrap_profile`: %+v", err)
+			}
+
+			imageCleanerEnabled, imageCleanerIntervalHours := flattenKubernetesClusterImageCleaner(workaroundProperties.Properties)
+			d.Set("image_cleaner_enabled", imageCleanerEnabled)
+			d.Set("image_cleaner_interval_hours", imageCleanerIntervalHours)
+		}
+
 		// TODO: 2.0 we should introduce a access_profile block to match the new API design,
 		if accessProfile := props.APIServerAccessProfile; accessProfile != nil {
 			apiServerAuthorizedIPRanges := utils.FlattenStringSlice(accessProfile.AuthorizedIPRanges)
@@ -2651,3 +2875,286 @@ func flattenKubernetesClusterMaintenanceConfigurationTimeInWeeks(input *[]contai
 	}
 	return results
 }
+
+func expandKubernetesClusterMicrosoftDefender(d *pluginsdk.ResourceData, input []interface{}) (*containerservice.ManagedClusterSecurityProfile, error) {
+	if (len(input) == 0 || input[0] == nil) && d.HasChange("microsoft_defender") {
+		return &containerservice.ManagedClusterSecurityProfile{
+			AzureDefender: &containerservice.ManagedClusterSecurityProfileAzureDefender{
+				Enabled: utils.Bool(false),
+			},
+		}, nil
+	} else if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	config := input[0].(map[string]interface{})
+	workspaceId, err := laparse.LogAnalyticsWorkspaceID(config["log_analytics_workspace_id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `log_analytics_workspace_id`: %+v", err)
+	}
+
+	return &containerservice.ManagedClusterSecurityProfile{
+		AzureDefender: &containerservice.ManagedClusterSecurityProfileAzureDefender{
+			Enabled:                         utils.Bool(true),
+			LogAnalyticsWorkspaceResourceID: utils.String(workspaceId.ID()),
+		},
+	}, nil
+}
+
+func flattenKubernetesClusterMicrosoftDefender(input *containerservice.ManagedClusterSecurityProfile) []interface{} {
+	if input == nil || input.AzureDefender == nil || input.AzureDefender.Enabled == nil || !*input.AzureDefender.Enabled {
+		return []interface{}{}
+	}
+
+	logAnalyticsWorkspaceId := ""
+	if v := input.AzureDefender.LogAnalyticsWorkspaceResourceID; v != nil {
+		// the API returns this ID with inconsistent casing
+		if workspaceId, err := laparse.LogAnalyticsWorkspaceID(*v); err == nil {
+			logAnalyticsWorkspaceId = workspaceId.ID()
+		} else {
+			logAnalyticsWorkspaceId = *v
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics_workspace_id": logAnalyticsWorkspaceId,
+		},
+	}
+}
+
+func expandKubernetesClusterWebAppRouting(input []interface{}) *azuresdkhacks.ManagedClusterIngressProfile {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.ManagedClusterIngressProfile{
+			WebAppRouting: &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &azuresdkhacks.ManagedClusterIngressProfile{
+		WebAppRouting: &azuresdkhacks.ManagedClusterIngressProfileWebAppRouting{
+			Enabled:            utils.Bool(true),
+			DnsZoneResourceIds: utils.ExpandStringSlice(raw["dns_zone_ids"].([]interface{})),
+			Nginx: &azuresdkhacks.ManagedClusterIngressProfileNginx{
+				DefaultIngressControllerType: utils.String(raw["default_nginx_controller"].(string)),
+			},
+		},
+	}
+}
+
+func flattenKubernetesClusterWebAppRouting(input *azuresdkhacks.ManagedClusterWorkaroundProperties) []interface{} {
+	if input == nil || input.IngressProfile == nil || input.IngressProfile.WebAppRouting == nil {
+		return []interface{}{}
+	}
+
+	webAppRouting := input.IngressProfile.WebAppRouting
+	if webAppRouting.Enabled == nil || !*webAppRouting.Enabled {
+		return []interface{}{}
+	}
+
+	defaultNginxController := webAppRoutingNginxControllerAnnotationControlled
+	if nginx := webAppRouting.Nginx; nginx != nil && nginx.DefaultIngressControllerType != nil {
+		defaultNginxController = *nginx.DefaultIngressControllerType
+	}
+
+	identity := make([]interface{}, 0)
+	if v := webAppRouting.Identity; v != nil {
+		userAssignedIdentityId := ""
+		if v.ResourceId != nil {
+			parsedId, err := msiparse.UserAssignedIdentityIDInsensitively(*v.ResourceId)
+			if err == nil {
+				userAssignedIdentityId = parsedId.ID()
+			} else {
+				userAssignedIdentityId = *v.ResourceId
+			}
+		}
+
+		identity = append(identity, map[string]interface{}{
+			"client_id":                 utils.NormalizeNilableString(v.ClientId),
+			"object_id":                 utils.NormalizeNilableString(v.ObjectId),
+			"user_assigned_identity_id": userAssignedIdentityId,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dns_zone_ids":             utils.FlattenStringSlice(webAppRouting.DnsZoneResourceIds),
+			"default_nginx_controller": defaultNginxController,
+			"web_app_routing_identity": identity,
+		},
+	}
+}
+
+// validateKubernetesClusterWebAppRoutingDnsZones confirms that each of the DNS Zones specified within the `web_app_routing`
+// block exists and is accessible, since otherwise the API only surfaces an error once the add-on fails to reconcile.
+func validateKubernetesClusterWebAppRoutingDnsZones(ctx context.Context, meta interface{}, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	dnsZonesClient := meta.(*clients.Client).Dns.ZonesClient
+	privateDnsZonesClient := meta.(*clients.Client).PrivateDns.PrivateZonesClient
+
+	raw := input[0].(map[string]interface{})
+	for _, v := range raw["dns_zone_ids"].([]interface{}) {
+		zoneId := v.(string)
+
+		if id, err := dnsParse.DnsZoneID(zoneId); err == nil {
+			// the DNS Zone can live within a different Subscription to the Kubernetes Cluster
+			client := *dnsZonesClient
+			client.SubscriptionID = id.SubscriptionId
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("the DNS Zone %q specified in `web_app_routing.0.dns_zone_ids` was not found", zoneId)
+				}
+				return fmt.Errorf("retrieving %s specified in `web_app_routing.0.dns_zone_ids`: %+v", *id, err)
+			}
+			continue
+		}
+
+		id, err := privateDnsParse.PrivateDnsZoneID(zoneId)
+		if err != nil {
+			return fmt.Errorf("parsing %q specified in `web_app_routing.0.dns_zone_ids`: %+v", zoneId, err)
+		}
+
+		client := *privateDnsZonesClient
+		client.SubscriptionID = id.SubscriptionId
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("the Private DNS Zone %q specified in `web_app_routing.0.dns_zone_ids` was not found", zoneId)
+			}
+			return fmt.Errorf("retrieving %s specified in `web_app_routing.0.dns_zone_ids`: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterBootstrapProfile(input []interface{}) *azuresdkhacks.ManagedClusterBootstrapProfile {
+	if len(input) == 0 || input[0] == nil {
+		// removing the block reverts to pulling the bootstrap artifacts directly from MCR
+		return &azuresdkhacks.ManagedClusterBootstrapProfile{
+			ArtifactSource: utils.String(bootstrapArtifactSourceDirect),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &azuresdkhacks.ManagedClusterBootstrapProfile{
+		ArtifactSource: utils.String(raw["artifact_source"].(string)),
+	}
+	if v := raw["container_registry_id"].(string); v != "" {
+		output.ContainerRegistryId = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterBootstrapProfile(input *azuresdkhacks.ManagedClusterWorkaroundProperties) []interface{} {
+	if input == nil || input.BootstrapProfile == nil {
+		return []interface{}{}
+	}
+
+	bootstrapProfile := input.BootstrapProfile
+
+	artifactSource := bootstrapArtifactSourceDirect
+	if bootstrapProfile.ArtifactSource != nil && *bootstrapProfile.ArtifactSource != "" {
+		artifactSource = *bootstrapProfile.ArtifactSource
+	}
+
+	containerRegistryId := ""
+	if bootstrapProfile.ContainerRegistryId != nil {
+		if parsedId, err := parse.RegistryIDInsensitively(*bootstrapProfile.ContainerRegistryId); err == nil {
+			containerRegistryId = parsedId.ID()
+		} else {
+			containerRegistryId = *bootstrapProfile.ContainerRegistryId
+		}
+	}
+
+	// the API returns a `Direct` Bootstrap Profile when none is configured, which is the default behaviour
+	if artifactSource == bootstrapArtifactSourceDirect && containerRegistryId == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"artifact_source":       artifactSource,
+			"container_registry_id": containerRegistryId,
+		},
+	}
+}
+
+func expandKubernetesClusterImageCleaner(d *pluginsdk.ResourceData) *azuresdkhacks.ManagedClusterWorkaroundSecurityProfile {
+	return &azuresdkhacks.ManagedClusterWorkaroundSecurityProfile{
+		ImageCleaner: &azuresdkhacks.ManagedClusterSecurityProfileImageCleaner{
+			Enabled:       utils.Bool(d.Get("image_cleaner_enabled").(bool)),
+			IntervalHours: utils.Int32(int32(d.Get("image_cleaner_interval_hours").(int))),
+		},
+	}
+}
+
+func flattenKubernetesClusterImageCleaner(input *azuresdkhacks.ManagedClusterWorkaroundProperties) (bool, int) {
+	enabled := false
+	intervalHours := 48
+	if input == nil || input.SecurityProfile == nil || input.SecurityProfile.ImageCleaner == nil {
+		return enabled, intervalHours
+	}
+
+	imageCleaner := input.SecurityProfile.ImageCleaner
+	if imageCleaner.Enabled != nil {
+		enabled = *imageCleaner.Enabled
+	}
+	if imageCleaner.IntervalHours != nil {
+		intervalHours = int(*imageCleaner.IntervalHours)
+	}
+
+	return enabled, intervalHours
+}
+
+// validateKubernetesClusterBootstrapProfile confirms that a Container Registry is specified when the bootstrap artifacts
+// are sourced from a Cache, and that this Container Registry exists - since otherwise the API only surfaces an error
+// once the Nodes fail to bootstrap.
+func validateKubernetesClusterBootstrapProfile(ctx context.Context, meta interface{}, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	artifactSource := raw["artifact_source"].(string)
+	containerRegistryId := raw["container_registry_id"].(string)
+
+	if artifactSource == bootstrapArtifactSourceDirect {
+		if containerRegistryId != "" {
+			return fmt.Errorf("`bootstrap_profile.0.container_registry_id` can only be specified when `bootstrap_profile.0.artifact_source` is set to `%s`", bootstrapArtifactSourceCache)
+		}
+		return nil
+	}
+
+	if containerRegistryId == "" {
+		return fmt.Errorf("`bootstrap_profile.0.container_registry_id` must be specified when `bootstrap_profile.0.artifact_source` is set to `%s` - the bootstrap artifacts are cached within this Container Registry, which must be attached to the Kubernetes Cluster", bootstrapArtifactSourceCache)
+	}
+
+	id, err := parse.RegistryID(containerRegistryId)
+	if err != nil {
+		return fmt.Errorf("parsing `bootstrap_profile.0.container_registry_id`: %+v", err)
+	}
+
+	// the Container Registry can live within a different Subscription to the Kubernetes Cluster
+	client := *meta.(*clients.Client).Containers.RegistriesClient
+	client.SubscriptionID = id.SubscriptionId
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("the Container Registry %q specified in `bootstrap_profile.0.container_registry_id` was not found - the Container Registry must exist and be attached to the Kubernetes Cluster (e.g. by granting the Kubelet Identity the `AcrPull` role) before it can be used as the bootstrap artifact source", containerRegistryId)
+		}
+		return fmt.Errorf("retrieving %s specified in `bootstrap_profile.0.container_registry_id`: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -67,3 +67,47 @@ func RegistryID(input string) (*RegistryId, error) {
 
 	return &resourceId, nil
 }
+
+// RegistryIDInsensitively parses an Registry ID into an RegistryId struct, insensitively
+// This should only be used to parse an ID for rewriting, the RegistryID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func RegistryIDInsensitively(input string) (*RegistryId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RegistryId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'registries' segment
+	registriesKey := "registries"
+	for key := range id.Path {
+		if strings.EqualFold(key, registriesKey) {
+			registriesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(registriesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
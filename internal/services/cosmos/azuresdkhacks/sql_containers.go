@@ -0,0 +1,171 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `computedProperties` and `changeFeedPolicy` fields are only available from API Version `2023-04-15`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const sqlContainerExtendedPropertiesApiVersion = "2023-04-15"
+
+type SQLResourcesWorkaroundClient struct {
+	sdkClient *documentdb.SQLResourcesClient
+}
+
+func NewSQLResourcesWorkaroundClient(client *documentdb.SQLResourcesClient) SQLResourcesWorkaroundClient {
+	return SQLResourcesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type SQLContainerExtendedProperties struct {
+	ComputedProperties *[]SQLContainerComputedProperty `json:"computedProperties,omitempty"`
+	ChangeFeedPolicy   *SQLContainerChangeFeedPolicy   `json:"changeFeedPolicy,omitempty"`
+}
+
+type SQLContainerComputedProperty struct {
+	Name  *string `json:"name,omitempty"`
+	Query *string `json:"query,omitempty"`
+}
+
+type SQLContainerChangeFeedPolicy struct {
+	// RetentionDuration is the retention duration of the full fidelity change feed, in minutes
+	RetentionDuration *int32 `json:"retentionDuration,omitempty"`
+}
+
+// CreateUpdateSQLContainer creates or updates an Azure Cosmos DB SQL container, including the `computedProperties` and
+// `changeFeedPolicy` of the container.
+// Parameters:
+// resourceGroupName - the name of the resource group. The name is case insensitive.
+// accountName - cosmos DB database account name.
+// databaseName - cosmos DB database name.
+// containerName - cosmos DB container name.
+// parameters - the parameters to provide for the current SQL container.
+// extendedProperties - the properties of the SQL container which aren't available in the SDK.
+func (client SQLResourcesWorkaroundClient) CreateUpdateSQLContainer(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, parameters documentdb.SQLContainerCreateUpdateParameters, extendedProperties SQLContainerExtendedProperties) (result documentdb.SQLResourcesCreateUpdateSQLContainerFuture, err error) {
+	req, err := client.sdkClient.CreateUpdateSQLContainerPreparer(ctx, resourceGroupName, accountName, databaseName, containerName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "CreateUpdateSQLContainer", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withSQLContainerExtendedProperties(extendedProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "CreateUpdateSQLContainer", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateUpdateSQLContainerSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "CreateUpdateSQLContainer", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetSQLContainerExtendedProperties returns the `computedProperties` and `changeFeedPolicy` of the specified SQL container.
+// Parameters:
+// resourceGroupName - the name of the resource group. The name is case insensitive.
+// accountName - cosmos DB database account name.
+// databaseName - cosmos DB database name.
+// containerName - cosmos DB container name.
+func (client SQLResourcesWorkaroundClient) GetSQLContainerExtendedProperties(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string) (result SQLContainerExtendedGetResults, err error) {
+	req, err := client.sdkClient.GetSQLContainerPreparer(ctx, resourceGroupName, accountName, databaseName, containerName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "GetSQLContainerExtendedProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", sqlContainerExtendedPropertiesApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSQLContainerSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "GetSQLContainerExtendedProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "GetSQLContainerExtendedProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type SQLContainerExtendedGetResults struct {
+	autorest.Response `json:"-"`
+	Properties        *SQLContainerExtendedGetProperties `json:"properties,omitempty"`
+}
+
+type SQLContainerExtendedGetProperties struct {
+	Resource *SQLContainerExtendedProperties `json:"resource,omitempty"`
+}
+
+// withSQLContainerExtendedProperties sets the `computedProperties` and `changeFeedPolicy` within the resource of the
+// request body and updates the API Version used for the request to one which supports these fields.
+func withSQLContainerExtendedProperties(input SQLContainerExtendedProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			resource, ok := properties["resource"].(map[string]interface{})
+			if !ok {
+				resource = make(map[string]interface{})
+			}
+
+			if input.ComputedProperties != nil {
+				resource["computedProperties"] = *input.ComputedProperties
+			}
+			if input.ChangeFeedPolicy != nil {
+				resource["changeFeedPolicy"] = *input.ChangeFeedPolicy
+			}
+
+			properties["resource"] = resource
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", sqlContainerExtendedPropertiesApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
 )
 
 type Client struct {
@@ -11,8 +12,10 @@ type Client struct {
 	CassandraDatacentersClient *documentdb.CassandraDataCentersClient
 	DatabaseClient             *documentdb.DatabaseAccountsClient
 	GremlinClient              *documentdb.GremlinResourcesClient
+	LocationsClient            *documentdb.LocationsClient
 	MongoDbClient              *documentdb.MongoDBResourcesClient
 	NotebookWorkspaceClient    *documentdb.NotebookWorkspacesClient
+	PostgreSQLClustersClient   *clusters.ClustersClient
 	SqlClient                  *documentdb.SQLResourcesClient
 	SqlResourceClient          *documentdb.SQLResourcesClient
 	TableClient                *documentdb.TableResourcesClient
@@ -34,12 +37,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	gremlinClient := documentdb.NewGremlinResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&gremlinClient.Client, o.ResourceManagerAuthorizer)
 
+	locationsClient := documentdb.NewLocationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&locationsClient.Client, o.ResourceManagerAuthorizer)
+
 	mongoDbClient := documentdb.NewMongoDBResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&mongoDbClient.Client, o.ResourceManagerAuthorizer)
 
 	notebookWorkspaceClient := documentdb.NewNotebookWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&notebookWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
+	postgreSQLClustersClient := clusters.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&postgreSQLClustersClient.Client, o.ResourceManagerAuthorizer)
+
 	sqlClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlClient.Client, o.ResourceManagerAuthorizer)
 
@@ -55,8 +64,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		CassandraDatacentersClient: &cassandraDatacentersClient,
 		DatabaseClient:             &databaseClient,
 		GremlinClient:              &gremlinClient,
+		LocationsClient:            &locationsClient,
 		MongoDbClient:              &mongoDbClient,
 		NotebookWorkspaceClient:    &notebookWorkspaceClient,
+		PostgreSQLClustersClient:   &postgreSQLClustersClient,
 		SqlClient:                  &sqlClient,
 		SqlResourceClient:          &sqlResourceClient,
 		TableClient:                &tableClient,
@@ -1,6 +1,9 @@
 package common
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -50,3 +53,37 @@ func FlattenCosmosDbConflictResolutionPolicy(input *documentdb.ConflictResolutio
 		},
 	}
 }
+
+// ValidateCosmosDbConflictResolutionPolicy ensures the path/procedure specified matches the conflict resolution mode
+// and that the Cosmos DB Account supports writes in multiple locations when a `Custom` conflict resolution policy is
+// used, since conflicts (and therefore custom conflict resolution) can only occur when writing to multiple locations
+func ValidateCosmosDbConflictResolutionPolicy(ctx context.Context, client *documentdb.DatabaseAccountsClient, resourceGroup, accountName string, inputs []interface{}) error {
+	if len(inputs) == 0 || inputs[0] == nil {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	mode := documentdb.ConflictResolutionMode(input["mode"].(string))
+
+	if mode == documentdb.ConflictResolutionModeLastWriterWins {
+		if input["conflict_resolution_procedure"].(string) != "" {
+			return fmt.Errorf("`conflict_resolution_procedure` can only be specified when `mode` is `Custom`")
+		}
+		return nil
+	}
+
+	if input["conflict_resolution_path"].(string) != "" {
+		return fmt.Errorf("`conflict_resolution_path` can only be specified when `mode` is `LastWriterWins`")
+	}
+
+	account, err := client.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Cosmos DB Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if props := account.DatabaseAccountGetProperties; props == nil || props.EnableMultipleWriteLocations == nil || !*props.EnableMultipleWriteLocations {
+		return fmt.Errorf("a `conflict_resolution_policy` with a `mode` of `Custom` requires `enable_multiple_write_locations` to be enabled on the Cosmos DB Account %q (Resource Group %q)", accountName, resourceGroup)
+	}
+
+	return nil
+}
@@ -544,6 +544,10 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("expanding CosmosDB Account %q (Resource Group %q) geo locations: %+v", name, resourceGroup, err)
 	}
 
+	if err := validateCosmosDbAccountZoneRedundantLocations(ctx, meta.(*clients.Client).Cosmos.LocationsClient, geoLocations); err != nil {
+		return err
+	}
+
 	publicNetworkAccess := documentdb.PublicNetworkAccessEnabled
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
 		publicNetworkAccess = documentdb.PublicNetworkAccessDisabled
@@ -655,6 +659,12 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("expanding CosmosDB Account %q (Resource Group %q) geo locations: %+v", name, resourceGroup, err)
 	}
 
+	if d.HasChange("geo_location") {
+		if err := validateCosmosDbAccountZoneRedundantLocations(ctx, meta.(*clients.Client).Cosmos.LocationsClient, newLocations); err != nil {
+			return err
+		}
+	}
+
 	// get existing locations (if exists)
 	resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -1051,6 +1061,12 @@ func resourceCosmosDbAccountApiUpsert(client *documentdb.DatabaseAccountsClient,
 						}
 					}
 				}
+
+				// enabling/disabling zone redundancy on an existing location is a long running operation, during which
+				// the location continues to report its previous zone redundancy
+				if !cosmosDbAccountZoneRedundancyMatches(*account.Locations, props.Locations) {
+					return resp, "Updating", nil
+				}
 			}
 
 			return resp, status, nil
@@ -1224,6 +1240,57 @@ func findZoneRedundant(locations *[]documentdb.Location, id string) bool {
 	return false
 }
 
+// validateCosmosDbAccountZoneRedundantLocations ensures that each of the locations which are zone redundant
+// are in a region which supports Availability Zones for Cosmos DB
+func validateCosmosDbAccountZoneRedundantLocations(ctx context.Context, client *documentdb.LocationsClient, locations []documentdb.Location) error {
+	for _, location := range locations {
+		if location.IsZoneRedundant == nil || !*location.IsZoneRedundant || location.LocationName == nil {
+			continue
+		}
+
+		resp, err := client.Get(ctx, *location.LocationName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Cosmos DB location metadata for %q was not found - skipping Availability Zone validation", *location.LocationName)
+				continue
+			}
+			return fmt.Errorf("retrieving Cosmos DB location metadata for %q: %+v", *location.LocationName, err)
+		}
+
+		if props := resp.Properties; props != nil && props.SupportsAvailabilityZone != nil && !*props.SupportsAvailabilityZone {
+			return fmt.Errorf("`zone_redundant` cannot be enabled for the `geo_location` %q since Availability Zones aren't supported for Cosmos DB in this region", *location.LocationName)
+		}
+	}
+
+	return nil
+}
+
+// cosmosDbAccountZoneRedundancyMatches returns whether each of the desired locations has the desired zone redundancy
+func cosmosDbAccountZoneRedundancyMatches(desired []documentdb.Location, actual *[]documentdb.Location) bool {
+	if actual == nil {
+		return true
+	}
+
+	for _, desiredLocation := range desired {
+		if desiredLocation.LocationName == nil || desiredLocation.IsZoneRedundant == nil {
+			continue
+		}
+
+		for _, l := range *actual {
+			if l.LocationName == nil || azure.NormalizeLocation(*l.LocationName) != azure.NormalizeLocation(*desiredLocation.LocationName) {
+				continue
+			}
+
+			isZoneRedundant := l.IsZoneRedundant != nil && *l.IsZoneRedundant
+			if isZoneRedundant != *desiredLocation.IsZoneRedundant {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func isServerlessCapacityMode(accResp documentdb.DatabaseAccountGetResults) bool {
 	if props := accResp.DatabaseAccountGetProperties; props != nil && props.Capabilities != nil {
 		for _, v := range *props.Capabilities {
@@ -366,6 +366,48 @@ func TestAccCosmosDBAccount_zoneRedundant_update_mongo(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDBAccount_zoneRedundant_updateExistingLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account", "test")
+	r := CosmosDBAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zoneRedundantExistingLocation(data, data.Locations.Secondary, false),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.zoneRedundantExistingLocation(data, data.Locations.Secondary, true),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.zoneRedundantExistingLocation(data, data.Locations.Secondary, false),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDBAccount_zoneRedundant_unsupportedLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account", "test")
+	r := CosmosDBAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// West Central US doesn't support Availability Zones
+			Config:      r.zoneRedundantExistingLocation(data, "westcentralus", true),
+			ExpectError: regexp.MustCompile("Availability Zones aren't supported for Cosmos DB in this region"),
+		},
+	})
+}
+
 func TestAccCosmosDBAccount_update_mongo(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account", "test")
 	r := CosmosDBAccountResource{}
@@ -1234,6 +1276,42 @@ resource "azurerm_cosmosdb_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, string(kind), data.Locations.Secondary)
 }
 
+func (CosmosDBAccountResource) zoneRedundantExistingLocation(data acceptance.TestData, secondaryLocation string, zoneRedundant bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  geo_location {
+    location          = "%s"
+    failover_priority = 1
+    zone_redundant    = %t
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, secondaryLocation, zoneRedundant)
+}
+
 func (CosmosDBAccountResource) zoneRedundantMongoDB(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1474,6 +1552,49 @@ resource "azurerm_cosmosdb_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, string(kind), capeTf)
 }
 
+func (CosmosDBAccountResource) multipleWriteLocations(data acceptance.TestData, kind documentdb.DatabaseAccountKind, capabilities []string) string {
+	capeTf := ""
+	for _, c := range capabilities {
+		capeTf += fmt.Sprintf("capabilities {name = \"%s\"}\n", c)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                            = "acctest-ca-%d"
+  location                        = azurerm_resource_group.test.location
+  resource_group_name             = azurerm_resource_group.test.name
+  offer_type                      = "Standard"
+  kind                            = "%s"
+  enable_multiple_write_locations = true
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  %s
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  geo_location {
+    location          = "%s"
+    failover_priority = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, string(kind), capeTf, data.Locations.Secondary)
+}
+
 func (CosmosDBAccountResource) geoLocationUpdate(data acceptance.TestData, kind documentdb.DatabaseAccountKind, consistency documentdb.DefaultConsistencyLevel) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
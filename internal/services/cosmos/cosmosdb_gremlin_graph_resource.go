@@ -200,6 +200,10 @@ func resourceCosmosDbGremlinGraphCreate(d *pluginsdk.ResourceData, meta interfac
 		return tf.ImportAsExistsError("azurerm_cosmosdb_gremlin_graph", *existing.ID)
 	}
 
+	if err := common.ValidateCosmosDbConflictResolutionPolicy(ctx, meta.(*clients.Client).Cosmos.DatabaseClient, resourceGroup, account, d.Get("conflict_resolution_policy").([]interface{})); err != nil {
+		return fmt.Errorf("validating `conflict_resolution_policy` for Cosmos Gremlin Graph %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
 	db := documentdb.GremlinGraphCreateUpdateParameters{
 		GremlinGraphCreateUpdateProperties: &documentdb.GremlinGraphCreateUpdateProperties{
 			Resource: &documentdb.GremlinGraphResource{
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -222,6 +223,12 @@ func (CosmosGremlinGraphResource) customConflictResolutionPolicy(data acceptance
 	return fmt.Sprintf(`
 %[1]s
 
+resource "azurerm_cosmosdb_gremlin_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
 resource "azurerm_cosmosdb_gremlin_graph" "test" {
   name                = "acctest-CGRPC-%[2]d"
   resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
@@ -242,7 +249,7 @@ resource "azurerm_cosmosdb_gremlin_graph" "test" {
     conflict_resolution_procedure = "dbs/{0}/colls/{1}/sprocs/{2}"
   }
 }
-`, CosmosGremlinDatabaseResource{}.basic(data), data.RandomInteger)
+`, CosmosDBAccountResource{}.multipleWriteLocations(data, documentdb.DatabaseAccountKindGlobalDocumentDB, []string{"EnableGremlin"}), data.RandomInteger)
 }
 
 func (CosmosGremlinGraphResource) indexPolicy(data acceptance.TestData) string {
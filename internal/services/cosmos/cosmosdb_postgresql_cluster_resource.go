@@ -0,0 +1,643 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// postgreSQLClusterStorageQuotasInMb are the storage sizes supported for both the Coordinator and the Worker Nodes
+var postgreSQLClusterStorageQuotasInMb = []int{32768, 65536, 131072, 262144, 524288, 1048576, 2097152, 4194304, 8388608, 16777216}
+
+func resourceCosmosDbPostgreSQLCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbPostgreSQLClusterCreate,
+		Read:   resourceCosmosDbPostgreSQLClusterRead,
+		Update: resourceCosmosDbPostgreSQLClusterUpdate,
+		Delete: resourceCosmosDbPostgreSQLClusterDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := clusters.ParseServerGroupsv2ID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(3 * time.Hour),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceCosmosDbPostgreSQLClusterCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PostgreSQLClusterName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": location.Schema(),
+
+			"node_count": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.PostgreSQLClusterNodeCount,
+			},
+
+			"administrator_login_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"citus_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"8.3",
+					"9.0",
+					"9.1",
+					"9.2",
+					"9.3",
+					"9.4",
+					"9.5",
+					"10.0",
+					"10.1",
+					"10.2",
+					"11.0",
+					"11.1",
+					"11.2",
+					"11.3",
+				}, false),
+			},
+
+			"coordinator_public_ip_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"coordinator_server_edition": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "GeneralPurpose",
+				ValidateFunc: validation.StringInSlice([]string{
+					"BurstableGeneralPurpose",
+					"BurstableMemoryOptimized",
+					"GeneralPurpose",
+					"MemoryOptimized",
+				}, false),
+			},
+
+			"coordinator_storage_quota_in_mb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice(postgreSQLClusterStorageQuotasInMb),
+			},
+
+			"coordinator_vcore_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 8, 16, 32, 64, 96}),
+			},
+
+			"ha_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"maintenance_window": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"day_of_week": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 6),
+						},
+
+						"start_hour": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+
+						"start_minute": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+
+			"node_public_ip_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"node_server_edition": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "MemoryOptimized",
+				ValidateFunc: validation.StringInSlice([]string{
+					"GeneralPurpose",
+					"MemoryOptimized",
+				}, false),
+			},
+
+			"node_storage_quota_in_mb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice(postgreSQLClusterStorageQuotasInMb),
+			},
+
+			"node_vcores": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 8, 16, 32, 64, 96, 104}),
+			},
+
+			"point_in_time_in_utc": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				RequiredWith: []string{"source_resource_id"},
+			},
+
+			"preferred_primary_zone": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"shards_on_coordinator_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"source_location": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+				RequiredWith:     []string{"source_resource_id"},
+			},
+
+			"source_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PostgreSQLClusterID,
+			},
+
+			"sql_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"11",
+					"12",
+					"13",
+					"14",
+					"15",
+				}, false),
+			},
+
+			"tags": tags.Schema(),
+
+			"earliest_restore_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"read_replica_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"servers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fqdn": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCosmosDbPostgreSQLClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.PostgreSQLClustersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := clusters.NewServerGroupsv2ID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_postgresql_cluster", id.ID())
+	}
+
+	props := clusters.ClusterProperties{
+		CoordinatorEnablePublicIPAccess: utils.Bool(d.Get("coordinator_public_ip_access_enabled").(bool)),
+		CoordinatorServerEdition:        utils.String(d.Get("coordinator_server_edition").(string)),
+		EnableHa:                        utils.Bool(d.Get("ha_enabled").(bool)),
+		MaintenanceWindow:               expandCosmosDbPostgreSQLClusterMaintenanceWindow(d.Get("maintenance_window").([]interface{})),
+		NodeCount:                       utils.Int64(int64(d.Get("node_count").(int))),
+		NodeEnablePublicIPAccess:        utils.Bool(d.Get("node_public_ip_access_enabled").(bool)),
+		NodeServerEdition:               utils.String(d.Get("node_server_edition").(string)),
+	}
+
+	// a read replica (or a point-in-time restore) takes the Administrator Login from the source cluster
+	if v, ok := d.GetOk("source_resource_id"); ok {
+		props.SourceResourceId = utils.String(v.(string))
+
+		if v, ok := d.GetOk("source_location"); ok {
+			props.SourceLocation = utils.String(location.Normalize(v.(string)))
+		}
+
+		if v, ok := d.GetOk("point_in_time_in_utc"); ok {
+			props.PointInTimeUTC = utils.String(v.(string))
+		}
+	} else if d.Get("administrator_login_password").(string) == "" {
+		return fmt.Errorf("`administrator_login_password` is required when `source_resource_id` isn't specified")
+	}
+
+	if v, ok := d.GetOk("administrator_login_password"); ok {
+		props.AdministratorLoginPassword = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("citus_version"); ok {
+		props.CitusVersion = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("coordinator_storage_quota_in_mb"); ok {
+		props.CoordinatorStorageQuotaInMb = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("coordinator_vcore_count"); ok {
+		props.CoordinatorVCores = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("node_storage_quota_in_mb"); ok {
+		props.NodeStorageQuotaInMb = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("node_vcores"); ok {
+		props.NodeVCores = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("preferred_primary_zone"); ok {
+		props.PreferredPrimaryZone = utils.String(v.(string))
+	}
+
+	// NOTE: this must be checked using GetOkExists since `false` is a valid (and meaningful) value
+	// nolint staticcheck
+	if v, ok := d.GetOkExists("shards_on_coordinator_enabled"); ok {
+		props.EnableShardsOnCoordinator = utils.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("sql_version"); ok {
+		props.PostgresqlVersion = utils.String(v.(string))
+	}
+
+	parameters := clusters.Cluster{
+		Location:   location.Normalize(d.Get("location").(string)),
+		Properties: &props,
+		Tags:       expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCosmosDbPostgreSQLClusterRead(d, meta)
+}
+
+func resourceCosmosDbPostgreSQLClusterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.PostgreSQLClustersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := clusters.ParseServerGroupsv2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			d.Set("citus_version", props.CitusVersion)
+			d.Set("coordinator_public_ip_access_enabled", props.CoordinatorEnablePublicIPAccess)
+			d.Set("coordinator_server_edition", props.CoordinatorServerEdition)
+			d.Set("coordinator_storage_quota_in_mb", props.CoordinatorStorageQuotaInMb)
+			d.Set("coordinator_vcore_count", props.CoordinatorVCores)
+			d.Set("earliest_restore_time", props.EarliestRestoreTime)
+			d.Set("ha_enabled", props.EnableHa)
+			d.Set("node_count", props.NodeCount)
+			d.Set("node_public_ip_access_enabled", props.NodeEnablePublicIPAccess)
+			d.Set("node_server_edition", props.NodeServerEdition)
+			d.Set("node_storage_quota_in_mb", props.NodeStorageQuotaInMb)
+			d.Set("node_vcores", props.NodeVCores)
+			d.Set("preferred_primary_zone", props.PreferredPrimaryZone)
+			d.Set("shards_on_coordinator_enabled", props.EnableShardsOnCoordinator)
+			d.Set("sql_version", props.PostgresqlVersion)
+			d.Set("read_replica_ids", utils.FlattenStringSlice(props.ReadReplicas))
+
+			// the source of a read replica is returned, however the source of a point-in-time restore isn't
+			if props.SourceResourceId != nil {
+				d.Set("source_resource_id", props.SourceResourceId)
+			}
+			if props.SourceLocation != nil {
+				d.Set("source_location", location.Normalize(*props.SourceLocation))
+			}
+
+			if err := d.Set("maintenance_window", flattenCosmosDbPostgreSQLClusterMaintenanceWindow(props.MaintenanceWindow)); err != nil {
+				return fmt.Errorf("setting `maintenance_window`: %+v", err)
+			}
+
+			if err := d.Set("servers", flattenCosmosDbPostgreSQLClusterServers(props.ServerNames)); err != nil {
+				return fmt.Errorf("setting `servers`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceCosmosDbPostgreSQLClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.PostgreSQLClustersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := clusters.ParseServerGroupsv2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// only the changed properties are sent, since the API rejects some combinations of changes
+	// (e.g. changing the Citus Version) in the same request as scaling operations
+	props := clusters.ClusterPropertiesForUpdate{}
+	parameters := clusters.ClusterForUpdate{
+		Properties: &props,
+	}
+
+	if d.HasChange("administrator_login_password") {
+		props.AdministratorLoginPassword = utils.String(d.Get("administrator_login_password").(string))
+	}
+
+	if d.HasChange("citus_version") {
+		props.CitusVersion = utils.String(d.Get("citus_version").(string))
+	}
+
+	if d.HasChange("coordinator_public_ip_access_enabled") {
+		props.CoordinatorEnablePublicIPAccess = utils.Bool(d.Get("coordinator_public_ip_access_enabled").(bool))
+	}
+
+	if d.HasChange("coordinator_server_edition") {
+		props.CoordinatorServerEdition = utils.String(d.Get("coordinator_server_edition").(string))
+	}
+
+	if d.HasChange("coordinator_storage_quota_in_mb") {
+		props.CoordinatorStorageQuotaInMb = utils.Int64(int64(d.Get("coordinator_storage_quota_in_mb").(int)))
+	}
+
+	if d.HasChange("coordinator_vcore_count") {
+		props.CoordinatorVCores = utils.Int64(int64(d.Get("coordinator_vcore_count").(int)))
+	}
+
+	if d.HasChange("ha_enabled") {
+		props.EnableHa = utils.Bool(d.Get("ha_enabled").(bool))
+	}
+
+	if d.HasChange("maintenance_window") {
+		props.MaintenanceWindow = expandCosmosDbPostgreSQLClusterMaintenanceWindow(d.Get("maintenance_window").([]interface{}))
+	}
+
+	if d.HasChange("node_count") {
+		props.NodeCount = utils.Int64(int64(d.Get("node_count").(int)))
+	}
+
+	if d.HasChange("node_public_ip_access_enabled") {
+		props.NodeEnablePublicIPAccess = utils.Bool(d.Get("node_public_ip_access_enabled").(bool))
+	}
+
+	if d.HasChange("node_server_edition") {
+		props.NodeServerEdition = utils.String(d.Get("node_server_edition").(string))
+	}
+
+	if d.HasChange("node_storage_quota_in_mb") {
+		props.NodeStorageQuotaInMb = utils.Int64(int64(d.Get("node_storage_quota_in_mb").(int)))
+	}
+
+	if d.HasChange("node_vcores") {
+		props.NodeVCores = utils.Int64(int64(d.Get("node_vcores").(int)))
+	}
+
+	if d.HasChange("preferred_primary_zone") {
+		props.PreferredPrimaryZone = utils.String(d.Get("preferred_primary_zone").(string))
+	}
+
+	if d.HasChange("shards_on_coordinator_enabled") {
+		props.EnableShardsOnCoordinator = utils.Bool(d.Get("shards_on_coordinator_enabled").(bool))
+	}
+
+	if d.HasChange("sql_version") {
+		props.PostgresqlVersion = utils.String(d.Get("sql_version").(string))
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCosmosDbPostgreSQLClusterRead(d, meta)
+}
+
+func resourceCosmosDbPostgreSQLClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.PostgreSQLClustersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := clusters.ParseServerGroupsv2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// resourceCosmosDbPostgreSQLClusterCustomizeDiff ensures that scaling operations which aren't supported by the API
+// (reducing the storage size or removing Worker Nodes) are surfaced during the plan, rather than replacing the cluster
+func resourceCosmosDbPostgreSQLClusterCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{"coordinator_storage_quota_in_mb", "node_storage_quota_in_mb"} {
+		if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			continue
+		}
+
+		old, new := diff.GetChange(key)
+		if old.(int) > 0 && new.(int) < old.(int) {
+			return fmt.Errorf("`%s` can't be decreased (from %d to %d), the storage size can only be increased", key, old.(int), new.(int))
+		}
+	}
+
+	if diff.HasChange("node_count") && diff.NewValueKnown("node_count") {
+		old, new := diff.GetChange("node_count")
+		if new.(int) < old.(int) {
+			return fmt.Errorf("`node_count` can't be decreased (from %d to %d), Worker Nodes can only be added to a cluster", old.(int), new.(int))
+		}
+	}
+
+	return nil
+}
+
+func expandCosmosDbPostgreSQLClusterMaintenanceWindow(input []interface{}) *clusters.MaintenanceWindow {
+	if len(input) == 0 || input[0] == nil {
+		return &clusters.MaintenanceWindow{
+			CustomWindow: utils.String("Disabled"),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &clusters.MaintenanceWindow{
+		CustomWindow: utils.String("Enabled"),
+		DayOfWeek:    utils.Int64(int64(v["day_of_week"].(int))),
+		StartHour:    utils.Int64(int64(v["start_hour"].(int))),
+		StartMinute:  utils.Int64(int64(v["start_minute"].(int))),
+	}
+}
+
+func flattenCosmosDbPostgreSQLClusterMaintenanceWindow(input *clusters.MaintenanceWindow) []interface{} {
+	if input == nil || input.CustomWindow == nil || *input.CustomWindow != "Enabled" {
+		return make([]interface{}, 0)
+	}
+
+	var dayOfWeek, startHour, startMinute int64
+	if input.DayOfWeek != nil {
+		dayOfWeek = *input.DayOfWeek
+	}
+	if input.StartHour != nil {
+		startHour = *input.StartHour
+	}
+	if input.StartMinute != nil {
+		startMinute = *input.StartMinute
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"day_of_week":  dayOfWeek,
+			"start_hour":   startHour,
+			"start_minute": startMinute,
+		},
+	}
+}
+
+func flattenCosmosDbPostgreSQLClusterServers(input *[]clusters.ServerNameItem) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		fqdn := ""
+		if item.FullyQualifiedDomainName != nil {
+			fqdn = *item.FullyQualifiedDomainName
+		}
+
+		output = append(output, map[string]interface{}{
+			"name": name,
+			"fqdn": fqdn,
+		})
+	}
+
+	return output
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
@@ -123,6 +124,42 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 				},
 			},
 			"indexing_policy": common.CosmosDbIndexingPolicySchema(),
+
+			"computed_property": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.CosmosComputedPropertyName,
+						},
+
+						"query": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.CosmosComputedPropertyQuery,
+						},
+					},
+				},
+			},
+
+			"change_feed_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"retention_duration": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10080),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -151,6 +188,10 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 		return tf.ImportAsExistsError("azurerm_cosmosdb_sql_container", *existing.ID)
 	}
 
+	if err := common.ValidateCosmosDbConflictResolutionPolicy(ctx, meta.(*clients.Client).Cosmos.DatabaseClient, resourceGroup, account, d.Get("conflict_resolution_policy").([]interface{})); err != nil {
+		return fmt.Errorf("validating `conflict_resolution_policy` for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
 	indexingPolicy := common.ExpandAzureRmCosmosDbIndexingPolicy(d)
 	err = common.ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy)
 	if err != nil {
@@ -203,7 +244,13 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 		db.SQLContainerCreateUpdateProperties.Options.AutoscaleSettings = common.ExpandCosmosDbAutoscaleSettings(d)
 	}
 
-	future, err := client.CreateUpdateSQLContainer(ctx, resourceGroup, account, database, name, db)
+	extendedProperties := azuresdkhacks.SQLContainerExtendedProperties{
+		ComputedProperties: expandCosmosSQLContainerComputedProperties(d.Get("computed_property").([]interface{})),
+		ChangeFeedPolicy:   expandCosmosSQLContainerChangeFeedPolicy(d.Get("change_feed_policy").([]interface{})),
+	}
+
+	workaroundClient := azuresdkhacks.NewSQLResourcesWorkaroundClient(client)
+	future, err := workaroundClient.CreateUpdateSQLContainer(ctx, resourceGroup, account, database, name, db, extendedProperties)
 	if err != nil {
 		return fmt.Errorf("issuing create/update request for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", name, account, database, err)
 	}
@@ -284,7 +331,13 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		db.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
 	}
 
-	future, err := client.CreateUpdateSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, db)
+	extendedProperties := azuresdkhacks.SQLContainerExtendedProperties{
+		ComputedProperties: expandCosmosSQLContainerComputedProperties(d.Get("computed_property").([]interface{})),
+		ChangeFeedPolicy:   expandCosmosSQLContainerChangeFeedPolicy(d.Get("change_feed_policy").([]interface{})),
+	}
+
+	workaroundClient := azuresdkhacks.NewSQLResourcesWorkaroundClient(client)
+	future, err := workaroundClient.CreateUpdateSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, db, extendedProperties)
 	if err != nil {
 		return fmt.Errorf("issuing create/update request for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 	}
@@ -377,6 +430,27 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	workaroundClient := azuresdkhacks.NewSQLResourcesWorkaroundClient(client)
+	extendedResp, err := workaroundClient.GetSQLContainerExtendedProperties(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving the Computed Properties and Change Feed Policy for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+	}
+
+	var computedProperties *[]azuresdkhacks.SQLContainerComputedProperty
+	var changeFeedPolicy *azuresdkhacks.SQLContainerChangeFeedPolicy
+	if props := extendedResp.Properties; props != nil && props.Resource != nil {
+		computedProperties = props.Resource.ComputedProperties
+		changeFeedPolicy = props.Resource.ChangeFeedPolicy
+	}
+
+	if err := d.Set("computed_property", flattenCosmosSQLContainerComputedProperties(computedProperties)); err != nil {
+		return fmt.Errorf("setting `computed_property`: %+v", err)
+	}
+
+	if err := d.Set("change_feed_policy", flattenCosmosSQLContainerChangeFeedPolicy(changeFeedPolicy)); err != nil {
+		return fmt.Errorf("setting `change_feed_policy`: %+v", err)
+	}
+
 	accResp, err := accountClient.Get(ctx, id.ResourceGroup, id.DatabaseAccountName)
 	if err != nil {
 		return fmt.Errorf("reading CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroup, err)
@@ -470,3 +544,66 @@ func flattenCosmosSQLContainerUniqueKeys(keys *[]documentdb.UniqueKey) *[]map[st
 
 	return &slice
 }
+
+func expandCosmosSQLContainerComputedProperties(input []interface{}) *[]azuresdkhacks.SQLContainerComputedProperty {
+	// an empty list is sent (rather than nil) so that any existing Computed Properties are removed
+	results := make([]azuresdkhacks.SQLContainerComputedProperty, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, azuresdkhacks.SQLContainerComputedProperty{
+			Name:  utils.String(v["name"].(string)),
+			Query: utils.String(v["query"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenCosmosSQLContainerComputedProperties(input *[]azuresdkhacks.SQLContainerComputedProperty) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		query := ""
+		if item.Query != nil {
+			query = *item.Query
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":  name,
+			"query": query,
+		})
+	}
+
+	return results
+}
+
+func expandCosmosSQLContainerChangeFeedPolicy(input []interface{}) *azuresdkhacks.SQLContainerChangeFeedPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &azuresdkhacks.SQLContainerChangeFeedPolicy{
+		RetentionDuration: utils.Int32(int32(v["retention_duration"].(int))),
+	}
+}
+
+func flattenCosmosSQLContainerChangeFeedPolicy(input *azuresdkhacks.SQLContainerChangeFeedPolicy) []interface{} {
+	if input == nil || input.RetentionDuration == nil || *input.RetentionDuration == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"retention_duration": int(*input.RetentionDuration),
+		},
+	}
+}
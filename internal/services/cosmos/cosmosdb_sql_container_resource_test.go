@@ -3,6 +3,7 @@ package cosmos_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
@@ -223,6 +224,73 @@ func TestAccCosmosDbSqlContainer_customConflictResolutionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccCosmosDbSqlContainer_customConflictResolutionPolicySingleWriteLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.conflictResolutionPolicySingleWriteLocation(data),
+			ExpectError: regexp.MustCompile("requires `enable_multiple_write_locations` to be enabled"),
+		},
+	})
+}
+
+func TestAccCosmosDbSqlContainer_computedProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.computedProperties(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("computed_property.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("computed_property.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDbSqlContainer_changeFeedPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container", "test")
+	r := CosmosSqlContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.changeFeedPolicy(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("change_feed_policy.0.retention_duration").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.changeFeedPolicy(data, 60),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("change_feed_policy.0.retention_duration").HasValue("60"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t CosmosSqlContainerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SqlContainerID(state.ID)
 	if err != nil {
@@ -555,5 +623,78 @@ resource "azurerm_cosmosdb_sql_container" "test" {
     conflict_resolution_procedure = "dbs/{0}/colls/{1}/sprocs/{2}"
   }
 }
+`, CosmosSqlContainerResource{}.multipleWriteLocationsTemplate(data), data.RandomInteger)
+}
+
+func (CosmosSqlContainerResource) conflictResolutionPolicySingleWriteLocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+
+  conflict_resolution_policy {
+    mode                          = "Custom"
+    conflict_resolution_procedure = "dbs/{0}/colls/{1}/sprocs/{2}"
+  }
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
+}
+
+func (CosmosSqlContainerResource) multipleWriteLocationsTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+`, CosmosDBAccountResource{}.multipleWriteLocations(data, documentdb.DatabaseAccountKindGlobalDocumentDB, []string{}), data.RandomInteger)
+}
+
+func (CosmosSqlContainerResource) computedProperties(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+
+  computed_property {
+    name  = "cp_lowerName"
+    query = "SELECT VALUE LOWER(c.name) FROM c"
+  }
+
+  computed_property {
+    name  = "cp_fullName"
+    query = "SELECT VALUE CONCAT(c.firstName, ' ', c.lastName) FROM c"
+  }
+}
 `, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
 }
+
+func (CosmosSqlContainerResource) changeFeedPolicy(data acceptance.TestData, retentionDuration int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+
+  change_feed_policy {
+    retention_duration = %[3]d
+  }
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger, retentionDuration)
+}
@@ -39,6 +39,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cosmosdb_mongo_collection":     resourceCosmosDbMongoCollection(),
 		"azurerm_cosmosdb_mongo_database":       resourceCosmosDbMongoDatabase(),
 		"azurerm_cosmosdb_notebook_workspace":   resourceCosmosDbNotebookWorkspace(),
+		"azurerm_cosmosdb_postgresql_cluster":   resourceCosmosDbPostgreSQLCluster(),
 		"azurerm_cosmosdb_sql_container":        resourceCosmosDbSQLContainer(),
 		"azurerm_cosmosdb_sql_database":         resourceCosmosDbSQLDatabase(),
 		"azurerm_cosmosdb_sql_function":         resourceCosmosDbSQLFunction(),
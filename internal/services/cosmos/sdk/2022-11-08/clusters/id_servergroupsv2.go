@@ -0,0 +1,108 @@
+package clusters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ServerGroupsv2Id struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewServerGroupsv2ID(subscriptionId, resourceGroup, name string) ServerGroupsv2Id {
+	return ServerGroupsv2Id{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ServerGroupsv2Id) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Server Groupsv2", segmentsStr)
+}
+
+func (id ServerGroupsv2Id) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseServerGroupsv2ID parses a ServerGroupsv2 ID into an ServerGroupsv2Id struct
+func ParseServerGroupsv2ID(input string) (*ServerGroupsv2Id, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ServerGroupsv2Id{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("serverGroupsv2"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseServerGroupsv2IDInsensitively parses an ServerGroupsv2 ID into an ServerGroupsv2Id struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseServerGroupsv2ID method should be used instead for validation etc.
+func ParseServerGroupsv2IDInsensitively(input string) (*ServerGroupsv2Id, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ServerGroupsv2Id{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'serverGroupsv2' segment
+	serverGroupsv2Key := "serverGroupsv2"
+	for key := range id.Path {
+		if strings.EqualFold(key, serverGroupsv2Key) {
+			serverGroupsv2Key = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(serverGroupsv2Key); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
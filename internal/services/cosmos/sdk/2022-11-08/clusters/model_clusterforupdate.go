@@ -0,0 +1,6 @@
+package clusters
+
+type ClusterForUpdate struct {
+	Properties *ClusterPropertiesForUpdate `json:"properties,omitempty"`
+	Tags       *map[string]string          `json:"tags,omitempty"`
+}
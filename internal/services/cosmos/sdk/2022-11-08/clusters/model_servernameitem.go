@@ -0,0 +1,6 @@
+package clusters
+
+type ServerNameItem struct {
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+}
@@ -0,0 +1,9 @@
+package clusters
+
+import "fmt"
+
+const defaultApiVersion = "2022-11-08"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/clusters/%s", defaultApiVersion)
+}
@@ -3,6 +3,7 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 func CosmosAccountName(v interface{}, k string) (warnings []string, errors []error) {
@@ -62,3 +63,33 @@ func CosmosMaxThroughput(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func CosmosComputedPropertyName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// system properties such as `id` and `_rid` cannot be used as the name of a Computed Property
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,254}$`).MatchString(v) || strings.EqualFold(v, "id") {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 255 characters, start with a letter, contain only letters, numbers and underscores and cannot be `id`", k))
+	}
+
+	return warnings, errors
+}
+
+func CosmosComputedPropertyQuery(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// Computed Property queries must project a single value, e.g. `SELECT VALUE LOWER(c.name) FROM c`
+	if !regexp.MustCompile(`(?is)^\s*SELECT\s+VALUE\s+.+\s+FROM\s+\S+`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a query in the format `SELECT VALUE <expression> FROM <alias>`", k))
+	}
+
+	return warnings, errors
+}
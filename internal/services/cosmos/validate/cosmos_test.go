@@ -149,3 +149,77 @@ func TestCosmosMaxThroughput(t *testing.T) {
 		}
 	}
 }
+
+func TestCosmosComputedPropertyName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "cp_lowerName",
+			Errors: 0,
+		},
+		{
+			Value:  "cp1",
+			Errors: 0,
+		},
+		{
+			Value:  "_rid",
+			Errors: 1,
+		},
+		{
+			Value:  "id",
+			Errors: 1,
+		},
+		{
+			Value:  "cp-lower",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CosmosComputedPropertyName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected CosmosComputedPropertyName to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestCosmosComputedPropertyQuery(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "SELECT VALUE LOWER(c.name) FROM c",
+			Errors: 0,
+		},
+		{
+			Value:  "select value c.firstName || ' ' || c.lastName from c",
+			Errors: 0,
+		},
+		{
+			Value:  "SELECT LOWER(c.name) FROM c",
+			Errors: 1,
+		},
+		{
+			Value:  "SELECT VALUE LOWER(c.name)",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CosmosComputedPropertyQuery(tc.Value, "query")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected CosmosComputedPropertyQuery to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
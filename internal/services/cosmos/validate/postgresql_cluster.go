@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
+)
+
+func PostgreSQLClusterName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,38}[a-z0-9]$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 40 characters long, can only contain lowercase letters, numbers and hyphens, and must start and end with a letter or number", k))
+	}
+
+	return warnings, errors
+}
+
+// PostgreSQLClusterNodeCount validates the number of worker nodes - a cluster either has no worker nodes
+// (a single node cluster) or between 2 and 20 worker nodes
+func PostgreSQLClusterNodeCount(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be an int", k))
+		return
+	}
+
+	if value != 0 && (value < 2 || value > 20) {
+		errors = append(errors, fmt.Errorf("%q must be `0` (for a single node cluster) or between `2` and `20`, got %d", k, value))
+	}
+
+	return warnings, errors
+}
+
+func PostgreSQLClusterID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := clusters.ParseServerGroupsv2ID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,97 @@
+package validate
+
+import "testing"
+
+func TestPostgreSQLClusterName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "ab",
+			Errors: 1,
+		},
+		{
+			Value:  "abc",
+			Errors: 0,
+		},
+		{
+			Value:  "my-cluster-01",
+			Errors: 0,
+		},
+		{
+			Value:  "-cluster",
+			Errors: 1,
+		},
+		{
+			Value:  "cluster-",
+			Errors: 1,
+		},
+		{
+			Value:  "My-Cluster",
+			Errors: 1,
+		},
+		{
+			Value:  "my_cluster",
+			Errors: 1,
+		},
+		{
+			Value:  "abcdefghijklmnopqrstuvwxyz0123456789abcd",
+			Errors: 0,
+		},
+		{
+			Value:  "abcdefghijklmnopqrstuvwxyz0123456789abcde",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PostgreSQLClusterName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected PostgreSQLClusterName to trigger %d errors for %q - got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestPostgreSQLClusterNodeCount(t *testing.T) {
+	cases := []struct {
+		Value  int
+		Errors int
+	}{
+		{
+			Value:  -1,
+			Errors: 1,
+		},
+		{
+			Value:  0,
+			Errors: 0,
+		},
+		{
+			Value:  1,
+			Errors: 1,
+		},
+		{
+			Value:  2,
+			Errors: 0,
+		},
+		{
+			Value:  20,
+			Errors: 0,
+		},
+		{
+			Value:  21,
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PostgreSQLClusterNodeCount(tc.Value, "node_count")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected PostgreSQLClusterNodeCount to trigger %d errors for %d - got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
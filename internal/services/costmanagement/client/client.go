@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2019-10-01/costmanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2022-10-01/scheduledactions"
 )
 
 type Client struct {
-	ExportClient *costmanagement.ExportsClient
+	ExportClient           *costmanagement.ExportsClient
+	ScheduledActionsClient *scheduledactions.ScheduledActionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	ExportClient := costmanagement.NewExportsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExportClient.Client, o.ResourceManagerAuthorizer)
 
+	ScheduledActionsClient := scheduledactions.NewScheduledActionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ScheduledActionsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ExportClient: &ExportClient,
+		ExportClient:           &ExportClient,
+		ScheduledActionsClient: &ScheduledActionsClient,
 	}
 }
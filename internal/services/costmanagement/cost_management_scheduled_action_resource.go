@@ -0,0 +1,436 @@
+package costmanagement
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2022-10-01/scheduledactions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	subscriptionValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCostManagementScheduledAction() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCostManagementScheduledActionCreateUpdate,
+		Read:   resourceCostManagementScheduledActionRead,
+		Update: resourceCostManagementScheduledActionCreateUpdate,
+		Delete: resourceCostManagementScheduledActionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := scheduledactions.ParseScopedScheduledActionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					billingValidate.EnrollmentID,
+					managementGroupValidate.ManagementGroupID,
+					subscriptionValidate.SubscriptionID,
+					resourceValidate.ResourceGroupID,
+				),
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(scheduledactions.ScheduledActionKindEmail),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scheduledactions.ScheduledActionKindEmail),
+					string(scheduledactions.ScheduledActionKindInsightAlert),
+				}, false),
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"view_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ViewID,
+			},
+
+			"email_address_sender": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"email_addresses": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"subject": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 70),
+			},
+
+			"message": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+
+			"frequency": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scheduledactions.ScheduleFrequencyDaily),
+					string(scheduledactions.ScheduleFrequencyWeekly),
+					string(scheduledactions.ScheduleFrequencyMonthly),
+				}, false),
+			},
+
+			"days_of_week": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(scheduledactions.DaysOfWeekMonday),
+						string(scheduledactions.DaysOfWeekTuesday),
+						string(scheduledactions.DaysOfWeekWednesday),
+						string(scheduledactions.DaysOfWeekThursday),
+						string(scheduledactions.DaysOfWeekFriday),
+						string(scheduledactions.DaysOfWeekSaturday),
+						string(scheduledactions.DaysOfWeekSunday),
+					}, false),
+				},
+			},
+
+			"weeks_of_month": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(scheduledactions.WeeksOfMonthFirst),
+						string(scheduledactions.WeeksOfMonthSecond),
+						string(scheduledactions.WeeksOfMonthThird),
+						string(scheduledactions.WeeksOfMonthFourth),
+						string(scheduledactions.WeeksOfMonthLast),
+					}, false),
+				},
+			},
+
+			"day_of_month": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 31),
+			},
+
+			"hour_of_day": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+
+			"start_date": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"end_date": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(costManagementScheduledActionCustomizeDiff),
+	}
+}
+
+func costManagementScheduledActionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the dates may be interpolated from other resources, in which case they can only be validated at apply time
+	if !d.NewValueKnown("start_date") || !d.NewValueKnown("end_date") {
+		return nil
+	}
+
+	return validateCostManagementScheduledActionSchedule(
+		d.Get("frequency").(string),
+		d.Get("days_of_week").(*pluginsdk.Set).Len(),
+		d.Get("weeks_of_month").(*pluginsdk.Set).Len(),
+		d.Get("day_of_month").(int),
+		d.Get("start_date").(string),
+		d.Get("end_date").(string),
+	)
+}
+
+// validateCostManagementScheduledActionSchedule validates that the schedule fields which are specified are
+// supported by the frequency, since the API only surfaces these as a generic `BadRequest`.
+func validateCostManagementScheduledActionSchedule(frequency string, daysOfWeek int, weeksOfMonth int, dayOfMonth int, startDate string, endDate string) (err error) {
+	switch scheduledactions.ScheduleFrequency(frequency) {
+	case scheduledactions.ScheduleFrequencyDaily:
+		if daysOfWeek > 0 || weeksOfMonth > 0 || dayOfMonth > 0 {
+			err = multierror.Append(err, fmt.Errorf("`days_of_week`, `weeks_of_month` and `day_of_month` cannot be specified when `frequency` is `Daily`"))
+		}
+
+	case scheduledactions.ScheduleFrequencyWeekly:
+		if daysOfWeek == 0 {
+			err = multierror.Append(err, fmt.Errorf("`days_of_week` must be specified when `frequency` is `Weekly`"))
+		}
+		if weeksOfMonth > 0 || dayOfMonth > 0 {
+			err = multierror.Append(err, fmt.Errorf("`weeks_of_month` and `day_of_month` cannot be specified when `frequency` is `Weekly`"))
+		}
+
+	case scheduledactions.ScheduleFrequencyMonthly:
+		if dayOfMonth > 0 && (daysOfWeek > 0 || weeksOfMonth > 0) {
+			err = multierror.Append(err, fmt.Errorf("`day_of_month` cannot be specified with `days_of_week` or `weeks_of_month` when `frequency` is `Monthly`"))
+		}
+		if dayOfMonth == 0 && (daysOfWeek == 0 || weeksOfMonth == 0) {
+			err = multierror.Append(err, fmt.Errorf("either `day_of_month` or both `days_of_week` and `weeks_of_month` must be specified when `frequency` is `Monthly`"))
+		}
+	}
+
+	start, startErr := time.Parse(time.RFC3339, startDate)
+	end, endErr := time.Parse(time.RFC3339, endDate)
+	if startErr == nil && endErr == nil && !end.After(start) {
+		err = multierror.Append(err, fmt.Errorf("`end_date` (%q) must be after `start_date` (%q)", endDate, startDate))
+	}
+
+	return
+}
+
+func resourceCostManagementScheduledActionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).CostManagement.ScheduledActionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := scheduledactions.NewScopedScheduledActionID(d.Get("scope").(string), d.Get("name").(string))
+
+	var etag *string
+	existing, err := client.GetByScope(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if d.IsNewResource() && !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cost_management_scheduled_action", id.ID())
+	}
+	if model := existing.Model; model != nil {
+		// the API uses optimistic concurrency, so the existing eTag must be sent when updating
+		etag = model.ETag
+	}
+
+	kind := scheduledactions.ScheduledActionKind(d.Get("kind").(string))
+	payload := scheduledactions.ScheduledAction{
+		ETag: etag,
+		Kind: &kind,
+		Properties: &scheduledactions.ScheduledActionProperties{
+			DisplayName: d.Get("display_name").(string),
+			Notification: scheduledactions.NotificationProperties{
+				Subject: d.Get("subject").(string),
+				To:      *utils.ExpandStringSlice(d.Get("email_addresses").(*pluginsdk.Set).List()),
+			},
+			NotificationEmail: utils.String(d.Get("email_address_sender").(string)),
+			Schedule: scheduledactions.ScheduleProperties{
+				Frequency: scheduledactions.ScheduleFrequency(d.Get("frequency").(string)),
+				StartDate: d.Get("start_date").(string),
+				EndDate:   d.Get("end_date").(string),
+			},
+			Scope:  utils.String(id.Scope),
+			Status: scheduledactions.ScheduledActionStatusEnabled,
+			ViewId: d.Get("view_id").(string),
+		},
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		payload.Properties.Notification.Message = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("days_of_week"); ok {
+		payload.Properties.Schedule.DaysOfWeek = expandCostManagementScheduledActionDaysOfWeek(v.(*pluginsdk.Set).List())
+	}
+	if v, ok := d.GetOk("weeks_of_month"); ok {
+		payload.Properties.Schedule.WeeksOfMonth = expandCostManagementScheduledActionWeeksOfMonth(v.(*pluginsdk.Set).List())
+	}
+	if v, ok := d.GetOk("day_of_month"); ok {
+		payload.Properties.Schedule.DayOfMonth = utils.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("hour_of_day"); ok {
+		payload.Properties.Schedule.HourOfDay = utils.Int64(int64(v.(int)))
+	}
+
+	if _, err := client.CreateOrUpdateByScope(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCostManagementScheduledActionRead(d, meta)
+}
+
+func resourceCostManagementScheduledActionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).CostManagement.ScheduledActionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := scheduledactions.ParseScopedScheduledActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByScope(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("scope", id.Scope)
+
+	if model := resp.Model; model != nil {
+		kind := string(scheduledactions.ScheduledActionKindEmail)
+		if model.Kind != nil {
+			kind = string(*model.Kind)
+		}
+		d.Set("kind", kind)
+
+		if props := model.Properties; props != nil {
+			d.Set("display_name", props.DisplayName)
+			d.Set("view_id", props.ViewId)
+			d.Set("email_address_sender", props.NotificationEmail)
+			d.Set("subject", props.Notification.Subject)
+			d.Set("message", props.Notification.Message)
+
+			if err := d.Set("email_addresses", utils.FlattenStringSlice(&props.Notification.To)); err != nil {
+				return fmt.Errorf("setting `email_addresses`: %+v", err)
+			}
+
+			schedule := props.Schedule
+			d.Set("frequency", string(schedule.Frequency))
+			d.Set("start_date", schedule.StartDate)
+			d.Set("end_date", schedule.EndDate)
+
+			dayOfMonth := 0
+			if schedule.DayOfMonth != nil {
+				dayOfMonth = int(*schedule.DayOfMonth)
+			}
+			d.Set("day_of_month", dayOfMonth)
+
+			hourOfDay := 0
+			if schedule.HourOfDay != nil {
+				hourOfDay = int(*schedule.HourOfDay)
+			}
+			d.Set("hour_of_day", hourOfDay)
+
+			if err := d.Set("days_of_week", flattenCostManagementScheduledActionDaysOfWeek(schedule.DaysOfWeek)); err != nil {
+				return fmt.Errorf("setting `days_of_week`: %+v", err)
+			}
+			if err := d.Set("weeks_of_month", flattenCostManagementScheduledActionWeeksOfMonth(schedule.WeeksOfMonth)); err != nil {
+				return fmt.Errorf("setting `weeks_of_month`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCostManagementScheduledActionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).CostManagement.ScheduledActionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := scheduledactions.ParseScopedScheduledActionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteByScope(ctx, *id)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandCostManagementScheduledActionDaysOfWeek(input []interface{}) *[]scheduledactions.DaysOfWeek {
+	output := make([]scheduledactions.DaysOfWeek, 0)
+	for _, v := range input {
+		output = append(output, scheduledactions.DaysOfWeek(v.(string)))
+	}
+	return &output
+}
+
+func flattenCostManagementScheduledActionDaysOfWeek(input *[]scheduledactions.DaysOfWeek) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, string(v))
+	}
+	return output
+}
+
+func expandCostManagementScheduledActionWeeksOfMonth(input []interface{}) *[]scheduledactions.WeeksOfMonth {
+	output := make([]scheduledactions.WeeksOfMonth, 0)
+	for _, v := range input {
+		output = append(output, scheduledactions.WeeksOfMonth(v.(string)))
+	}
+	return &output
+}
+
+func flattenCostManagementScheduledActionWeeksOfMonth(input *[]scheduledactions.WeeksOfMonth) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, string(v))
+	}
+	return output
+}
@@ -0,0 +1,235 @@
+package costmanagement_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2022-10-01/scheduledactions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CostManagementScheduledActionResource struct{}
+
+func TestAccCostManagementScheduledAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cost_management_scheduled_action", "test")
+	r := CostManagementScheduledActionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCostManagementScheduledAction_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cost_management_scheduled_action", "test")
+	r := CostManagementScheduledActionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCostManagementScheduledAction_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cost_management_scheduled_action", "test")
+	r := CostManagementScheduledActionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.weekly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.monthly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCostManagementScheduledAction_invalidSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cost_management_scheduled_action", "test")
+	r := CostManagementScheduledActionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidSchedule(data),
+			ExpectError: regexp.MustCompile("`days_of_week` must be specified when `frequency` is `Weekly`"),
+		},
+	})
+}
+
+func (t CostManagementScheduledActionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := scheduledactions.ParseScopedScheduledActionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.CostManagement.ScheduledActionsClient.GetByScope(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (CostManagementScheduledActionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+locals {
+  start_date = "%s"
+  end_date   = "%s"
+}
+`, time.Now().AddDate(0, 0, 1).Format("2006-01-02T00:00:00Z"), time.Now().AddDate(0, 1, 0).Format("2006-01-02T00:00:00Z"))
+}
+
+func (r CostManagementScheduledActionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cost_management_scheduled_action" "test" {
+  name         = "acctestsa%d"
+  scope        = data.azurerm_subscription.current.id
+  display_name = "CostByService%d"
+  view_id      = "${data.azurerm_subscription.current.id}/providers/Microsoft.CostManagement/views/ms:CostByService"
+
+  email_address_sender = "test@test.com"
+  email_addresses      = ["test@test.com"]
+  subject              = "Cost Management Report"
+
+  frequency  = "Daily"
+  start_date = local.start_date
+  end_date   = local.end_date
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r CostManagementScheduledActionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cost_management_scheduled_action" "import" {
+  name         = azurerm_cost_management_scheduled_action.test.name
+  scope        = azurerm_cost_management_scheduled_action.test.scope
+  display_name = azurerm_cost_management_scheduled_action.test.display_name
+  view_id      = azurerm_cost_management_scheduled_action.test.view_id
+
+  email_address_sender = azurerm_cost_management_scheduled_action.test.email_address_sender
+  email_addresses      = azurerm_cost_management_scheduled_action.test.email_addresses
+  subject              = azurerm_cost_management_scheduled_action.test.subject
+
+  frequency  = azurerm_cost_management_scheduled_action.test.frequency
+  start_date = azurerm_cost_management_scheduled_action.test.start_date
+  end_date   = azurerm_cost_management_scheduled_action.test.end_date
+}
+`, r.basic(data))
+}
+
+func (r CostManagementScheduledActionResource) weekly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cost_management_scheduled_action" "test" {
+  name         = "acctestsa%d"
+  scope        = data.azurerm_subscription.current.id
+  display_name = "CostByServiceWeekly%d"
+  view_id      = "${data.azurerm_subscription.current.id}/providers/Microsoft.CostManagement/views/ms:CostByService"
+
+  email_address_sender = "test@test.com"
+  email_addresses      = ["test@test.com", "test2@test.com"]
+  subject              = "Weekly Cost Management Report"
+  message              = "The weekly cost report"
+
+  frequency    = "Weekly"
+  days_of_week = ["Monday", "Friday"]
+  hour_of_day  = 8
+  start_date   = local.start_date
+  end_date     = local.end_date
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r CostManagementScheduledActionResource) monthly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cost_management_scheduled_action" "test" {
+  name         = "acctestsa%d"
+  scope        = data.azurerm_subscription.current.id
+  display_name = "CostByServiceMonthly%d"
+  view_id      = "${data.azurerm_subscription.current.id}/providers/Microsoft.CostManagement/views/ms:CostByService"
+
+  email_address_sender = "test@test.com"
+  email_addresses      = ["test@test.com"]
+  subject              = "Monthly Cost Management Report"
+
+  frequency      = "Monthly"
+  days_of_week   = ["Monday"]
+  weeks_of_month = ["First"]
+  start_date     = local.start_date
+  end_date       = local.end_date
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r CostManagementScheduledActionResource) invalidSchedule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cost_management_scheduled_action" "test" {
+  name         = "acctestsa%d"
+  scope        = data.azurerm_subscription.current.id
+  display_name = "CostByService%d"
+  view_id      = "${data.azurerm_subscription.current.id}/providers/Microsoft.CostManagement/views/ms:CostByService"
+
+  email_address_sender = "test@test.com"
+  email_addresses      = ["test@test.com"]
+  subject              = "Cost Management Report"
+
+  frequency  = "Weekly"
+  start_date = local.start_date
+  end_date   = local.end_date
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,126 @@
+package costmanagement
+
+import (
+	"testing"
+)
+
+func TestValidateCostManagementScheduledActionSchedule(t *testing.T) {
+	testData := []struct {
+		name         string
+		frequency    string
+		daysOfWeek   int
+		weeksOfMonth int
+		dayOfMonth   int
+		startDate    string
+		endDate      string
+		valid        bool
+	}{
+		{
+			name:      "daily",
+			frequency: "Daily",
+			startDate: "2023-01-01T00:00:00Z",
+			endDate:   "2024-01-01T00:00:00Z",
+			valid:     true,
+		},
+		{
+			name:       "daily with days of week",
+			frequency:  "Daily",
+			daysOfWeek: 1,
+			startDate:  "2023-01-01T00:00:00Z",
+			endDate:    "2024-01-01T00:00:00Z",
+			valid:      false,
+		},
+		{
+			name:       "weekly",
+			frequency:  "Weekly",
+			daysOfWeek: 2,
+			startDate:  "2023-01-01T00:00:00Z",
+			endDate:    "2024-01-01T00:00:00Z",
+			valid:      true,
+		},
+		{
+			name:      "weekly without days of week",
+			frequency: "Weekly",
+			startDate: "2023-01-01T00:00:00Z",
+			endDate:   "2024-01-01T00:00:00Z",
+			valid:     false,
+		},
+		{
+			name:       "weekly with day of month",
+			frequency:  "Weekly",
+			daysOfWeek: 1,
+			dayOfMonth: 1,
+			startDate:  "2023-01-01T00:00:00Z",
+			endDate:    "2024-01-01T00:00:00Z",
+			valid:      false,
+		},
+		{
+			name:       "monthly with day of month",
+			frequency:  "Monthly",
+			dayOfMonth: 15,
+			startDate:  "2023-01-01T00:00:00Z",
+			endDate:    "2024-01-01T00:00:00Z",
+			valid:      true,
+		},
+		{
+			name:         "monthly with weeks of month and days of week",
+			frequency:    "Monthly",
+			daysOfWeek:   1,
+			weeksOfMonth: 1,
+			startDate:    "2023-01-01T00:00:00Z",
+			endDate:      "2024-01-01T00:00:00Z",
+			valid:        true,
+		},
+		{
+			name:         "monthly with weeks of month but no days of week",
+			frequency:    "Monthly",
+			weeksOfMonth: 1,
+			startDate:    "2023-01-01T00:00:00Z",
+			endDate:      "2024-01-01T00:00:00Z",
+			valid:        false,
+		},
+		{
+			name:         "monthly with both day of month and weeks of month",
+			frequency:    "Monthly",
+			daysOfWeek:   1,
+			weeksOfMonth: 1,
+			dayOfMonth:   1,
+			startDate:    "2023-01-01T00:00:00Z",
+			endDate:      "2024-01-01T00:00:00Z",
+			valid:        false,
+		},
+		{
+			name:      "monthly without a schedule",
+			frequency: "Monthly",
+			startDate: "2023-01-01T00:00:00Z",
+			endDate:   "2024-01-01T00:00:00Z",
+			valid:     false,
+		},
+		{
+			name:      "end date before start date",
+			frequency: "Daily",
+			startDate: "2024-01-01T00:00:00Z",
+			endDate:   "2023-01-01T00:00:00Z",
+			valid:     false,
+		},
+		{
+			name:      "end date equal to start date",
+			frequency: "Daily",
+			startDate: "2023-01-01T00:00:00Z",
+			endDate:   "2023-01-01T00:00:00Z",
+			valid:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateCostManagementScheduledActionSchedule(v.frequency, v.daysOfWeek, v.weeksOfMonth, v.dayOfMonth, v.startDate, v.endDate)
+		if v.valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.name, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q to be invalid but got no error", v.name)
+		}
+	}
+}
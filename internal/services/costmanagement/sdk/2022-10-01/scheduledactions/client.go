@@ -0,0 +1,15 @@
+package scheduledactions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ScheduledActionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewScheduledActionsClientWithBaseURI(endpoint string) ScheduledActionsClient {
+	return ScheduledActionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
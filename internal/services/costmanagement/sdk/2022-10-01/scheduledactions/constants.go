@@ -0,0 +1,46 @@
+package scheduledactions
+
+type DaysOfWeek string
+
+const (
+	DaysOfWeekFriday    DaysOfWeek = "Friday"
+	DaysOfWeekMonday    DaysOfWeek = "Monday"
+	DaysOfWeekSaturday  DaysOfWeek = "Saturday"
+	DaysOfWeekSunday    DaysOfWeek = "Sunday"
+	DaysOfWeekThursday  DaysOfWeek = "Thursday"
+	DaysOfWeekTuesday   DaysOfWeek = "Tuesday"
+	DaysOfWeekWednesday DaysOfWeek = "Wednesday"
+)
+
+type ScheduleFrequency string
+
+const (
+	ScheduleFrequencyDaily   ScheduleFrequency = "Daily"
+	ScheduleFrequencyMonthly ScheduleFrequency = "Monthly"
+	ScheduleFrequencyWeekly  ScheduleFrequency = "Weekly"
+)
+
+type ScheduledActionKind string
+
+const (
+	ScheduledActionKindEmail        ScheduledActionKind = "Email"
+	ScheduledActionKindInsightAlert ScheduledActionKind = "InsightAlert"
+)
+
+type ScheduledActionStatus string
+
+const (
+	ScheduledActionStatusDisabled ScheduledActionStatus = "Disabled"
+	ScheduledActionStatusEnabled  ScheduledActionStatus = "Enabled"
+	ScheduledActionStatusExpired  ScheduledActionStatus = "Expired"
+)
+
+type WeeksOfMonth string
+
+const (
+	WeeksOfMonthFirst  WeeksOfMonth = "First"
+	WeeksOfMonthFourth WeeksOfMonth = "Fourth"
+	WeeksOfMonthLast   WeeksOfMonth = "Last"
+	WeeksOfMonthSecond WeeksOfMonth = "Second"
+	WeeksOfMonthThird  WeeksOfMonth = "Third"
+)
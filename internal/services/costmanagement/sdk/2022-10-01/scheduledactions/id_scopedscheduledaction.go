@@ -0,0 +1,70 @@
+package scheduledactions
+
+import (
+	"fmt"
+	"strings"
+)
+
+const scheduledActionsSegment = "/providers/Microsoft.CostManagement/scheduledActions/"
+
+type ScopedScheduledActionId struct {
+	Scope string
+	Name  string
+}
+
+func NewScopedScheduledActionID(scope, name string) ScopedScheduledActionId {
+	return ScopedScheduledActionId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id ScopedScheduledActionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Scoped Scheduled Action", segmentsStr)
+}
+
+func (id ScopedScheduledActionId) ID() string {
+	fmtString := "%s/providers/Microsoft.CostManagement/scheduledActions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
+}
+
+// ParseScopedScheduledActionID parses a ScopedScheduledAction ID into an ScopedScheduledActionId struct
+func ParseScopedScheduledActionID(input string) (*ScopedScheduledActionId, error) {
+	return parseScopedScheduledActionID(input, false)
+}
+
+// ParseScopedScheduledActionIDInsensitively parses an ScopedScheduledAction ID into an ScopedScheduledActionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseScopedScheduledActionID method should be used instead for validation etc.
+func ParseScopedScheduledActionIDInsensitively(input string) (*ScopedScheduledActionId, error) {
+	return parseScopedScheduledActionID(input, true)
+}
+
+func parseScopedScheduledActionID(input string, insensitively bool) (*ScopedScheduledActionId, error) {
+	index := strings.LastIndex(input, scheduledActionsSegment)
+	if insensitively {
+		index = strings.LastIndex(strings.ToLower(input), strings.ToLower(scheduledActionsSegment))
+	}
+	if index == -1 {
+		return nil, fmt.Errorf("expected %q to be in the format `{scope}%s{name}`", input, scheduledActionsSegment)
+	}
+
+	scope := input[:index]
+	name := input[index+len(scheduledActionsSegment):]
+	if scope == "" || !strings.HasPrefix(scope, "/") {
+		return nil, fmt.Errorf("ID was missing the 'scope' element")
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("ID was missing the 'scheduledActions' element")
+	}
+
+	return &ScopedScheduledActionId{
+		Scope: scope,
+		Name:  name,
+	}, nil
+}
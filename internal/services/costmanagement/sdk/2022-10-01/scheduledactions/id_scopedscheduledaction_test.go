@@ -0,0 +1,113 @@
+package scheduledactions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ScopedScheduledActionId{}
+
+func TestNewScopedScheduledActionID(t *testing.T) {
+	id := NewScopedScheduledActionID("/subscriptions/12345678-1234-9876-4563-123456789012", "action1")
+
+	if id.Scope != "/subscriptions/12345678-1234-9876-4563-123456789012" {
+		t.Fatalf("Expected %q but got %q for Segment 'Scope'", id.Scope, "/subscriptions/12345678-1234-9876-4563-123456789012")
+	}
+
+	if id.Name != "action1" {
+		t.Fatalf("Expected %q but got %q for Segment 'Name'", id.Name, "action1")
+	}
+}
+
+func TestFormatScopedScheduledActionID(t *testing.T) {
+	actual := NewScopedScheduledActionID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", "action1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.CostManagement/scheduledActions/action1"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParseScopedScheduledActionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScopedScheduledActionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing scope
+			Input: "/providers/Microsoft.CostManagement/scheduledActions/action1",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/scheduledActions/",
+			Error: true,
+		},
+		{
+			// subscription scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/scheduledActions/action1",
+			Expected: &ScopedScheduledActionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "action1",
+			},
+		},
+		{
+			// resource group scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.CostManagement/scheduledActions/action1",
+			Expected: &ScopedScheduledActionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "action1",
+			},
+		},
+		{
+			// upper-cased
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/SCHEDULEDACTIONS/action1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScopedScheduledActionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestParseScopedScheduledActionIDInsensitively(t *testing.T) {
+	actual, err := ParseScopedScheduledActionIDInsensitively("/subscriptions/12345678-1234-9876-4563-123456789012/providers/microsoft.costmanagement/SCHEDULEDACTIONS/action1")
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+
+	if actual.Scope != "/subscriptions/12345678-1234-9876-4563-123456789012" {
+		t.Fatalf("Expected %q but got %q for Scope", "/subscriptions/12345678-1234-9876-4563-123456789012", actual.Scope)
+	}
+
+	if actual.Name != "action1" {
+		t.Fatalf("Expected %q but got %q for Name", "action1", actual.Name)
+	}
+}
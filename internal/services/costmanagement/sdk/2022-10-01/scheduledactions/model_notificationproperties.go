@@ -0,0 +1,7 @@
+package scheduledactions
+
+type NotificationProperties struct {
+	Message *string  `json:"message,omitempty"`
+	Subject string   `json:"subject"`
+	To      []string `json:"to"`
+}
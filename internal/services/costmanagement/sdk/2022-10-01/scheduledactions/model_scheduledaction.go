@@ -0,0 +1,10 @@
+package scheduledactions
+
+type ScheduledAction struct {
+	ETag       *string                    `json:"eTag,omitempty"`
+	Id         *string                    `json:"id,omitempty"`
+	Kind       *ScheduledActionKind       `json:"kind,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *ScheduledActionProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package scheduledactions
+
+type ScheduledActionProperties struct {
+	DisplayName       string                 `json:"displayName"`
+	Notification      NotificationProperties `json:"notification"`
+	NotificationEmail *string                `json:"notificationEmail,omitempty"`
+	Schedule          ScheduleProperties     `json:"schedule"`
+	Scope             *string                `json:"scope,omitempty"`
+	Status            ScheduledActionStatus  `json:"status"`
+	ViewId            string                 `json:"viewId"`
+}
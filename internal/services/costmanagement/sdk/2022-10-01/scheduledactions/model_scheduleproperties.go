@@ -0,0 +1,11 @@
+package scheduledactions
+
+type ScheduleProperties struct {
+	DayOfMonth   *int64            `json:"dayOfMonth,omitempty"`
+	DaysOfWeek   *[]DaysOfWeek     `json:"daysOfWeek,omitempty"`
+	EndDate      string            `json:"endDate"`
+	Frequency    ScheduleFrequency `json:"frequency"`
+	HourOfDay    *int64            `json:"hourOfDay,omitempty"`
+	StartDate    string            `json:"startDate"`
+	WeeksOfMonth *[]WeeksOfMonth   `json:"weeksOfMonth,omitempty"`
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ViewID validates the ID of a Cost Management View, which is either scoped (e.g. to a Subscription or
+// Resource Group) or - for a private view - unscoped.
+func ViewID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^(/.+)?/providers/Microsoft\.CostManagement/views/[^/]+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("expected %q to be a Cost Management View ID in the format `{scope}/providers/Microsoft.CostManagement/views/{name}` but got %q", key, v))
+	}
+
+	return
+}
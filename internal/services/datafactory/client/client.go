@@ -3,10 +3,11 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/dataflows"
 )
 
 type Client struct {
-	DataFlowClient                *datafactory.DataFlowsClient
+	DataFlowClient                *dataflows.DataFlowsClient
 	DatasetClient                 *datafactory.DatasetsClient
 	FactoriesClient               *datafactory.FactoriesClient
 	IntegrationRuntimesClient     *datafactory.IntegrationRuntimesClient
@@ -18,7 +19,7 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	dataFlowClient := datafactory.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	dataFlowClient := dataflows.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&dataFlowClient.Client, o.ResourceManagerAuthorizer)
 
 	DatasetClient := datafactory.NewDatasetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
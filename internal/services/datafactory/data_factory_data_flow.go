@@ -1,7 +1,10 @@
 package datafactory
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/dataflows"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -48,6 +51,29 @@ func SchemaForDataFlowSourceAndSink() *pluginsdk.Schema {
 					},
 				},
 
+				"flowlet": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"parameters": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+						},
+					},
+				},
+
 				"linked_service": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
@@ -98,18 +124,154 @@ func SchemaForDataFlowSourceAndSink() *pluginsdk.Schema {
 	}
 }
 
-func expandDataFactoryDataFlowSource(input []interface{}) *[]datafactory.DataFlowSource {
+func SchemaForDataFlowletSourceAndSink() *pluginsdk.Schema {
+	s := SchemaForDataFlowSourceAndSink()
+	s.Required = false
+	s.Optional = true
+	return s
+}
+
+func SchemaForDataFlowTransformation() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"description": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"dataset": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"parameters": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+						},
+					},
+				},
+
+				"flowlet": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"parameters": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+						},
+					},
+				},
+
+				"linked_service": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"parameters": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataFactoryDataFlowCustomizeDiff ensures that the names of the sources, sinks and transformations are unique, since
+// these are used to identify each step within the data flow script
+func dataFactoryDataFlowCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	names := make(map[string]string)
+	for _, key := range []string{"source", "sink", "transformation"} {
+		for _, raw := range d.Get(key).([]interface{}) {
+			if raw == nil {
+				continue
+			}
+
+			name := raw.(map[string]interface{})["name"].(string)
+			if name == "" {
+				continue
+			}
+
+			if existing, ok := names[name]; ok {
+				return fmt.Errorf("the name %q is used by more than one `source`, `sink` or `transformation` (`%s` and `%s`) - names must be unique", name, existing, key)
+			}
+			names[name] = key
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryDataFlowScriptLines(input []interface{}) *[]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0)
+	for _, v := range input {
+		result = append(result, v.(string))
+	}
+	return &result
+}
+
+func expandDataFactoryDataFlowSource(input []interface{}) *[]dataflows.DataFlowSource {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
-	result := make([]datafactory.DataFlowSource, 0)
+	result := make([]dataflows.DataFlowSource, 0)
 	for _, v := range input {
 		raw := v.(map[string]interface{})
-		result = append(result, datafactory.DataFlowSource{
+		result = append(result, dataflows.DataFlowSource{
 			Description:         utils.String(raw["description"].(string)),
-			Name:                utils.String(raw["name"].(string)),
+			Name:                raw["name"].(string),
 			Dataset:             expandDataFactoryDatasetReference(raw["dataset"].([]interface{})),
+			Flowlet:             expandDataFactoryDataFlowReference(raw["flowlet"].([]interface{})),
 			LinkedService:       expandDataFactoryLinkedServiceReference(raw["linked_service"].([]interface{})),
 			SchemaLinkedService: expandDataFactoryLinkedServiceReference(raw["schema_linked_service"].([]interface{})),
 		})
@@ -117,18 +279,19 @@ func expandDataFactoryDataFlowSource(input []interface{}) *[]datafactory.DataFlo
 	return &result
 }
 
-func expandDataFactoryDataFlowSink(input []interface{}) *[]datafactory.DataFlowSink {
+func expandDataFactoryDataFlowSink(input []interface{}) *[]dataflows.DataFlowSink {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
-	result := make([]datafactory.DataFlowSink, 0)
+	result := make([]dataflows.DataFlowSink, 0)
 	for _, v := range input {
 		raw := v.(map[string]interface{})
-		result = append(result, datafactory.DataFlowSink{
+		result = append(result, dataflows.DataFlowSink{
 			Description:         utils.String(raw["description"].(string)),
-			Name:                utils.String(raw["name"].(string)),
+			Name:                raw["name"].(string),
 			Dataset:             expandDataFactoryDatasetReference(raw["dataset"].([]interface{})),
+			Flowlet:             expandDataFactoryDataFlowReference(raw["flowlet"].([]interface{})),
 			LinkedService:       expandDataFactoryLinkedServiceReference(raw["linked_service"].([]interface{})),
 			SchemaLinkedService: expandDataFactoryLinkedServiceReference(raw["schema_linked_service"].([]interface{})),
 		})
@@ -136,51 +299,91 @@ func expandDataFactoryDataFlowSink(input []interface{}) *[]datafactory.DataFlowS
 	return &result
 }
 
-func expandDataFactoryDatasetReference(input []interface{}) *datafactory.DatasetReference {
+func expandDataFactoryDataFlowTransformation(input []interface{}) *[]dataflows.Transformation {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	result := make([]dataflows.Transformation, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		result = append(result, dataflows.Transformation{
+			Description:   utils.String(raw["description"].(string)),
+			Name:          raw["name"].(string),
+			Dataset:       expandDataFactoryDatasetReference(raw["dataset"].([]interface{})),
+			Flowlet:       expandDataFactoryDataFlowReference(raw["flowlet"].([]interface{})),
+			LinkedService: expandDataFactoryLinkedServiceReference(raw["linked_service"].([]interface{})),
+		})
+	}
+	return &result
+}
+
+func expandDataFactoryDatasetReference(input []interface{}) *dataflows.DatasetReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	parameters := raw["parameters"].(map[string]interface{})
+	return &dataflows.DatasetReference{
+		Type:          dataflows.DatasetReferenceTypeDatasetReference,
+		ReferenceName: raw["name"].(string),
+		Parameters:    &parameters,
+	}
+}
+
+func expandDataFactoryDataFlowReference(input []interface{}) *dataflows.DataFlowReference {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	return &datafactory.DatasetReference{
-		Type:          utils.String("DatasetReference"),
-		ReferenceName: utils.String(raw["name"].(string)),
-		Parameters:    raw["parameters"].(map[string]interface{}),
+	parameters := raw["parameters"].(map[string]interface{})
+	return &dataflows.DataFlowReference{
+		Type:          dataflows.DataFlowReferenceTypeDataFlowReference,
+		ReferenceName: raw["name"].(string),
+		Parameters:    &parameters,
 	}
 }
 
-func expandDataFactoryLinkedServiceReference(input []interface{}) *datafactory.LinkedServiceReference {
+func expandDataFactoryLinkedServiceReference(input []interface{}) *dataflows.LinkedServiceReference {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	return &datafactory.LinkedServiceReference{
-		Type:          utils.String("LinkedServiceReference"),
-		ReferenceName: utils.String(raw["name"].(string)),
-		Parameters:    raw["parameters"].(map[string]interface{}),
+	parameters := raw["parameters"].(map[string]interface{})
+	return &dataflows.LinkedServiceReference{
+		Type:          dataflows.TypeLinkedServiceReference,
+		ReferenceName: raw["name"].(string),
+		Parameters:    &parameters,
 	}
 }
 
-func flattenDataFactoryDataFlowSource(input *[]datafactory.DataFlowSource) []interface{} {
+func flattenDataFactoryDataFlowScriptLines(input *[]string) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0)
+	for _, v := range *input {
+		result = append(result, v)
+	}
+	return result
+}
+
+func flattenDataFactoryDataFlowSource(input *[]dataflows.DataFlowSource) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
 	result := make([]interface{}, 0)
 	for _, v := range *input {
-		name := ""
-		description := ""
-		if v.Name != nil {
-			name = *v.Name
-		}
-		if v.Description != nil {
-			description = *v.Description
-		}
 		result = append(result, map[string]interface{}{
-			"name":                  name,
-			"description":           description,
+			"name":                  v.Name,
+			"description":           utils.NormalizeNilableString(v.Description),
 			"dataset":               flattenDataFactoryDatasetReference(v.Dataset),
+			"flowlet":               flattenDataFactoryDataFlowReference(v.Flowlet),
 			"linked_service":        flattenDataFactoryLinkedServiceReference(v.LinkedService),
 			"schema_linked_service": flattenDataFactoryLinkedServiceReference(v.SchemaLinkedService),
 		})
@@ -188,25 +391,18 @@ func flattenDataFactoryDataFlowSource(input *[]datafactory.DataFlowSource) []int
 	return result
 }
 
-func flattenDataFactoryDataFlowSink(input *[]datafactory.DataFlowSink) []interface{} {
+func flattenDataFactoryDataFlowSink(input *[]dataflows.DataFlowSink) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
 	result := make([]interface{}, 0)
 	for _, v := range *input {
-		name := ""
-		description := ""
-		if v.Name != nil {
-			name = *v.Name
-		}
-		if v.Description != nil {
-			description = *v.Description
-		}
 		result = append(result, map[string]interface{}{
-			"name":                  name,
-			"description":           description,
+			"name":                  v.Name,
+			"description":           utils.NormalizeNilableString(v.Description),
 			"dataset":               flattenDataFactoryDatasetReference(v.Dataset),
+			"flowlet":               flattenDataFactoryDataFlowReference(v.Flowlet),
 			"linked_service":        flattenDataFactoryLinkedServiceReference(v.LinkedService),
 			"schema_linked_service": flattenDataFactoryLinkedServiceReference(v.SchemaLinkedService),
 		})
@@ -214,38 +410,66 @@ func flattenDataFactoryDataFlowSink(input *[]datafactory.DataFlowSink) []interfa
 	return result
 }
 
-func flattenDataFactoryDatasetReference(input *datafactory.DatasetReference) []interface{} {
+func flattenDataFactoryDataFlowTransformation(input *[]dataflows.Transformation) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
-	name := ""
-	if input.ReferenceName != nil {
-		name = *input.ReferenceName
+	result := make([]interface{}, 0)
+	for _, v := range *input {
+		result = append(result, map[string]interface{}{
+			"name":           v.Name,
+			"description":    utils.NormalizeNilableString(v.Description),
+			"dataset":        flattenDataFactoryDatasetReference(v.Dataset),
+			"flowlet":        flattenDataFactoryDataFlowReference(v.Flowlet),
+			"linked_service": flattenDataFactoryLinkedServiceReference(v.LinkedService),
+		})
+	}
+	return result
+}
+
+func flattenDataFactoryDatasetReference(input *dataflows.DatasetReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"name":       name,
-			"parameters": input.Parameters,
+			"name":       input.ReferenceName,
+			"parameters": flattenDataFactoryDataFlowReferenceParameters(input.Parameters),
 		},
 	}
 }
 
-func flattenDataFactoryLinkedServiceReference(input *datafactory.LinkedServiceReference) []interface{} {
+func flattenDataFactoryDataFlowReference(input *dataflows.DataFlowReference) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
-	name := ""
-	if input.ReferenceName != nil {
-		name = *input.ReferenceName
+	return []interface{}{
+		map[string]interface{}{
+			"name":       input.ReferenceName,
+			"parameters": flattenDataFactoryDataFlowReferenceParameters(input.Parameters),
+		},
+	}
+}
+
+func flattenDataFactoryLinkedServiceReference(input *dataflows.LinkedServiceReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"name":       name,
-			"parameters": input.Parameters,
+			"name":       input.ReferenceName,
+			"parameters": flattenDataFactoryDataFlowReferenceParameters(input.Parameters),
 		},
 	}
 }
+
+func flattenDataFactoryDataFlowReferenceParameters(input *map[string]interface{}) map[string]interface{} {
+	if input == nil {
+		return map[string]interface{}{}
+	}
+	return *input
+}
@@ -4,10 +4,11 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/dataflows"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -23,10 +24,12 @@ func resourceDataFactoryDataFlow() *pluginsdk.Resource {
 		Delete: resourceDataFactoryDataFlowDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DataFlowID(id)
+			_, err := dataflows.ParseDataFlowID(id)
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dataFactoryDataFlowCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -50,34 +53,27 @@ func resourceDataFactoryDataFlow() *pluginsdk.Resource {
 
 			"script": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"script", "script_lines"},
 			},
 
-			"source": SchemaForDataFlowSourceAndSink(),
-
-			"sink": SchemaForDataFlowSourceAndSink(),
-
-			"transformation": {
+			"script_lines": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"description": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-					},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
+				ExactlyOneOf: []string{"script", "script_lines"},
 			},
 
+			"source": SchemaForDataFlowSourceAndSink(),
+
+			"sink": SchemaForDataFlowSourceAndSink(),
+
+			"transformation": SchemaForDataFlowTransformation(),
+
 			"annotations": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -112,29 +108,32 @@ func resourceDataFactoryDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	id := parse.NewDataFlowID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	id := dataflows.NewDataFlowID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_data_factory_data_flow", id.ID())
 		}
 	}
 
-	mappingDataFlow := datafactory.MappingDataFlow{
-		MappingDataFlowTypeProperties: &datafactory.MappingDataFlowTypeProperties{
-			Script:          utils.String(d.Get("script").(string)),
+	mappingDataFlow := dataflows.MappingDataFlow{
+		TypeProperties: &dataflows.MappingDataFlowTypeProperties{
+			ScriptLines:     expandDataFactoryDataFlowScriptLines(d.Get("script_lines").([]interface{})),
 			Sinks:           expandDataFactoryDataFlowSink(d.Get("sink").([]interface{})),
 			Sources:         expandDataFactoryDataFlowSource(d.Get("source").([]interface{})),
 			Transformations: expandDataFactoryDataFlowTransformation(d.Get("transformation").([]interface{})),
 		},
 		Description: utils.String(d.Get("description").(string)),
-		Type:        datafactory.TypeBasicDataFlowTypeMappingDataFlow,
+	}
+
+	if v, ok := d.GetOk("script"); ok {
+		mappingDataFlow.TypeProperties.Script = utils.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("annotations"); ok {
@@ -143,17 +142,17 @@ func resourceDataFactoryDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	if v, ok := d.GetOk("folder"); ok {
-		mappingDataFlow.Folder = &datafactory.DataFlowFolder{
+		mappingDataFlow.Folder = &dataflows.DataFlowFolder{
 			Name: utils.String(v.(string)),
 		}
 	}
 
-	dataFlow := datafactory.DataFlowResource{
-		Properties: &mappingDataFlow,
+	dataFlow := dataflows.DataFlowResource{
+		Properties: mappingDataFlow,
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, dataFlow, ""); err != nil {
-		return fmt.Errorf(" creating/updating %s: %+v", id, err)
+	if _, err := client.CreateOrUpdate(ctx, id, dataFlow); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
@@ -166,14 +165,14 @@ func resourceDataFactoryDataFlowRead(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DataFlowID(d.Id())
+	id, err := dataflows.ParseDataFlowID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -181,9 +180,13 @@ func resourceDataFactoryDataFlowRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	mappingDataFlow, ok := resp.Properties.AsMappingDataFlow()
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	mappingDataFlow, ok := resp.Model.Properties.(dataflows.MappingDataFlow)
 	if !ok {
-		return fmt.Errorf("classifying type of %s: Expected: %q", id, datafactory.TypeBasicDataFlowTypeMappingDataFlow)
+		return fmt.Errorf("classifying type of %s: Expected: %q", id, "MappingDataFlow")
 	}
 
 	d.Set("name", id.Name)
@@ -200,9 +203,12 @@ func resourceDataFactoryDataFlowRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("folder", folder)
 
-	if prop := mappingDataFlow.MappingDataFlowTypeProperties; prop != nil {
+	if prop := mappingDataFlow.TypeProperties; prop != nil {
 		d.Set("script", prop.Script)
 
+		if err := d.Set("script_lines", flattenDataFactoryDataFlowScriptLines(prop.ScriptLines)); err != nil {
+			return fmt.Errorf("setting `script_lines`: %+v", err)
+		}
 		if err := d.Set("source", flattenDataFactoryDataFlowSource(prop.Sources)); err != nil {
 			return fmt.Errorf("setting `source`: %+v", err)
 		}
@@ -222,53 +228,14 @@ func resourceDataFactoryDataFlowDelete(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DataFlowID(d.Id())
+	id, err := dataflows.ParseDataFlowID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name); err != nil {
+	if _, err := client.Delete(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
 	return nil
 }
-
-func expandDataFactoryDataFlowTransformation(input []interface{}) *[]datafactory.Transformation {
-	if len(input) == 0 || input[0] == nil {
-		return nil
-	}
-
-	result := make([]datafactory.Transformation, 0)
-	for _, v := range input {
-		raw := v.(map[string]interface{})
-		result = append(result, datafactory.Transformation{
-			Description: utils.String(raw["description"].(string)),
-			Name:        utils.String(raw["name"].(string)),
-		})
-	}
-	return &result
-}
-
-func flattenDataFactoryDataFlowTransformation(input *[]datafactory.Transformation) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	result := make([]interface{}, 0)
-	for _, v := range *input {
-		name := ""
-		description := ""
-		if v.Name != nil {
-			name = *v.Name
-		}
-		if v.Description != nil {
-			description = *v.Description
-		}
-		result = append(result, map[string]interface{}{
-			"name":        name,
-			"description": description,
-		})
-	}
-	return result
-}
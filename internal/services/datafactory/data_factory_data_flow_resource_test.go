@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/dataflows"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -90,18 +90,33 @@ func TestAccDataFactoryDataFlow_update(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryDataFlow_flowlet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_data_flow", "test")
+	r := DataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.flowlet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t DataFlowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := parse.DataFlowID(state.ID)
+	id, err := dataflows.ParseDataFlowID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.DataFactory.DataFlowClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	resp, err := clients.DataFactory.DataFlowClient.Get(ctx, *id)
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil), nil
 }
 
 func (r DataFlowResource) basic(data acceptance.TestData) string {
@@ -249,6 +264,52 @@ EOT
 `, r.template(data), data.RandomInteger)
 }
 
+func (r DataFlowResource) flowlet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_data_flow" "test" {
+  name            = "acctestdf%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  source {
+    name = "source1"
+
+    linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+    }
+  }
+
+  sink {
+    name = "sink1"
+
+    linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+    }
+  }
+
+  transformation {
+    name = "flowlet1"
+
+    flowlet {
+      name = azurerm_data_factory_flowlet_data_flow.test.name
+    }
+  }
+
+  script_lines = [
+    "source(allowSchemaDrift: true,",
+    "  validateSchema: false,",
+    "  ignoreNoFilesFound: false) ~> source1",
+    "source1 compose(composition: '${azurerm_data_factory_flowlet_data_flow.test.name}') ~> flowlet1@(output1)",
+    "flowlet1@output1 sink(allowSchemaDrift: true,",
+    "  validateSchema: false,",
+    "  skipDuplicateMapInputs: true,",
+    "  skipDuplicateMapOutputs: true) ~> sink1",
+  ]
+}
+`, FlowletDataFlowResource{}.basic(data), data.RandomInteger)
+}
+
 func (DataFlowResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,241 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/dataflows"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryFlowletDataFlow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryFlowletDataFlowCreateUpdate,
+		Read:   resourceDataFactoryFlowletDataFlowRead,
+		Update: resourceDataFactoryFlowletDataFlowCreateUpdate,
+		Delete: resourceDataFactoryFlowletDataFlowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := dataflows.ParseDataFlowID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dataFactoryDataFlowCustomizeDiff),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"script": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"script", "script_lines"},
+			},
+
+			"script_lines": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ExactlyOneOf: []string{"script", "script_lines"},
+			},
+
+			"source": SchemaForDataFlowletSourceAndSink(),
+
+			"sink": SchemaForDataFlowletSourceAndSink(),
+
+			"transformation": SchemaForDataFlowTransformation(),
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceDataFactoryFlowletDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DataFlowClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := dataflows.NewDataFlowID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_factory_flowlet_data_flow", id.ID())
+		}
+	}
+
+	flowlet := dataflows.Flowlet{
+		TypeProperties: &dataflows.FlowletTypeProperties{
+			ScriptLines:     expandDataFactoryDataFlowScriptLines(d.Get("script_lines").([]interface{})),
+			Sinks:           expandDataFactoryDataFlowSink(d.Get("sink").([]interface{})),
+			Sources:         expandDataFactoryDataFlowSource(d.Get("source").([]interface{})),
+			Transformations: expandDataFactoryDataFlowTransformation(d.Get("transformation").([]interface{})),
+		},
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("script"); ok {
+		flowlet.TypeProperties.Script = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		flowlet.Annotations = &annotations
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		flowlet.Folder = &dataflows.DataFlowFolder{
+			Name: utils.String(v.(string)),
+		}
+	}
+
+	dataFlow := dataflows.DataFlowResource{
+		Properties: flowlet,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, dataFlow); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryFlowletDataFlowRead(d, meta)
+}
+
+func resourceDataFactoryFlowletDataFlowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DataFlowClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dataflows.ParseDataFlowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	flowlet, ok := resp.Model.Properties.(dataflows.Flowlet)
+	if !ok {
+		return fmt.Errorf("classifying type of %s: Expected: %q", id, "Flowlet")
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+	d.Set("description", flowlet.Description)
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(flowlet.Annotations)); err != nil {
+		return fmt.Errorf("setting `annotations`: %+v", err)
+	}
+
+	folder := ""
+	if flowlet.Folder != nil && flowlet.Folder.Name != nil {
+		folder = *flowlet.Folder.Name
+	}
+	d.Set("folder", folder)
+
+	if prop := flowlet.TypeProperties; prop != nil {
+		d.Set("script", prop.Script)
+
+		if err := d.Set("script_lines", flattenDataFactoryDataFlowScriptLines(prop.ScriptLines)); err != nil {
+			return fmt.Errorf("setting `script_lines`: %+v", err)
+		}
+		if err := d.Set("source", flattenDataFactoryDataFlowSource(prop.Sources)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+		if err := d.Set("sink", flattenDataFactoryDataFlowSink(prop.Sinks)); err != nil {
+			return fmt.Errorf("setting `sink`: %+v", err)
+		}
+		if err := d.Set("transformation", flattenDataFactoryDataFlowTransformation(prop.Transformations)); err != nil {
+			return fmt.Errorf("setting `transformation`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryFlowletDataFlowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DataFlowClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dataflows.ParseDataFlowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
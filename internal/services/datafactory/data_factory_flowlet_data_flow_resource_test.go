@@ -0,0 +1,270 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/2018-06-01/dataflows"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FlowletDataFlowResource struct {
+}
+
+func TestAccDataFactoryFlowletDataFlow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_flowlet_data_flow", "test")
+	r := FlowletDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryFlowletDataFlow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_flowlet_data_flow", "test")
+	r := FlowletDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryFlowletDataFlow_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_flowlet_data_flow", "test")
+	r := FlowletDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryFlowletDataFlow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_flowlet_data_flow", "test")
+	r := FlowletDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryFlowletDataFlow_duplicateTransformationName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_flowlet_data_flow", "test")
+	r := FlowletDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateTransformationName(data),
+			ExpectError: regexp.MustCompile("names must be unique"),
+		},
+	})
+}
+
+func (t FlowletDataFlowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dataflows.ParseDataFlowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.DataFlowClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r FlowletDataFlowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_flowlet_data_flow" "test" {
+  name            = "acctestfdf%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  source {
+    name = "source1"
+
+    linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+    }
+  }
+
+  sink {
+    name = "sink1"
+
+    linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+    }
+  }
+
+  script = <<EOT
+source(
+  allowSchemaDrift: true, 
+  validateSchema: false, 
+  limit: 100, 
+  ignoreNoFilesFound: false, 
+  documentForm: 'documentPerLine') ~> source1 
+source1 sink(
+  allowSchemaDrift: true, 
+  validateSchema: false, 
+  skipDuplicateMapInputs: true, 
+  skipDuplicateMapOutputs: true) ~> sink1
+EOT
+}
+`, DataFlowResource{}.template(data), data.RandomInteger)
+}
+
+func (r FlowletDataFlowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_flowlet_data_flow" "import" {
+  name            = azurerm_data_factory_flowlet_data_flow.test.name
+  data_factory_id = azurerm_data_factory_flowlet_data_flow.test.data_factory_id
+  script          = azurerm_data_factory_flowlet_data_flow.test.script
+  source {
+    name = azurerm_data_factory_flowlet_data_flow.test.source.0.name
+    linked_service {
+      name = azurerm_data_factory_flowlet_data_flow.test.source.0.linked_service.0.name
+    }
+  }
+
+  sink {
+    name = azurerm_data_factory_flowlet_data_flow.test.sink.0.name
+    linked_service {
+      name = azurerm_data_factory_flowlet_data_flow.test.sink.0.linked_service.0.name
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r FlowletDataFlowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_flowlet_data_flow" "test" {
+  name            = "acctestfdf%d"
+  data_factory_id = azurerm_data_factory.test.id
+  description     = "description for flowlet"
+  annotations     = ["anno1", "anno2"]
+  folder          = "folder1"
+
+  source {
+    name        = "source1"
+    description = "description for source1"
+
+    linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+      parameters = {
+        "Key1" = "value1"
+      }
+    }
+
+    schema_linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+      parameters = {
+        "Key1" = "value1"
+      }
+    }
+  }
+
+  sink {
+    name        = "sink1"
+    description = "description for sink1"
+
+    linked_service {
+      name = azurerm_data_factory_linked_custom_service.test.name
+      parameters = {
+        "Key1" = "value1"
+      }
+    }
+  }
+
+  transformation {
+    name        = "filter1"
+    description = "description for filter1"
+  }
+
+  script_lines = [
+    "source(output(",
+    "    movie as string,",
+    "    year as string",
+    "  ),",
+    "  allowSchemaDrift: true,",
+    "  validateSchema: false,",
+    "  ignoreNoFilesFound: false) ~> source1",
+    "source1 filter(toInteger(year) >= 1910 && toInteger(year) <= 2000) ~> filter1",
+    "filter1 sink(allowSchemaDrift: true,",
+    "  validateSchema: false,",
+    "  skipDuplicateMapInputs: true,",
+    "  skipDuplicateMapOutputs: true) ~> sink1",
+  ]
+}
+`, DataFlowResource{}.template(data), data.RandomInteger)
+}
+
+func (r FlowletDataFlowResource) duplicateTransformationName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_flowlet_data_flow" "test" {
+  name            = "acctestfdf%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  transformation {
+    name = "filter1"
+  }
+
+  transformation {
+    name = "filter1"
+  }
+
+  script = "source1 filter(true()) ~> filter1"
+}
+`, DataFlowResource{}.template(data), data.RandomInteger)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -234,7 +235,44 @@ func resourceDataFactory() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceDataFactoryCustomizeDiff),
+	}
+}
+
+func resourceDataFactoryCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if diff.Id() != "" && diff.HasChange("managed_virtual_network_enabled") {
+		if old, _ := diff.GetChange("managed_virtual_network_enabled"); old.(bool) {
+			return fmt.Errorf("once `managed_virtual_network_enabled` has been enabled it's not possible to disable it")
+		}
+	}
+
+	names := make(map[string]struct{})
+	for _, item := range diff.Get("global_parameter").(*pluginsdk.Set).List() {
+		if item == nil {
+			continue
+		}
+		parameter := item.(map[string]interface{})
+
+		name := parameter["name"].(string)
+		if name != "" {
+			if _, ok := names[name]; ok {
+				return fmt.Errorf("`global_parameter` names must be unique, %q is specified more than once", name)
+			}
+			names[name] = struct{}{}
+		}
+
+		// the value may not be known until apply
+		value := parameter["value"].(string)
+		if value == "" {
+			continue
+		}
+		if _, err := expandDataFactoryGlobalParameterValue(parameter["type"].(string), value); err != nil {
+			return fmt.Errorf("`global_parameter` %q: %+v", name, err)
+		}
 	}
+
+	return nil
 }
 
 func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -389,7 +427,7 @@ func resourceDataFactoryRead(d *pluginsdk.ResourceData, meta interface{}) error
 			}
 		}
 
-		if err := d.Set("global_parameter", flattenDataFactoryGlobalParameters(factoryProps.GlobalParameters)); err != nil {
+		if err := d.Set("global_parameter", flattenDataFactoryGlobalParameters(factoryProps.GlobalParameters, d.Get("global_parameter").(*pluginsdk.Set).List())); err != nil {
 			return fmt.Errorf("setting `global_parameter`: %+v", err)
 		}
 	}
@@ -508,18 +546,67 @@ func expandDataFactoryGlobalParameters(input []interface{}) (map[string]*datafac
 		v := item.(map[string]interface{})
 
 		name := v["name"].(string)
-		if _, ok := v[name]; ok {
-			return nil, fmt.Errorf("duplicate parameter name")
+		if _, ok := result[name]; ok {
+			return nil, fmt.Errorf("duplicate `global_parameter` name %q", name)
+		}
+
+		parameterType := v["type"].(string)
+		value, err := expandDataFactoryGlobalParameterValue(parameterType, v["value"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `global_parameter` %q: %+v", name, err)
 		}
 
 		result[name] = &datafactory.GlobalParameterSpecification{
-			Type:  datafactory.GlobalParameterType(v["type"].(string)),
-			Value: v["value"].(string),
+			Type:  datafactory.GlobalParameterType(parameterType),
+			Value: value,
 		}
 	}
 	return result, nil
 }
 
+// expandDataFactoryGlobalParameterValue converts the string representation of a global parameter
+// into the JSON type expected by the API for the given parameter type
+func expandDataFactoryGlobalParameterValue(parameterType string, value string) (interface{}, error) {
+	switch parameterType {
+	case "Int":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("`value` must be an integer when `type` is `Int`, got %q", value)
+		}
+		return v, nil
+
+	case "Float":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("`value` must be a number when `type` is `Float`, got %q", value)
+		}
+		return v, nil
+
+	case "Bool":
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("`value` must be `true` or `false` when `type` is `Bool`, got %q", value)
+		}
+		return v, nil
+
+	case "Array":
+		v := make([]interface{}, 0)
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("`value` must be a JSON encoded array when `type` is `Array`: %+v", err)
+		}
+		return v, nil
+
+	case "Object":
+		v := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("`value` must be a JSON encoded object when `type` is `Object`: %+v", err)
+		}
+		return v, nil
+	}
+
+	return value, nil
+}
+
 func flattenDataFactoryRepoConfiguration(factory *datafactory.Factory) (datafactory.TypeBasicFactoryRepoConfiguration, []interface{}) {
 	result := make([]interface{}, 0)
 
@@ -605,11 +692,21 @@ func flattenDataFactoryIdentity(identity *datafactory.FactoryIdentity) (interfac
 	}, nil
 }
 
-func flattenDataFactoryGlobalParameters(input map[string]*datafactory.GlobalParameterSpecification) []interface{} {
+func flattenDataFactoryGlobalParameters(input map[string]*datafactory.GlobalParameterSpecification, existing []interface{}) []interface{} {
 	if len(input) == 0 {
 		return []interface{}{}
 	}
 
+	// the API returns values in a normalised form (e.g. `3.0` as `3`), so the configured value is retained when equivalent
+	existingValues := make(map[string]string)
+	for _, item := range existing {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		existingValues[v["name"].(string)] = v["value"].(string)
+	}
+
 	result := make([]interface{}, 0)
 	for name, item := range input {
 		var valueResult string
@@ -618,10 +715,23 @@ func flattenDataFactoryGlobalParameters(input map[string]*datafactory.GlobalPara
 		if (typeResult == "Array" || typeResult == "Object") && reflect.TypeOf(item.Value).Name() != "string" {
 			j, _ := json.Marshal(item.Value)
 			valueResult = string(j)
+		} else if f, ok := item.Value.(float64); ok {
+			// numbers are decoded as a float64, which would otherwise be rendered in exponent form when large
+			valueResult = strconv.FormatFloat(f, 'f', -1, 64)
 		} else {
 			valueResult = fmt.Sprintf("%v", item.Value)
 		}
 
+		if existingValue, ok := existingValues[name]; ok && existingValue != valueResult {
+			expected, err := expandDataFactoryGlobalParameterValue(typeResult, existingValue)
+			if err == nil {
+				actual, err := expandDataFactoryGlobalParameterValue(typeResult, valueResult)
+				if err == nil && reflect.DeepEqual(expected, actual) {
+					valueResult = existingValue
+				}
+			}
+		}
+
 		result = append(result, map[string]interface{}{
 			"name":  name,
 			"type":  typeResult,
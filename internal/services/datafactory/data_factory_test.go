@@ -1,6 +1,9 @@
 package datafactory
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	cases := []struct {
@@ -203,3 +206,84 @@ func TestNormalizeJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestDataFactoryExpandGlobalParameterValue(t *testing.T) {
+	cases := []struct {
+		Type      string
+		Value     string
+		Expected  interface{}
+		ExpectErr bool
+	}{
+		{
+			Type:     "String",
+			Value:    "foo",
+			Expected: "foo",
+		},
+		{
+			Type:     "Int",
+			Value:    "3",
+			Expected: int64(3),
+		},
+		{
+			Type:      "Int",
+			Value:     "3.5",
+			ExpectErr: true,
+		},
+		{
+			Type:     "Float",
+			Value:    "3.0",
+			Expected: float64(3),
+		},
+		{
+			Type:      "Float",
+			Value:     "three",
+			ExpectErr: true,
+		},
+		{
+			Type:     "Bool",
+			Value:    "true",
+			Expected: true,
+		},
+		{
+			Type:      "Bool",
+			Value:     "yes",
+			ExpectErr: true,
+		},
+		{
+			Type:     "Array",
+			Value:    `["a","b"]`,
+			Expected: []interface{}{"a", "b"},
+		},
+		{
+			Type:      "Array",
+			Value:     `{"name":"value"}`,
+			ExpectErr: true,
+		},
+		{
+			Type:     "Object",
+			Value:    `{"name":"value"}`,
+			Expected: map[string]interface{}{"name": "value"},
+		},
+		{
+			Type:      "Object",
+			Value:     `["a","b"]`,
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := expandDataFactoryGlobalParameterValue(tc.Type, tc.Value)
+		if tc.ExpectErr {
+			if err == nil {
+				t.Fatalf("Expected an error for type %q and value %q but didn't get one", tc.Type, tc.Value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error for type %q and value %q but got: %+v", tc.Type, tc.Value, err)
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %#v for type %q and value %q but got %#v", tc.Expected, tc.Type, tc.Value, actual)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package dataflows
+
+type DataFlowReferenceType string
+
+const (
+	DataFlowReferenceTypeDataFlowReference DataFlowReferenceType = "DataFlowReference"
+)
+
+type DatasetReferenceType string
+
+const (
+	DatasetReferenceTypeDatasetReference DatasetReferenceType = "DatasetReference"
+)
+
+type Type string
+
+const (
+	TypeLinkedServiceReference Type = "LinkedServiceReference"
+)
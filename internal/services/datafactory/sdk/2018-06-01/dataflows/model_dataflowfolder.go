@@ -0,0 +1,5 @@
+package dataflows
+
+type DataFlowFolder struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dataflows
+
+type DataFlowReference struct {
+	DatasetParameters *interface{}            `json:"datasetParameters,omitempty"`
+	Parameters        *map[string]interface{} `json:"parameters,omitempty"`
+	ReferenceName     string                  `json:"referenceName"`
+	Type              DataFlowReferenceType   `json:"type"`
+}
@@ -0,0 +1,10 @@
+package dataflows
+
+type DataFlowSource struct {
+	Dataset             *DatasetReference       `json:"dataset,omitempty"`
+	Description         *string                 `json:"description,omitempty"`
+	Flowlet             *DataFlowReference      `json:"flowlet,omitempty"`
+	LinkedService       *LinkedServiceReference `json:"linkedService,omitempty"`
+	Name                string                  `json:"name"`
+	SchemaLinkedService *LinkedServiceReference `json:"schemaLinkedService,omitempty"`
+}
@@ -0,0 +1,9 @@
+package dataflows
+
+type MappingDataFlowTypeProperties struct {
+	Script          *string           `json:"script,omitempty"`
+	ScriptLines     *[]string         `json:"scriptLines,omitempty"`
+	Sinks           *[]DataFlowSink   `json:"sinks,omitempty"`
+	Sources         *[]DataFlowSource `json:"sources,omitempty"`
+	Transformations *[]Transformation `json:"transformations,omitempty"`
+}
@@ -0,0 +1,9 @@
+package dataflows
+
+type Transformation struct {
+	Dataset       *DatasetReference       `json:"dataset,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	Flowlet       *DataFlowReference      `json:"flowlet,omitempty"`
+	LinkedService *LinkedServiceReference `json:"linkedService,omitempty"`
+	Name          string                  `json:"name"`
+}
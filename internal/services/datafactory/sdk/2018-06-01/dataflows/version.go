@@ -0,0 +1,9 @@
+package dataflows
+
+import "fmt"
+
+const defaultApiVersion = "2018-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/dataflows/%s", defaultApiVersion)
+}
@@ -85,12 +85,14 @@ func resourceDevTestGlobalVMShutdownSchedule() *pluginsdk.Resource {
 							ValidateFunc: validation.IntBetween(15, 120),
 						},
 						"webhook_url": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 						},
 						"email": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
 				},
@@ -157,7 +159,10 @@ func resourceDevTestGlobalVMShutdownScheduleCreateUpdate(d *pluginsdk.ResourceDa
 	}
 
 	if _, ok := d.GetOk("notification_settings"); ok {
-		notificationSettings := expandDevTestGlobalVMShutdownScheduleNotificationSettings(d)
+		notificationSettings, err := expandDevTestGlobalVMShutdownScheduleNotificationSettings(d)
+		if err != nil {
+			return err
+		}
 		schedule.NotificationSettings = notificationSettings
 	}
 
@@ -256,7 +261,7 @@ func flattenDevTestGlobalVMShutdownScheduleRecurrenceDaily(dailyRecurrence *dtl.
 	return result
 }
 
-func expandDevTestGlobalVMShutdownScheduleNotificationSettings(d *pluginsdk.ResourceData) *dtl.NotificationSettings {
+func expandDevTestGlobalVMShutdownScheduleNotificationSettings(d *pluginsdk.ResourceData) (*dtl.NotificationSettings, error) {
 	notificationSettingsConfigs := d.Get("notification_settings").([]interface{})
 	notificationSettingsConfig := notificationSettingsConfigs[0].(map[string]interface{})
 	webhookUrl := notificationSettingsConfig["webhook_url"].(string)
@@ -265,6 +270,9 @@ func expandDevTestGlobalVMShutdownScheduleNotificationSettings(d *pluginsdk.Reso
 
 	var notificationStatus dtl.EnableStatus
 	if notificationSettingsConfig["enabled"].(bool) {
+		if webhookUrl == "" && email == "" {
+			return nil, fmt.Errorf("at least one of `webhook_url` or `email` must be specified within the `notification_settings` block when `enabled` is `true`")
+		}
 		notificationStatus = dtl.EnableStatusEnabled
 	} else {
 		notificationStatus = dtl.EnableStatusDisabled
@@ -275,7 +283,7 @@ func expandDevTestGlobalVMShutdownScheduleNotificationSettings(d *pluginsdk.Reso
 		TimeInMinutes:  &timeInMinutes,
 		Status:         notificationStatus,
 		EmailRecipient: &email,
-	}
+	}, nil
 }
 
 func flattenDevTestGlobalVMShutdownScheduleNotificationSettings(notificationSettings *dtl.NotificationSettings) []interface{} {
@@ -290,11 +298,14 @@ func flattenDevTestGlobalVMShutdownScheduleNotificationSettings(notificationSett
 	}
 
 	if notificationSettings.TimeInMinutes != nil {
-		result["time_in_minutes"] = *notificationSettings.TimeInMinutes
+		result["time_in_minutes"] = int(*notificationSettings.TimeInMinutes)
+	}
+
+	if notificationSettings.EmailRecipient != nil {
+		result["email"] = *notificationSettings.EmailRecipient
 	}
 
 	result["enabled"] = notificationSettings.Status == dtl.EnableStatusEnabled
-	result["email"] = notificationSettings.EmailRecipient
 
 	return []interface{}{result}
 }
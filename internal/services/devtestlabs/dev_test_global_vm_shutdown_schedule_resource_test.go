@@ -3,6 +3,7 @@ package devtestlabs_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -104,6 +105,37 @@ func TestAccDevTestGlobalVMShutdownSchedule_autoShutdownUpdate(t *testing.T) {
 	})
 }
 
+func TestAccDevTestGlobalVMShutdownSchedule_autoShutdownEmailOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_global_vm_shutdown_schedule", "test")
+	r := DevTestGlobalVMShutdownScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoShutdownEmailOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notification_settings.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("notification_settings.0.time_in_minutes").HasValue("120"),
+				check.That(data.ResourceName).Key("notification_settings.0.webhook_url").HasValue(""),
+				check.That(data.ResourceName).Key("notification_settings.0.email").HasValue("alerts@devtest.com"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevTestGlobalVMShutdownSchedule_autoShutdownNoNotificationChannel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_global_vm_shutdown_schedule", "test")
+	r := DevTestGlobalVMShutdownScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.autoShutdownNoNotificationChannel(data),
+			ExpectError: regexp.MustCompile("at least one of `webhook_url` or `email` must be specified"),
+		},
+	})
+}
+
 func (DevTestGlobalVMShutdownScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ScheduleID(state.ID)
 	if err != nil {
@@ -229,3 +261,39 @@ resource "azurerm_dev_test_global_vm_shutdown_schedule" "test" {
 
 `, r.template(data))
 }
+
+func (r DevTestGlobalVMShutdownScheduleResource) autoShutdownEmailOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_global_vm_shutdown_schedule" "test" {
+  location              = azurerm_resource_group.test.location
+  virtual_machine_id    = azurerm_linux_virtual_machine.test.id
+  daily_recurrence_time = "0100"
+  timezone              = "Pacific Standard Time"
+
+  notification_settings {
+    enabled         = true
+    time_in_minutes = 120
+    email           = "alerts@devtest.com"
+  }
+}
+`, r.template(data))
+}
+
+func (r DevTestGlobalVMShutdownScheduleResource) autoShutdownNoNotificationChannel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_global_vm_shutdown_schedule" "test" {
+  location              = azurerm_resource_group.test.location
+  virtual_machine_id    = azurerm_linux_virtual_machine.test.id
+  daily_recurrence_time = "0100"
+  timezone              = "Pacific Standard Time"
+
+  notification_settings {
+    enabled = true
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2023-06-01/monitoredsubscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2023-06-01/rules"
+)
+
+type Client struct {
+	MonitoredSubscriptionsClient *monitoredsubscriptions.MonitoredSubscriptionsClient
+	TagRuleClient                *rules.RulesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	monitoredSubscriptionsClient := monitoredsubscriptions.NewMonitoredSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&monitoredSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
+
+	tagRuleClient := rules.NewRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&tagRuleClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		MonitoredSubscriptionsClient: &monitoredSubscriptionsClient,
+		TagRuleClient:                &tagRuleClient,
+	}
+}
@@ -0,0 +1,301 @@
+package elastic
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2023-06-01/rules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Monitor only supports a single set of Tag Rules, which is always named `default`
+const elasticTagRuleName = "default"
+
+func resourceElasticCloudElasticsearchTagRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceElasticCloudElasticsearchTagRuleCreate,
+		Read:   resourceElasticCloudElasticsearchTagRuleRead,
+		Update: resourceElasticCloudElasticsearchTagRuleUpdate,
+		Delete: resourceElasticCloudElasticsearchTagRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := rules.ParseTagRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"monitor_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorID,
+			},
+
+			"send_azuread_logs": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"send_activity_logs": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"send_subscription_logs": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"filtering_tag": filteringTagSchema(),
+		},
+	}
+}
+
+func resourceElasticCloudElasticsearchTagRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.TagRuleClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	monitorId, err := rules.ParseMonitorID(d.Get("monitor_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := rules.NewTagRuleID(monitorId.SubscriptionId, monitorId.ResourceGroup, monitorId.Name, elasticTagRuleName)
+	existing, err := client.TagRulesGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	// the `default` Tag Rule is returned for every Monitor, so it's only treated as existing once Log Rules are configured
+	if !response.WasNotFound(existing.HttpResponse) && existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.LogRules != nil && tagRuleHasLogRules(*existing.Model.Properties.LogRules) {
+		return tf.ImportAsExistsError("azurerm_elastic_cloud_elasticsearch_tag_rule", id.ID())
+	}
+
+	parameters := rules.MonitoringTagRules{
+		Properties: &rules.MonitoringTagRulesProperties{
+			LogRules: expandTagRuleLogRules(d),
+		},
+	}
+
+	if _, err := client.TagRulesCreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceElasticCloudElasticsearchTagRuleRead(d, meta)
+}
+
+func resourceElasticCloudElasticsearchTagRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.TagRuleClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseTagRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TagRulesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("monitor_id", rules.NewMonitorID(id.SubscriptionId, id.ResourceGroup, id.MonitorName).ID())
+
+	sendAadLogs := false
+	sendActivityLogs := false
+	sendSubscriptionLogs := false
+	filteringTags := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.LogRules != nil {
+		logRules := model.Properties.LogRules
+		if logRules.SendAadLogs != nil {
+			sendAadLogs = *logRules.SendAadLogs
+		}
+		if logRules.SendActivityLogs != nil {
+			sendActivityLogs = *logRules.SendActivityLogs
+		}
+		if logRules.SendSubscriptionLogs != nil {
+			sendSubscriptionLogs = *logRules.SendSubscriptionLogs
+		}
+		filteringTags = flattenTagRuleFilteringTags(logRules.FilteringTags)
+	}
+	d.Set("send_azuread_logs", sendAadLogs)
+	d.Set("send_activity_logs", sendActivityLogs)
+	d.Set("send_subscription_logs", sendSubscriptionLogs)
+	if err := d.Set("filtering_tag", filteringTags); err != nil {
+		return fmt.Errorf("setting `filtering_tag`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceElasticCloudElasticsearchTagRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.TagRuleClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseTagRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.TagRulesGet(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	parameters := *existing.Model
+	if parameters.Properties == nil {
+		parameters.Properties = &rules.MonitoringTagRulesProperties{}
+	}
+	// the Log Rules are replaced as a whole, so all of the fields need to be sent
+	parameters.Properties.LogRules = expandTagRuleLogRules(d)
+	parameters.Properties.ProvisioningState = nil
+
+	if _, err := client.TagRulesCreateOrUpdate(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceElasticCloudElasticsearchTagRuleRead(d, meta)
+}
+
+func resourceElasticCloudElasticsearchTagRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.TagRuleClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseTagRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.TagRulesDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func filteringTagSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"action": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(rules.TagActionInclude),
+						string(rules.TagActionExclude),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func tagRuleHasLogRules(input rules.LogRules) bool {
+	if input.FilteringTags != nil && len(*input.FilteringTags) > 0 {
+		return true
+	}
+
+	return (input.SendAadLogs != nil && *input.SendAadLogs) ||
+		(input.SendActivityLogs != nil && *input.SendActivityLogs) ||
+		(input.SendSubscriptionLogs != nil && *input.SendSubscriptionLogs)
+}
+
+func expandTagRuleLogRules(d *pluginsdk.ResourceData) *rules.LogRules {
+	return &rules.LogRules{
+		FilteringTags:        expandTagRuleFilteringTags(d.Get("filtering_tag").([]interface{})),
+		SendAadLogs:          utils.Bool(d.Get("send_azuread_logs").(bool)),
+		SendActivityLogs:     utils.Bool(d.Get("send_activity_logs").(bool)),
+		SendSubscriptionLogs: utils.Bool(d.Get("send_subscription_logs").(bool)),
+	}
+}
+
+func expandTagRuleFilteringTags(input []interface{}) *[]rules.FilteringTag {
+	results := make([]rules.FilteringTag, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		action := rules.TagAction(v["action"].(string))
+		results = append(results, rules.FilteringTag{
+			Action: &action,
+			Name:   utils.String(v["name"].(string)),
+			Value:  utils.String(v["value"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenTagRuleFilteringTags(input *[]rules.FilteringTag) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		action := ""
+		if item.Action != nil {
+			action = string(*item.Action)
+		}
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+		value := ""
+		if item.Value != nil {
+			value = *item.Value
+		}
+
+		results = append(results, map[string]interface{}{
+			"action": action,
+			"name":   name,
+			"value":  value,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,195 @@
+package elastic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2023-06-01/rules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticCloudElasticsearchTagRuleResource struct{}
+
+func TestAccElasticCloudElasticsearchTagRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch_tag_rule", "test")
+	r := ElasticCloudElasticsearchTagRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticCloudElasticsearchTagRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch_tag_rule", "test")
+	r := ElasticCloudElasticsearchTagRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccElasticCloudElasticsearchTagRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_cloud_elasticsearch_tag_rule", "test")
+	r := ElasticCloudElasticsearchTagRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("filtering_tag.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ElasticCloudElasticsearchTagRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := rules.ParseTagRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Elastic.TagRuleClient.TagRulesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// template provisions the Elastic Monitor using a Template Deployment, since this isn't otherwise available in the
+// Provider
+func (ElasticCloudElasticsearchTagRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-elastic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-elastic-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Elastic/monitors",
+      "apiVersion": "2023-06-01",
+      "name": "acctest-estc%[1]d",
+      "location": "%[2]s",
+      "sku": {
+        "name": "ess-consumption-2024_Monthly"
+      },
+      "properties": {
+        "monitoringStatus": "Enabled",
+        "userInfo": {
+          "emailAddress": "terraform-acctest@hashicorp.com"
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Elastic/monitors', 'acctest-estc%[1]d')]"
+    }
+  }
+}
+TEMPLATE
+}
+
+locals {
+  monitor_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ElasticCloudElasticsearchTagRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_elasticsearch_tag_rule" "test" {
+  monitor_id         = local.monitor_id
+  send_activity_logs = true
+}
+`, r.template(data))
+}
+
+func (r ElasticCloudElasticsearchTagRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_elasticsearch_tag_rule" "import" {
+  monitor_id         = azurerm_elastic_cloud_elasticsearch_tag_rule.test.monitor_id
+  send_activity_logs = true
+}
+`, r.basic(data))
+}
+
+func (r ElasticCloudElasticsearchTagRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_cloud_elasticsearch_tag_rule" "test" {
+  monitor_id             = local.monitor_id
+  send_azuread_logs      = true
+  send_activity_logs     = true
+  send_subscription_logs = true
+
+  filtering_tag {
+    name   = "environment"
+    value  = "production"
+    action = "Include"
+  }
+
+  filtering_tag {
+    name   = "team"
+    value  = "sandbox"
+    action = "Exclude"
+  }
+}
+`, r.template(data))
+}
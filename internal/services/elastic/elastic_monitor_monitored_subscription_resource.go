@@ -0,0 +1,303 @@
+package elastic
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2023-06-01/monitoredsubscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Monitor only supports a single list of Monitored Subscriptions, which is always named `default`
+const elasticMonitoredSubscriptionName = "default"
+
+func resourceElasticMonitorMonitoredSubscription() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceElasticMonitorMonitoredSubscriptionCreate,
+		Read:   resourceElasticMonitorMonitoredSubscriptionRead,
+		Update: resourceElasticMonitorMonitoredSubscriptionUpdate,
+		Delete: resourceElasticMonitorMonitoredSubscriptionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := monitoredsubscriptions.ParseMonitoredSubscriptionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"monitor_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorID,
+			},
+
+			"subscription": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subscription_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"send_azuread_logs": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"send_activity_logs": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"send_subscription_logs": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"filtering_tag": filteringTagSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceElasticMonitorMonitoredSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.MonitoredSubscriptionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	monitorId, err := monitoredsubscriptions.ParseMonitorID(d.Get("monitor_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := monitoredsubscriptions.NewMonitoredSubscriptionID(monitorId.SubscriptionId, monitorId.ResourceGroup, monitorId.Name, elasticMonitoredSubscriptionName)
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) && existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.MonitoredSubscriptionList != nil && len(*existing.Model.Properties.MonitoredSubscriptionList) > 0 {
+		return tf.ImportAsExistsError("azurerm_elastic_monitor_monitored_subscription", id.ID())
+	}
+
+	operation := monitoredsubscriptions.OperationAddComplete
+	parameters := monitoredsubscriptions.MonitoredSubscriptionProperties{
+		Properties: &monitoredsubscriptions.SubscriptionList{
+			MonitoredSubscriptionList: expandMonitoredSubscriptions(d.Get("subscription").([]interface{})),
+			Operation:                 &operation,
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceElasticMonitorMonitoredSubscriptionRead(d, meta)
+}
+
+func resourceElasticMonitorMonitoredSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.MonitoredSubscriptionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := monitoredsubscriptions.ParseMonitoredSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("monitor_id", monitoredsubscriptions.NewMonitorID(id.SubscriptionId, id.ResourceGroup, id.MonitorName).ID())
+
+	var subscriptions *[]monitoredsubscriptions.MonitoredSubscription
+	if model := resp.Model; model != nil && model.Properties != nil {
+		subscriptions = model.Properties.MonitoredSubscriptionList
+	}
+	if err := d.Set("subscription", flattenMonitoredSubscriptions(subscriptions)); err != nil {
+		return fmt.Errorf("setting `subscription`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceElasticMonitorMonitoredSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.MonitoredSubscriptionsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := monitoredsubscriptions.ParseMonitoredSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	parameters := *existing.Model
+	if parameters.Properties == nil {
+		parameters.Properties = &monitoredsubscriptions.SubscriptionList{}
+	}
+	if d.HasChange("subscription") {
+		parameters.Properties.MonitoredSubscriptionList = expandMonitoredSubscriptions(d.Get("subscription").([]interface{}))
+	}
+	operation := monitoredsubscriptions.OperationAddComplete
+	parameters.Properties.Operation = &operation
+	parameters.Properties.ProvisioningState = nil
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceElasticMonitorMonitoredSubscriptionRead(d, meta)
+}
+
+func resourceElasticMonitorMonitoredSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Elastic.MonitoredSubscriptionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := monitoredsubscriptions.ParseMonitoredSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMonitoredSubscriptions(input []interface{}) *[]monitoredsubscriptions.MonitoredSubscription {
+	results := make([]monitoredsubscriptions.MonitoredSubscription, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		filteringTags := make([]monitoredsubscriptions.FilteringTag, 0)
+		for _, tag := range v["filtering_tag"].([]interface{}) {
+			t := tag.(map[string]interface{})
+			action := monitoredsubscriptions.TagAction(t["action"].(string))
+			filteringTags = append(filteringTags, monitoredsubscriptions.FilteringTag{
+				Action: &action,
+				Name:   utils.String(t["name"].(string)),
+				Value:  utils.String(t["value"].(string)),
+			})
+		}
+
+		results = append(results, monitoredsubscriptions.MonitoredSubscription{
+			SubscriptionId: utils.String(v["subscription_id"].(string)),
+			TagRules: &monitoredsubscriptions.MonitoringTagRulesProperties{
+				LogRules: &monitoredsubscriptions.LogRules{
+					FilteringTags:        &filteringTags,
+					SendAadLogs:          utils.Bool(v["send_azuread_logs"].(bool)),
+					SendActivityLogs:     utils.Bool(v["send_activity_logs"].(bool)),
+					SendSubscriptionLogs: utils.Bool(v["send_subscription_logs"].(bool)),
+				},
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenMonitoredSubscriptions(input *[]monitoredsubscriptions.MonitoredSubscription) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		subscriptionId := ""
+		if item.SubscriptionId != nil {
+			subscriptionId = *item.SubscriptionId
+		}
+
+		sendAadLogs := false
+		sendActivityLogs := false
+		sendSubscriptionLogs := false
+		filteringTags := make([]interface{}, 0)
+		if item.TagRules != nil && item.TagRules.LogRules != nil {
+			logRules := item.TagRules.LogRules
+			if logRules.SendAadLogs != nil {
+				sendAadLogs = *logRules.SendAadLogs
+			}
+			if logRules.SendActivityLogs != nil {
+				sendActivityLogs = *logRules.SendActivityLogs
+			}
+			if logRules.SendSubscriptionLogs != nil {
+				sendSubscriptionLogs = *logRules.SendSubscriptionLogs
+			}
+			if logRules.FilteringTags != nil {
+				for _, tag := range *logRules.FilteringTags {
+					action := ""
+					if tag.Action != nil {
+						action = string(*tag.Action)
+					}
+					name := ""
+					if tag.Name != nil {
+						name = *tag.Name
+					}
+					value := ""
+					if tag.Value != nil {
+						value = *tag.Value
+					}
+					filteringTags = append(filteringTags, map[string]interface{}{
+						"action": action,
+						"name":   name,
+						"value":  value,
+					})
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"subscription_id":        subscriptionId,
+			"send_azuread_logs":      sendAadLogs,
+			"send_activity_logs":     sendActivityLogs,
+			"send_subscription_logs": sendSubscriptionLogs,
+			"filtering_tag":          filteringTags,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,170 @@
+package elastic_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/sdk/2023-06-01/monitoredsubscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticMonitorMonitoredSubscriptionResource struct{}
+
+func TestAccElasticMonitorMonitoredSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_monitor_monitored_subscription", "test")
+	r := ElasticMonitorMonitoredSubscriptionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticMonitorMonitoredSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_monitor_monitored_subscription", "test")
+	r := ElasticMonitorMonitoredSubscriptionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccElasticMonitorMonitoredSubscription_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_monitor_monitored_subscription", "test")
+	r := ElasticMonitorMonitoredSubscriptionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription.0.filtering_tag.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticMonitorMonitoredSubscription_invalidSubscriptionId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_monitor_monitored_subscription", "test")
+	r := ElasticMonitorMonitoredSubscriptionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidSubscriptionId(data),
+			ExpectError: regexp.MustCompile("expected \"subscription.0.subscription_id\" to be a valid UUID"),
+		},
+	})
+}
+
+func (r ElasticMonitorMonitoredSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := monitoredsubscriptions.ParseMonitoredSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Elastic.MonitoredSubscriptionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ElasticMonitorMonitoredSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_monitor_monitored_subscription" "test" {
+  monitor_id = local.monitor_id
+
+  subscription {
+    subscription_id    = data.azurerm_client_config.current.subscription_id
+    send_activity_logs = true
+  }
+}
+`, ElasticCloudElasticsearchTagRuleResource{}.template(data))
+}
+
+func (r ElasticMonitorMonitoredSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_monitor_monitored_subscription" "import" {
+  monitor_id = azurerm_elastic_monitor_monitored_subscription.test.monitor_id
+
+  subscription {
+    subscription_id    = data.azurerm_client_config.current.subscription_id
+    send_activity_logs = true
+  }
+}
+`, r.basic(data))
+}
+
+func (r ElasticMonitorMonitoredSubscriptionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_monitor_monitored_subscription" "test" {
+  monitor_id = local.monitor_id
+
+  subscription {
+    subscription_id        = data.azurerm_client_config.current.subscription_id
+    send_azuread_logs      = true
+    send_activity_logs     = true
+    send_subscription_logs = true
+
+    filtering_tag {
+      name   = "environment"
+      value  = "production"
+      action = "Include"
+    }
+  }
+}
+`, ElasticCloudElasticsearchTagRuleResource{}.template(data))
+}
+
+func (r ElasticMonitorMonitoredSubscriptionResource) invalidSubscriptionId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_monitor_monitored_subscription" "test" {
+  monitor_id = local.monitor_id
+
+  subscription {
+    subscription_id = "not-a-subscription-id"
+  }
+}
+`, ElasticCloudElasticsearchTagRuleResource{}.template(data))
+}
@@ -0,0 +1,15 @@
+package monitoredsubscriptions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MonitoredSubscriptionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMonitoredSubscriptionsClientWithBaseURI(endpoint string) MonitoredSubscriptionsClient {
+	return MonitoredSubscriptionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
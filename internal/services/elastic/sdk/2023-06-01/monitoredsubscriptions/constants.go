@@ -0,0 +1,41 @@
+package monitoredsubscriptions
+
+type Operation string
+
+const (
+	OperationActive         Operation = "Active"
+	OperationAddBegin       Operation = "AddBegin"
+	OperationAddComplete    Operation = "AddComplete"
+	OperationDeleteBegin    Operation = "DeleteBegin"
+	OperationDeleteComplete Operation = "DeleteComplete"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted     ProvisioningState = "Accepted"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateCreating     ProvisioningState = "Creating"
+	ProvisioningStateDeleted      ProvisioningState = "Deleted"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateNotSpecified ProvisioningState = "NotSpecified"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+type Status string
+
+const (
+	StatusActive     Status = "Active"
+	StatusDeleting   Status = "Deleting"
+	StatusFailed     Status = "Failed"
+	StatusInProgress Status = "InProgress"
+)
+
+type TagAction string
+
+const (
+	TagActionExclude TagAction = "Exclude"
+	TagActionInclude TagAction = "Include"
+)
@@ -0,0 +1,126 @@
+package monitoredsubscriptions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MonitoredSubscriptionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	MonitorName    string
+	Name           string
+}
+
+func NewMonitoredSubscriptionID(subscriptionId, resourceGroup, monitorName, name string) MonitoredSubscriptionId {
+	return MonitoredSubscriptionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		MonitorName:    monitorName,
+		Name:           name,
+	}
+}
+
+func (id MonitoredSubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Monitor Name %q", id.MonitorName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Monitored Subscription", segmentsStr)
+}
+
+func (id MonitoredSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Elastic/monitors/%s/monitoredSubscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MonitorName, id.Name)
+}
+
+// ParseMonitoredSubscriptionID parses a MonitoredSubscription ID into an MonitoredSubscriptionId struct
+func ParseMonitoredSubscriptionID(input string) (*MonitoredSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MonitoredSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MonitorName, err = id.PopSegment("monitors"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("monitoredSubscriptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseMonitoredSubscriptionIDInsensitively parses an MonitoredSubscription ID into an MonitoredSubscriptionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseMonitoredSubscriptionID method should be used instead for validation etc.
+func ParseMonitoredSubscriptionIDInsensitively(input string) (*MonitoredSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MonitoredSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'monitors' segment
+	monitorsKey := "monitors"
+	for key := range id.Path {
+		if strings.EqualFold(key, monitorsKey) {
+			monitorsKey = key
+			break
+		}
+	}
+	if resourceId.MonitorName, err = id.PopSegment(monitorsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'monitoredSubscriptions' segment
+	monitoredSubscriptionsKey := "monitoredSubscriptions"
+	for key := range id.Path {
+		if strings.EqualFold(key, monitoredSubscriptionsKey) {
+			monitoredSubscriptionsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(monitoredSubscriptionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
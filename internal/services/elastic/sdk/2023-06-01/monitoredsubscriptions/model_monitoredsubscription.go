@@ -0,0 +1,8 @@
+package monitoredsubscriptions
+
+type MonitoredSubscription struct {
+	Error          *string                       `json:"error,omitempty"`
+	Status         *Status                       `json:"status,omitempty"`
+	SubscriptionId *string                       `json:"subscriptionId,omitempty"`
+	TagRules       *MonitoringTagRulesProperties `json:"tagRules,omitempty"`
+}
@@ -0,0 +1,8 @@
+package monitoredsubscriptions
+
+type MonitoredSubscriptionProperties struct {
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *SubscriptionList `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
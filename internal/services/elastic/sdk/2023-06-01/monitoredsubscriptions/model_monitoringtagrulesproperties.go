@@ -0,0 +1,6 @@
+package monitoredsubscriptions
+
+type MonitoringTagRulesProperties struct {
+	LogRules          *LogRules          `json:"logRules,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,7 @@
+package monitoredsubscriptions
+
+type SubscriptionList struct {
+	MonitoredSubscriptionList *[]MonitoredSubscription `json:"monitoredSubscriptionList,omitempty"`
+	Operation                 *Operation               `json:"operation,omitempty"`
+	ProvisioningState         *ProvisioningState       `json:"provisioningState,omitempty"`
+}
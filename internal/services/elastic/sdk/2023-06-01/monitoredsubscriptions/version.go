@@ -0,0 +1,9 @@
+package monitoredsubscriptions
+
+import "fmt"
+
+const defaultApiVersion = "2023-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/monitoredsubscriptions/%s", defaultApiVersion)
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type TagRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	MonitorName    string
+	Name           string
+}
+
+func NewTagRuleID(subscriptionId, resourceGroup, monitorName, name string) TagRuleId {
+	return TagRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		MonitorName:    monitorName,
+		Name:           name,
+	}
+}
+
+func (id TagRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Monitor Name %q", id.MonitorName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Tag Rule", segmentsStr)
+}
+
+func (id TagRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Elastic/monitors/%s/tagRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MonitorName, id.Name)
+}
+
+// ParseTagRuleID parses a TagRule ID into an TagRuleId struct
+func ParseTagRuleID(input string) (*TagRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TagRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MonitorName, err = id.PopSegment("monitors"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("tagRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseTagRuleIDInsensitively parses an TagRule ID into an TagRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseTagRuleID method should be used instead for validation etc.
+func ParseTagRuleIDInsensitively(input string) (*TagRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TagRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'monitors' segment
+	monitorsKey := "monitors"
+	for key := range id.Path {
+		if strings.EqualFold(key, monitorsKey) {
+			monitorsKey = key
+			break
+		}
+	}
+	if resourceId.MonitorName, err = id.PopSegment(monitorsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'tagRules' segment
+	tagRulesKey := "tagRules"
+	for key := range id.Path {
+		if strings.EqualFold(key, tagRulesKey) {
+			tagRulesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(tagRulesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,8 @@
+package rules
+
+type MonitoringTagRules struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *MonitoringTagRulesProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package rules
+
+import "fmt"
+
+const defaultApiVersion = "2023-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/rules/%s", defaultApiVersion)
+}
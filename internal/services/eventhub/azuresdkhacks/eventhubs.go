@@ -0,0 +1,175 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
+)
+
+// the `identity` field of the Capture Destination is only available from API Version `2024-01-01` - as such the
+// workaround client is only used to create/update when this is configured (or removed), and the Read tolerates this
+// API Version being unavailable
+const eventHubCaptureIdentityApiVersion = "2024-01-01"
+
+type CaptureIdentityType string
+
+const (
+	CaptureIdentityTypeSystemAssigned CaptureIdentityType = "SystemAssigned"
+	CaptureIdentityTypeUserAssigned   CaptureIdentityType = "UserAssigned"
+)
+
+type CaptureIdentity struct {
+	Type                 *CaptureIdentityType `json:"type,omitempty"`
+	UserAssignedIdentity *string              `json:"userAssignedIdentity,omitempty"`
+}
+
+type EventHubsWorkaroundClient struct {
+	sdkClient *eventhubs.EventHubsClient
+	baseUri   string
+}
+
+// NewEventHubsWorkaroundClient returns a workaround client which sends requests using the same (autorest) Client as
+// the specified SDK client - since the SDK client doesn't expose its Base URI this has to be specified too.
+func NewEventHubsWorkaroundClient(client *eventhubs.EventHubsClient, endpoint string) EventHubsWorkaroundClient {
+	return EventHubsWorkaroundClient{
+		sdkClient: client,
+		baseUri:   endpoint,
+	}
+}
+
+// CreateOrUpdate creates or updates the specified EventHub, including the `identity` of the Capture Destination.
+// Parameters:
+// id - the ID of the EventHub.
+// input - parameters supplied to create or update an EventHub.
+// captureIdentity - the Managed Identity used to access the Capture Destination.
+func (c EventHubsWorkaroundClient) CreateOrUpdate(ctx context.Context, id eventhubs.EventhubId, input eventhubs.Eventhub, captureIdentity *CaptureIdentity) (result eventhubs.CreateOrUpdateResponse, err error) {
+	body, err := withCaptureIdentity(input, captureIdentity)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": eventHubCaptureIdentityApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+	}
+
+	return
+}
+
+// GetCaptureIdentity returns the `identity` of the Capture Destination of the specified EventHub.
+// Parameters:
+// id - the ID of the EventHub.
+func (c EventHubsWorkaroundClient) GetCaptureIdentity(ctx context.Context, id eventhubs.EventhubId) (result CaptureIdentityGetResult, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": eventHubCaptureIdentityApiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "GetCaptureIdentity", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.Client.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "GetCaptureIdentity", resp, "Failure sending request")
+		return
+	}
+
+	var model eventHubCaptureIdentityModel
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&model),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "eventhubs.EventHubsClient", "GetCaptureIdentity", resp, "Failure responding to request")
+		return
+	}
+
+	if props := model.Properties; props != nil && props.CaptureDescription != nil && props.CaptureDescription.Destination != nil {
+		result.CaptureIdentity = props.CaptureDescription.Destination.Identity
+	}
+
+	return
+}
+
+type CaptureIdentityGetResult struct {
+	autorest.Response `json:"-"`
+	CaptureIdentity   *CaptureIdentity
+}
+
+type eventHubCaptureIdentityModel struct {
+	Properties *struct {
+		CaptureDescription *struct {
+			Destination *struct {
+				Identity *CaptureIdentity `json:"identity,omitempty"`
+			} `json:"destination,omitempty"`
+		} `json:"captureDescription,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// withCaptureIdentity returns the request body for the specified EventHub with the `identity` set within the Capture
+// Destination, which isn't available in the SDK.
+func withCaptureIdentity(input eventhubs.Eventhub, captureIdentity *CaptureIdentity) (map[string]interface{}, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("encoding the request body: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("decoding the request body: %+v", err)
+	}
+
+	if captureIdentity == nil {
+		return body, nil
+	}
+
+	properties, _ := body["properties"].(map[string]interface{})
+	captureDescription, _ := properties["captureDescription"].(map[string]interface{})
+	destination, ok := captureDescription["destination"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("a Capture Destination must be specified to set the Capture Identity")
+	}
+	destination["identity"] = captureIdentity
+
+	return body, nil
+}
@@ -3,6 +3,7 @@ package eventhub
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -25,6 +26,11 @@ type ConsumerGroupObject struct {
 
 var _ sdk.Resource = ConsumerGroupResource{}
 var _ sdk.ResourceWithUpdate = ConsumerGroupResource{}
+var _ sdk.ResourceWithCustomImporter = ConsumerGroupResource{}
+
+// defaultConsumerGroupName is the Consumer Group which is created (and deleted) alongside the EventHub
+// and as such can't be managed as a separate resource
+const defaultConsumerGroupName = "$Default"
 
 type ConsumerGroupResource struct {
 }
@@ -93,10 +99,11 @@ func (r ConsumerGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			parameters := consumergroups.ConsumerGroup{
-				Name: utils.String(state.Name),
-				Properties: &consumergroups.ConsumerGroupProperties{
-					UserMetadata: utils.String(state.UserMetadata),
-				},
+				Name:       utils.String(state.Name),
+				Properties: &consumergroups.ConsumerGroupProperties{},
+			}
+			if state.UserMetadata != "" {
+				parameters.Properties.UserMetadata = utils.String(state.UserMetadata)
 			}
 
 			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
@@ -188,6 +195,11 @@ func (r ConsumerGroupResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
+			if strings.EqualFold(id.Name, defaultConsumerGroupName) {
+				metadata.Logger.Infof("skipping deletion of the default Consumer Group %q since it's managed by the EventHub", id.Name)
+				return nil
+			}
+
 			metadata.Logger.Infof("deleting Consumer Group %q..", id.Name)
 			if resp, err := client.Delete(ctx, *id); err != nil {
 				if !response.WasNotFound(resp.HttpResponse) {
@@ -201,6 +213,21 @@ func (r ConsumerGroupResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r ConsumerGroupResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, err := consumergroups.ParseConsumergroupID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		if strings.EqualFold(id.Name, defaultConsumerGroupName) {
+			return fmt.Errorf("the %q Consumer Group is created automatically with the EventHub and can't be imported", defaultConsumerGroupName)
+		}
+
+		return nil
+	}
+}
+
 func (r ConsumerGroupResource) ModelObject() interface{} {
 	return &ConsumerGroupObject{}
 }
@@ -84,6 +84,14 @@ func TestAccEventHubConsumerGroup_userMetadataUpdate(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_metadata").HasValue(""),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -1,6 +1,7 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,9 +10,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -136,6 +140,28 @@ func resourceEventHub() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: azure.ValidateResourceID,
 									},
+									"identity": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"type": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(azuresdkhacks.CaptureIdentityTypeSystemAssigned),
+														string(azuresdkhacks.CaptureIdentityTypeUserAssigned),
+													}, false),
+												},
+												"user_assigned_identity_id": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: msivalidate.UserAssignedIdentityID,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -161,7 +187,33 @@ func resourceEventHub() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceEventHubCaptureIdentityCustomizeDiff),
+	}
+}
+
+func resourceEventHubCaptureIdentityCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	identities := d.Get("capture_description.0.destination.0.identity").([]interface{})
+	if len(identities) == 0 || identities[0] == nil {
+		return nil
+	}
+
+	identity := identities[0].(map[string]interface{})
+	userAssignedIdentityId := identity["user_assigned_identity_id"].(string)
+	switch azuresdkhacks.CaptureIdentityType(identity["type"].(string)) {
+	case azuresdkhacks.CaptureIdentityTypeUserAssigned:
+		// the ID of the User Assigned Identity may not be known until apply
+		if userAssignedIdentityId == "" && d.NewValueKnown("capture_description.0.destination.0.identity.0.user_assigned_identity_id") {
+			return fmt.Errorf("`user_assigned_identity_id` must be specified when the capture `identity` `type` is `UserAssigned`")
+		}
+
+	case azuresdkhacks.CaptureIdentityTypeSystemAssigned:
+		if userAssignedIdentityId != "" {
+			return fmt.Errorf("`user_assigned_identity_id` cannot be specified when the capture `identity` `type` is `SystemAssigned`")
+		}
 	}
+
+	return nil
 }
 
 func resourceEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -203,8 +255,18 @@ func resourceEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.Properties.CaptureDescription = expandEventHubCaptureDescription(d)
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
-		return err
+	// the Capture Identity is only available in a newer API Version, so the workaround client is only used when this is
+	// configured (or is being removed)
+	captureIdentity := expandEventHubCaptureIdentity(d.Get("capture_description.0.destination.0.identity").([]interface{}))
+	if captureIdentity != nil || d.HasChange("capture_description.0.destination.0.identity") {
+		endpoint := meta.(*clients.Client).Account.Environment.ResourceManagerEndpoint
+		if _, err := azuresdkhacks.NewEventHubsWorkaroundClient(client, endpoint).CreateOrUpdate(ctx, id, parameters, captureIdentity); err != nil {
+			return err
+		}
+	} else {
+		if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+			return err
+		}
 	}
 
 	d.SetId(id.ID())
@@ -242,7 +304,21 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("partition_ids", props.PartitionIds)
 			d.Set("status", string(*props.Status))
 
-			captureDescription := flattenEventHubCaptureDescription(props.CaptureDescription)
+			var captureIdentity *azuresdkhacks.CaptureIdentity
+			endpoint := meta.(*clients.Client).Account.Environment.ResourceManagerEndpoint
+			captureIdentityResp, err := azuresdkhacks.NewEventHubsWorkaroundClient(client, endpoint).GetCaptureIdentity(ctx, *id)
+			if err != nil {
+				if !utils.ResponseWasBadRequest(captureIdentityResp.Response) && !utils.ResponseWasNotFound(captureIdentityResp.Response) {
+					return fmt.Errorf("retrieving the capture identity for %s: %+v", id, err)
+				}
+
+				log.Printf("[DEBUG] unable to retrieve the capture identity for %s - retaining the existing value(s): %+v", id, err)
+				captureIdentity = expandEventHubCaptureIdentity(d.Get("capture_description.0.destination.0.identity").([]interface{}))
+			} else {
+				captureIdentity = captureIdentityResp.CaptureIdentity
+			}
+
+			captureDescription := flattenEventHubCaptureDescription(props.CaptureDescription, captureIdentity)
 			if err := d.Set("capture_description", captureDescription); err != nil {
 				return err
 			}
@@ -319,7 +395,26 @@ func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.Capt
 	return &captureDescription
 }
 
-func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription) []interface{} {
+func expandEventHubCaptureIdentity(input []interface{}) *azuresdkhacks.CaptureIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identityType := azuresdkhacks.CaptureIdentityType(raw["type"].(string))
+
+	identity := azuresdkhacks.CaptureIdentity{
+		Type: &identityType,
+	}
+
+	if userAssignedIdentityId := raw["user_assigned_identity_id"].(string); userAssignedIdentityId != "" {
+		identity.UserAssignedIdentity = utils.String(userAssignedIdentityId)
+	}
+
+	return &identity
+}
+
+func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription, captureIdentity *azuresdkhacks.CaptureIdentity) []interface{} {
 	results := make([]interface{}, 0)
 
 	if description != nil {
@@ -366,6 +461,8 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 				}
 			}
 
+			destinationOutput["identity"] = flattenEventHubCaptureIdentity(captureIdentity)
+
 			output["destination"] = []interface{}{destinationOutput}
 		}
 
@@ -374,3 +471,24 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 
 	return results
 }
+
+func flattenEventHubCaptureIdentity(input *azuresdkhacks.CaptureIdentity) []interface{} {
+	if input == nil || input.Type == nil {
+		return []interface{}{}
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentity != nil {
+		userAssignedIdentityId = *input.UserAssignedIdentity
+		if id, err := msiparse.UserAssignedIdentityIDInsensitively(userAssignedIdentityId); err == nil {
+			userAssignedIdentityId = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      string(*input.Type),
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
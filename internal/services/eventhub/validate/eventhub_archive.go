@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -26,5 +27,23 @@ func ValidateEventHubArchiveNameFormat(v interface{}, k string) (warnings []stri
 		}
 	}
 
+	// any other placeholders aren't substituted by the service, so these are most likely a typo
+	for _, placeholder := range regexp.MustCompile(`{[^{}]*}`).FindAllString(value, -1) {
+		supported := false
+		for _, component := range requiredComponents {
+			if placeholder == component {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			errors = append(errors, fmt.Errorf("%s contains the unsupported placeholder %q - supported placeholders are %s", k, placeholder, strings.Join(requiredComponents, ", ")))
+		}
+	}
+
+	if strings.Count(value, "{") != strings.Count(value, "}") {
+		errors = append(errors, fmt.Errorf("%s contains an unterminated placeholder", k))
+	}
+
 	return warnings, errors
 }
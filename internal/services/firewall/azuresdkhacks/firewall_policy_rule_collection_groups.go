@@ -0,0 +1,203 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `httpHeadersToInsert` field of an Application Rule is only available from API Version `2022-07-01`
+// - as such the workaround client is only used to create/update when HTTP Headers are configured (or removed), and the
+// Read tolerates this API Version being unavailable
+const firewallPolicyRuleCollectionGroupsWorkaroundApiVersion = "2022-07-01"
+
+type FirewallPolicyRuleCollectionGroupsWorkaroundClient struct {
+	sdkClient *network.FirewallPolicyRuleCollectionGroupsClient
+}
+
+func NewFirewallPolicyRuleCollectionGroupsWorkaroundClient(client *network.FirewallPolicyRuleCollectionGroupsClient) FirewallPolicyRuleCollectionGroupsWorkaroundClient {
+	return FirewallPolicyRuleCollectionGroupsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// ApplicationRuleHttpHeaders contains the HTTP Headers to insert for each Application Rule, keyed by the name of the
+// Rule Collection and then the name of the Rule (which are unique within a Rule Collection Group/Rule Collection).
+type ApplicationRuleHttpHeaders map[string]map[string][]FirewallPolicyHttpHeaderToInsert
+
+type FirewallPolicyHttpHeaderToInsert struct {
+	HeaderName  *string `json:"headerName,omitempty"`
+	HeaderValue *string `json:"headerValue,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the specified FirewallPolicyRuleCollectionGroup, including the HTTP Headers to
+// insert for each Application Rule which aren't available in the SDK.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// firewallPolicyName - the name of the Firewall Policy.
+// ruleCollectionGroupName - the name of the FirewallPolicyRuleCollectionGroup.
+// parameters - parameters supplied to the create or update FirewallPolicyRuleCollectionGroup operation.
+// httpHeaders - the HTTP Headers to insert for each Application Rule.
+func (client FirewallPolicyRuleCollectionGroupsWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, firewallPolicyName string, ruleCollectionGroupName string, parameters network.FirewallPolicyRuleCollectionGroup, httpHeaders ApplicationRuleHttpHeaders) (result network.FirewallPolicyRuleCollectionGroupsCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, firewallPolicyName, ruleCollectionGroupName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.FirewallPolicyRuleCollectionGroupsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withApplicationRuleHttpHeaders(httpHeaders))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.FirewallPolicyRuleCollectionGroupsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.FirewallPolicyRuleCollectionGroupsClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetApplicationRuleHttpHeaders returns the HTTP Headers to insert for each Application Rule within the specified
+// FirewallPolicyRuleCollectionGroup, which aren't available in the SDK.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// firewallPolicyName - the name of the Firewall Policy.
+// ruleCollectionGroupName - the name of the FirewallPolicyRuleCollectionGroup.
+func (client FirewallPolicyRuleCollectionGroupsWorkaroundClient) GetApplicationRuleHttpHeaders(ctx context.Context, resourceGroupName string, firewallPolicyName string, ruleCollectionGroupName string) (result ApplicationRuleHttpHeadersGetResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, firewallPolicyName, ruleCollectionGroupName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.FirewallPolicyRuleCollectionGroupsClient", "GetApplicationRuleHttpHeaders", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", firewallPolicyRuleCollectionGroupsWorkaroundApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.FirewallPolicyRuleCollectionGroupsClient", "GetApplicationRuleHttpHeaders", resp, "Failure sending request")
+		return
+	}
+
+	var group firewallPolicyRuleCollectionGroupWorkaround
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&group),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.FirewallPolicyRuleCollectionGroupsClient", "GetApplicationRuleHttpHeaders", resp, "Failure responding to request")
+		return
+	}
+
+	result.HttpHeaders = make(ApplicationRuleHttpHeaders)
+	if group.Properties == nil {
+		return
+	}
+	for _, collection := range group.Properties.RuleCollections {
+		if collection.Name == nil {
+			continue
+		}
+		for _, rule := range collection.Rules {
+			if rule.Name == nil || rule.RuleType == nil || *rule.RuleType != string(network.RuleTypeApplicationRule) || len(rule.HttpHeadersToInsert) == 0 {
+				continue
+			}
+			if _, ok := result.HttpHeaders[*collection.Name]; !ok {
+				result.HttpHeaders[*collection.Name] = make(map[string][]FirewallPolicyHttpHeaderToInsert)
+			}
+			result.HttpHeaders[*collection.Name][*rule.Name] = rule.HttpHeadersToInsert
+		}
+	}
+
+	return
+}
+
+type ApplicationRuleHttpHeadersGetResult struct {
+	autorest.Response `json:"-"`
+	HttpHeaders       ApplicationRuleHttpHeaders
+}
+
+type firewallPolicyRuleCollectionGroupWorkaround struct {
+	Properties *struct {
+		RuleCollections []struct {
+			Name  *string `json:"name,omitempty"`
+			Rules []struct {
+				Name                *string                            `json:"name,omitempty"`
+				RuleType            *string                            `json:"ruleType,omitempty"`
+				HttpHeadersToInsert []FirewallPolicyHttpHeaderToInsert `json:"httpHeadersToInsert,omitempty"`
+			} `json:"rules,omitempty"`
+		} `json:"ruleCollections,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// withApplicationRuleHttpHeaders sets the HTTP Headers to insert on each of the Application Rules within the request
+// body and updates the API Version used for the request to one which supports this field.
+func withApplicationRuleHttpHeaders(input ApplicationRuleHttpHeaders) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			if properties, ok := body["properties"].(map[string]interface{}); ok {
+				collections, _ := properties["ruleCollections"].([]interface{})
+				for _, rawCollection := range collections {
+					collection, ok := rawCollection.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					collectionName, _ := collection["name"].(string)
+					headersForCollection, ok := input[collectionName]
+					if !ok {
+						continue
+					}
+
+					rules, _ := collection["rules"].([]interface{})
+					for _, rawRule := range rules {
+						rule, ok := rawRule.(map[string]interface{})
+						if !ok || rule["ruleType"] != string(network.RuleTypeApplicationRule) {
+							continue
+						}
+						ruleName, _ := rule["name"].(string)
+						if headers, ok := headersForCollection[ruleName]; ok && len(headers) > 0 {
+							rule["httpHeadersToInsert"] = headers
+						}
+					}
+				}
+			}
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", firewallPolicyRuleCollectionGroupsWorkaroundApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -186,7 +189,25 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 										Optional: true,
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
+											ValidateFunc: validate.FirewallPolicyWebCategoryName(),
+										},
+									},
+									"http_headers": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"name": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringMatch(regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$"), "The name of the HTTP Header must be a valid HTTP Header name."),
+												},
+												"value": {
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
 										},
 									},
 								},
@@ -444,13 +465,24 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
+	applicationRuleCollections := d.Get("application_rule_collection").(*pluginsdk.Set).List()
+	if err := validateFirewallPolicyRuleCollectionApplication(applicationRuleCollections); err != nil {
+		return err
+	}
+
+	if firewallPolicyRuleCollectionApplicationTerminatesTLS(applicationRuleCollections) {
+		if err := checkFirewallPolicySupportsTLSInspection(ctx, meta.(*clients.Client).Firewall.FirewallPolicyClient, *policyId); err != nil {
+			return err
+		}
+	}
+
 	param := network.FirewallPolicyRuleCollectionGroup{
 		FirewallPolicyRuleCollectionGroupProperties: &network.FirewallPolicyRuleCollectionGroupProperties{
 			Priority: utils.Int32(int32(d.Get("priority").(int))),
 		},
 	}
 	var rulesCollections []network.BasicFirewallPolicyRuleCollection
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
+	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(applicationRuleCollections)...)
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
 
 	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())
@@ -461,7 +493,17 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 
 	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
 
-	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
+	var future network.FirewallPolicyRuleCollectionGroupsCreateOrUpdateFuture
+	httpHeaders := expandFirewallPolicyRuleCollectionApplicationHttpHeaders(applicationRuleCollections)
+	oldApplicationRuleCollections, _ := d.GetChange("application_rule_collection")
+	existingHttpHeaders := expandFirewallPolicyRuleCollectionApplicationHttpHeaders(oldApplicationRuleCollections.(*pluginsdk.Set).List())
+	// the HTTP Headers are only available in a newer API Version, so the workaround client is only used when these are
+	// configured (or are being removed)
+	if len(httpHeaders) > 0 || len(existingHttpHeaders) > 0 {
+		future, err = azuresdkhacks.NewFirewallPolicyRuleCollectionGroupsWorkaroundClient(client).CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param, httpHeaders)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
+	}
 	if err != nil {
 		return fmt.Errorf("creating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 	}
@@ -511,7 +553,20 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 	d.Set("priority", resp.Priority)
 	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(subscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
 
-	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections)
+	var httpHeaders azuresdkhacks.ApplicationRuleHttpHeaders
+	httpHeadersResp, err := azuresdkhacks.NewFirewallPolicyRuleCollectionGroupsWorkaroundClient(client).GetApplicationRuleHttpHeaders(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if !utils.ResponseWasBadRequest(httpHeadersResp.Response) && !utils.ResponseWasNotFound(httpHeadersResp.Response) {
+			return fmt.Errorf("retrieving HTTP Headers for Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+		}
+
+		log.Printf("[DEBUG] unable to retrieve HTTP Headers for Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) - retaining the existing value(s): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+		httpHeaders = expandFirewallPolicyRuleCollectionApplicationHttpHeaders(d.Get("application_rule_collection").(*pluginsdk.Set).List())
+	} else {
+		httpHeaders = httpHeadersResp.HttpHeaders
+	}
+
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections, httpHeaders)
 	if err != nil {
 		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 	}
@@ -696,7 +751,7 @@ func expandFirewallPolicyRuleNat(input []interface{}) (*[]network.BasicFirewallP
 	return &result, nil
 }
 
-func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRuleCollection) ([]interface{}, []interface{}, []interface{}, error) {
+func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRuleCollection, httpHeaders azuresdkhacks.ApplicationRuleHttpHeaders) ([]interface{}, []interface{}, []interface{}, error) {
 	var (
 		applicationRuleCollection = []interface{}{}
 		networkRuleCollection     = []interface{}{}
@@ -738,7 +793,7 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 			// Determine the rule type based on the first rule's type
 			switch (*rule.Rules)[0].(type) {
 			case network.ApplicationRule:
-				appRules, err := flattenFirewallPolicyRuleApplication(rule.Rules)
+				appRules, err := flattenFirewallPolicyRuleApplication(rule.Rules, httpHeaders[name])
 				if err != nil {
 					return nil, nil, nil, err
 				}
@@ -793,7 +848,7 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 	return applicationRuleCollection, networkRuleCollection, natRuleCollection, nil
 }
 
-func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRule) ([]interface{}, error) {
+func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRule, httpHeaders map[string][]azuresdkhacks.FirewallPolicyHttpHeaderToInsert) ([]interface{}, error) {
 	if input == nil {
 		return []interface{}{}, nil
 	}
@@ -845,6 +900,7 @@ func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRu
 			"destination_fqdn_tags": utils.FlattenStringSlice(rule.FqdnTags),
 			"terminate_tls":         terminate_tls,
 			"web_categories":        utils.FlattenStringSlice(rule.WebCategories),
+			"http_headers":          flattenFirewallPolicyRuleApplicationHttpHeaders(httpHeaders[name]),
 		})
 	}
 
@@ -948,3 +1004,129 @@ func flattenFirewallPolicyRuleNat(input *[]network.BasicFirewallPolicyRule) ([]i
 	}
 	return output, nil
 }
+
+// validateFirewallPolicyRuleCollectionApplication validates the combinations of destinations and TLS settings for
+// each Application Rule, since these are otherwise only rejected by the API once the Rule Collection Group is updated
+func validateFirewallPolicyRuleCollectionApplication(input []interface{}) error {
+	for _, e := range input {
+		collection := e.(map[string]interface{})
+		collectionName := collection["name"].(string)
+
+		for _, r := range collection["rule"].(*pluginsdk.Set).List() {
+			rule := r.(map[string]interface{})
+			ruleName := rule["name"].(string)
+
+			terminateTLS := rule["terminate_tls"].(bool)
+			hasFqdns := rule["destination_fqdns"].(*pluginsdk.Set).Len() > 0
+			hasUrls := rule["destination_urls"].(*pluginsdk.Set).Len() > 0
+			hasFqdnTags := rule["destination_fqdn_tags"].(*pluginsdk.Set).Len() > 0
+			hasWebCategories := rule["web_categories"].(*pluginsdk.Set).Len() > 0
+
+			if hasUrls && !terminateTLS {
+				return fmt.Errorf("`terminate_tls` must be enabled when `destination_urls` are specified for the rule %q within the application rule collection %q", ruleName, collectionName)
+			}
+			if hasFqdns && hasUrls {
+				return fmt.Errorf("`destination_fqdns` and `destination_urls` cannot be specified together for the rule %q within the application rule collection %q", ruleName, collectionName)
+			}
+			if hasFqdnTags && (hasFqdns || hasUrls || hasWebCategories) {
+				return fmt.Errorf("`destination_fqdn_tags` cannot be specified together with `destination_fqdns`, `destination_urls` or `web_categories` for the rule %q within the application rule collection %q", ruleName, collectionName)
+			}
+
+			if len(rule["http_headers"].([]interface{})) > 0 && !terminateTLS {
+				for _, p := range rule["protocols"].(*pluginsdk.Set).List() {
+					protocol := p.(map[string]interface{})
+					if protocol["type"].(string) == string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS) {
+						return fmt.Errorf("`terminate_tls` must be enabled to insert `http_headers` into `Https` requests for the rule %q within the application rule collection %q", ruleName, collectionName)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func firewallPolicyRuleCollectionApplicationTerminatesTLS(input []interface{}) bool {
+	for _, e := range input {
+		collection := e.(map[string]interface{})
+		for _, r := range collection["rule"].(*pluginsdk.Set).List() {
+			rule := r.(map[string]interface{})
+			if rule["terminate_tls"].(bool) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// checkFirewallPolicySupportsTLSInspection checks that TLS Inspection can be used with the Firewall Policy, which
+// requires that the Firewall Policy uses the Premium SKU and has an intermediate CA certificate configured
+func checkFirewallPolicySupportsTLSInspection(ctx context.Context, client *network.FirewallPoliciesClient, policyId parse.FirewallPolicyId) error {
+	policy, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", policyId.Name, policyId.ResourceGroup, err)
+	}
+
+	props := policy.FirewallPolicyPropertiesFormat
+	if props == nil || props.Sku == nil || props.Sku.Tier != network.FirewallPolicySkuTierPremium {
+		return fmt.Errorf("`terminate_tls` can only be enabled when the Firewall Policy %q (Resource Group %q) uses the `Premium` SKU", policyId.Name, policyId.ResourceGroup)
+	}
+	if props.TransportSecurity == nil || props.TransportSecurity.CertificateAuthority == nil {
+		return fmt.Errorf("`terminate_tls` can only be enabled when a `tls_certificate` is configured for the Firewall Policy %q (Resource Group %q)", policyId.Name, policyId.ResourceGroup)
+	}
+
+	return nil
+}
+
+func expandFirewallPolicyRuleCollectionApplicationHttpHeaders(input []interface{}) azuresdkhacks.ApplicationRuleHttpHeaders {
+	result := make(azuresdkhacks.ApplicationRuleHttpHeaders)
+	for _, e := range input {
+		collection := e.(map[string]interface{})
+		collectionName := collection["name"].(string)
+
+		for _, r := range collection["rule"].(*pluginsdk.Set).List() {
+			rule := r.(map[string]interface{})
+			headers := make([]azuresdkhacks.FirewallPolicyHttpHeaderToInsert, 0)
+			for _, h := range rule["http_headers"].([]interface{}) {
+				header := h.(map[string]interface{})
+				headers = append(headers, azuresdkhacks.FirewallPolicyHttpHeaderToInsert{
+					HeaderName:  utils.String(header["name"].(string)),
+					HeaderValue: utils.String(header["value"].(string)),
+				})
+			}
+			if len(headers) == 0 {
+				continue
+			}
+
+			if _, ok := result[collectionName]; !ok {
+				result[collectionName] = make(map[string][]azuresdkhacks.FirewallPolicyHttpHeaderToInsert)
+			}
+			result[collectionName][rule["name"].(string)] = headers
+		}
+	}
+
+	return result
+}
+
+func flattenFirewallPolicyRuleApplicationHttpHeaders(input []azuresdkhacks.FirewallPolicyHttpHeaderToInsert) []interface{} {
+	output := make([]interface{}, 0)
+	for _, header := range input {
+		var name string
+		if header.HeaderName != nil {
+			name = *header.HeaderName
+		}
+
+		var value string
+		if header.HeaderValue != nil {
+			value = *header.HeaderValue
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	return output
+}
@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -134,6 +135,52 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_applicationRuleHttpHeaders(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.applicationRuleHttpHeaders(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completePremium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroup_applicationRuleInvalidDestinations(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.applicationRuleInvalidDestinations(data),
+			ExpectError: regexp.MustCompile("`destination_fqdn_tags` cannot be specified together with `destination_fqdns`"),
+		},
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroup_terminateTlsWithoutCertificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.terminateTlsWithoutCertificate(data),
+			ExpectError: regexp.MustCompile("`terminate_tls` can only be enabled when a `tls_certificate` is configured"),
+		},
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	var id, err = parse.FirewallPolicyRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -442,37 +489,9 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
-func (FirewallPolicyRuleCollectionGroupResource) completePremium(data acceptance.TestData) string {
+func (r FirewallPolicyRuleCollectionGroupResource) completePremium(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-provider "azurerm" {
-  features {}
-}
-resource "azurerm_resource_group" "test" {
-  name     = "acctestRG-fwpolicy-RCG-%[1]d"
-  location = "%[2]s"
-}
-resource "azurerm_firewall_policy" "test" {
-  name                = "acctest-fwpolicy-RCG-%[1]d"
-  resource_group_name = azurerm_resource_group.test.name
-  location            = azurerm_resource_group.test.location
-  sku                 = "Premium"
-  dns {
-    network_rule_fqdn_enabled = false
-    proxy_enabled             = true
-  }
-}
-resource "azurerm_ip_group" "test_source" {
-  name                = "acctestIpGroupForFirewallPolicySource"
-  location            = azurerm_resource_group.test.location
-  resource_group_name = azurerm_resource_group.test.name
-  cidrs               = ["1.2.3.4/32", "12.34.56.0/24"]
-}
-resource "azurerm_ip_group" "test_destination" {
-  name                = "acctestIpGroupForFirewallPolicyDest"
-  location            = azurerm_resource_group.test.location
-  resource_group_name = azurerm_resource_group.test.name
-  cidrs               = ["192.168.0.0/25", "192.168.0.192/26"]
-}
+%[2]s
 resource "azurerm_firewall_policy_rule_collection_group" "test" {
   name               = "acctest-fwpolicy-RCG-%[1]d"
   firewall_policy_id = azurerm_firewall_policy.test.id
@@ -590,39 +609,12 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
     }
   }
 }
-`, data.RandomInteger, data.Locations.Primary)
+`, data.RandomInteger, r.templatePremium(data))
 }
 
-func (FirewallPolicyRuleCollectionGroupResource) updatePremium(data acceptance.TestData) string {
+func (r FirewallPolicyRuleCollectionGroupResource) updatePremium(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-provider "azurerm" {
-  features {}
-}
-resource "azurerm_resource_group" "test" {
-  name     = "acctestRG-fwpolicy-RCG-%[1]d"
-  location = "%[2]s"
-}
-resource "azurerm_firewall_policy" "test" {
-  name                = "acctest-fwpolicy-RCG-%[1]d"
-  resource_group_name = azurerm_resource_group.test.name
-  location            = azurerm_resource_group.test.location
-  dns {
-    network_rule_fqdn_enabled = false
-    proxy_enabled             = true
-  }
-}
-resource "azurerm_ip_group" "test_source" {
-  name                = "acctestIpGroupForFirewallPolicySource"
-  location            = azurerm_resource_group.test.location
-  resource_group_name = azurerm_resource_group.test.name
-  cidrs               = ["1.2.3.4/32", "12.34.56.0/24"]
-}
-resource "azurerm_ip_group" "test_destination" {
-  name                = "acctestIpGroupForFirewallPolicyDest"
-  location            = azurerm_resource_group.test.location
-  resource_group_name = azurerm_resource_group.test.name
-  cidrs               = ["192.168.0.0/25", "192.168.0.192/26"]
-}
+%[2]s
 resource "azurerm_firewall_policy_rule_collection_group" "test" {
   name               = "acctest-fwpolicy-RCG-%[1]d"
   firewall_policy_id = azurerm_firewall_policy.test.id
@@ -727,7 +719,7 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
     }
   }
 }
-`, data.RandomInteger, data.Locations.Primary)
+`, data.RandomInteger, r.templatePremium(data))
 }
 
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
@@ -741,3 +733,151 @@ resource "azurerm_firewall_policy_rule_collection_group" "import" {
 }
 `, template)
 }
+
+func (FirewallPolicyRuleCollectionGroupResource) templatePremium(data acceptance.TestData) string {
+	template := FirewallPolicyResource{}.templatePremium(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+  dns {
+    network_rule_fqdn_enabled = false
+    proxy_enabled             = true
+  }
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+  tls_certificate {
+    key_vault_secret_id = azurerm_key_vault_certificate.test.secret_id
+    name                = azurerm_key_vault_certificate.test.name
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r FirewallPolicyRuleCollectionGroupResource) applicationRuleHttpHeaders(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[2]s
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Allow"
+    rule {
+      name = "app_rule_collection1_rule1"
+      protocols {
+        type = "Http"
+        port = 80
+      }
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses  = ["10.0.0.1"]
+      destination_fqdns = ["www.microsoft.com"]
+      terminate_tls     = true
+      http_headers {
+        name  = "X-Tenant-Id"
+        value = "00000000-0000-0000-0000-000000000000"
+      }
+      http_headers {
+        name  = "Restrict-Access-To-Tenants"
+        value = "example.com"
+      }
+    }
+    rule {
+      name = "app_rule_collection1_rule2"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses = ["10.0.0.1"]
+      web_categories   = ["News", "SocialNetworking"]
+      terminate_tls    = true
+    }
+    rule {
+      name = "app_rule_collection1_rule3"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses      = ["10.0.0.1"]
+      destination_fqdn_tags = ["WindowsUpdate"]
+    }
+  }
+}
+`, data.RandomInteger, r.templatePremium(data))
+}
+
+func (r FirewallPolicyRuleCollectionGroupResource) applicationRuleInvalidDestinations(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[2]s
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Allow"
+    rule {
+      name = "app_rule_collection1_rule1"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses      = ["10.0.0.1"]
+      destination_fqdns     = ["www.microsoft.com"]
+      destination_fqdn_tags = ["WindowsUpdate"]
+    }
+  }
+}
+`, data.RandomInteger, r.templatePremium(data))
+}
+
+func (FirewallPolicyRuleCollectionGroupResource) terminateTlsWithoutCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Allow"
+    rule {
+      name = "app_rule_collection1_rule1"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses = ["10.0.0.1"]
+      destination_urls = ["www.microsoft.com/en-us"]
+      terminate_tls    = true
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,12 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func FirewallPolicyWebCategoryName() func(i interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`),
+		"The Web Category must be the name of the category without spaces, e.g. `Gambling` or `SocialNetworking`, and may contain only letters and numbers.")
+}
@@ -1,18 +1,64 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/iotcentral/mgmt/2018-09-01/iotcentral"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/sdk/2021-11-01-preview/apps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/sdk/2022-07-31/dataplane"
+)
+
+const (
+	// dataPlaneDomainSuffix is the domain suffix used by the data plane endpoint of IoT Central Applications
+	dataPlaneDomainSuffix = "azureiotcentral.com"
+
+	// dataPlaneTokenAudience is the audience used when obtaining a token for the IoT Central data plane
+	dataPlaneTokenAudience = "https://apps.azureiotcentral.com"
 )
 
 type Client struct {
-	AppsClient *iotcentral.AppsClient
+	AppsClient          *iotcentral.AppsClient
+	AppsPreviewClient   *apps.AppsClient
+	tokenFunc           func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc func(c *autorest.Client, authorizer autorest.Authorizer)
+}
+
+func (c Client) DataPlaneClient(ctx context.Context, id parse.ApplicationId) (*dataplane.BaseClient, error) {
+	app, err := c.AppsClient.Get(ctx, id.ResourceGroup, id.IoTAppName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if app.AppProperties == nil || app.AppProperties.Subdomain == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.subdomain` was nil", id)
+	}
+
+	auth, err := c.tokenFunc(dataPlaneTokenAudience)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", dataPlaneTokenAudience, err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s.%s/api", *app.AppProperties.Subdomain, dataPlaneDomainSuffix)
+	client := dataplane.NewWithoutDefaults(endpoint)
+	c.configureClientFunc(&client.Client, auth)
+	return &client, nil
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	AppsClient := iotcentral.NewAppsClient(o.SubscriptionId)
 	o.ConfigureClient(&AppsClient.Client, o.ResourceManagerAuthorizer)
+
+	AppsPreviewClient := apps.NewAppsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AppsPreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AppsClient: &AppsClient,
+		AppsClient:          &AppsClient,
+		AppsPreviewClient:   &AppsPreviewClient,
+		tokenFunc:           o.TokenFunc,
+		configureClientFunc: o.ConfigureClient,
 	}
 }
@@ -0,0 +1,228 @@
+package iotcentral
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/sdk/2022-07-31/dataplane"
+	iotcentralValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceIotCentralApplicationFileUpload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceIotCentralApplicationFileUploadCreateUpdate,
+		Read:   resourceIotCentralApplicationFileUploadRead,
+		Update: resourceIotCentralApplicationFileUploadCreateUpdate,
+		Delete: resourceIotCentralApplicationFileUploadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"iotcentral_application_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: iotcentralValidate.ApplicationID,
+			},
+
+			"connection_string": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"sas_ttl": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "PT1H",
+				ValidateFunc: validate.ISO8601Duration,
+			},
+		},
+	}
+}
+
+func resourceIotCentralApplicationFileUploadCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationID(d.Get("iotcentral_application_id").(string))
+	if err != nil {
+		return err
+	}
+
+	dataPlaneClient, err := client.DataPlaneClient(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	if d.IsNewResource() {
+		existing, err := dataPlaneClient.GetFileUpload(ctx)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing File Upload configuration for %s: %+v", *id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_iotcentral_application_file_upload", id.ID())
+		}
+	}
+
+	payload := dataplane.FileUpload{
+		ConnectionString: utils.String(d.Get("connection_string").(string)),
+		Container:        utils.String(d.Get("container_name").(string)),
+		SasTTL:           utils.String(d.Get("sas_ttl").(string)),
+	}
+	if v := d.Get("account_name").(string); v != "" {
+		payload.Account = utils.String(v)
+	}
+
+	if _, err := dataPlaneClient.CreateFileUpload(ctx, payload); err != nil {
+		return fmt.Errorf("creating/updating the File Upload configuration for %s: %+v", *id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(dataplane.FileUploadStatePending)},
+		Target:     []string{string(dataplane.FileUploadStateSucceeded)},
+		Refresh:    iotCentralFileUploadStateRefreshFunc(ctx, dataPlaneClient),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the File Upload configuration for %s to be provisioned: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceIotCentralApplicationFileUploadRead(d, meta)
+}
+
+func resourceIotCentralApplicationFileUploadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataPlaneClient, err := client.DataPlaneClient(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	resp, err := dataPlaneClient.GetFileUpload(ctx)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] File Upload configuration for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving the File Upload configuration for %s: %+v", *id, err)
+	}
+
+	d.Set("iotcentral_application_id", id.ID())
+	d.Set("account_name", resp.Account)
+	d.Set("container_name", resp.Container)
+	d.Set("sas_ttl", resp.SasTTL)
+	// the `connection_string` isn't returned by the API, so we use the value from the config
+
+	return nil
+}
+
+func resourceIotCentralApplicationFileUploadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataPlaneClient, err := client.DataPlaneClient(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	resp, err := dataPlaneClient.RemoveFileUpload(ctx)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting the File Upload configuration for %s: %+v", *id, err)
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(dataplane.FileUploadStateDeleting), string(dataplane.FileUploadStateSucceeded)},
+		Target:     []string{"NotFound"},
+		Refresh:    iotCentralFileUploadStateRefreshFunc(ctx, dataPlaneClient),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the File Upload configuration for %s to be deleted: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func iotCentralFileUploadStateRefreshFunc(ctx context.Context, client *dataplane.BaseClient) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetFileUpload(ctx)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("retrieving the File Upload configuration: %+v", err)
+		}
+
+		if resp.State == dataplane.FileUploadStateFailed {
+			return resp, string(resp.State), fmt.Errorf("the File Upload configuration is in a failed state")
+		}
+
+		return resp, string(resp.State), nil
+	}
+}
@@ -0,0 +1,151 @@
+package iotcentral_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IoTCentralApplicationFileUploadResource struct{}
+
+func TestAccIoTCentralApplicationFileUpload_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_file_upload", "test")
+	r := IoTCentralApplicationFileUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "PT1H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccIoTCentralApplicationFileUpload_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_file_upload", "test")
+	r := IoTCentralApplicationFileUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "PT1H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIoTCentralApplicationFileUpload_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_file_upload", "test")
+	r := IoTCentralApplicationFileUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "PT1H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.basic(data, "PT2H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sas_ttl").HasValue("PT2H"),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func (IoTCentralApplicationFileUploadResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.IoTCentral.DataPlaneClient(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	resp, err := dataPlaneClient.GetFileUpload(ctx)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving the File Upload configuration for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r IoTCentralApplicationFileUploadResource) basic(data acceptance.TestData, sasTtl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_application_file_upload" "test" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+  connection_string         = azurerm_storage_account.test.primary_connection_string
+  container_name            = azurerm_storage_container.test.name
+  sas_ttl                   = "%s"
+}
+`, r.template(data), sasTtl)
+}
+
+func (r IoTCentralApplicationFileUploadResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_application_file_upload" "import" {
+  iotcentral_application_id = azurerm_iotcentral_application_file_upload.test.iotcentral_application_id
+  connection_string         = azurerm_iotcentral_application_file_upload.test.connection_string
+  container_name            = azurerm_iotcentral_application_file_upload.test.container_name
+}
+`, r.basic(data, "PT1H"))
+}
+
+func (IoTCentralApplicationFileUploadResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_iotcentral_application" "test" {
+  name                = "acctest-iotcentralapp-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sub_domain          = "subdomain-%[1]d"
+  sku                 = "ST1"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "fileupload"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,241 @@
+package iotcentral
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/sdk/2021-11-01-preview/apps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceIotCentralApplicationNetworkRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceIotCentralApplicationNetworkRuleSetCreateUpdate,
+		Read:   resourceIotCentralApplicationNetworkRuleSetRead,
+		Update: resourceIotCentralApplicationNetworkRuleSetCreateUpdate,
+		Delete: resourceIotCentralApplicationNetworkRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"iotcentral_application_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationID,
+			},
+
+			"apply_to_devices": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"default_action": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(apps.NetworkActionDeny),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apps.NetworkActionAllow),
+					string(apps.NetworkActionDeny),
+				}, false),
+			},
+
+			"ip_rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ip_mask": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIotCentralApplicationNetworkRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral.AppsPreviewClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationID(d.Get("iotcentral_application_id").(string))
+	if err != nil {
+		return err
+	}
+
+	appId := apps.NewIotAppID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName)
+	existing, err := client.Get(ctx, appId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if d.IsNewResource() {
+		if model := existing.Model; model != nil && model.Properties != nil && model.Properties.NetworkRuleSets != nil {
+			ruleSets := model.Properties.NetworkRuleSets
+			hasRules := ruleSets.IPRules != nil && len(*ruleSets.IPRules) > 0
+			denyByDefault := ruleSets.DefaultAction != nil && *ruleSets.DefaultAction == apps.NetworkActionDeny
+			if hasRules || denyByDefault {
+				return tf.ImportAsExistsError("azurerm_iotcentral_application_network_rule_set", id.ID())
+			}
+		}
+	}
+
+	defaultAction := apps.NetworkAction(d.Get("default_action").(string))
+	payload := apps.AppPatch{
+		Properties: &apps.AppProperties{
+			NetworkRuleSets: &apps.NetworkRuleSets{
+				ApplyToDevices: utils.Bool(d.Get("apply_to_devices").(bool)),
+				DefaultAction:  &defaultAction,
+				IPRules:        expandIotCentralApplicationNetworkRuleSetIPRules(d.Get("ip_rule").([]interface{})),
+			},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, appId, payload); err != nil {
+		return fmt.Errorf("updating the Network Rule Set for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceIotCentralApplicationNetworkRuleSetRead(d, meta)
+}
+
+func resourceIotCentralApplicationNetworkRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral.AppsPreviewClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, apps.NewIotAppID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("iotcentral_application_id", id.ID())
+
+	applyToDevices := true
+	defaultAction := string(apps.NetworkActionDeny)
+	ipRules := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.NetworkRuleSets != nil {
+		ruleSets := model.Properties.NetworkRuleSets
+		if ruleSets.ApplyToDevices != nil {
+			applyToDevices = *ruleSets.ApplyToDevices
+		}
+		if ruleSets.DefaultAction != nil {
+			defaultAction = string(*ruleSets.DefaultAction)
+		}
+		ipRules = flattenIotCentralApplicationNetworkRuleSetIPRules(ruleSets.IPRules)
+	}
+
+	d.Set("apply_to_devices", applyToDevices)
+	d.Set("default_action", defaultAction)
+	if err := d.Set("ip_rule", ipRules); err != nil {
+		return fmt.Errorf("setting `ip_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceIotCentralApplicationNetworkRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral.AppsPreviewClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Network Rule Set can't be removed from the IoT Central Application, so we reset it to the defaults instead
+	defaultAction := apps.NetworkActionAllow
+	payload := apps.AppPatch{
+		Properties: &apps.AppProperties{
+			NetworkRuleSets: &apps.NetworkRuleSets{
+				ApplyToDevices: utils.Bool(true),
+				DefaultAction:  &defaultAction,
+				IPRules:        &[]apps.NetworkRuleSetIPRule{},
+			},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, apps.NewIotAppID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName), payload); err != nil {
+		return fmt.Errorf("resetting the Network Rule Set for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandIotCentralApplicationNetworkRuleSetIPRules(input []interface{}) *[]apps.NetworkRuleSetIPRule {
+	results := make([]apps.NetworkRuleSetIPRule, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, apps.NetworkRuleSetIPRule{
+			FilterName: utils.String(v["name"].(string)),
+			IPMask:     utils.String(v["ip_mask"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenIotCentralApplicationNetworkRuleSetIPRules(input *[]apps.NetworkRuleSetIPRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.FilterName != nil {
+			name = *item.FilterName
+		}
+
+		ipMask := ""
+		if item.IPMask != nil {
+			ipMask = *item.IPMask
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    name,
+			"ip_mask": ipMask,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,170 @@
+package iotcentral_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/sdk/2021-11-01-preview/apps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IoTCentralApplicationNetworkRuleSetResource struct{}
+
+func TestAccIoTCentralApplicationNetworkRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_network_rule_set", "test")
+	r := IoTCentralApplicationNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIoTCentralApplicationNetworkRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_network_rule_set", "test")
+	r := IoTCentralApplicationNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIoTCentralApplicationNetworkRuleSet_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_network_rule_set", "test")
+	r := IoTCentralApplicationNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIoTCentralApplicationNetworkRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_network_rule_set", "test")
+	r := IoTCentralApplicationNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_rule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (IoTCentralApplicationNetworkRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.IoTCentral.AppsPreviewClient.Get(ctx, apps.NewIotAppID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.NetworkRuleSets != nil), nil
+}
+
+func (r IoTCentralApplicationNetworkRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_application_network_rule_set" "test" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+}
+`, r.template(data))
+}
+
+func (r IoTCentralApplicationNetworkRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_application_network_rule_set" "import" {
+  iotcentral_application_id = azurerm_iotcentral_application_network_rule_set.test.iotcentral_application_id
+}
+`, r.basic(data))
+}
+
+func (r IoTCentralApplicationNetworkRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_application_network_rule_set" "test" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+  apply_to_devices          = false
+  default_action            = "Allow"
+
+  ip_rule {
+    name    = "rule1"
+    ip_mask = "10.0.1.0/24"
+  }
+
+  ip_rule {
+    name    = "rule2"
+    ip_mask = "10.1.1.0/24"
+  }
+}
+`, r.template(data))
+}
+
+func (IoTCentralApplicationNetworkRuleSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_iotcentral_application" "test" {
+  name                = "acctest-iotcentralapp-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sub_domain          = "subdomain-%[1]d"
+  sku                 = "ST1"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
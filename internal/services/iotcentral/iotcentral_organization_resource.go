@@ -0,0 +1,251 @@
+package iotcentral
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/sdk/2022-07-31/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceIotCentralOrganization() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceIotCentralOrganizationCreate,
+		Read:   resourceIotCentralOrganizationRead,
+		Update: resourceIotCentralOrganizationUpdate,
+		Delete: resourceIotCentralOrganizationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.OrganizationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"iotcentral_application_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationID,
+			},
+
+			"organization_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.OrganizationName,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parent_organization_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.OrganizationName,
+			},
+		},
+	}
+}
+
+func resourceIotCentralOrganizationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	appId, err := parse.ApplicationID(d.Get("iotcentral_application_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewOrganizationID(appId.SubscriptionId, appId.ResourceGroup, appId.IoTAppName, d.Get("organization_id").(string))
+
+	dataPlaneClient, err := client.DataPlaneClient(ctx, *appId)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", id, err)
+	}
+
+	existing, err := dataPlaneClient.GetOrganization(ctx, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_iotcentral_organization", id.ID())
+	}
+
+	parent := d.Get("parent_organization_id").(string)
+	if err := validateIotCentralOrganizationParent(ctx, dataPlaneClient, id, parent); err != nil {
+		return err
+	}
+
+	if _, err := dataPlaneClient.CreateOrganization(ctx, id.Name, expandIotCentralOrganization(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceIotCentralOrganizationRead(d, meta)
+}
+
+func resourceIotCentralOrganizationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OrganizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	appId := parse.NewApplicationID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName)
+	dataPlaneClient, err := client.DataPlaneClient(ctx, appId)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	resp, err := dataPlaneClient.GetOrganization(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("iotcentral_application_id", appId.ID())
+	d.Set("organization_id", id.Name)
+	d.Set("display_name", resp.DisplayName)
+	d.Set("parent_organization_id", resp.Parent)
+
+	return nil
+}
+
+func resourceIotCentralOrganizationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OrganizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	appId := parse.NewApplicationID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName)
+	dataPlaneClient, err := client.DataPlaneClient(ctx, appId)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	if d.HasChange("parent_organization_id") {
+		if err := validateIotCentralOrganizationParent(ctx, dataPlaneClient, *id, d.Get("parent_organization_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	// the whole organization is sent so that removing the parent moves the organization to the root of the hierarchy
+	if _, err := dataPlaneClient.CreateOrganization(ctx, id.Name, expandIotCentralOrganization(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceIotCentralOrganizationRead(d, meta)
+}
+
+func resourceIotCentralOrganizationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTCentral
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OrganizationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	appId := parse.NewApplicationID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName)
+	dataPlaneClient, err := client.DataPlaneClient(ctx, appId)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	resp, err := dataPlaneClient.RemoveOrganization(ctx, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandIotCentralOrganization(d *pluginsdk.ResourceData) dataplane.Organization {
+	organization := dataplane.Organization{
+		DisplayName: utils.String(d.Get("display_name").(string)),
+	}
+
+	if parent := d.Get("parent_organization_id").(string); parent != "" {
+		organization.Parent = utils.String(parent)
+	}
+
+	return organization
+}
+
+// validateIotCentralOrganizationParent ensures that the parent organization exists within the IoT Central Application
+// and that using it as the parent wouldn't introduce a cycle into the organization hierarchy
+func validateIotCentralOrganizationParent(ctx context.Context, client *dataplane.BaseClient, id parse.OrganizationId, parent string) error {
+	if parent == "" {
+		return nil
+	}
+
+	if parent == id.Name {
+		return fmt.Errorf("`parent_organization_id` cannot be the same as `organization_id`")
+	}
+
+	visited := map[string]struct{}{}
+	current := parent
+	for current != "" {
+		if current == id.Name {
+			return fmt.Errorf("`parent_organization_id` %q is a descendant of %s - organizations cannot be nested within themselves", parent, id)
+		}
+
+		if _, ok := visited[current]; ok {
+			break
+		}
+		visited[current] = struct{}{}
+
+		resp, err := client.GetOrganization(ctx, current)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				if current == parent {
+					return fmt.Errorf("the parent organization %q specified in `parent_organization_id` was not found within IoT Central Application %q", parent, id.IoTAppName)
+				}
+				break
+			}
+			return fmt.Errorf("retrieving parent organization %q for %s: %+v", current, id, err)
+		}
+
+		current = ""
+		if resp.Parent != nil {
+			current = *resp.Parent
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package iotcentral_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IoTCentralOrganizationResource struct{}
+
+func TestAccIoTCentralOrganization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_organization", "test")
+	r := IoTCentralOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIoTCentralOrganization_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_organization", "test")
+	r := IoTCentralOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIoTCentralOrganization_parent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_organization", "test")
+	r := IoTCentralOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parent_organization_id").HasValue("org-parent"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIoTCentralOrganization_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_organization", "test")
+	r := IoTCentralOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.parent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parent_organization_id").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIoTCentralOrganization_parentNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_organization", "test")
+	r := IoTCentralOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.parentNotFound(data),
+			ExpectError: regexp.MustCompile("the parent organization \"org-missing\" specified in `parent_organization_id` was not found"),
+		},
+	})
+}
+
+func (IoTCentralOrganizationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.OrganizationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := clients.IoTCentral.DataPlaneClient(ctx, parse.NewApplicationID(id.SubscriptionId, id.ResourceGroup, id.IoTAppName))
+	if err != nil {
+		return nil, fmt.Errorf("building data plane client for %s: %+v", *id, err)
+	}
+
+	resp, err := dataPlaneClient.GetOrganization(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r IoTCentralOrganizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_organization" "test" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+  organization_id           = "org-test-id"
+  display_name              = "Org basic"
+}
+`, r.template(data))
+}
+
+func (r IoTCentralOrganizationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_organization" "import" {
+  iotcentral_application_id = azurerm_iotcentral_organization.test.iotcentral_application_id
+  organization_id           = azurerm_iotcentral_organization.test.organization_id
+  display_name              = azurerm_iotcentral_organization.test.display_name
+}
+`, r.basic(data))
+}
+
+func (r IoTCentralOrganizationResource) parent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_organization" "parent" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+  organization_id           = "org-parent"
+  display_name              = "Org parent"
+}
+
+resource "azurerm_iotcentral_organization" "test" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+  organization_id           = "org-test-id"
+  display_name              = "Org child"
+  parent_organization_id    = azurerm_iotcentral_organization.parent.organization_id
+}
+`, r.template(data))
+}
+
+func (r IoTCentralOrganizationResource) parentNotFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iotcentral_organization" "test" {
+  iotcentral_application_id = azurerm_iotcentral_application.test.id
+  organization_id           = "org-test-id"
+  display_name              = "Org child"
+  parent_organization_id    = "org-missing"
+}
+`, r.template(data))
+}
+
+func (IoTCentralOrganizationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_iotcentral_application" "test" {
+  name                = "acctest-iotcentralapp-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sub_domain          = "subdomain-%[1]d"
+  sku                 = "ST1"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type OrganizationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	IoTAppName     string
+	Name           string
+}
+
+func NewOrganizationID(subscriptionId, resourceGroup, ioTAppName, name string) OrganizationId {
+	return OrganizationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		IoTAppName:     ioTAppName,
+		Name:           name,
+	}
+}
+
+func (id OrganizationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Io T App Name %q", id.IoTAppName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Organization", segmentsStr)
+}
+
+func (id OrganizationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.IoTCentral/ioTApps/%s/organizations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IoTAppName, id.Name)
+}
+
+// OrganizationID parses a Organization ID into an OrganizationId struct
+func OrganizationID(input string) (*OrganizationId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := OrganizationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.IoTAppName, err = id.PopSegment("ioTApps"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("organizations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// OrganizationIDInsensitively parses an Organization ID into an OrganizationId struct, insensitively
+// This should only be used to parse an ID for rewriting, the OrganizationID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func OrganizationIDInsensitively(input string) (*OrganizationId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := OrganizationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'ioTApps' segment
+	ioTAppsKey := "ioTApps"
+	for key := range id.Path {
+		if strings.EqualFold(key, ioTAppsKey) {
+			ioTAppsKey = key
+			break
+		}
+	}
+	if resourceId.IoTAppName, err = id.PopSegment(ioTAppsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'organizations' segment
+	organizationsKey := "organizations"
+	for key := range id.Path {
+		if strings.EqualFold(key, organizationsKey) {
+			organizationsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(organizationsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -19,7 +19,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_iotcentral_application": resourceIotCentralApplication(),
+		"azurerm_iotcentral_application":                  resourceIotCentralApplication(),
+		"azurerm_iotcentral_application_file_upload":      resourceIotCentralApplicationFileUpload(),
+		"azurerm_iotcentral_application_network_rule_set": resourceIotCentralApplicationNetworkRuleSet(),
+		"azurerm_iotcentral_organization":                 resourceIotCentralOrganization(),
 	}
 }
 
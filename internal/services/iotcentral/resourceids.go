@@ -1,3 +1,4 @@
 package iotcentral
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Application -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.IoTCentral/ioTApps/app1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Organization -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.IoTCentral/ioTApps/app1/organizations/org1 -rewrite=true
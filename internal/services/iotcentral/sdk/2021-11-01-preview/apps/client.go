@@ -0,0 +1,15 @@
+package apps
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AppsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAppsClientWithBaseURI(endpoint string) AppsClient {
+	return AppsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
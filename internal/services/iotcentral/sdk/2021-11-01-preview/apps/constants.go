@@ -0,0 +1,8 @@
+package apps
+
+type NetworkAction string
+
+const (
+	NetworkActionAllow NetworkAction = "Allow"
+	NetworkActionDeny  NetworkAction = "Deny"
+)
@@ -0,0 +1,6 @@
+package apps
+
+type AppPatch struct {
+	Properties *AppProperties     `json:"properties,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package apps
+
+type AppProperties struct {
+	ApplicationId   *string          `json:"applicationId,omitempty"`
+	DisplayName     *string          `json:"displayName,omitempty"`
+	NetworkRuleSets *NetworkRuleSets `json:"networkRuleSets,omitempty"`
+	Subdomain       *string          `json:"subdomain,omitempty"`
+	Template        *string          `json:"template,omitempty"`
+}
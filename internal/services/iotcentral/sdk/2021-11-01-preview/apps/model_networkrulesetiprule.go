@@ -0,0 +1,6 @@
+package apps
+
+type NetworkRuleSetIPRule struct {
+	FilterName *string `json:"filterName,omitempty"`
+	IPMask     *string `json:"ipMask,omitempty"`
+}
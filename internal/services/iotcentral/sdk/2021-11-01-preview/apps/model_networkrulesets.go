@@ -0,0 +1,8 @@
+package apps
+
+type NetworkRuleSets struct {
+	ApplyToDevices    *bool                   `json:"applyToDevices,omitempty"`
+	ApplyToIoTCentral *bool                   `json:"applyToIoTCentral,omitempty"`
+	DefaultAction     *NetworkAction          `json:"defaultAction,omitempty"`
+	IPRules           *[]NetworkRuleSetIPRule `json:"ipRules,omitempty"`
+}
@@ -0,0 +1,9 @@
+package apps
+
+import "fmt"
+
+const defaultApiVersion = "2021-11-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/apps/%s", defaultApiVersion)
+}
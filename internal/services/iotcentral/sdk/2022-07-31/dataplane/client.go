@@ -0,0 +1,565 @@
+// Package dataplane implements the Azure IoT Central data plane service API version 2022-07-31.
+package dataplane
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+// BaseClient is the base client for the IoT Central data plane.
+type BaseClient struct {
+	autorest.Client
+	Endpoint string
+}
+
+// New creates an instance of the BaseClient client.
+func New(endpoint string) BaseClient {
+	return NewWithoutDefaults(endpoint)
+}
+
+// NewWithoutDefaults creates an instance of the BaseClient client.
+func NewWithoutDefaults(endpoint string) BaseClient {
+	return BaseClient{
+		Client:   autorest.NewClientWithUserAgent(UserAgent()),
+		Endpoint: endpoint,
+	}
+}
+
+// GetOrganization get an organization by ID.
+// Parameters:
+// organizationID - unique ID for the organization.
+func (client BaseClient) GetOrganization(ctx context.Context, organizationID string) (result Organization, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetOrganization")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetOrganizationPreparer(ctx, organizationID)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "GetOrganization", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetOrganizationSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "GetOrganization", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetOrganizationResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "GetOrganization", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetOrganizationPreparer prepares the GetOrganization request.
+func (client BaseClient) GetOrganizationPreparer(ctx context.Context, organizationID string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"organizationId": autorest.Encode("path", organizationID),
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPathParameters("/organizations/{organizationId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetOrganizationSender sends the GetOrganization request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetOrganizationSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetOrganizationResponder handles the response to the GetOrganization request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetOrganizationResponder(resp *http.Response) (result Organization, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrganization create an organization in the application.
+// Parameters:
+// organizationID - unique ID for the organization.
+// body - organization body.
+func (client BaseClient) CreateOrganization(ctx context.Context, organizationID string, body Organization) (result Organization, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.CreateOrganization")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.CreateOrganizationPreparer(ctx, organizationID, body)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "CreateOrganization", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateOrganizationSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "CreateOrganization", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateOrganizationResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "CreateOrganization", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrganizationPreparer prepares the CreateOrganization request.
+func (client BaseClient) CreateOrganizationPreparer(ctx context.Context, organizationID string, body Organization) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"organizationId": autorest.Encode("path", organizationID),
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPathParameters("/organizations/{organizationId}", pathParameters),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateOrganizationSender sends the CreateOrganization request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) CreateOrganizationSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// CreateOrganizationResponder handles the response to the CreateOrganization request. The method always
+// closes the http.Response Body.
+func (client BaseClient) CreateOrganizationResponder(resp *http.Response) (result Organization, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateOrganization update an organization in the application via patch.
+// Parameters:
+// organizationID - unique ID for the organization.
+// body - organization patch body.
+func (client BaseClient) UpdateOrganization(ctx context.Context, organizationID string, body Organization) (result Organization, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.UpdateOrganization")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.UpdateOrganizationPreparer(ctx, organizationID, body)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "UpdateOrganization", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateOrganizationSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "UpdateOrganization", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateOrganizationResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "UpdateOrganization", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateOrganizationPreparer prepares the UpdateOrganization request.
+func (client BaseClient) UpdateOrganizationPreparer(ctx context.Context, organizationID string, body Organization) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"organizationId": autorest.Encode("path", organizationID),
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPathParameters("/organizations/{organizationId}", pathParameters),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateOrganizationSender sends the UpdateOrganization request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateOrganizationSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateOrganizationResponder handles the response to the UpdateOrganization request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateOrganizationResponder(resp *http.Response) (result Organization, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// RemoveOrganization delete an organization.
+// Parameters:
+// organizationID - unique ID for the organization.
+func (client BaseClient) RemoveOrganization(ctx context.Context, organizationID string) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.RemoveOrganization")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.RemoveOrganizationPreparer(ctx, organizationID)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "RemoveOrganization", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.RemoveOrganizationSender(req)
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "RemoveOrganization", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.RemoveOrganizationResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "RemoveOrganization", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// RemoveOrganizationPreparer prepares the RemoveOrganization request.
+func (client BaseClient) RemoveOrganizationPreparer(ctx context.Context, organizationID string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"organizationId": autorest.Encode("path", organizationID),
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPathParameters("/organizations/{organizationId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// RemoveOrganizationSender sends the RemoveOrganization request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) RemoveOrganizationSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// RemoveOrganizationResponder handles the response to the RemoveOrganization request. The method always
+// closes the http.Response Body.
+func (client BaseClient) RemoveOrganizationResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
+
+// GetFileUpload get the file upload storage account configuration.
+func (client BaseClient) GetFileUpload(ctx context.Context) (result FileUpload, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetFileUpload")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetFileUploadPreparer(ctx)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "GetFileUpload", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetFileUploadSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "GetFileUpload", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetFileUploadResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "GetFileUpload", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetFileUploadPreparer prepares the GetFileUpload request.
+func (client BaseClient) GetFileUploadPreparer(ctx context.Context) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPath("/fileUploads"),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetFileUploadSender sends the GetFileUpload request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetFileUploadSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetFileUploadResponder handles the response to the GetFileUpload request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetFileUploadResponder(resp *http.Response) (result FileUpload, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateFileUpload create the file upload storage account configuration.
+// Parameters:
+// body - file upload storage account configuration body.
+func (client BaseClient) CreateFileUpload(ctx context.Context, body FileUpload) (result FileUpload, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.CreateFileUpload")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.CreateFileUploadPreparer(ctx, body)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "CreateFileUpload", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateFileUploadSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "CreateFileUpload", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateFileUploadResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "CreateFileUpload", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateFileUploadPreparer prepares the CreateFileUpload request.
+func (client BaseClient) CreateFileUploadPreparer(ctx context.Context, body FileUpload) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPath("/fileUploads"),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateFileUploadSender sends the CreateFileUpload request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) CreateFileUploadSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// CreateFileUploadResponder handles the response to the CreateFileUpload request. The method always
+// closes the http.Response Body.
+func (client BaseClient) CreateFileUploadResponder(resp *http.Response) (result FileUpload, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// RemoveFileUpload delete the file upload storage configuration.
+func (client BaseClient) RemoveFileUpload(ctx context.Context) (result autorest.Response, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.RemoveFileUpload")
+		defer func() {
+			sc := -1
+			if result.Response != nil {
+				sc = result.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.RemoveFileUploadPreparer(ctx)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "RemoveFileUpload", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.RemoveFileUploadSender(req)
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "RemoveFileUpload", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.RemoveFileUploadResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "RemoveFileUpload", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// RemoveFileUploadPreparer prepares the RemoveFileUpload request.
+func (client BaseClient) RemoveFileUploadPreparer(ctx context.Context) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"baseUrl": client.Endpoint,
+	}
+
+	const APIVersion = "2022-07-31"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{baseUrl}", urlParameters),
+		autorest.WithPath("/fileUploads"),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// RemoveFileUploadSender sends the RemoveFileUpload request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) RemoveFileUploadSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// RemoveFileUploadResponder handles the response to the RemoveFileUpload request. The method always
+// closes the http.Response Body.
+func (client BaseClient) RemoveFileUploadResponder(resp *http.Response) (result autorest.Response, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	return
+}
@@ -0,0 +1,61 @@
+package dataplane
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// The package's fully qualified name.
+const fqdn = "github.com/Azure/azure-sdk-for-go/services/iotcentral/2022-07-31/dataplane"
+
+// FileUploadState enumerates the values for file upload state.
+type FileUploadState string
+
+const (
+	// FileUploadStateDeleting ...
+	FileUploadStateDeleting FileUploadState = "deleting"
+	// FileUploadStateFailed ...
+	FileUploadStateFailed FileUploadState = "failed"
+	// FileUploadStatePending ...
+	FileUploadStatePending FileUploadState = "pending"
+	// FileUploadStateSucceeded ...
+	FileUploadStateSucceeded FileUploadState = "succeeded"
+)
+
+// PossibleFileUploadStateValues returns an array of possible values for the FileUploadState const type.
+func PossibleFileUploadStateValues() []FileUploadState {
+	return []FileUploadState{FileUploadStateDeleting, FileUploadStateFailed, FileUploadStatePending, FileUploadStateSucceeded}
+}
+
+// FileUpload the file upload configuration definition.
+type FileUpload struct {
+	autorest.Response `json:"-"`
+	// Account - The storage account name where to upload the file to.
+	Account *string `json:"account,omitempty"`
+	// ConnectionString - The connection string used to configure the storage account.
+	ConnectionString *string `json:"connectionString,omitempty"`
+	// Container - The name of the container inside the storage account.
+	Container *string `json:"container,omitempty"`
+	// SasTTL - ISO 8601 duration standard, The amount of time the device's request to upload a file is valid before it expires.
+	SasTTL *string `json:"sasTtl,omitempty"`
+	// State - READ-ONLY; The state of the file upload configuration. Possible values include: 'FileUploadStatePending', 'FileUploadStateSucceeded', 'FileUploadStateFailed', 'FileUploadStateDeleting'
+	State FileUploadState `json:"state,omitempty"`
+	// Etag - ETag used to prevent conflict with multiple uploads
+	Etag *string `json:"etag,omitempty"`
+}
+
+// Organization the organization definition.
+type Organization struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; Unique ID of the organization.
+	ID *string `json:"id,omitempty"`
+	// DisplayName - Display name of the organization.
+	DisplayName *string `json:"displayName,omitempty"`
+	// Parent - ID of the parent of the organization.
+	Parent *string `json:"parent,omitempty"`
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func OrganizationName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	// Portal: The organization ID may only contain lowercase letters, numbers and dashes and cannot start or end with a dash
+	if matched := regexp.MustCompile(`^[a-z\d]([a-z\d-]{0,46}[a-z\d])?$`).Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain lowercase letters, numbers and dashes, cannot start or end with a dash and must be between 1 and 48 characters, got %q", k, value))
+	}
+	return warnings, errors
+}
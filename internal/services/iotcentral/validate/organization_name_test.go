@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOrganizationName(t *testing.T) {
+	testData := []struct {
+		Value string
+		Error bool
+	}{
+		{
+			Value: "",
+			Error: true,
+		},
+		{
+			Value: "a",
+			Error: false,
+		},
+		{
+			Value: "org1",
+			Error: false,
+		},
+		{
+			Value: "org-1",
+			Error: false,
+		},
+		{
+			Value: "-org",
+			Error: true,
+		},
+		{
+			Value: "org-",
+			Error: true,
+		},
+		{
+			Value: "Org1",
+			Error: true,
+		},
+		{
+			Value: "org_1",
+			Error: true,
+		},
+		{
+			Value: strings.Repeat("a", 48),
+			Error: false,
+		},
+		{
+			Value: strings.Repeat("a", 49),
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Value)
+
+		_, errors := OrganizationName(v.Value, "organization_id")
+		isError := len(errors) != 0
+		if v.Error != isError {
+			t.Fatalf("Expected %t but got %t for %q", v.Error, isError, v.Value)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `exportable` attribute and the `release_policy` of a Key are only available from API Version `7.3`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const keysReleasePolicyApiVersion = "7.3"
+
+type KeysWorkaroundClient struct {
+	sdkClient *keyvault.BaseClient
+}
+
+func NewKeysWorkaroundClient(client *keyvault.BaseClient) KeysWorkaroundClient {
+	return KeysWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// KeyReleasePolicy is the policy rules under which the Key can be exported.
+type KeyReleasePolicy struct {
+	// ContentType - The Content Type and Version of the Key Release Policy.
+	ContentType *string `json:"contentType,omitempty"`
+	// Immutable - Whether the Key Release Policy can no longer be changed.
+	Immutable *bool `json:"immutable,omitempty"`
+	// EncodedPolicy - The Base64URL encoded Key Release Policy document.
+	EncodedPolicy *string `json:"data,omitempty"`
+}
+
+type KeyReleaseProperties struct {
+	autorest.Response `json:"-"`
+	Attributes        *KeyReleaseAttributes `json:"attributes,omitempty"`
+	ReleasePolicy     *KeyReleasePolicy     `json:"release_policy,omitempty"`
+}
+
+type KeyReleaseAttributes struct {
+	Exportable *bool `json:"exportable,omitempty"`
+}
+
+// CreateKey creates a new key, including the `exportable` attribute and the `release_policy` of the key.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name for the new key.
+// parameters - the parameters to create a key.
+// exportable - whether the private key can be exported.
+// releasePolicy - the policy rules under which the key can be exported.
+func (client KeysWorkaroundClient) CreateKey(ctx context.Context, vaultBaseURL string, keyName string, parameters keyvault.KeyCreateParameters, exportable bool, releasePolicy *KeyReleasePolicy) (result keyvault.KeyBundle, err error) {
+	req, err := client.sdkClient.CreateKeyPreparer(ctx, vaultBaseURL, keyName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withKeyReleasePolicy(&exportable, releasePolicy))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.CreateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// UpdateKey updates the specified key, including the `release_policy` of the key.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of key to update.
+// keyVersion - the version of the key to update.
+// parameters - the parameters of the key to update.
+// releasePolicy - the policy rules under which the key can be exported.
+func (client KeysWorkaroundClient) UpdateKey(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string, parameters keyvault.KeyUpdateParameters, releasePolicy *KeyReleasePolicy) (result keyvault.KeyBundle, err error) {
+	req, err := client.sdkClient.UpdateKeyPreparer(ctx, vaultBaseURL, keyName, keyVersion, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withKeyReleasePolicy(nil, releasePolicy))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.UpdateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.sdkClient.UpdateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// GetKeyReleaseProperties returns the `exportable` attribute and the `release_policy` of the specified key.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of the key to get.
+// keyVersion - adding the version parameter retrieves a specific version of a key.
+func (client KeysWorkaroundClient) GetKeyReleaseProperties(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string) (result KeyReleaseProperties, err error) {
+	req, err := client.sdkClient.GetKeyPreparer(ctx, vaultBaseURL, keyName, keyVersion)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyReleaseProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", keysReleasePolicyApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyReleaseProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyReleaseProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// withKeyReleasePolicy sets the `exportable` attribute (when specified) and the `release_policy` within the request body
+// and updates the API Version used for the request to one which supports these fields.
+func withKeyReleasePolicy(exportable *bool, releasePolicy *KeyReleasePolicy) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			if exportable != nil {
+				attributes, ok := body["attributes"].(map[string]interface{})
+				if !ok {
+					attributes = make(map[string]interface{})
+				}
+				attributes["exportable"] = *exportable
+				body["attributes"] = attributes
+			}
+
+			if releasePolicy != nil {
+				body["release_policy"] = releasePolicy
+			}
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", keysReleasePolicyApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
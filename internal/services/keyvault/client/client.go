@@ -4,19 +4,24 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.2/roleassignments"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	ManagedHsmClient                *keyvault.ManagedHsmsClient
+	ManagedHsmRoleAssignmentsClient *roleassignments.RoleAssignmentsClient
+	ManagementClient                *keyvaultmgmt.BaseClient
+	VaultsClient                    *keyvault.VaultsClient
+	options                         *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
 
+	managedHsmRoleAssignmentsClient := roleassignments.NewRoleAssignmentsClient()
+	o.ConfigureClient(&managedHsmRoleAssignmentsClient.Client, o.KeyVaultAuthorizer)
+
 	managementClient := keyvaultmgmt.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
@@ -24,10 +29,11 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		ManagedHsmClient:                &managedHsmClient,
+		ManagedHsmRoleAssignmentsClient: &managedHsmRoleAssignmentsClient,
+		ManagementClient:                &managementClient,
+		VaultsClient:                    &vaultsClient,
+		options:                         o,
 	}
 }
 
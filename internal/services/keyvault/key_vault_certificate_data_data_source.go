@@ -208,9 +208,17 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
+	// the private key isn't included in the secret when the key is non-exportable, so there's nothing to decode
+	exportable := true
+	if cert.Policy != nil && cert.Policy.KeyProperties != nil && cert.Policy.KeyProperties.Exportable != nil {
+		exportable = *cert.Policy.KeyProperties.Exportable
+	}
+
 	var privateKey interface{}
 
-	if *pfx.ContentType == "application/x-pkcs12" {
+	if !exportable || len(pemKey) == 0 {
+		log.Printf("[DEBUG] Certificate %q has a non-exportable key - skipping decoding the private key", id.Name)
+	} else if *pfx.ContentType == "application/x-pkcs12" {
 		rsakey, err := x509.ParsePKCS1PrivateKey(pemKey)
 		if err != nil {
 			// try to parse as a EC key
@@ -248,15 +256,17 @@ func dataSourceArmKeyVaultCertificateDataRead(d *pluginsdk.ResourceData, meta in
 	}
 
 	// Encode Key and PEM
-	keyBlock := &pem.Block{
-		Type:  pemKeyHeader,
-		Bytes: keyX509,
-	}
-
 	var keyPEM bytes.Buffer
-	err = pem.Encode(&keyPEM, keyBlock)
-	if err != nil {
-		return fmt.Errorf("encoding Key Vault Certificate Key: %+v", err)
+	if privateKey != nil {
+		keyBlock := &pem.Block{
+			Type:  pemKeyHeader,
+			Bytes: keyX509,
+		}
+
+		err = pem.Encode(&keyPEM, keyBlock)
+		if err != nil {
+			return fmt.Errorf("encoding Key Vault Certificate Key: %+v", err)
+		}
 	}
 
 	certs := ""
@@ -730,9 +730,14 @@ func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.Certi
 		}
 	}
 
+	exportable := props["exportable"].(bool)
+	if exportable && (strings.EqualFold(keyType, string(keyvault.RSAHSM)) || strings.EqualFold(keyType, string(keyvault.ECHSM))) {
+		return nil, fmt.Errorf("`exportable` must be set to `false` when `key_type` is `%s`, since HSM-backed keys cannot be exported", keyType)
+	}
+
 	policy.KeyProperties = &keyvault.KeyProperties{
 		Curve:      keyvault.JSONWebKeyCurveName(curve),
-		Exportable: utils.Bool(props["exportable"].(bool)),
+		Exportable: utils.Bool(exportable),
 		KeySize:    utils.Int32(int32(keySize)),
 		KeyType:    keyvault.JSONWebKeyType(keyType),
 		ReuseKey:   utils.Bool(props["reuse_key"].(bool)),
@@ -776,8 +781,13 @@ func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.Certi
 
 	secrets := policyRaw["secret_properties"].([]interface{})
 	secret := secrets[0].(map[string]interface{})
+	contentType := secret["content_type"].(string)
+	// a PFX bundles the private key, which isn't available when the key is non-exportable
+	if !exportable && strings.EqualFold(contentType, "application/x-pkcs12") {
+		return nil, fmt.Errorf("`content_type` within the `secret_properties` block must be `application/x-pem-file` when `exportable` is set to `false`")
+	}
 	policy.SecretProperties = &keyvault.SecretProperties{
-		ContentType: utils.String(secret["content_type"].(string)),
+		ContentType: utils.String(contentType),
 	}
 
 	certificateProperties := policyRaw["x509_certificate_properties"].([]interface{})
@@ -11,13 +11,16 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	keyvaultMgmt "github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -28,6 +31,9 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
+// keyVaultKeyReleasePolicyDefaultContentType is the Content Type of a Key Release Policy written in the JSON format
+const keyVaultKeyReleasePolicyDefaultContentType = "application/json; charset=utf-8"
+
 func resourceKeyVaultKey() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create:   resourceKeyVaultKeyCreate,
@@ -36,6 +42,8 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 		Delete:   resourceKeyVaultKeyDelete,
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(keyVaultKeyReleasePolicyCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -132,6 +140,42 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"exportable": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"release_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"data": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     keyVaultValidate.KeyReleasePolicyData,
+							DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+						},
+
+						"content_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      keyVaultKeyReleasePolicyDefaultContentType,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"immutable": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -207,6 +251,18 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return tf.ImportAsExistsError("azurerm_key_vault_key", *existing.Key.Kid)
 	}
 
+	exportable := d.Get("exportable").(bool)
+	if exportable {
+		// Secure Key Release requires the keys to be HSM-protected, which is only supported by Premium Key Vaults
+		vault, err := keyVaultsClient.KeyVaultClientForSubscription(keyVaultId.SubscriptionId).Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *keyVaultId, err)
+		}
+		if vault.Properties == nil || vault.Properties.Sku == nil || vault.Properties.Sku.Name != keyvaultMgmt.Premium {
+			return fmt.Errorf("exportable Keys with a `release_policy` can only be created in a Key Vault with the `premium` SKU")
+		}
+	}
+
 	keyType := d.Get("key_type").(string)
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
@@ -248,7 +304,14 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
-	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
+	var resp keyvault.KeyBundle
+	if exportable {
+		releasePolicy := expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+		resp, err = azuresdkhacks.NewKeysWorkaroundClient(client).CreateKey(ctx, *keyVaultBaseUri, name, parameters, exportable, releasePolicy)
+	} else {
+		resp, err = client.CreateKey(ctx, *keyVaultBaseUri, name, parameters)
+	}
+	if err != nil {
 		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp.Response) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
 			if err != nil {
@@ -345,8 +408,15 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
-	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
-		return err
+	if d.HasChange("release_policy") {
+		releasePolicy := expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+		if _, err = azuresdkhacks.NewKeysWorkaroundClient(client).UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters, releasePolicy); err != nil {
+			return err
+		}
+	} else {
+		if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+			return err
+		}
 	}
 
 	return resourceKeyVaultKeyRead(d, meta)
@@ -434,6 +504,23 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	releaseProperties, err := azuresdkhacks.NewKeysWorkaroundClient(client).GetKeyReleaseProperties(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving the Release Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	exportable := false
+	if attributes := releaseProperties.Attributes; attributes != nil && attributes.Exportable != nil {
+		exportable = *attributes.Exportable
+	}
+	d.Set("exportable", exportable)
+	releasePolicy, err := flattenKeyVaultKeyReleasePolicy(releaseProperties.ReleasePolicy)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("release_policy", releasePolicy); err != nil {
+		return fmt.Errorf("setting `release_policy`: %+v", err)
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
@@ -575,6 +662,82 @@ func expandKeyVaultKeyOptions(d *pluginsdk.ResourceData) *[]keyvault.JSONWebKeyO
 	return &results
 }
 
+func expandKeyVaultKeyReleasePolicy(input []interface{}) *azuresdkhacks.KeyReleasePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &azuresdkhacks.KeyReleasePolicy{
+		ContentType:   utils.String(raw["content_type"].(string)),
+		Immutable:     utils.Bool(raw["immutable"].(bool)),
+		EncodedPolicy: utils.String(base64.RawURLEncoding.EncodeToString([]byte(raw["data"].(string)))),
+	}
+}
+
+func flattenKeyVaultKeyReleasePolicy(input *azuresdkhacks.KeyReleasePolicy) ([]interface{}, error) {
+	if input == nil || input.EncodedPolicy == nil {
+		return []interface{}{}, nil
+	}
+
+	// the policy is returned Base64URL encoded, but without padding
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*input.EncodedPolicy, "="))
+	if err != nil {
+		return nil, fmt.Errorf("decoding the Release Policy: %+v", err)
+	}
+
+	contentType := keyVaultKeyReleasePolicyDefaultContentType
+	if input.ContentType != nil {
+		contentType = *input.ContentType
+	}
+
+	immutable := false
+	if input.Immutable != nil {
+		immutable = *input.Immutable
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"content_type": contentType,
+			"data":         string(data),
+			"immutable":    immutable,
+		},
+	}, nil
+}
+
+// keyVaultKeyReleasePolicyCustomizeDiff validates that a `release_policy` is only specified for exportable, HSM-protected
+// Keys and that an immutable `release_policy` isn't changed, since these are otherwise only rejected by the API during apply
+func keyVaultKeyReleasePolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	exportable := diff.Get("exportable").(bool)
+	releasePolicy := diff.Get("release_policy").([]interface{})
+
+	if len(releasePolicy) > 0 && !exportable {
+		return fmt.Errorf("`exportable` must be set to `true` when `release_policy` is specified")
+	}
+
+	if exportable {
+		if len(releasePolicy) == 0 {
+			return fmt.Errorf("`release_policy` must be specified when `exportable` is set to `true`")
+		}
+
+		keyType := diff.Get("key_type").(string)
+		if keyType != string(keyvault.RSAHSM) && keyType != string(keyvault.ECHSM) {
+			return fmt.Errorf("`exportable` can only be set to `true` when `key_type` is `%s` or `%s`, got %q", string(keyvault.RSAHSM), string(keyvault.ECHSM), keyType)
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("release_policy") {
+		old, _ := diff.GetChange("release_policy")
+		if oldPolicy := old.([]interface{}); len(oldPolicy) > 0 && oldPolicy[0] != nil {
+			if oldPolicy[0].(map[string]interface{})["immutable"].(bool) {
+				return fmt.Errorf("the `release_policy` can't be changed once `immutable` has been set to `true`")
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 	results := make([]interface{}, 0, len(*input))
 
@@ -3,6 +3,7 @@ package keyvault_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -146,6 +147,42 @@ func TestAccKeyVaultKey_complete(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_releasePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.releasePolicy(data, "sevsnpvm"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exportable").HasValue("true"),
+				check.That(data.ResourceName).Key("release_policy.0.immutable").HasValue("false"),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+		{
+			Config: r.releasePolicy(data, "tdxvm"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+	})
+}
+
+func TestAccKeyVaultKey_releasePolicyNotExportable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.releasePolicyNotExportable(data),
+			ExpectError: regexp.MustCompile("`exportable` must be set to `true` when `release_policy` is specified"),
+		},
+	})
+}
+
 func TestAccKeyVaultKey_softDeleteRecovery(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -510,6 +547,89 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templatePremium(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) releasePolicy(data acceptance.TestData, attestationType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA-HSM"
+  key_size     = 2048
+  exportable   = true
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  release_policy {
+    data = jsonencode({
+      version = "1.0.0"
+      anyOf = [
+        {
+          authority = "https://sharedeus.eus.attest.azure.net"
+          allOf = [
+            {
+              claim  = "x-ms-isolation-tee.x-ms-attestation-type"
+              equals = "%s"
+            },
+          ]
+        },
+      ]
+    })
+  }
+}
+`, r.templatePremium(data), data.RandomString, attestationType)
+}
+
+func (r KeyVaultKeyResource) releasePolicyNotExportable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA-HSM"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+  ]
+
+  release_policy {
+    data = jsonencode({
+      version = "1.0.0"
+      anyOf = [
+        {
+          authority = "https://sharedeus.eus.attest.azure.net"
+          allOf = [
+            {
+              claim  = "x-ms-isolation-tee.x-ms-attestation-type"
+              equals = "sevsnpvm"
+            },
+          ]
+        },
+      ]
+    })
+  }
+}
+`, r.templatePremium(data), data.RandomString)
+}
+
 func (r KeyVaultKeyResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,169 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.2/roleassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct {
+	name string
+}
+
+// NOTE: Role Assignments can only be created within a Managed HSM which has been activated by downloading its
+// Security Domain, which isn't possible from within Terraform - as such these tests run against an existing
+// (activated) Managed HSM, whose URI is specified in the `ARM_TEST_MANAGED_HSM_URI` Environment Variable.
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_URI") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_URI is not set")
+	}
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_URI") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_URI is not set")
+	}
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(_ acceptance.TestData) string {
+			return r.requiresImport()
+		}),
+	})
+}
+
+func TestAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_keyScope(t *testing.T) {
+	if os.Getenv("ARM_TEST_MANAGED_HSM_URI") == "" {
+		t.Skip("Skipping as ARM_TEST_MANAGED_HSM_URI is not set")
+	}
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope").HasValue(fmt.Sprintf("/keys/acctestkey%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func newKeyVaultManagedHardwareSecurityModuleRoleAssignmentResource(t *testing.T) KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource {
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating a UUID: %+v", err)
+	}
+
+	return KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{
+		name: name,
+	}
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := roleassignments.ParseRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmRoleAssignmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) template() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+locals {
+  vault_base_url = "%s"
+
+  # Managed HSM Crypto User
+  role_definition_id = "/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"
+}
+`, os.Getenv("ARM_TEST_MANAGED_HSM_URI"))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "%s"
+  vault_base_url     = local.vault_base_url
+  scope              = "/keys"
+  role_definition_id = local.role_definition_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, r.template(), r.name)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) requiresImport() string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "import" {
+  name               = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.name
+  vault_base_url     = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.vault_base_url
+  scope              = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.scope
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.role_definition_id
+  principal_id       = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.principal_id
+}
+`, r.basic())
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) keyScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "%s"
+  vault_base_url     = local.vault_base_url
+  scope              = "/keys/acctestkey%d"
+  role_definition_id = local.role_definition_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, r.template(), r.name, data.RandomInteger)
+}
@@ -12,9 +12,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultSuppress "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -54,9 +56,10 @@ func resourceKeyVaultSecret() *pluginsdk.Resource {
 			},
 
 			"value": {
-				Type:      pluginsdk.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: keyVaultSuppress.DiffSuppressKeyVaultSecretValue,
 			},
 
 			"content_type": {
@@ -65,15 +68,17 @@ func resourceKeyVaultSecret() *pluginsdk.Resource {
 			},
 
 			"not_before_date": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
 			},
 
 			"expiration_date": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
 			},
 
 			"version": {
@@ -249,7 +254,12 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		secretAttributes.Expires = &expirationUnixTime
 	}
 
-	if d.HasChange("value") {
+	// the `nbf` and `exp` attributes can't be removed from an existing version of a secret, so removing either
+	// of these requires that a new version of the secret is created without them
+	removingDates := (d.HasChange("not_before_date") && d.Get("not_before_date").(string) == "") ||
+		(d.HasChange("expiration_date") && d.Get("expiration_date").(string) == "")
+
+	if d.HasChange("value") || removingDates {
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
@@ -348,15 +358,19 @@ func resourceKeyVaultSecretRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("content_type", resp.ContentType)
 	d.Set("versionless_id", id.VersionlessID())
 
+	notBeforeDate := ""
+	expirationDate := ""
 	if attributes := resp.Attributes; attributes != nil {
 		if v := attributes.NotBefore; v != nil {
-			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
+			notBeforeDate = time.Time(*v).Format(time.RFC3339)
 		}
 
 		if v := attributes.Expires; v != nil {
-			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
+			expirationDate = time.Time(*v).Format(time.RFC3339)
 		}
 	}
+	d.Set("not_before_date", notBeforeDate)
+	d.Set("expiration_date", expirationDate)
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
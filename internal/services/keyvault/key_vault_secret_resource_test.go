@@ -121,6 +121,57 @@ func TestAccKeyVaultSecret_update(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultSecret_datesWithTimeZoneOffset(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
+	r := KeyVaultSecretResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.datesWithTimeZoneOffset(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("not_before_date", "expiration_date"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("not_before_date").HasValue(""),
+				check.That(data.ResourceName).Key("expiration_date").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultSecret_jsonContentType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
+	r := KeyVaultSecretResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.jsonContentType(data, `<<JSON
+{
+  "hello": "world",
+  "rick": [
+    "morty",
+    "summer"
+  ]
+}
+JSON`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// reformatting the JSON document shouldn't result in a diff
+			Config:   r.jsonContentType(data, `jsonencode({ hello = "world", rick = ["morty", "summer"] })`),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccKeyVaultSecret_updatingValueChangedExternally(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "test")
 	r := KeyVaultSecretResource{}
@@ -378,6 +429,41 @@ resource "azurerm_key_vault_secret" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r KeyVaultSecretResource) datesWithTimeZoneOffset(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name            = "secret-%s"
+  value           = "rick-and-morty"
+  key_vault_id    = azurerm_key_vault.test.id
+  not_before_date = "2019-01-01T02:02:03+01:00"
+  expiration_date = "2030-01-01T02:02:03+01:00"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultSecretResource) jsonContentType(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  content_type = "application/json"
+  value        = %s
+}
+`, r.template(data), data.RandomString, value)
+}
+
 func (r KeyVaultSecretResource) basicUpdated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,14 @@
+package roleassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+// RoleAssignmentsClient is a Data Plane client - requests are sent to the Managed HSM specified within the ID
+type RoleAssignmentsClient struct {
+	Client autorest.Client
+}
+
+func NewRoleAssignmentsClient() RoleAssignmentsClient {
+	return RoleAssignmentsClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
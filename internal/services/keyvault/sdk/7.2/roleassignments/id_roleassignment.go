@@ -0,0 +1,70 @@
+package roleassignments
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const roleAssignmentsSegment = "/providers/Microsoft.Authorization/roleAssignments/"
+
+// RoleAssignmentId is a Data Plane ID, comprised of the Base URL of the Managed HSM, the Scope and the Name
+type RoleAssignmentId struct {
+	VaultBaseUrl string
+	Scope        string
+	Name         string
+}
+
+// NewRoleAssignmentID returns a new RoleAssignmentId struct
+func NewRoleAssignmentID(vaultBaseUrl, scope, name string) RoleAssignmentId {
+	return RoleAssignmentId{
+		VaultBaseUrl: strings.TrimSuffix(vaultBaseUrl, "/"),
+		Scope:        scope,
+		Name:         name,
+	}
+}
+
+// ParseRoleAssignmentID parses 'input' into a RoleAssignmentId
+func ParseRoleAssignmentID(input string) (*RoleAssignmentId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URI: %+v", input, err)
+	}
+
+	if uri.Scheme == "" || uri.Host == "" {
+		return nil, fmt.Errorf("expected %q to be an absolute URI", input)
+	}
+
+	segments := strings.Split(uri.Path, roleAssignmentsSegment)
+	if len(segments) != 2 || segments[1] == "" || strings.Contains(segments[1], "/") {
+		return nil, fmt.Errorf("expected the path of %q to be in the format `{scope}%s{name}`", input, roleAssignmentsSegment)
+	}
+
+	scope := segments[0]
+	if scope == "" {
+		scope = "/"
+	}
+
+	id := NewRoleAssignmentID(fmt.Sprintf("%s://%s", uri.Scheme, uri.Host), scope, segments[1])
+	return &id, nil
+}
+
+// Path returns the path of this Role Assignment, relative to the Managed HSM
+func (id RoleAssignmentId) Path() string {
+	return fmt.Sprintf("%s%s%s", strings.TrimSuffix(id.Scope, "/"), roleAssignmentsSegment, id.Name)
+}
+
+// ID returns the formatted Role Assignment ID
+func (id RoleAssignmentId) ID() string {
+	return fmt.Sprintf("%s%s", id.VaultBaseUrl, id.Path())
+}
+
+// String returns a human-readable description of this Role Assignment ID
+func (id RoleAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Vault Base Url: %q", id.VaultBaseUrl),
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Name: %q", id.Name),
+	}
+	return fmt.Sprintf("Managed HSM Role Assignment (%s)", strings.Join(components, "\n"))
+}
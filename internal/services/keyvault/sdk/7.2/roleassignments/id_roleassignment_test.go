@@ -0,0 +1,83 @@
+package roleassignments
+
+import "testing"
+
+func TestParseRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *RoleAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// not a uri
+			Input: "not-a-uri",
+		},
+		{
+			// missing role assignments segment
+			Input: "https://example.managedhsm.azure.net/keys/key1",
+		},
+		{
+			// missing name
+			Input: "https://example.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/",
+		},
+		{
+			// root scope
+			Input: "https://example.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &RoleAssignmentId{
+				VaultBaseUrl: "https://example.managedhsm.azure.net",
+				Scope:        "/",
+				Name:         "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			// keys scope
+			Input: "https://example.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &RoleAssignmentId{
+				VaultBaseUrl: "https://example.managedhsm.azure.net",
+				Scope:        "/keys",
+				Name:         "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			// key scope
+			Input: "https://example.managedhsm.azure.net/keys/key1/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+			Expected: &RoleAssignmentId{
+				VaultBaseUrl: "https://example.managedhsm.azure.net",
+				Scope:        "/keys/key1",
+				Name:         "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.VaultBaseUrl != v.Expected.VaultBaseUrl {
+			t.Fatalf("Expected %q but got %q for VaultBaseUrl", v.Expected.VaultBaseUrl, actual.VaultBaseUrl)
+		}
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID()", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package roleassignments
+
+type RoleAssignmentCreateParameters struct {
+	Properties RoleAssignmentProperties `json:"properties"`
+}
@@ -0,0 +1,43 @@
+package suppress
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DiffSuppressKeyVaultSecretValue suppresses differences in the value of a Key Vault Secret which aren't meaningful
+// for the `content_type` of the Secret - such as whitespace within a JSON document or line endings within a PEM file
+func DiffSuppressKeyVaultSecretValue(_, old, new string, d *pluginsdk.ResourceData) bool {
+	return keyVaultSecretValuesAreEquivalent(d.Get("content_type").(string), old, new)
+}
+
+func keyVaultSecretValuesAreEquivalent(contentType, old, new string) bool {
+	if old == new {
+		return true
+	}
+
+	// strip any parameters (e.g. `; charset=utf-8`) from the media type
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var oldValue, newValue interface{}
+		if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+			return false
+		}
+		if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+			return false
+		}
+		return reflect.DeepEqual(oldValue, newValue)
+
+	case mediaType == "application/x-pem-file" || mediaType == "application/x-pkcs12":
+		// PEM files and base64 encoded PKCS#12 content aren't sensitive to whitespace, which includes line endings
+		// and line wrapping, both of which can be changed when the value is stored
+		return strings.Join(strings.Fields(old), "") == strings.Join(strings.Fields(new), "")
+	}
+
+	return false
+}
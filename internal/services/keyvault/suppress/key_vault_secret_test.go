@@ -0,0 +1,92 @@
+package suppress
+
+import "testing"
+
+func TestKeyVaultSecretValuesAreEquivalent(t *testing.T) {
+	cases := []struct {
+		Name        string
+		ContentType string
+		Old         string
+		New         string
+		Suppress    bool
+	}{
+		{
+			Name:        "identical values",
+			ContentType: "",
+			Old:         "hello",
+			New:         "hello",
+			Suppress:    true,
+		},
+		{
+			Name:        "whitespace is significant without a content type",
+			ContentType: "",
+			Old:         "hello\r\nworld",
+			New:         "hello\nworld",
+			Suppress:    false,
+		},
+		{
+			Name:        "json with different formatting",
+			ContentType: "application/json",
+			Old:         `{"hello":"world","list":[1,2]}`,
+			New:         "{\n  \"list\": [1, 2],\n  \"hello\": \"world\"\n}\n",
+			Suppress:    true,
+		},
+		{
+			Name:        "json with parameters in the content type",
+			ContentType: "Application/JSON; charset=utf-8",
+			Old:         `{"hello":"world"}`,
+			New:         `{ "hello": "world" }`,
+			Suppress:    true,
+		},
+		{
+			Name:        "json with different values",
+			ContentType: "application/json",
+			Old:         `{"hello":"world"}`,
+			New:         `{"hello":"there"}`,
+			Suppress:    false,
+		},
+		{
+			Name:        "invalid json",
+			ContentType: "application/json",
+			Old:         `{"hello":"world"}`,
+			New:         `{"hello":"world"`,
+			Suppress:    false,
+		},
+		{
+			Name:        "pem with different line endings",
+			ContentType: "application/x-pem-file",
+			Old:         "-----BEGIN CERTIFICATE-----\r\nMIIB\r\n-----END CERTIFICATE-----\r\n",
+			New:         "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+			Suppress:    true,
+		},
+		{
+			Name:        "pem with different content",
+			ContentType: "application/x-pem-file",
+			Old:         "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+			New:         "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----",
+			Suppress:    false,
+		},
+		{
+			Name:        "pem with newlines removed",
+			ContentType: "application/x-pem-file",
+			Old:         "-----BEGIN CERTIFICATE-----MIIB-----END CERTIFICATE-----",
+			New:         "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+			Suppress:    true,
+		},
+		{
+			Name:        "pkcs12 with line wrapping",
+			ContentType: "application/x-pkcs12",
+			Old:         "MIIKAgEDMIIJvgYJKoZI",
+			New:         "MIIKAgEDMI\nIJvgYJKoZI\n",
+			Suppress:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		if keyVaultSecretValuesAreEquivalent(tc.ContentType, tc.Old, tc.New) != tc.Suppress {
+			t.Fatalf("Expected %t for %q", tc.Suppress, tc.Name)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// KeyReleasePolicyData validates that the Key Release Policy document is a JSON object containing a `version` and
+// at least one `anyOf` or `allOf` rule, each of which must specify the `authority` the claims are issued by
+func KeyReleasePolicyData(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	policy := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(v), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %+v", k, err))
+		return
+	}
+
+	if version, ok := policy["version"].(string); !ok || version == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a `version`", k))
+	}
+
+	rules := make([]interface{}, 0)
+	for _, operator := range []string{"anyOf", "allOf"} {
+		raw, exists := policy[operator]
+		if !exists {
+			continue
+		}
+
+		items, ok := raw.([]interface{})
+		if !ok || len(items) == 0 {
+			errors = append(errors, fmt.Errorf("`%s` within %q must be a non-empty list", operator, k))
+			continue
+		}
+		rules = append(rules, items...)
+	}
+
+	if len(rules) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one `anyOf` or `allOf` rule", k))
+		return
+	}
+
+	for _, raw := range rules {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("the rules within %q must be JSON objects", k))
+			continue
+		}
+
+		if authority, ok := rule["authority"].(string); !ok || authority == "" {
+			errors = append(errors, fmt.Errorf("each rule within %q must specify the `authority` issuing the attestation claims", k))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package validate
+
+import "testing"
+
+func TestKeyReleasePolicyData(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "not json",
+			ExpectError: true,
+		},
+		{
+			Input:       `["anyOf"]`,
+			ExpectError: true,
+		},
+		{
+			// missing version
+			Input:       `{"anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			ExpectError: true,
+		},
+		{
+			// missing rules
+			Input:       `{"version":"1.0.0"}`,
+			ExpectError: true,
+		},
+		{
+			// empty rules
+			Input:       `{"version":"1.0.0","anyOf":[]}`,
+			ExpectError: true,
+		},
+		{
+			// missing authority
+			Input:       `{"version":"1.0.0","anyOf":[{"allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			ExpectError: true,
+		},
+		{
+			Input:       `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			ExpectError: false,
+		},
+		{
+			Input:       `{"version":"1.0.0","allOf":[{"authority":"https://sharedeus.eus.attest.azure.net","anyOf":[{"claim":"x-ms-compliance-status","equals":"azure-compliant-cvm"}]}]}`,
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := KeyReleasePolicyData(tc.Input, "data")
+		hasError := len(errors) > 0
+
+		if tc.ExpectError && !hasError {
+			t.Fatalf("Expected the Key Release Policy to trigger a validation error for '%s'", tc.Input)
+		}
+		if !tc.ExpectError && hasError {
+			t.Fatalf("Expected the Key Release Policy not to trigger a validation error for '%s': %+v", tc.Input, errors)
+		}
+	}
+}
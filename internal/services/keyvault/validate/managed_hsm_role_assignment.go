@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ManagedHardwareSecurityModuleRoleAssignmentScope validates the scope of a Managed HSM Role Assignment, which is
+// either all objects (`/`), all keys (`/keys`) or a specific key (`/keys/{name}`)
+func ManagedHardwareSecurityModuleRoleAssignmentScope(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^/(keys(/[0-9a-zA-Z-]{1,127})?)?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be one of `/`, `/keys` or `/keys/{name}`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// ManagedHardwareSecurityModuleRoleDefinitionID validates the ID of a built-in or custom Managed HSM Role Definition
+func ManagedHardwareSecurityModuleRoleDefinitionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^/?(Microsoft\.KeyVault/)?providers/Microsoft\.Authorization/roleDefinitions/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a Managed HSM Role Definition ID in the format `/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/{id}`, got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,109 @@
+package validate
+
+import "testing"
+
+func TestManagedHardwareSecurityModuleRoleAssignmentScope(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// all objects
+			input:    "/",
+			expected: true,
+		},
+		{
+			// all keys
+			input:    "/keys",
+			expected: true,
+		},
+		{
+			// a specific key
+			input:    "/keys/my-key1",
+			expected: true,
+		},
+		{
+			// trailing slash
+			input:    "/keys/",
+			expected: false,
+		},
+		{
+			// missing leading slash
+			input:    "keys",
+			expected: false,
+		},
+		{
+			// unsupported collection
+			input:    "/secrets",
+			expected: false,
+		},
+		{
+			// nested key path
+			input:    "/keys/key1/versions",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ManagedHardwareSecurityModuleRoleAssignmentScope(v.input, "scope")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestManagedHardwareSecurityModuleRoleDefinitionID(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// built-in
+			input:    "/Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b",
+			expected: true,
+		},
+		{
+			// built-in, as returned by the API
+			input:    "Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b",
+			expected: true,
+		},
+		{
+			// custom
+			input:    "/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			expected: true,
+		},
+		{
+			// not a uuid
+			input:    "/providers/Microsoft.Authorization/roleDefinitions/reader",
+			expected: false,
+		},
+		{
+			// resource manager role definition
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ManagedHardwareSecurityModuleRoleDefinitionID(v.input, "role_definition_id")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/lab"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplan"
+)
+
+type Client struct {
+	LabClient     *lab.LabClient
+	LabPlanClient *labplan.LabPlanClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	labClient := lab.NewLabClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&labClient.Client, o.ResourceManagerAuthorizer)
+
+	labPlanClient := labplan.NewLabPlanClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&labPlanClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		LabClient:     &labClient,
+		LabPlanClient: &labPlanClient,
+	}
+}
@@ -0,0 +1,935 @@
+package labservice
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/lab"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLabServiceLab() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLabServiceLabCreate,
+		Read:   resourceLabServiceLabRead,
+		Update: resourceLabServiceLabUpdate,
+		Delete: resourceLabServiceLabDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := lab.ParseLabID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LabName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"title": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 120),
+			},
+
+			"security": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"open_access_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"registration_code": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"virtual_machine": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"admin_user": credentialsSchema(true),
+
+						"image_reference": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+
+									"offer": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"publisher": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"sku": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"version": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"sku": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"capacity": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 400),
+									},
+								},
+							},
+						},
+
+						"additional_capability_gpu_drivers_installed": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+
+						"create_option": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  string(lab.CreateOptionImage),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(lab.CreateOptionImage),
+								string(lab.CreateOptionTemplateVM),
+							}, false),
+						},
+
+						"non_admin_user": credentialsSchema(false),
+
+						"shared_password_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+
+						"usage_quota": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "PT0S",
+							ValidateFunc: helpersValidate.ISO8601Duration,
+						},
+					},
+				},
+			},
+
+			"auto_shutdown": autoShutdownSchema(),
+
+			"connection_setting": connectionSchema(),
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"lab_plan_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.LabPlanID,
+			},
+
+			"network": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: networkValidate.SubnetID,
+						},
+
+						"load_balancer_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"roster": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"active_directory_group_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"lms_instance": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"lti_client_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"lti_context_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"lti_roster_endpoint": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func credentialsSchema(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"username": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"password": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceLabServiceLabCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := lab.NewLabID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_lab_service_lab", id.ID())
+	}
+
+	parameters, err := expandLabServiceLab(d)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLabServiceLabRead(d, meta)
+}
+
+func resourceLabServiceLabRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := lab.ParseLabID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		props := model.Properties
+
+		title := ""
+		if props.Title != nil {
+			title = *props.Title
+		}
+		d.Set("title", title)
+
+		description := ""
+		if props.Description != nil {
+			description = *props.Description
+		}
+		d.Set("description", description)
+
+		labPlanId := ""
+		if props.LabPlanId != nil {
+			labPlanId = *props.LabPlanId
+		}
+		d.Set("lab_plan_id", labPlanId)
+
+		if err := d.Set("auto_shutdown", flattenLabAutoShutdownProfile(props.AutoShutdownProfile)); err != nil {
+			return fmt.Errorf("setting `auto_shutdown`: %+v", err)
+		}
+
+		if err := d.Set("connection_setting", flattenLabConnectionProfile(props.ConnectionProfile)); err != nil {
+			return fmt.Errorf("setting `connection_setting`: %+v", err)
+		}
+
+		if err := d.Set("security", flattenLabSecurityProfile(props.SecurityProfile)); err != nil {
+			return fmt.Errorf("setting `security`: %+v", err)
+		}
+
+		if err := d.Set("virtual_machine", flattenLabVirtualMachineProfile(d, props.VirtualMachineProfile)); err != nil {
+			return fmt.Errorf("setting `virtual_machine`: %+v", err)
+		}
+
+		if err := d.Set("network", flattenLabNetworkProfile(props.NetworkProfile)); err != nil {
+			return fmt.Errorf("setting `network`: %+v", err)
+		}
+
+		if err := d.Set("roster", flattenLabRosterProfile(props.RosterProfile)); err != nil {
+			return fmt.Errorf("setting `roster`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceLabServiceLabUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := lab.ParseLabID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters, err := expandLabServiceLab(d)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceLabServiceLabRead(d, meta)
+}
+
+func resourceLabServiceLabDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := lab.ParseLabID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandLabServiceLab(d *pluginsdk.ResourceData) (*lab.Lab, error) {
+	autoShutdownProfile, err := expandLabAutoShutdownProfile(d.Get("auto_shutdown").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `auto_shutdown`: %+v", err)
+	}
+
+	virtualMachineProfile, err := expandLabVirtualMachineProfile(d.Get("virtual_machine").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `virtual_machine`: %+v", err)
+	}
+
+	parameters := lab.Lab{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: lab.LabProperties{
+			AutoShutdownProfile:   *autoShutdownProfile,
+			ConnectionProfile:     expandLabConnectionProfile(d.Get("connection_setting").([]interface{})),
+			NetworkProfile:        expandLabNetworkProfile(d.Get("network").([]interface{})),
+			RosterProfile:         expandLabRosterProfile(d.Get("roster").([]interface{})),
+			SecurityProfile:       expandLabSecurityProfile(d.Get("security").([]interface{})),
+			Title:                 utils.String(d.Get("title").(string)),
+			VirtualMachineProfile: *virtualMachineProfile,
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lab_plan_id"); ok {
+		parameters.Properties.LabPlanId = utils.String(v.(string))
+	}
+
+	return &parameters, nil
+}
+
+func expandLabAutoShutdownProfile(input []interface{}) (*lab.AutoShutdownProfile, error) {
+	shutdownOnDisconnect := lab.EnableStateDisabled
+	shutdownWhenNotConnected := lab.EnableStateDisabled
+	shutdownOnIdle := lab.ShutdownOnIdleModeNone
+	result := lab.AutoShutdownProfile{
+		ShutdownOnDisconnect:     &shutdownOnDisconnect,
+		ShutdownWhenNotConnected: &shutdownWhenNotConnected,
+		ShutdownOnIdle:           &shutdownOnIdle,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &result, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	if err := validateAutoShutdown(v); err != nil {
+		return nil, err
+	}
+
+	if disconnectDelay := v["disconnect_delay"].(string); disconnectDelay != "" {
+		shutdownOnDisconnect = lab.EnableStateEnabled
+		result.DisconnectDelay = utils.String(disconnectDelay)
+	}
+
+	if noConnectDelay := v["no_connect_delay"].(string); noConnectDelay != "" {
+		shutdownWhenNotConnected = lab.EnableStateEnabled
+		result.NoConnectDelay = utils.String(noConnectDelay)
+	}
+
+	if idleMode := v["shutdown_on_idle"].(string); idleMode != "" {
+		shutdownOnIdle = lab.ShutdownOnIdleMode(idleMode)
+		result.IdleDelay = utils.String(v["idle_delay"].(string))
+	}
+
+	return &result, nil
+}
+
+func flattenLabAutoShutdownProfile(input lab.AutoShutdownProfile) []interface{} {
+	disconnectDelay := ""
+	if input.ShutdownOnDisconnect != nil && *input.ShutdownOnDisconnect == lab.EnableStateEnabled && input.DisconnectDelay != nil {
+		disconnectDelay = *input.DisconnectDelay
+	}
+
+	noConnectDelay := ""
+	if input.ShutdownWhenNotConnected != nil && *input.ShutdownWhenNotConnected == lab.EnableStateEnabled && input.NoConnectDelay != nil {
+		noConnectDelay = *input.NoConnectDelay
+	}
+
+	idleDelay := ""
+	shutdownOnIdle := ""
+	if input.ShutdownOnIdle != nil && *input.ShutdownOnIdle != lab.ShutdownOnIdleModeNone {
+		shutdownOnIdle = string(*input.ShutdownOnIdle)
+		if input.IdleDelay != nil {
+			idleDelay = *input.IdleDelay
+		}
+	}
+
+	if disconnectDelay == "" && noConnectDelay == "" && shutdownOnIdle == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disconnect_delay": disconnectDelay,
+			"idle_delay":       idleDelay,
+			"no_connect_delay": noConnectDelay,
+			"shutdown_on_idle": shutdownOnIdle,
+		},
+	}
+}
+
+func expandLabConnectionProfile(input []interface{}) lab.ConnectionProfile {
+	access := map[string]lab.ConnectionType{
+		"client_rdp_access": lab.ConnectionTypeNone,
+		"client_ssh_access": lab.ConnectionTypeNone,
+		"web_rdp_access":    lab.ConnectionTypeNone,
+		"web_ssh_access":    lab.ConnectionTypeNone,
+	}
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		for key := range access {
+			if value := v[key].(string); value != "" {
+				access[key] = lab.ConnectionType(value)
+			}
+		}
+	}
+
+	clientRdpAccess := access["client_rdp_access"]
+	clientSshAccess := access["client_ssh_access"]
+	webRdpAccess := access["web_rdp_access"]
+	webSshAccess := access["web_ssh_access"]
+
+	return lab.ConnectionProfile{
+		ClientRdpAccess: &clientRdpAccess,
+		ClientSshAccess: &clientSshAccess,
+		WebRdpAccess:    &webRdpAccess,
+		WebSshAccess:    &webSshAccess,
+	}
+}
+
+func flattenLabConnectionProfile(input lab.ConnectionProfile) []interface{} {
+	flattenAccess := func(input *lab.ConnectionType) string {
+		if input == nil || *input == lab.ConnectionTypeNone {
+			return ""
+		}
+		return string(*input)
+	}
+
+	result := map[string]interface{}{
+		"client_rdp_access": flattenAccess(input.ClientRdpAccess),
+		"client_ssh_access": flattenAccess(input.ClientSshAccess),
+		"web_rdp_access":    flattenAccess(input.WebRdpAccess),
+		"web_ssh_access":    flattenAccess(input.WebSshAccess),
+	}
+
+	for _, v := range result {
+		if v != "" {
+			return []interface{}{result}
+		}
+	}
+
+	return []interface{}{}
+}
+
+func expandLabSecurityProfile(input []interface{}) lab.SecurityProfile {
+	v := input[0].(map[string]interface{})
+
+	openAccess := lab.EnableStateDisabled
+	if v["open_access_enabled"].(bool) {
+		openAccess = lab.EnableStateEnabled
+	}
+
+	return lab.SecurityProfile{
+		OpenAccess: &openAccess,
+	}
+}
+
+func flattenLabSecurityProfile(input lab.SecurityProfile) []interface{} {
+	registrationCode := ""
+	if input.RegistrationCode != nil {
+		registrationCode = *input.RegistrationCode
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"open_access_enabled": input.OpenAccess != nil && *input.OpenAccess == lab.EnableStateEnabled,
+			"registration_code":   registrationCode,
+		},
+	}
+}
+
+func expandLabVirtualMachineProfile(input []interface{}) (*lab.VirtualMachineProfile, error) {
+	v := input[0].(map[string]interface{})
+
+	imageReference, err := expandLabImageReference(v["image_reference"].([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `image_reference`: %+v", err)
+	}
+
+	installGpuDrivers := lab.EnableStateDisabled
+	if v["additional_capability_gpu_drivers_installed"].(bool) {
+		installGpuDrivers = lab.EnableStateEnabled
+	}
+
+	useSharedPassword := lab.EnableStateDisabled
+	if v["shared_password_enabled"].(bool) {
+		useSharedPassword = lab.EnableStateEnabled
+	}
+
+	skus := v["sku"].([]interface{})
+	sku := skus[0].(map[string]interface{})
+
+	result := lab.VirtualMachineProfile{
+		AdditionalCapabilities: &lab.VirtualMachineAdditionalCapabilities{
+			InstallGpuDrivers: &installGpuDrivers,
+		},
+		AdminUser:      *expandLabCredentials(v["admin_user"].([]interface{})),
+		CreateOption:   lab.CreateOption(v["create_option"].(string)),
+		ImageReference: *imageReference,
+		NonAdminUser:   expandLabCredentials(v["non_admin_user"].([]interface{})),
+		Sku: lab.Sku{
+			Name:     sku["name"].(string),
+			Capacity: utils.Int64(int64(sku["capacity"].(int))),
+		},
+		UsageQuota:        v["usage_quota"].(string),
+		UseSharedPassword: &useSharedPassword,
+	}
+
+	return &result, nil
+}
+
+// expandLabImageReference validates that the image is referenced either by its Resource ID or by its Marketplace
+// details, but not both
+func expandLabImageReference(input []interface{}) (*lab.ImageReference, error) {
+	v := input[0].(map[string]interface{})
+
+	id := v["id"].(string)
+	offer := v["offer"].(string)
+	publisher := v["publisher"].(string)
+	sku := v["sku"].(string)
+	version := v["version"].(string)
+
+	if id != "" {
+		if offer != "" || publisher != "" || sku != "" || version != "" {
+			return nil, fmt.Errorf("`offer`, `publisher`, `sku` and `version` cannot be specified when `id` is set")
+		}
+
+		return &lab.ImageReference{
+			Id: utils.String(id),
+		}, nil
+	}
+
+	if offer == "" || publisher == "" || sku == "" || version == "" {
+		return nil, fmt.Errorf("either `id` or all of `offer`, `publisher`, `sku` and `version` must be specified")
+	}
+
+	return &lab.ImageReference{
+		Offer:     utils.String(offer),
+		Publisher: utils.String(publisher),
+		Sku:       utils.String(sku),
+		Version:   utils.String(version),
+	}, nil
+}
+
+func expandLabCredentials(input []interface{}) *lab.Credentials {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &lab.Credentials{
+		Username: v["username"].(string),
+		Password: utils.String(v["password"].(string)),
+	}
+}
+
+func flattenLabVirtualMachineProfile(d *pluginsdk.ResourceData, input lab.VirtualMachineProfile) []interface{} {
+	installGpuDrivers := false
+	if input.AdditionalCapabilities != nil && input.AdditionalCapabilities.InstallGpuDrivers != nil {
+		installGpuDrivers = *input.AdditionalCapabilities.InstallGpuDrivers == lab.EnableStateEnabled
+	}
+
+	// the passwords aren't returned by the API, so we pull them from the config
+	adminUser := []interface{}{
+		map[string]interface{}{
+			"username": input.AdminUser.Username,
+			"password": d.Get("virtual_machine.0.admin_user.0.password").(string),
+		},
+	}
+
+	nonAdminUser := make([]interface{}, 0)
+	if input.NonAdminUser != nil {
+		nonAdminUser = append(nonAdminUser, map[string]interface{}{
+			"username": input.NonAdminUser.Username,
+			"password": d.Get("virtual_machine.0.non_admin_user.0.password").(string),
+		})
+	}
+
+	capacity := 0
+	if input.Sku.Capacity != nil {
+		capacity = int(*input.Sku.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"admin_user":      adminUser,
+			"image_reference": flattenLabImageReference(d, input.ImageReference),
+			"sku": []interface{}{
+				map[string]interface{}{
+					"name":     input.Sku.Name,
+					"capacity": capacity,
+				},
+			},
+			"additional_capability_gpu_drivers_installed": installGpuDrivers,
+			"create_option":           string(input.CreateOption),
+			"non_admin_user":          nonAdminUser,
+			"shared_password_enabled": input.UseSharedPassword != nil && *input.UseSharedPassword == lab.EnableStateEnabled,
+			"usage_quota":             input.UsageQuota,
+		},
+	}
+}
+
+func flattenLabImageReference(d *pluginsdk.ResourceData, input lab.ImageReference) []interface{} {
+	result := map[string]interface{}{
+		"id":        "",
+		"offer":     "",
+		"publisher": "",
+		"sku":       "",
+		"version":   "",
+	}
+
+	// the API can return both the Resource ID and the Marketplace details of the image, so to avoid a diff we only set
+	// the fields matching how the image was referenced in the config
+	if d.Get("virtual_machine.0.image_reference.0.id").(string) != "" {
+		if input.Id != nil {
+			result["id"] = *input.Id
+		}
+
+		return []interface{}{result}
+	}
+
+	if input.Offer != nil {
+		result["offer"] = *input.Offer
+	}
+
+	if input.Publisher != nil {
+		result["publisher"] = *input.Publisher
+	}
+
+	if input.Sku != nil {
+		result["sku"] = *input.Sku
+	}
+
+	if input.Version != nil {
+		result["version"] = *input.Version
+	}
+
+	return []interface{}{result}
+}
+
+func expandLabNetworkProfile(input []interface{}) *lab.LabNetworkProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := lab.LabNetworkProfile{}
+
+	if subnetId := v["subnet_id"].(string); subnetId != "" {
+		result.SubnetId = utils.String(subnetId)
+	}
+
+	return &result
+}
+
+func flattenLabNetworkProfile(input *lab.LabNetworkProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	loadBalancerId := ""
+	if input.LoadBalancerId != nil {
+		loadBalancerId = *input.LoadBalancerId
+	}
+
+	publicIPId := ""
+	if input.PublicIPId != nil {
+		publicIPId = *input.PublicIPId
+	}
+
+	subnetId := ""
+	if input.SubnetId != nil {
+		subnetId = *input.SubnetId
+	}
+
+	if loadBalancerId == "" && publicIPId == "" && subnetId == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"load_balancer_id": loadBalancerId,
+			"public_ip_id":     publicIPId,
+			"subnet_id":        subnetId,
+		},
+	}
+}
+
+func expandLabRosterProfile(input []interface{}) *lab.RosterProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := lab.RosterProfile{}
+
+	if activeDirectoryGroupId := v["active_directory_group_id"].(string); activeDirectoryGroupId != "" {
+		result.ActiveDirectoryGroupId = utils.String(activeDirectoryGroupId)
+	}
+
+	if lmsInstance := v["lms_instance"].(string); lmsInstance != "" {
+		result.LmsInstance = utils.String(lmsInstance)
+	}
+
+	if ltiClientId := v["lti_client_id"].(string); ltiClientId != "" {
+		result.LtiClientId = utils.String(ltiClientId)
+	}
+
+	if ltiContextId := v["lti_context_id"].(string); ltiContextId != "" {
+		result.LtiContextId = utils.String(ltiContextId)
+	}
+
+	if ltiRosterEndpoint := v["lti_roster_endpoint"].(string); ltiRosterEndpoint != "" {
+		result.LtiRosterEndpoint = utils.String(ltiRosterEndpoint)
+	}
+
+	return &result
+}
+
+func flattenLabRosterProfile(input *lab.RosterProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	activeDirectoryGroupId := ""
+	if input.ActiveDirectoryGroupId != nil {
+		activeDirectoryGroupId = *input.ActiveDirectoryGroupId
+	}
+
+	lmsInstance := ""
+	if input.LmsInstance != nil {
+		lmsInstance = *input.LmsInstance
+	}
+
+	ltiClientId := ""
+	if input.LtiClientId != nil {
+		ltiClientId = *input.LtiClientId
+	}
+
+	ltiContextId := ""
+	if input.LtiContextId != nil {
+		ltiContextId = *input.LtiContextId
+	}
+
+	ltiRosterEndpoint := ""
+	if input.LtiRosterEndpoint != nil {
+		ltiRosterEndpoint = *input.LtiRosterEndpoint
+	}
+
+	if activeDirectoryGroupId == "" && lmsInstance == "" && ltiClientId == "" && ltiContextId == "" && ltiRosterEndpoint == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"active_directory_group_id": activeDirectoryGroupId,
+			"lms_instance":              lmsInstance,
+			"lti_client_id":             ltiClientId,
+			"lti_context_id":            ltiContextId,
+			"lti_roster_endpoint":       ltiRosterEndpoint,
+		},
+	}
+}
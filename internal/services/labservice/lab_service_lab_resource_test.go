@@ -0,0 +1,431 @@
+package labservice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/lab"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceLabResource struct{}
+
+func TestAccLabServiceLab_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password"),
+	})
+}
+
+func TestAccLabServiceLab_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLabServiceLab_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security.0.registration_code").Exists(),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password", "virtual_machine.0.non_admin_user.0.password"),
+	})
+}
+
+func TestAccLabServiceLab_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password", "virtual_machine.0.non_admin_user.0.password"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password", "virtual_machine.0.non_admin_user.0.password"),
+	})
+}
+
+func TestAccLabServiceLab_invalidImageReference(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidImageReference(data),
+			ExpectError: regexp.MustCompile("either `id` or all of `offer`, `publisher`, `sku` and `version` must be specified"),
+		},
+	})
+}
+
+func TestAccLabServiceLab_invalidAutoShutdown(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidAutoShutdown(data),
+			ExpectError: regexp.MustCompile("`idle_delay` must be specified when `shutdown_on_idle` is set"),
+		},
+	})
+}
+
+func (r LabServiceLabResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := lab.ParseLabID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LabService.LabClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LabServiceLabResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-lab-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r LabServiceLabResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title"
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 0
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LabServiceLabResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_lab" "import" {
+  name                = azurerm_lab_service_lab.test.name
+  resource_group_name = azurerm_lab_service_lab.test.resource_group_name
+  location            = azurerm_lab_service_lab.test.location
+  title               = azurerm_lab_service_lab.test.title
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 0
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r LabServiceLabResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_lab_service_plan" "test" {
+  name                = "acctest-lp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allowed_regions     = [azurerm_resource_group.test.location]
+}
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title"
+  description         = "Test Description"
+  lab_plan_id         = azurerm_lab_service_plan.test.id
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    additional_capability_gpu_drivers_installed = false
+    create_option                               = "TemplateVM"
+    shared_password_enabled                     = true
+    usage_quota                                 = "PT10H"
+
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    non_admin_user {
+      username = "testnonadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 1
+    }
+  }
+
+  auto_shutdown {
+    disconnect_delay = "PT15M"
+    idle_delay       = "PT15M"
+    no_connect_delay = "PT15M"
+    shutdown_on_idle = "UserAbsence"
+  }
+
+  connection_setting {
+    client_rdp_access = "Public"
+    client_ssh_access = "Public"
+  }
+
+  roster {
+    lms_instance        = "https://terraform.io/"
+    lti_client_id       = "b4933d9d-a2c9-4e4c-a4e6-89f3b5ac9b1b"
+    lti_context_id      = "b4933d9d-a2c9-4e4c-a4e6-89f3b5ac9b1b"
+    lti_roster_endpoint = "https://terraform.io/"
+  }
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LabServiceLabResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_lab_service_plan" "test" {
+  name                = "acctest-lp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allowed_regions     = [azurerm_resource_group.test.location]
+}
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title Updated"
+  description         = "Test Description Updated"
+  lab_plan_id         = azurerm_lab_service_plan.test.id
+
+  security {
+    open_access_enabled = true
+  }
+
+  virtual_machine {
+    additional_capability_gpu_drivers_installed = false
+    create_option                               = "TemplateVM"
+    shared_password_enabled                     = true
+    usage_quota                                 = "PT11H"
+
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    non_admin_user {
+      username = "testnonadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 2
+    }
+  }
+
+  auto_shutdown {
+    disconnect_delay = "PT16M"
+    idle_delay       = "PT16M"
+    no_connect_delay = "PT16M"
+    shutdown_on_idle = "LowUsage"
+  }
+
+  connection_setting {
+    client_rdp_access = "Private"
+    client_ssh_access = "Private"
+  }
+
+  tags = {
+    Env = "Test2"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LabServiceLabResource) invalidImageReference(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title"
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 0
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LabServiceLabResource) invalidAutoShutdown(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title"
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 0
+    }
+  }
+
+  auto_shutdown {
+    shutdown_on_idle = "UserAbsence"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
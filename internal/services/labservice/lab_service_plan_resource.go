@@ -0,0 +1,386 @@
+package labservice
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplan"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLabServicePlan() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLabServicePlanCreate,
+		Read:   resourceLabServicePlanRead,
+		Update: resourceLabServicePlanUpdate,
+		Delete: resourceLabServicePlanDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := labplan.ParseLabPlanID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LabPlanName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"allowed_regions": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:             pluginsdk.TypeString,
+					StateFunc:        location.StateFunc,
+					DiffSuppressFunc: location.DiffSuppressFunc,
+				},
+			},
+
+			"default_auto_shutdown": autoShutdownSchema(),
+
+			"default_connection": connectionSchema(),
+
+			"default_network_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"shared_gallery_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: computeValidate.SharedImageGalleryID,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceLabServicePlanCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabPlanClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := labplan.NewLabPlanID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_lab_service_plan", id.ID())
+	}
+
+	parameters, err := expandLabServicePlan(d)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLabServicePlanRead(d, meta)
+}
+
+func resourceLabServicePlanRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabPlanClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := labplan.ParseLabPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		props := model.Properties
+
+		allowedRegions := make([]interface{}, 0)
+		if props.AllowedRegions != nil {
+			for _, v := range *props.AllowedRegions {
+				allowedRegions = append(allowedRegions, location.Normalize(v))
+			}
+		}
+		d.Set("allowed_regions", allowedRegions)
+
+		if err := d.Set("default_auto_shutdown", flattenLabPlanAutoShutdownProfile(props.DefaultAutoShutdownProfile)); err != nil {
+			return fmt.Errorf("setting `default_auto_shutdown`: %+v", err)
+		}
+
+		if err := d.Set("default_connection", flattenLabPlanConnectionProfile(props.DefaultConnectionProfile)); err != nil {
+			return fmt.Errorf("setting `default_connection`: %+v", err)
+		}
+
+		subnetId := ""
+		if props.DefaultNetworkProfile != nil && props.DefaultNetworkProfile.SubnetId != nil {
+			subnetId = *props.DefaultNetworkProfile.SubnetId
+		}
+		d.Set("default_network_subnet_id", subnetId)
+
+		sharedGalleryId := ""
+		if props.SharedGalleryId != nil {
+			sharedGalleryId = *props.SharedGalleryId
+		}
+		d.Set("shared_gallery_id", sharedGalleryId)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceLabServicePlanUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabPlanClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := labplan.ParseLabPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters, err := expandLabServicePlan(d)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceLabServicePlanRead(d, meta)
+}
+
+func resourceLabServicePlanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LabService.LabPlanClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := labplan.ParseLabPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandLabServicePlan(d *pluginsdk.ResourceData) (*labplan.LabPlan, error) {
+	autoShutdownProfile, err := expandLabPlanAutoShutdownProfile(d.Get("default_auto_shutdown").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `default_auto_shutdown`: %+v", err)
+	}
+
+	allowedRegions := make([]string, 0)
+	for _, v := range d.Get("allowed_regions").([]interface{}) {
+		allowedRegions = append(allowedRegions, location.Normalize(v.(string)))
+	}
+
+	parameters := labplan.LabPlan{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: labplan.LabPlanProperties{
+			AllowedRegions:             &allowedRegions,
+			DefaultAutoShutdownProfile: autoShutdownProfile,
+			DefaultConnectionProfile:   expandLabPlanConnectionProfile(d.Get("default_connection").([]interface{})),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("default_network_subnet_id"); ok {
+		parameters.Properties.DefaultNetworkProfile = &labplan.LabPlanNetworkProfile{
+			SubnetId: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("shared_gallery_id"); ok {
+		parameters.Properties.SharedGalleryId = utils.String(v.(string))
+	}
+
+	return &parameters, nil
+}
+
+func expandLabPlanAutoShutdownProfile(input []interface{}) (*labplan.AutoShutdownProfile, error) {
+	shutdownOnDisconnect := labplan.EnableStateDisabled
+	shutdownWhenNotConnected := labplan.EnableStateDisabled
+	shutdownOnIdle := labplan.ShutdownOnIdleModeNone
+	result := labplan.AutoShutdownProfile{
+		ShutdownOnDisconnect:     &shutdownOnDisconnect,
+		ShutdownWhenNotConnected: &shutdownWhenNotConnected,
+		ShutdownOnIdle:           &shutdownOnIdle,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &result, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	if err := validateAutoShutdown(v); err != nil {
+		return nil, err
+	}
+
+	if disconnectDelay := v["disconnect_delay"].(string); disconnectDelay != "" {
+		shutdownOnDisconnect = labplan.EnableStateEnabled
+		result.DisconnectDelay = utils.String(disconnectDelay)
+	}
+
+	if noConnectDelay := v["no_connect_delay"].(string); noConnectDelay != "" {
+		shutdownWhenNotConnected = labplan.EnableStateEnabled
+		result.NoConnectDelay = utils.String(noConnectDelay)
+	}
+
+	if idleMode := v["shutdown_on_idle"].(string); idleMode != "" {
+		shutdownOnIdle = labplan.ShutdownOnIdleMode(idleMode)
+		result.IdleDelay = utils.String(v["idle_delay"].(string))
+	}
+
+	return &result, nil
+}
+
+func flattenLabPlanAutoShutdownProfile(input *labplan.AutoShutdownProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	disconnectDelay := ""
+	if input.ShutdownOnDisconnect != nil && *input.ShutdownOnDisconnect == labplan.EnableStateEnabled && input.DisconnectDelay != nil {
+		disconnectDelay = *input.DisconnectDelay
+	}
+
+	noConnectDelay := ""
+	if input.ShutdownWhenNotConnected != nil && *input.ShutdownWhenNotConnected == labplan.EnableStateEnabled && input.NoConnectDelay != nil {
+		noConnectDelay = *input.NoConnectDelay
+	}
+
+	idleDelay := ""
+	shutdownOnIdle := ""
+	if input.ShutdownOnIdle != nil && *input.ShutdownOnIdle != labplan.ShutdownOnIdleModeNone {
+		shutdownOnIdle = string(*input.ShutdownOnIdle)
+		if input.IdleDelay != nil {
+			idleDelay = *input.IdleDelay
+		}
+	}
+
+	if disconnectDelay == "" && noConnectDelay == "" && shutdownOnIdle == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disconnect_delay": disconnectDelay,
+			"idle_delay":       idleDelay,
+			"no_connect_delay": noConnectDelay,
+			"shutdown_on_idle": shutdownOnIdle,
+		},
+	}
+}
+
+func expandLabPlanConnectionProfile(input []interface{}) *labplan.ConnectionProfile {
+	access := map[string]labplan.ConnectionType{
+		"client_rdp_access": labplan.ConnectionTypeNone,
+		"client_ssh_access": labplan.ConnectionTypeNone,
+		"web_rdp_access":    labplan.ConnectionTypeNone,
+		"web_ssh_access":    labplan.ConnectionTypeNone,
+	}
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		for key := range access {
+			if value := v[key].(string); value != "" {
+				access[key] = labplan.ConnectionType(value)
+			}
+		}
+	}
+
+	clientRdpAccess := access["client_rdp_access"]
+	clientSshAccess := access["client_ssh_access"]
+	webRdpAccess := access["web_rdp_access"]
+	webSshAccess := access["web_ssh_access"]
+
+	return &labplan.ConnectionProfile{
+		ClientRdpAccess: &clientRdpAccess,
+		ClientSshAccess: &clientSshAccess,
+		WebRdpAccess:    &webRdpAccess,
+		WebSshAccess:    &webSshAccess,
+	}
+}
+
+func flattenLabPlanConnectionProfile(input *labplan.ConnectionProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	flattenAccess := func(input *labplan.ConnectionType) string {
+		if input == nil || *input == labplan.ConnectionTypeNone {
+			return ""
+		}
+		return string(*input)
+	}
+
+	result := map[string]interface{}{
+		"client_rdp_access": flattenAccess(input.ClientRdpAccess),
+		"client_ssh_access": flattenAccess(input.ClientSshAccess),
+		"web_rdp_access":    flattenAccess(input.WebRdpAccess),
+		"web_ssh_access":    flattenAccess(input.WebSshAccess),
+	}
+
+	for _, v := range result {
+		if v != "" {
+			return []interface{}{result}
+		}
+	}
+
+	return []interface{}{}
+}
@@ -0,0 +1,105 @@
+package labservice
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/lab"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func autoShutdownSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"disconnect_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+
+				"idle_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+
+				"no_connect_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+
+				"shutdown_on_idle": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(lab.ShutdownOnIdleModeLowUsage),
+						string(lab.ShutdownOnIdleModeUserAbsence),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func connectionSchema() *pluginsdk.Schema {
+	connectionTypes := []string{
+		string(lab.ConnectionTypePrivate),
+		string(lab.ConnectionTypePublic),
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_rdp_access": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(connectionTypes, false),
+				},
+
+				"client_ssh_access": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(connectionTypes, false),
+				},
+
+				"web_rdp_access": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(connectionTypes, false),
+				},
+
+				"web_ssh_access": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(connectionTypes, false),
+				},
+			},
+		},
+	}
+}
+
+// validateAutoShutdown ensures that idle shutdown is configured consistently, since shutting down an idle Virtual
+// Machine requires both the mode used to detect idleness and the delay before the Virtual Machine is shut down
+func validateAutoShutdown(input map[string]interface{}) error {
+	idleDelay := input["idle_delay"].(string)
+	shutdownOnIdle := input["shutdown_on_idle"].(string)
+
+	if idleDelay != "" && shutdownOnIdle == "" {
+		return fmt.Errorf("`shutdown_on_idle` must be specified when `idle_delay` is set")
+	}
+
+	if shutdownOnIdle != "" && idleDelay == "" {
+		return fmt.Errorf("`idle_delay` must be specified when `shutdown_on_idle` is set")
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package lab
+
+type ConnectionProfile struct {
+	ClientRdpAccess *ConnectionType `json:"clientRdpAccess,omitempty"`
+	ClientSshAccess *ConnectionType `json:"clientSshAccess,omitempty"`
+	WebRdpAccess    *ConnectionType `json:"webRdpAccess,omitempty"`
+	WebSshAccess    *ConnectionType `json:"webSshAccess,omitempty"`
+}
@@ -0,0 +1,7 @@
+package lab
+
+type LabNetworkProfile struct {
+	LoadBalancerId *string `json:"loadBalancerId,omitempty"`
+	PublicIPId     *string `json:"publicIpId,omitempty"`
+	SubnetId       *string `json:"subnetId,omitempty"`
+}
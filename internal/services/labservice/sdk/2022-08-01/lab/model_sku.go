@@ -0,0 +1,6 @@
+package lab
+
+type Sku struct {
+	Capacity *int64 `json:"capacity,omitempty"`
+	Name     string `json:"name"`
+}
@@ -0,0 +1,5 @@
+package lab
+
+type VirtualMachineAdditionalCapabilities struct {
+	InstallGpuDrivers *EnableState `json:"installGpuDrivers,omitempty"`
+}
@@ -0,0 +1,9 @@
+package lab
+
+import "fmt"
+
+const defaultApiVersion = "2022-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/lab/%s", defaultApiVersion)
+}
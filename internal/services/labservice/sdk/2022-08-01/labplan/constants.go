@@ -0,0 +1,35 @@
+package labplan
+
+type ConnectionType string
+
+const (
+	ConnectionTypeNone    ConnectionType = "None"
+	ConnectionTypePrivate ConnectionType = "Private"
+	ConnectionTypePublic  ConnectionType = "Public"
+)
+
+type EnableState string
+
+const (
+	EnableStateDisabled EnableState = "Disabled"
+	EnableStateEnabled  EnableState = "Enabled"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateLocked    ProvisioningState = "Locked"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+type ShutdownOnIdleMode string
+
+const (
+	ShutdownOnIdleModeLowUsage    ShutdownOnIdleMode = "LowUsage"
+	ShutdownOnIdleModeNone        ShutdownOnIdleMode = "None"
+	ShutdownOnIdleModeUserAbsence ShutdownOnIdleMode = "UserAbsence"
+)
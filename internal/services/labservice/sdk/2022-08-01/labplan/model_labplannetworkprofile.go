@@ -0,0 +1,5 @@
+package labplan
+
+type LabPlanNetworkProfile struct {
+	SubnetId *string `json:"subnetId,omitempty"`
+}
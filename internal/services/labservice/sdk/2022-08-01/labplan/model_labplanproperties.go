@@ -0,0 +1,10 @@
+package labplan
+
+type LabPlanProperties struct {
+	AllowedRegions             *[]string              `json:"allowedRegions,omitempty"`
+	DefaultAutoShutdownProfile *AutoShutdownProfile   `json:"defaultAutoShutdownProfile,omitempty"`
+	DefaultConnectionProfile   *ConnectionProfile     `json:"defaultConnectionProfile,omitempty"`
+	DefaultNetworkProfile      *LabPlanNetworkProfile `json:"defaultNetworkProfile,omitempty"`
+	ProvisioningState          *ProvisioningState     `json:"provisioningState,omitempty"`
+	SharedGalleryId            *string                `json:"sharedGalleryId,omitempty"`
+}
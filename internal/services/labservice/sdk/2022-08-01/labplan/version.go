@@ -0,0 +1,9 @@
+package labplan
+
+import "fmt"
+
+const defaultApiVersion = "2022-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/labplan/%s", defaultApiVersion)
+}
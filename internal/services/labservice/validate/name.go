@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// LabName validates the name of a Lab Service Lab
+func LabName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,99}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 100 characters, begin with a letter or number and may only contain letters, numbers, underscores and hyphens", k))
+	}
+
+	return
+}
+
+// LabPlanName validates the name of a Lab Service Plan
+func LabPlanName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,98}[a-zA-Z0-9_]$|^[a-zA-Z0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 100 characters, begin with a letter or number, end with a letter, number or underscore and may only contain letters, numbers, underscores, periods and hyphens", k))
+	}
+
+	return
+}
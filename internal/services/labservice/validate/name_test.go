@@ -0,0 +1,102 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLabName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "example-lab_1",
+			Expected: true,
+		},
+		{
+			Input:    "-example",
+			Expected: false,
+		},
+		{
+			Input:    "example.lab",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 100),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 101),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := LabName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestLabPlanName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "example-plan.1_",
+			Expected: true,
+		},
+		{
+			Input:    "-example",
+			Expected: false,
+		},
+		{
+			Input:    "example-",
+			Expected: false,
+		},
+		{
+			Input:    "example plan",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 100),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 101),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := LabPlanName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
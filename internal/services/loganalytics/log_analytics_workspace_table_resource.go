@@ -0,0 +1,269 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2023-01-01-preview/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// basicPlanSupportedTables is the list of built-in tables which support the Basic plan,
+// custom tables (with the `_CL` suffix) created via a Data Collection Rule support it too.
+// https://docs.microsoft.com/en-us/azure/azure-monitor/logs/basic-logs-configure#which-tables-support-basic-logs
+var basicPlanSupportedTables = []string{
+	"AACAudit",
+	"AACHttpRequest",
+	"AADDomainServicesDNSAuditsDynamicUpdates",
+	"AADDomainServicesDNSAuditsGeneral",
+	"ACSCallAutomationIncomingOperations",
+	"ACSCallRecordingSummary",
+	"ACSRoomsIncomingOperations",
+	"AppTraces",
+	"AMSLiveEventOperations",
+	"AMSKeyDeliveryRequests",
+	"AMSMediaAccountHealth",
+	"AMSStreamingEndpointRequests",
+	"ContainerAppConsoleLogs",
+	"ContainerLogV2",
+	"DevCenterDiagnosticLogs",
+	"StorageBlobLogs",
+	"StorageFileLogs",
+	"StorageQueueLogs",
+	"StorageTableLogs",
+}
+
+func resourceLogAnalyticsWorkspaceTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := tables.ParseTableID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceTableName,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"plan": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(tables.TablePlanEnumAnalytics),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(tables.TablePlanEnumAnalytics),
+					string(tables.TablePlanEnumAuxiliary),
+					string(tables.TablePlanEnumBasic),
+				}, false),
+			},
+
+			"retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(4, 730),
+			},
+
+			"total_retention_in_days": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validation.IntBetween(4, 730),
+					validation.IntInSlice([]int{1095, 1460, 1826, 2191, 2556, 2922, 3288, 3653, 4018, 4383}),
+				),
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(logAnalyticsWorkspaceTableCustomizeDiff),
+	}
+}
+
+func logAnalyticsWorkspaceTableCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	name := diff.Get("name").(string)
+	plan := diff.Get("plan").(string)
+	retentionInDays := diff.Get("retention_in_days").(int)
+	totalRetentionInDays := diff.Get("total_retention_in_days").(int)
+	isCustomTable := strings.HasSuffix(name, "_CL")
+
+	switch plan {
+	case string(tables.TablePlanEnumBasic):
+		if !isCustomTable && !utils.SliceContainsValue(basicPlanSupportedTables, name) {
+			return fmt.Errorf("the `Basic` plan is only supported for custom tables and the following tables: %s", strings.Join(basicPlanSupportedTables, ", "))
+		}
+		if retentionInDays != 0 {
+			return fmt.Errorf("`retention_in_days` cannot be specified when `plan` is `Basic` since the interactive retention is fixed")
+		}
+
+	case string(tables.TablePlanEnumAuxiliary):
+		if !isCustomTable {
+			return fmt.Errorf("the `Auxiliary` plan is only supported for custom tables")
+		}
+		if retentionInDays != 0 {
+			return fmt.Errorf("`retention_in_days` cannot be specified when `plan` is `Auxiliary` since the interactive retention is fixed")
+		}
+	}
+
+	if retentionInDays != 0 && totalRetentionInDays != 0 && totalRetentionInDays < retentionInDays {
+		return fmt.Errorf("`total_retention_in_days` (%d) must be greater than or equal to `retention_in_days` (%d)", totalRetentionInDays, retentionInDays)
+	}
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, d.Get("name").(string))
+
+	// Tables are either built-in or created through a Data Collection Rule, as such they must exist
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("%s was not found - custom tables must be created before their plan and retention can be managed", id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	plan := tables.TablePlanEnum(d.Get("plan").(string))
+	parameters := tables.Table{
+		Properties: &tables.TableProperties{
+			Plan: &plan,
+			// -1 resets the retention to the workspace default
+			TotalRetentionInDays: utils.Int64(-1),
+		},
+	}
+
+	if plan == tables.TablePlanEnumAnalytics {
+		parameters.Properties.RetentionInDays = utils.Int64(-1)
+		if v := d.Get("retention_in_days").(int); v != 0 {
+			parameters.Properties.RetentionInDays = utils.Int64(int64(v))
+		}
+	}
+
+	if v := d.Get("total_retention_in_days").(int); v != 0 {
+		parameters.Properties.TotalRetentionInDays = utils.Int64(int64(v))
+	}
+
+	// a PATCH is used since the Table already exists, so that only the plan and retention are changed
+	if err := client.UpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			plan := string(tables.TablePlanEnumAnalytics)
+			if props.Plan != nil {
+				plan = string(*props.Plan)
+			}
+			d.Set("plan", plan)
+
+			// the API returns the workspace default when no value is set, which we don't want to surface
+			retentionInDays := 0
+			if plan == string(tables.TablePlanEnumAnalytics) && props.RetentionInDays != nil && (props.RetentionInDaysAsDefault == nil || !*props.RetentionInDaysAsDefault) {
+				retentionInDays = int(*props.RetentionInDays)
+			}
+			d.Set("retention_in_days", retentionInDays)
+
+			totalRetentionInDays := 0
+			if props.TotalRetentionInDays != nil && (props.TotalRetentionInDaysAsDefault == nil || !*props.TotalRetentionInDaysAsDefault) {
+				totalRetentionInDays = int(*props.TotalRetentionInDays)
+			}
+			d.Set("total_retention_in_days", totalRetentionInDays)
+		}
+	}
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Tables can't be removed from a Workspace, instead we reset the retention back to the workspace default.
+	// The plan is left as-is since it can only be changed once per week.
+	parameters := tables.Table{
+		Properties: &tables.TableProperties{
+			TotalRetentionInDays: utils.Int64(-1),
+		},
+	}
+	if d.Get("plan").(string) == string(tables.TablePlanEnumAnalytics) {
+		parameters.Properties.RetentionInDays = utils.Int64(-1)
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("resetting the retention for %s: %+v", *id, err)
+	}
+
+	return nil
+}
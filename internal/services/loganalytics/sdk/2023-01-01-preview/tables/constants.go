@@ -0,0 +1,18 @@
+package tables
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumDeleting   ProvisioningStateEnum = "Deleting"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+	ProvisioningStateEnumUpdating   ProvisioningStateEnum = "Updating"
+)
+
+type TablePlanEnum string
+
+const (
+	TablePlanEnumAnalytics TablePlanEnum = "Analytics"
+	TablePlanEnumAuxiliary TablePlanEnum = "Auxiliary"
+	TablePlanEnumBasic     TablePlanEnum = "Basic"
+)
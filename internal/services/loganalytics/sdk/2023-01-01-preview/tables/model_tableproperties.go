@@ -0,0 +1,12 @@
+package tables
+
+type TableProperties struct {
+	ArchiveRetentionInDays        *int64                 `json:"archiveRetentionInDays,omitempty"`
+	LastPlanModifiedDate          *string                `json:"lastPlanModifiedDate,omitempty"`
+	Plan                          *TablePlanEnum         `json:"plan,omitempty"`
+	ProvisioningState             *ProvisioningStateEnum `json:"provisioningState,omitempty"`
+	RetentionInDays               *int64                 `json:"retentionInDays,omitempty"`
+	RetentionInDaysAsDefault      *bool                  `json:"retentionInDaysAsDefault,omitempty"`
+	TotalRetentionInDays          *int64                 `json:"totalRetentionInDays,omitempty"`
+	TotalRetentionInDaysAsDefault *bool                  `json:"totalRetentionInDaysAsDefault,omitempty"`
+}
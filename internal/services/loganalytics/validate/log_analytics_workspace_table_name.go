@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func LogAnalyticsWorkspaceTableName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,62}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter, can only contain letters, numbers and underscores and must be at most 63 characters", k))
+	}
+
+	return warnings, errors
+}
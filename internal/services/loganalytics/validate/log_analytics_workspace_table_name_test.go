@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLogAnalyticsWorkspaceTableName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{
+			Value: "",
+			Valid: false,
+		},
+		{
+			Value: "AppTraces",
+			Valid: true,
+		},
+		{
+			Value: "ContainerLogV2",
+			Valid: true,
+		},
+		{
+			Value: "MyCustomTable_CL",
+			Valid: true,
+		},
+		{
+			Value: "_MyTable",
+			Valid: false,
+		},
+		{
+			Value: "1Table",
+			Valid: false,
+		},
+		{
+			Value: "My-Table",
+			Valid: false,
+		},
+		{
+			Value: "a" + strings.Repeat("b", 62),
+			Valid: true,
+		},
+		{
+			Value: "a" + strings.Repeat("b", 63),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Value)
+		_, errors := LogAnalyticsWorkspaceTableName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
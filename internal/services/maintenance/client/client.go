@@ -3,11 +3,15 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/maintenance/mgmt/2021-05-01/maintenance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/sdk/2023-04-01/configurationassignments"
 )
 
 type Client struct {
 	ConfigurationsClient           *maintenance.ConfigurationsClient
 	ConfigurationAssignmentsClient *maintenance.ConfigurationAssignmentsClient
+
+	// the dynamic scope assignments are only available in a newer API version
+	SubscriptionConfigurationAssignmentsClient *configurationassignments.ConfigurationAssignmentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +21,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	configurationAssignmentsClient := maintenance.NewConfigurationAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&configurationAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
+	subscriptionConfigurationAssignmentsClient := configurationassignments.NewConfigurationAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&subscriptionConfigurationAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationsClient:           &configurationsClient,
 		ConfigurationAssignmentsClient: &configurationAssignmentsClient,
+
+		SubscriptionConfigurationAssignmentsClient: &subscriptionConfigurationAssignmentsClient,
 	}
 }
@@ -0,0 +1,374 @@
+package maintenance
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/maintenance/mgmt/2021-05-01/maintenance"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/sdk/2023-04-01/configurationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmMaintenanceAssignmentDynamicScope() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmMaintenanceAssignmentDynamicScopeCreateUpdate,
+		Read:   resourceArmMaintenanceAssignmentDynamicScopeRead,
+		Update: resourceArmMaintenanceAssignmentDynamicScopeCreateUpdate,
+		Delete: resourceArmMaintenanceAssignmentDynamicScopeDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := configurationassignments.ParseConfigurationAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,255}$`),
+					"The name must begin with a letter or number, can only contain letters, numbers, underscores, periods and dashes and be up to 256 characters in length.",
+				),
+			},
+
+			"maintenance_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MaintenanceConfigurationID,
+			},
+
+			"filter": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"locations": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:             pluginsdk.TypeString,
+								ValidateFunc:     validation.StringIsNotEmpty,
+								StateFunc:        location.StateFunc,
+								DiffSuppressFunc: location.DiffSuppressFunc,
+							},
+							AtLeastOneOf: dynamicScopeFilterProperties,
+						},
+
+						"os_types": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Linux",
+									"Windows",
+								}, false),
+							},
+							AtLeastOneOf: dynamicScopeFilterProperties,
+						},
+
+						"resource_groups": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							AtLeastOneOf: dynamicScopeFilterProperties,
+						},
+
+						"resource_types": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Microsoft.Compute/virtualMachines",
+									"Microsoft.HybridCompute/machines",
+								}, true),
+							},
+							AtLeastOneOf: dynamicScopeFilterProperties,
+						},
+
+						"tag_filter": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(configurationassignments.TagOperatorsAny),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(configurationassignments.TagOperatorsAll),
+								string(configurationassignments.TagOperatorsAny),
+							}, false),
+						},
+
+						"tags": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"tag": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"values": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+							AtLeastOneOf: dynamicScopeFilterProperties,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var dynamicScopeFilterProperties = []string{
+	"filter.0.locations",
+	"filter.0.os_types",
+	"filter.0.resource_groups",
+	"filter.0.resource_types",
+	"filter.0.tags",
+}
+
+func resourceArmMaintenanceAssignmentDynamicScopeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.SubscriptionConfigurationAssignmentsClient
+	configurationsClient := meta.(*clients.Client).Maintenance.ConfigurationsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := configurationassignments.NewConfigurationAssignmentID(subscriptionId, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.ForSubscriptionsGet(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_maintenance_assignment_dynamic_scope", id.ID())
+		}
+	}
+
+	configurationId, err := parse.MaintenanceConfigurationIDInsensitively(d.Get("maintenance_configuration_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Dynamic Scopes can only be used to assign Guest OS patching
+	configuration, err := configurationsClient.Get(ctx, configurationId.ResourceGroup, configurationId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *configurationId, err)
+	}
+	if props := configuration.ConfigurationProperties; props == nil || props.MaintenanceScope != maintenance.ScopeInGuestPatch {
+		return fmt.Errorf("the Maintenance Configuration %q must have the scope `InGuestPatch` to be assigned to a Dynamic Scope", configurationId.Name)
+	}
+
+	parameters := configurationassignments.ConfigurationAssignment{
+		Name: utils.String(id.Name),
+		Properties: &configurationassignments.ConfigurationAssignmentProperties{
+			MaintenanceConfigurationId: utils.String(configurationId.ID()),
+			ResourceId:                 utils.String(fmt.Sprintf("/subscriptions/%s", subscriptionId)),
+			Filter:                     expandMaintenanceAssignmentDynamicScopeFilter(d.Get("filter").([]interface{})),
+		},
+	}
+
+	if _, err := client.ForSubscriptionsCreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceArmMaintenanceAssignmentDynamicScopeRead(d, meta)
+}
+
+func resourceArmMaintenanceAssignmentDynamicScopeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.SubscriptionConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := configurationassignments.ParseConfigurationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ForSubscriptionsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			maintenanceConfigurationId := ""
+			if props.MaintenanceConfigurationId != nil {
+				configurationId, err := parse.MaintenanceConfigurationIDInsensitively(*props.MaintenanceConfigurationId)
+				if err != nil {
+					return err
+				}
+				maintenanceConfigurationId = configurationId.ID()
+			}
+			d.Set("maintenance_configuration_id", maintenanceConfigurationId)
+
+			if err := d.Set("filter", flattenMaintenanceAssignmentDynamicScopeFilter(props.Filter)); err != nil {
+				return fmt.Errorf("setting `filter`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMaintenanceAssignmentDynamicScopeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.SubscriptionConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := configurationassignments.ParseConfigurationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ForSubscriptionsDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMaintenanceAssignmentDynamicScopeFilter(input []interface{}) *configurationassignments.ConfigurationAssignmentFilterProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	locations := make([]string, 0)
+	for _, v := range raw["locations"].([]interface{}) {
+		locations = append(locations, location.Normalize(v.(string)))
+	}
+
+	tags := make(map[string][]string)
+	for _, v := range raw["tags"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		tag := v.(map[string]interface{})
+		tags[tag["tag"].(string)] = *utils.ExpandStringSlice(tag["values"].([]interface{}))
+	}
+
+	filterOperator := configurationassignments.TagOperators(raw["tag_filter"].(string))
+
+	return &configurationassignments.ConfigurationAssignmentFilterProperties{
+		Locations:      &locations,
+		OsTypes:        utils.ExpandStringSlice(raw["os_types"].([]interface{})),
+		ResourceGroups: utils.ExpandStringSlice(raw["resource_groups"].([]interface{})),
+		ResourceTypes:  utils.ExpandStringSlice(raw["resource_types"].([]interface{})),
+		TagSettings: &configurationassignments.TagSettingsProperties{
+			FilterOperator: &filterOperator,
+			Tags:           &tags,
+		},
+	}
+}
+
+func flattenMaintenanceAssignmentDynamicScopeFilter(input *configurationassignments.ConfigurationAssignmentFilterProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	locations := make([]interface{}, 0)
+	if input.Locations != nil {
+		for _, v := range *input.Locations {
+			locations = append(locations, location.Normalize(v))
+		}
+	}
+
+	tagFilter := string(configurationassignments.TagOperatorsAny)
+	tags := make([]interface{}, 0)
+	if settings := input.TagSettings; settings != nil {
+		if settings.FilterOperator != nil {
+			tagFilter = string(*settings.FilterOperator)
+		}
+
+		if settings.Tags != nil {
+			// sort the keys so that the ordering is consistent
+			keys := make([]string, 0)
+			for k := range *settings.Tags {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			for _, k := range keys {
+				values := (*settings.Tags)[k]
+				tags = append(tags, map[string]interface{}{
+					"tag":    k,
+					"values": utils.FlattenStringSlice(&values),
+				})
+			}
+		}
+	}
+
+	resourceTypes := make([]interface{}, 0)
+	if input.ResourceTypes != nil {
+		for _, v := range *input.ResourceTypes {
+			// the API returns these in lower-case
+			resourceTypes = append(resourceTypes, normalizeDynamicScopeResourceType(v))
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"locations":       locations,
+			"os_types":        utils.FlattenStringSlice(input.OsTypes),
+			"resource_groups": utils.FlattenStringSlice(input.ResourceGroups),
+			"resource_types":  resourceTypes,
+			"tag_filter":      tagFilter,
+			"tags":            tags,
+		},
+	}
+}
+
+func normalizeDynamicScopeResourceType(input string) string {
+	for _, v := range []string{"Microsoft.Compute/virtualMachines", "Microsoft.HybridCompute/machines"} {
+		if strings.EqualFold(input, v) {
+			return v
+		}
+	}
+	return input
+}
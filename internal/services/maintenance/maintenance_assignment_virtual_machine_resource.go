@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/maintenance/mgmt/2021-05-01/maintenance"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -81,6 +82,23 @@ func resourceArmMaintenanceAssignmentVirtualMachineCreate(d *pluginsdk.ResourceD
 	maintenanceConfigurationID := d.Get("maintenance_configuration_id").(string)
 	configurationId, _ := parse.MaintenanceConfigurationIDInsensitively(maintenanceConfigurationID)
 
+	configuration, err := meta.(*clients.Client).Maintenance.ConfigurationsClient.Get(ctx, configurationId.ResourceGroup, configurationId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *configurationId, err)
+	}
+
+	// Guest OS patching is orchestrated by the platform, as such the Virtual Machine must be configured to allow this
+	if props := configuration.ConfigurationProperties; props != nil && props.MaintenanceScope == maintenance.ScopeInGuestPatch {
+		vm, err := meta.(*clients.Client).Compute.VMClient.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *virtualMachineId, err)
+		}
+
+		if !virtualMachineIsPatchedByPlatform(vm) {
+			return fmt.Errorf("the Maintenance Configuration %q has the scope `InGuestPatch` which requires that the Virtual Machine %q has `patch_mode` set to `AutomaticByPlatform`", configurationId.Name, virtualMachineId.Name)
+		}
+	}
+
 	// set assignment name to configuration name
 	assignmentName := configurationId.Name
 	configurationAssignment := maintenance.ConfigurationAssignment{
@@ -185,3 +203,19 @@ func getMaintenanceAssignmentVirtualMachine(ctx context.Context, client *mainten
 	}
 	return resp.Value, nil
 }
+
+func virtualMachineIsPatchedByPlatform(vm compute.VirtualMachine) bool {
+	if vm.VirtualMachineProperties == nil || vm.VirtualMachineProperties.OsProfile == nil {
+		return false
+	}
+
+	osProfile := vm.VirtualMachineProperties.OsProfile
+	if config := osProfile.LinuxConfiguration; config != nil && config.PatchSettings != nil {
+		return config.PatchSettings.PatchMode == compute.LinuxVMGuestPatchModeAutomaticByPlatform
+	}
+	if config := osProfile.WindowsConfiguration; config != nil && config.PatchSettings != nil {
+		return config.PatchSettings.PatchMode == compute.WindowsVMGuestPatchModeAutomaticByPlatform
+	}
+
+	return false
+}
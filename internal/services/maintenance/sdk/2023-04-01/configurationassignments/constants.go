@@ -0,0 +1,8 @@
+package configurationassignments
+
+type TagOperators string
+
+const (
+	TagOperatorsAll TagOperators = "All"
+	TagOperatorsAny TagOperators = "Any"
+)
@@ -0,0 +1,95 @@
+package configurationassignments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ConfigurationAssignmentId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func NewConfigurationAssignmentID(subscriptionId, name string) ConfigurationAssignmentId {
+	return ConfigurationAssignmentId{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+func (id ConfigurationAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Configuration Assignment", segmentsStr)
+}
+
+func (id ConfigurationAssignmentId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Maintenance/configurationAssignments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// ParseConfigurationAssignmentID parses a ConfigurationAssignment ID into an ConfigurationAssignmentId struct
+func ParseConfigurationAssignmentID(input string) (*ConfigurationAssignmentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ConfigurationAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("configurationAssignments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseConfigurationAssignmentIDInsensitively parses an ConfigurationAssignment ID into an ConfigurationAssignmentId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseConfigurationAssignmentID method should be used instead for validation etc.
+func ParseConfigurationAssignmentIDInsensitively(input string) (*ConfigurationAssignmentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ConfigurationAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	// find the correct casing for the 'configurationAssignments' segment
+	configurationAssignmentsKey := "configurationAssignments"
+	for key := range id.Path {
+		if strings.EqualFold(key, configurationAssignmentsKey) {
+			configurationAssignmentsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(configurationAssignmentsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,9 @@
+package configurationassignments
+
+type ConfigurationAssignment struct {
+	Id         *string                            `json:"id,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *ConfigurationAssignmentProperties `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
@@ -0,0 +1,116 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/aad/mgmt/2017-04-01/aad"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `categoryGroup` field within the `logs` of an AAD Diagnostic Setting isn't defined in the SDK, and the
+// `category` field is defined as a non-pointer value which means it's always sent - as such we need to use
+// our own models when creating/updating and retrieving the AAD Diagnostic Setting to be able to set/retrieve this.
+// TODO: this can be removed once the AAD Diagnostic Setting resource is updated to use a newer SDK
+
+type AADDiagnosticSettingsWorkaroundClient struct {
+	sdkClient *aad.DiagnosticSettingsClient
+}
+
+func NewAADDiagnosticSettingsWorkaroundClient(client *aad.DiagnosticSettingsClient) AADDiagnosticSettingsWorkaroundClient {
+	return AADDiagnosticSettingsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type AADDiagnosticSettingsResource struct {
+	autorest.Response `json:"-"`
+	ID                *string                `json:"id,omitempty"`
+	Name              *string                `json:"name,omitempty"`
+	Type              *string                `json:"type,omitempty"`
+	Properties        *AADDiagnosticSettings `json:"properties,omitempty"`
+}
+
+type AADDiagnosticSettings struct {
+	StorageAccountID            *string           `json:"storageAccountId,omitempty"`
+	WorkspaceID                 *string           `json:"workspaceId,omitempty"`
+	EventHubAuthorizationRuleID *string           `json:"eventHubAuthorizationRuleId,omitempty"`
+	EventHubName                *string           `json:"eventHubName,omitempty"`
+	Logs                        *[]AADLogSettings `json:"logs,omitempty"`
+}
+
+type AADLogSettings struct {
+	Category        *string              `json:"category,omitempty"`
+	CategoryGroup   *string              `json:"categoryGroup,omitempty"`
+	Enabled         *bool                `json:"enabled,omitempty"`
+	RetentionPolicy *aad.RetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the diagnostic setting for AadIam, including the `categoryGroup` of the logs.
+// Parameters:
+// parameters - parameters supplied to create or update the diagnostic setting.
+// name - the name of the diagnostic setting.
+func (client AADDiagnosticSettingsWorkaroundClient) CreateOrUpdate(ctx context.Context, parameters AADDiagnosticSettingsResource, name string) (result AADDiagnosticSettingsResource, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, aad.DiagnosticSettingsResource{}, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	// replace the (empty) body generated by the SDK with our own model
+	req, err = autorest.Prepare(req, autorest.WithJSON(parameters))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Get gets the active diagnostic setting for AadIam, including the `categoryGroup` of the logs.
+// Parameters:
+// name - the name of the diagnostic setting.
+func (client AADDiagnosticSettingsWorkaroundClient) Get(ctx context.Context, name string) (result AADDiagnosticSettingsResource, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.responder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "aad.DiagnosticSettingsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (client AADDiagnosticSettingsWorkaroundClient) responder(resp *http.Response) (result AADDiagnosticSettingsResource, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
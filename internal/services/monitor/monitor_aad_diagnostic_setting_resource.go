@@ -14,6 +14,7 @@ import (
 	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
 	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -82,24 +83,15 @@ func resourceMonitorAADDiagnosticSetting() *pluginsdk.Resource {
 			},
 
 			"log": {
-				Type:     pluginsdk.TypeSet,
-				Required: true,
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"enabled_log", "log"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(aad.AuditLogs),
-								string(aad.SignInLogs),
-								"ADFSSignInLogs",
-								"ManagedIdentitySignInLogs",
-								"NonInteractiveUserSignInLogs",
-								"ProvisioningLogs",
-								"ServicePrincipalSignInLogs",
-								"RiskyUsers",
-								"UserRiskEvents",
-							}, false),
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(monitorAADDiagnosticSettingLogCategories(), false),
 						},
 
 						"enabled": {
@@ -132,12 +124,71 @@ func resourceMonitorAADDiagnosticSetting() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"enabled_log": {
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"enabled_log", "log"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"category": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(monitorAADDiagnosticSettingLogCategories(), false),
+						},
+
+						"category_group": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"allLogs",
+							}, false),
+						},
+
+						"retention_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"days": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+										Default:      0,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func monitorAADDiagnosticSettingLogCategories() []string {
+	return []string{
+		string(aad.AuditLogs),
+		string(aad.SignInLogs),
+		"ADFSSignInLogs",
+		"ManagedIdentitySignInLogs",
+		"NonInteractiveUserSignInLogs",
+		"ProvisioningLogs",
+		"ServicePrincipalSignInLogs",
+		"RiskyUsers",
+		"UserRiskEvents",
+	}
+}
+
 func resourceMonitorAADDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AADDiagnosticSettingsClient
+	client := azuresdkhacks.NewAADDiagnosticSettingsWorkaroundClient(meta.(*clients.Client).Monitor.AADDiagnosticSettingsClient)
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM AAD Diagnostic Setting.")
@@ -158,6 +209,13 @@ func resourceMonitorAADDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData,
 	}
 
 	logs := expandMonitorAADDiagnosticsSettingsLogs(d.Get("log").(*pluginsdk.Set).List())
+	if v, ok := d.GetOk("enabled_log"); ok {
+		expanded, err := expandMonitorAADDiagnosticsSettingsEnabledLogs(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		logs = expanded
+	}
 
 	// If there is no `enabled` log entry, the PUT will succeed while the next GET will return a 404.
 	// Therefore, ensure users has at least one enabled log entry.
@@ -169,11 +227,11 @@ func resourceMonitorAADDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 	if !valid {
-		return fmt.Errorf("At least one of the `log` of the %s should be enabled", id)
+		return fmt.Errorf("At least one of the `enabled_log` or `log` of the %s should be enabled", id)
 	}
 
-	properties := aad.DiagnosticSettingsResource{
-		DiagnosticSettings: &aad.DiagnosticSettings{
+	properties := azuresdkhacks.AADDiagnosticSettingsResource{
+		Properties: &azuresdkhacks.AADDiagnosticSettings{
 			Logs: &logs,
 		},
 	}
@@ -181,18 +239,20 @@ func resourceMonitorAADDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData,
 	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
 	eventHubName := d.Get("eventhub_name").(string)
 	if eventHubAuthorizationRuleId != "" {
-		properties.DiagnosticSettings.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
-		properties.DiagnosticSettings.EventHubName = utils.String(eventHubName)
+		properties.Properties.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
+		properties.Properties.EventHubName = utils.String(eventHubName)
+	} else if eventHubName != "" {
+		return fmt.Errorf("`eventhub_authorization_rule_id` must be set for `eventhub_name` to be used")
 	}
 
 	workspaceId := d.Get("log_analytics_workspace_id").(string)
 	if workspaceId != "" {
-		properties.DiagnosticSettings.WorkspaceID = utils.String(workspaceId)
+		properties.Properties.WorkspaceID = utils.String(workspaceId)
 	}
 
 	storageAccountId := d.Get("storage_account_id").(string)
 	if storageAccountId != "" {
-		properties.DiagnosticSettings.StorageAccountID = utils.String(storageAccountId)
+		properties.Properties.StorageAccountID = utils.String(storageAccountId)
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, properties, id.Name); err != nil {
@@ -205,7 +265,7 @@ func resourceMonitorAADDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData,
 }
 
 func resourceMonitorAADDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AADDiagnosticSettingsClient
+	client := azuresdkhacks.NewAADDiagnosticSettingsWorkaroundClient(meta.(*clients.Client).Monitor.AADDiagnosticSettingsClient)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -227,42 +287,69 @@ func resourceMonitorAADDiagnosticSettingRead(d *pluginsdk.ResourceData, meta int
 
 	d.Set("name", id.Name)
 
-	d.Set("eventhub_name", resp.EventHubName)
-	eventhubAuthorizationRuleId := ""
-	if resp.EventHubAuthorizationRuleID != nil && *resp.EventHubAuthorizationRuleID != "" {
-		parsedId, err := eventhubParse.NamespaceAuthorizationRuleIDInsensitively(*resp.EventHubAuthorizationRuleID)
-		if err != nil {
-			return err
+	if props := resp.Properties; props != nil {
+		d.Set("eventhub_name", props.EventHubName)
+		eventhubAuthorizationRuleId := ""
+		if props.EventHubAuthorizationRuleID != nil && *props.EventHubAuthorizationRuleID != "" {
+			parsedId, err := eventhubParse.NamespaceAuthorizationRuleIDInsensitively(*props.EventHubAuthorizationRuleID)
+			if err != nil {
+				return err
+			}
+
+			eventhubAuthorizationRuleId = parsedId.ID()
 		}
+		d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
 
-		eventhubAuthorizationRuleId = parsedId.ID()
-	}
-	d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
+		workspaceId := ""
+		if props.WorkspaceID != nil && *props.WorkspaceID != "" {
+			parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*props.WorkspaceID)
+			if err != nil {
+				return err
+			}
 
-	workspaceId := ""
-	if resp.WorkspaceID != nil && *resp.WorkspaceID != "" {
-		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*resp.WorkspaceID)
-		if err != nil {
-			return err
+			workspaceId = parsedId.ID()
 		}
+		d.Set("log_analytics_workspace_id", workspaceId)
 
-		workspaceId = parsedId.ID()
-	}
-	d.Set("log_analytics_workspace_id", workspaceId)
+		storageAccountId := ""
+		if props.StorageAccountID != nil && *props.StorageAccountID != "" {
+			parsedId, err := storageParse.StorageAccountID(*props.StorageAccountID)
+			if err != nil {
+				return err
+			}
 
-	storageAccountId := ""
-	if resp.StorageAccountID != nil && *resp.StorageAccountID != "" {
-		parsedId, err := storageParse.StorageAccountID(*resp.StorageAccountID)
-		if err != nil {
-			return err
+			storageAccountId = parsedId.ID()
+		}
+		d.Set("storage_account_id", storageAccountId)
+
+		// only the block which is being used is populated to avoid a diff on the other block - when importing
+		// the `enabled_log` block is used when a Category Group is configured, since this can't be represented in `log`
+		configuredEnabledLogs := d.Get("enabled_log").(*pluginsdk.Set).List()
+		useEnabledLog := len(configuredEnabledLogs) > 0
+		if !useEnabledLog && len(d.Get("log").(*pluginsdk.Set).List()) == 0 && props.Logs != nil {
+			for _, v := range *props.Logs {
+				if v.CategoryGroup != nil && *v.CategoryGroup != "" {
+					useEnabledLog = true
+					break
+				}
+			}
 		}
 
-		storageAccountId = parsedId.ID()
-	}
-	d.Set("storage_account_id", storageAccountId)
-
-	if err := d.Set("log", flattenMonitorAADDiagnosticLogs(resp.Logs)); err != nil {
-		return fmt.Errorf("setting `log`: %+v", err)
+		if useEnabledLog {
+			if err := d.Set("enabled_log", flattenMonitorAADDiagnosticEnabledLogs(props.Logs, configuredEnabledLogs)); err != nil {
+				return fmt.Errorf("setting `enabled_log`: %+v", err)
+			}
+			if err := d.Set("log", make([]interface{}, 0)); err != nil {
+				return fmt.Errorf("setting `log`: %+v", err)
+			}
+		} else {
+			if err := d.Set("log", flattenMonitorAADDiagnosticLogs(props.Logs)); err != nil {
+				return fmt.Errorf("setting `log`: %+v", err)
+			}
+			if err := d.Set("enabled_log", make([]interface{}, 0)); err != nil {
+				return fmt.Errorf("setting `enabled_log`: %+v", err)
+			}
+		}
 	}
 
 	return nil
@@ -318,8 +405,8 @@ func monitorAADDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *
 	}
 }
 
-func expandMonitorAADDiagnosticsSettingsLogs(input []interface{}) []aad.LogSettings {
-	results := make([]aad.LogSettings, 0)
+func expandMonitorAADDiagnosticsSettingsLogs(input []interface{}) []azuresdkhacks.AADLogSettings {
+	results := make([]azuresdkhacks.AADLogSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
@@ -331,8 +418,8 @@ func expandMonitorAADDiagnosticsSettingsLogs(input []interface{}) []aad.LogSetti
 		retentionDays := policyRaw["days"].(int)
 		retentionEnabled := policyRaw["enabled"].(bool)
 
-		output := aad.LogSettings{
-			Category: aad.Category(category),
+		output := azuresdkhacks.AADLogSettings{
+			Category: utils.String(category),
 			Enabled:  utils.Bool(enabled),
 			RetentionPolicy: &aad.RetentionPolicy{
 				Days:    utils.Int32(int32(retentionDays)),
@@ -346,44 +433,146 @@ func expandMonitorAADDiagnosticsSettingsLogs(input []interface{}) []aad.LogSetti
 	return results
 }
 
-func flattenMonitorAADDiagnosticLogs(input *[]aad.LogSettings) []interface{} {
+func expandMonitorAADDiagnosticsSettingsEnabledLogs(input []interface{}) ([]azuresdkhacks.AADLogSettings, error) {
+	results := make([]azuresdkhacks.AADLogSettings, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+		if (category == "" && categoryGroup == "") || (category != "" && categoryGroup != "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within an `enabled_log` block")
+		}
+
+		output := azuresdkhacks.AADLogSettings{
+			Enabled: utils.Bool(true),
+			RetentionPolicy: &aad.RetentionPolicy{
+				Days:    utils.Int32(0),
+				Enabled: utils.Bool(false),
+			},
+		}
+
+		if category != "" {
+			output.Category = utils.String(category)
+		} else {
+			output.CategoryGroup = utils.String(categoryGroup)
+		}
+
+		if policiesRaw := v["retention_policy"].([]interface{}); len(policiesRaw) > 0 && policiesRaw[0] != nil {
+			policyRaw := policiesRaw[0].(map[string]interface{})
+			output.RetentionPolicy = &aad.RetentionPolicy{
+				Days:    utils.Int32(int32(policyRaw["days"].(int))),
+				Enabled: utils.Bool(policyRaw["enabled"].(bool)),
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
+
+func flattenMonitorAADDiagnosticLogs(input *[]azuresdkhacks.AADLogSettings) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, v := range *input {
-		category := string(v.Category)
+		// Category Groups are only exposed via the `enabled_log` block
+		if v.Category == nil || *v.Category == "" {
+			continue
+		}
 
 		enabled := false
 		if v.Enabled != nil {
 			enabled = *v.Enabled
 		}
 
-		policies := make([]interface{}, 0)
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			days := 0
-			if inputPolicy.Days != nil {
-				days = int(*inputPolicy.Days)
-			}
+		results = append(results, map[string]interface{}{
+			"category":         *v.Category,
+			"enabled":          enabled,
+			"retention_policy": flattenMonitorAADDiagnosticRetentionPolicy(v.RetentionPolicy),
+		})
+	}
 
-			enabled := false
-			if inputPolicy.Enabled != nil {
-				enabled = *inputPolicy.Enabled
+	return results
+}
+
+func flattenMonitorAADDiagnosticEnabledLogs(input *[]azuresdkhacks.AADLogSettings, configured []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// when a Category Group is enabled Azure also returns each of the Categories within that Group as enabled,
+	// as such we only surface the Categories which have been explicitly configured to avoid a perpetual diff
+	categoryGroupEnabled := false
+	for _, v := range *input {
+		if v.CategoryGroup != nil && *v.CategoryGroup != "" && v.Enabled != nil && *v.Enabled {
+			categoryGroupEnabled = true
+			break
+		}
+	}
+	configuredCategories := make(map[string]struct{})
+	for _, raw := range configured {
+		if v, ok := raw.(map[string]interface{}); ok {
+			if category := v["category"].(string); category != "" {
+				configuredCategories[category] = struct{}{}
 			}
+		}
+	}
 
-			policies = append(policies, map[string]interface{}{
-				"days":    days,
-				"enabled": enabled,
-			})
+	for _, v := range *input {
+		if v.Enabled == nil || !*v.Enabled {
+			continue
+		}
+
+		category := ""
+		if v.Category != nil {
+			category = *v.Category
+		}
+		categoryGroup := ""
+		if v.CategoryGroup != nil {
+			categoryGroup = *v.CategoryGroup
+		}
+
+		if category != "" && categoryGroupEnabled {
+			if _, ok := configuredCategories[category]; !ok {
+				continue
+			}
 		}
 
 		results = append(results, map[string]interface{}{
 			"category":         category,
-			"enabled":          enabled,
-			"retention_policy": policies,
+			"category_group":   categoryGroup,
+			"retention_policy": flattenMonitorAADDiagnosticRetentionPolicy(v.RetentionPolicy),
 		})
 	}
 
 	return results
 }
+
+func flattenMonitorAADDiagnosticRetentionPolicy(input *aad.RetentionPolicy) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"days":    days,
+			"enabled": enabled,
+		},
+	}
+}
@@ -21,6 +21,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var activityLogAlertResourceHealthStatuses = []string{
+	"Available",
+	"Degraded",
+	"Unavailable",
+	"Unknown",
+}
+
 func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMonitorActivityLogAlertCreateUpdate,
@@ -203,7 +210,48 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 									},
 								},
 							},
-							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.status", "criteria.0.sub_status", "criteria.0.recommendation_impact", "criteria.0.resource_provider", "criteria.0.resource_type", "criteria.0.operation_name", "criteria.0.caller", "criteria.0.operation_name"},
+							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.status", "criteria.0.sub_status", "criteria.0.recommendation_impact", "criteria.0.resource_provider", "criteria.0.resource_type", "criteria.0.operation_name", "criteria.0.caller", "criteria.0.operation_name", "criteria.0.resource_health"},
+						},
+						"resource_health": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"current": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice(activityLogAlertResourceHealthStatuses, false),
+										},
+										Set: pluginsdk.HashString,
+									},
+									"previous": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice(activityLogAlertResourceHealthStatuses, false),
+										},
+										Set: pluginsdk.HashString,
+									},
+									"reason": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"PlatformInitiated",
+												"UserInitiated",
+												"Unknown",
+											}, false),
+										},
+										Set: pluginsdk.HashString,
+									},
+								},
+							},
+							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.recommendation_type", "criteria.0.service_health"},
 						},
 					},
 				},
@@ -274,6 +322,10 @@ func resourceMonitorActivityLogAlertCreateUpdate(d *pluginsdk.ResourceData, meta
 	criteriaRaw := d.Get("criteria").([]interface{})
 	actionRaw := d.Get("action").(*pluginsdk.Set).List()
 
+	if err := validateMonitorActivityLogAlertCriteria(criteriaRaw); err != nil {
+		return err
+	}
+
 	t := d.Get("tags").(map[string]interface{})
 	expandedTags := tags.Expand(t)
 
@@ -444,6 +496,10 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.AlertR
 		conditions = expandServiceHealth(serviceHealth, conditions)
 	}
 
+	if resourceHealth := v["resource_health"].([]interface{}); len(resourceHealth) > 0 {
+		conditions = expandResourceHealth(resourceHealth, conditions)
+	}
+
 	return &insights.AlertRuleAllOfCondition{
 		AllOf: &conditions,
 	}
@@ -489,6 +545,42 @@ func expandServiceHealth(serviceHealth []interface{}, conditions []insights.Aler
 	return conditions
 }
 
+func expandResourceHealth(resourceHealth []interface{}, conditions []insights.AlertRuleAnyOfOrLeafCondition) []insights.AlertRuleAnyOfOrLeafCondition {
+	for _, item := range resourceHealth {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		fields := []struct {
+			key   string
+			field string
+		}{
+			{key: "current", field: "properties.currentHealthStatus"},
+			{key: "previous", field: "properties.previousHealthStatus"},
+			{key: "reason", field: "properties.cause"},
+		}
+		for _, f := range fields {
+			values := v[f.key].(*pluginsdk.Set).List()
+			if len(values) == 0 {
+				continue
+			}
+
+			ruleLeafCondition := make([]insights.AlertRuleLeafCondition, 0)
+			for _, value := range values {
+				ruleLeafCondition = append(ruleLeafCondition, insights.AlertRuleLeafCondition{
+					Field:  utils.String(f.field),
+					Equals: utils.String(value.(string)),
+				})
+			}
+			conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+				AnyOf: &ruleLeafCondition,
+			})
+		}
+	}
+	return conditions
+}
+
 func expandMonitorActivityLogAlertAction(input []interface{}) *insights.ActionList {
 	actions := make([]insights.ActionGroup, 0)
 	for _, item := range input {
@@ -548,6 +640,10 @@ func flattenMonitorActivityLogAlertCriteria(input *insights.AlertRuleAllOfCondit
 		flattenMonitorActivityLogAlertServiceHealth(input, result)
 	}
 
+	if result["category"] == "ResourceHealth" {
+		flattenMonitorActivityLogAlertResourceHealth(input, result)
+	}
+
 	return []interface{}{result}
 }
 
@@ -562,7 +658,7 @@ func flattenMonitorActivityLogAlertServiceHealth(input *insights.AlertRuleAllOfC
 				shResult["services"] = *condition.ContainsAny
 			}
 		}
-		if condition.Field == nil && len(*condition.AnyOf) > 0 {
+		if condition.Field == nil && condition.AnyOf != nil && len(*condition.AnyOf) > 0 {
 			events := []string{}
 			for _, evCond := range *condition.AnyOf {
 				if evCond.Field != nil && evCond.Equals != nil {
@@ -576,6 +672,67 @@ func flattenMonitorActivityLogAlertServiceHealth(input *insights.AlertRuleAllOfC
 	result["service_health"] = []interface{}{shResult}
 }
 
+func flattenMonitorActivityLogAlertResourceHealth(input *insights.AlertRuleAllOfCondition, result map[string]interface{}) {
+	rhResult := make(map[string]interface{})
+	for _, condition := range *input.AllOf {
+		if condition.Field != nil || condition.AnyOf == nil {
+			continue
+		}
+
+		for _, leaf := range *condition.AnyOf {
+			if leaf.Field == nil || leaf.Equals == nil {
+				continue
+			}
+
+			key := ""
+			switch strings.ToLower(*leaf.Field) {
+			case "properties.currenthealthstatus":
+				key = "current"
+			case "properties.previoushealthstatus":
+				key = "previous"
+			case "properties.cause":
+				key = "reason"
+			default:
+				continue
+			}
+
+			values, _ := rhResult[key].([]string)
+			rhResult[key] = append(values, *leaf.Equals)
+		}
+	}
+
+	if len(rhResult) > 0 {
+		result["resource_health"] = []interface{}{rhResult}
+	}
+}
+
+// validateMonitorActivityLogAlertCriteria ensures the category specific criteria are only used with their matching category
+func validateMonitorActivityLogAlertCriteria(input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+	category := v["category"].(string)
+
+	if serviceHealth := v["service_health"].([]interface{}); len(serviceHealth) > 0 && serviceHealth[0] != nil && category != "ServiceHealth" {
+		return fmt.Errorf("`criteria.0.service_health` can only be specified when `criteria.0.category` is `ServiceHealth`")
+	}
+
+	if resourceHealth := v["resource_health"].([]interface{}); len(resourceHealth) > 0 && resourceHealth[0] != nil && category != "ResourceHealth" {
+		return fmt.Errorf("`criteria.0.resource_health` can only be specified when `criteria.0.category` is `ResourceHealth`")
+	}
+
+	if category != "Recommendation" {
+		for _, key := range []string{"recommendation_category", "recommendation_impact", "recommendation_type"} {
+			if v[key].(string) != "" {
+				return fmt.Errorf("`criteria.0.%s` can only be specified when `criteria.0.category` is `Recommendation`", key)
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenMonitorActivityLogAlertAction(input *insights.ActionList) (result []interface{}) {
 	result = make([]interface{}, 0)
 	if input == nil || input.ActionGroups == nil {
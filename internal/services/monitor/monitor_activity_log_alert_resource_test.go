@@ -3,6 +3,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -210,6 +211,36 @@ func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndDelete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ResourceHealth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceHealth(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.current.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.previous.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.reason.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActivityLogAlert_ResourceHealthWithInvalidCategory(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.resourceHealthWithInvalidCategory(data),
+			ExpectError: regexp.MustCompile("`criteria.0.resource_health` can only be specified when `criteria.0.category` is `ResourceHealth`"),
+		},
+	})
+}
+
 func (MonitorActivityLogAlertResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -570,3 +601,71 @@ func (t MonitorActivityLogAlertResource) Exists(ctx context.Context, clients *cl
 
 	return utils.Bool(resp.ID != nil), nil
 }
+
+func (MonitorActivityLogAlertResource) resourceHealth(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category = "ResourceHealth"
+    resource_health {
+      current  = ["Degraded", "Unavailable"]
+      previous = ["Available"]
+      reason   = ["PlatformInitiated", "UserInitiated"]
+    }
+  }
+
+  action {
+    action_group_id = azurerm_monitor_action_group.test.id
+
+    webhook_properties = {
+      from = "terraform test"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (MonitorActivityLogAlertResource) resourceHealthWithInvalidCategory(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category = "Administrative"
+    resource_health {
+      current = ["Unavailable"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,369 @@
+package monitor
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// dataSourceMonitorScheduledQueryRules lists the classic (Log Search) Scheduled Query Rules with an Alerting Action
+// within a Resource Group, exposing each rule using the field names used by the Scheduled Query Rules Alert V2 API
+// so that these can be migrated.
+func dataSourceMonitorScheduledQueryRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMonitorScheduledQueryRulesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"rules": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"action_groups": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"custom_webhook_payload": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"email_subject": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"authorized_resource_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"criteria": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"query": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"threshold": {
+										Type:     pluginsdk.TypeFloat,
+										Computed: true,
+									},
+
+									"metric_trigger": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"metric_column": {
+													Type:     pluginsdk.TypeString,
+													Computed: true,
+												},
+
+												"metric_trigger_type": {
+													Type:     pluginsdk.TypeString,
+													Computed: true,
+												},
+
+												"operator": {
+													Type:     pluginsdk.TypeString,
+													Computed: true,
+												},
+
+												"threshold": {
+													Type:     pluginsdk.TypeFloat,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"evaluation_frequency": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"mute_actions_after_alert_duration": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"scopes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"severity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"window_duration": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorScheduledQueryRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := resourceParse.NewResourceGroupID(subscriptionId, d.Get("resource_group_name").(string))
+
+	resp, err := client.ListByResourceGroup(ctx, id.ResourceGroup, "")
+	if err != nil {
+		return fmt.Errorf("listing Monitor Scheduled Query Rules within %s: %+v", id, err)
+	}
+
+	rules, err := flattenMonitorScheduledQueryRules(resp.Value)
+	if err != nil {
+		return fmt.Errorf("flattening Monitor Scheduled Query Rules within %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	if err := d.Set("rules", rules); err != nil {
+		return fmt.Errorf("setting `rules`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMonitorScheduledQueryRules(input *[]insights.LogSearchRuleResource) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, rule := range *input {
+		// Log To Metric rules don't raise alerts and have no equivalent in the V2 API, so are omitted
+		action, ok := rule.Action.(insights.AlertingAction)
+		if !ok || rule.LogSearchRule == nil {
+			continue
+		}
+
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		location := ""
+		if rule.Location != nil {
+			location = azure.NormalizeLocation(*rule.Location)
+		}
+
+		description := ""
+		if rule.Description != nil {
+			description = *rule.Description
+		}
+
+		severity, err := strconv.Atoi(string(action.Severity))
+		if err != nil {
+			return nil, fmt.Errorf("converting the severity %q of Scheduled Query Rule %q to an int: %+v", action.Severity, name, err)
+		}
+
+		muteActionsAfterAlertDuration := ""
+		if action.ThrottlingInMin != nil && *action.ThrottlingInMin > 0 {
+			muteActionsAfterAlertDuration = flattenMonitorScheduledQueryRulesDuration(*action.ThrottlingInMin)
+		}
+
+		evaluationFrequency := ""
+		windowDuration := ""
+		if schedule := rule.Schedule; schedule != nil {
+			if schedule.FrequencyInMinutes != nil {
+				evaluationFrequency = flattenMonitorScheduledQueryRulesDuration(*schedule.FrequencyInMinutes)
+			}
+			if schedule.TimeWindowInMinutes != nil {
+				windowDuration = flattenMonitorScheduledQueryRulesDuration(*schedule.TimeWindowInMinutes)
+			}
+		}
+
+		query := ""
+		scopes := make([]interface{}, 0)
+		authorizedResourceIds := make([]interface{}, 0)
+		if source := rule.Source; source != nil {
+			if source.Query != nil {
+				query = *source.Query
+			}
+			if source.DataSourceID != nil {
+				scopes = append(scopes, *source.DataSourceID)
+			}
+			authorizedResourceIds = utils.FlattenStringSlice(source.AuthorizedResources)
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":                                utils.NormalizeNilableString(rule.ID),
+			"name":                              name,
+			"location":                          location,
+			"action":                            flattenMonitorScheduledQueryRulesAction(action.AznsAction),
+			"authorized_resource_ids":           authorizedResourceIds,
+			"criteria":                          flattenMonitorScheduledQueryRulesCriteria(query, action.Trigger),
+			"description":                       description,
+			"enabled":                           rule.Enabled == insights.EnabledTrue,
+			"evaluation_frequency":              evaluationFrequency,
+			"mute_actions_after_alert_duration": muteActionsAfterAlertDuration,
+			"scopes":                            scopes,
+			"severity":                          severity,
+			"window_duration":                   windowDuration,
+			"tags":                              tags.Flatten(rule.Tags),
+		})
+	}
+
+	return output, nil
+}
+
+func flattenMonitorScheduledQueryRulesAction(input *insights.AzNsActionGroup) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	customWebhookPayload := ""
+	if input.CustomWebhookPayload != nil {
+		customWebhookPayload = *input.CustomWebhookPayload
+	}
+
+	emailSubject := ""
+	if input.EmailSubject != nil {
+		emailSubject = *input.EmailSubject
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"action_groups":          utils.FlattenStringSlice(input.ActionGroup),
+			"custom_webhook_payload": customWebhookPayload,
+			"email_subject":          emailSubject,
+		},
+	}
+}
+
+func flattenMonitorScheduledQueryRulesCriteria(query string, input *insights.TriggerCondition) []interface{} {
+	operator := ""
+	threshold := 0.0
+	metricTrigger := make([]interface{}, 0)
+	if input != nil {
+		operator = string(input.ThresholdOperator)
+		if input.Threshold != nil {
+			threshold = *input.Threshold
+		}
+
+		if v := input.MetricTrigger; v != nil {
+			metricColumn := ""
+			if v.MetricColumn != nil {
+				metricColumn = *v.MetricColumn
+			}
+
+			metricThreshold := 0.0
+			if v.Threshold != nil {
+				metricThreshold = *v.Threshold
+			}
+
+			metricTrigger = append(metricTrigger, map[string]interface{}{
+				"metric_column":       metricColumn,
+				"metric_trigger_type": string(v.MetricTriggerType),
+				"operator":            string(v.ThresholdOperator),
+				"threshold":           metricThreshold,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"query":          query,
+			"operator":       operator,
+			"threshold":      threshold,
+			"metric_trigger": metricTrigger,
+		},
+	}
+}
+
+// flattenMonitorScheduledQueryRulesDuration converts a duration in minutes (as used by the classic API) into the
+// ISO8601 duration used by the V2 API, using the largest unit which represents the duration exactly (e.g. `PT1H`)
+func flattenMonitorScheduledQueryRulesDuration(minutes int32) string {
+	switch {
+	case minutes%1440 == 0:
+		return fmt.Sprintf("P%dD", minutes/1440)
+	case minutes%60 == 0:
+		return fmt.Sprintf("PT%dH", minutes/60)
+	default:
+		return fmt.Sprintf("PT%dM", minutes)
+	}
+}
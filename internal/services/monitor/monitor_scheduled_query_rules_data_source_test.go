@@ -0,0 +1,45 @@
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorScheduledQueryRulesListDataSource struct{}
+
+func TestAccDataSourceMonitorScheduledQueryRulesList_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_scheduled_query_rules", "test")
+	r := MonitorScheduledQueryRulesListDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rules.0.name").HasValue(fmt.Sprintf("acctestsqr-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("rules.0.evaluation_frequency").HasValue("PT1H"),
+				check.That(data.ResourceName).Key("rules.0.window_duration").HasValue("PT1H"),
+				check.That(data.ResourceName).Key("rules.0.severity").Exists(),
+				check.That(data.ResourceName).Key("rules.0.scopes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rules.0.criteria.0.query").Exists(),
+				check.That(data.ResourceName).Key("rules.0.action.0.action_groups.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (MonitorScheduledQueryRulesListDataSource) basic(data acceptance.TestData) string {
+	ts := time.Now().Format(time.RFC3339)
+
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_scheduled_query_rules" "test" {
+  resource_group_name = azurerm_monitor_scheduled_query_rules_alert.test.resource_group_name
+}
+`, MonitorScheduledQueryRulesResource{}.AlertingActionConfigBasic(data, ts))
+}
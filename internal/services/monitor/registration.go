@@ -24,6 +24,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_monitor_action_group":                dataSourceMonitorActionGroup(),
 		"azurerm_monitor_diagnostic_categories":       dataSourceMonitorDiagnosticCategories(),
 		"azurerm_monitor_log_profile":                 dataSourceMonitorLogProfile(),
+		"azurerm_monitor_scheduled_query_rules":       dataSourceMonitorScheduledQueryRules(),
 		"azurerm_monitor_scheduled_query_rules_alert": dataSourceMonitorScheduledQueryRulesAlert(),
 		"azurerm_monitor_scheduled_query_rules_log":   dataSourceMonitorScheduledQueryRulesLog(),
 	}
@@ -34,6 +35,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_monitor_aad_diagnostic_setting":      resourceMonitorAADDiagnosticSetting(),
 		"azurerm_monitor_autoscale_setting":           resourceMonitorAutoScaleSetting(),
+		"azurerm_monitor_data_collection_endpoint":    resourceMonitorDataCollectionEndpoint(),
 		"azurerm_monitor_action_group":                resourceMonitorActionGroup(),
 		"azurerm_monitor_action_rule_action_group":    resourceMonitorActionRuleActionGroup(),
 		"azurerm_monitor_action_rule_suppression":     resourceMonitorActionRuleSuppression(),
@@ -41,6 +43,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_monitor_diagnostic_setting":          resourceMonitorDiagnosticSetting(),
 		"azurerm_monitor_log_profile":                 resourceMonitorLogProfile(),
 		"azurerm_monitor_metric_alert":                resourceMonitorMetricAlert(),
+		"azurerm_monitor_private_link_scope":          resourceMonitorPrivateLinkScope(),
+		"azurerm_monitor_private_link_scoped_service": resourceMonitorPrivateLinkScopedService(),
 		"azurerm_monitor_scheduled_query_rules_alert": resourceMonitorScheduledQueryRulesAlert(),
 		"azurerm_monitor_scheduled_query_rules_log":   resourceMonitorScheduledQueryRulesLog(),
 		"azurerm_monitor_smart_detector_alert_rule":   resourceMonitorSmartDetectorAlertRule(),
@@ -0,0 +1,180 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sqlvirtualmachine/mgmt/2017-03-01-preview/sqlvirtualmachine"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `sqlInstanceSettings` and `assessmentSettings` fields are only available from API Version `2022-02-01`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const sqlVirtualMachinesWorkaroundApiVersion = "2022-02-01"
+
+type SqlVirtualMachinesWorkaroundClient struct {
+	sdkClient *sqlvirtualmachine.SQLVirtualMachinesClient
+}
+
+func NewSqlVirtualMachinesWorkaroundClient(client *sqlvirtualmachine.SQLVirtualMachinesClient) SqlVirtualMachinesWorkaroundClient {
+	return SqlVirtualMachinesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// SqlVirtualMachineWorkaroundProperties contains the properties of the SQL Virtual Machine which aren't available in
+// the SDK, any which are nil are omitted from the request.
+type SqlVirtualMachineWorkaroundProperties struct {
+	AssessmentSettings                     *SqlVirtualMachineAssessmentSettings                     `json:"assessmentSettings,omitempty"`
+	ServerConfigurationsManagementSettings *SqlVirtualMachineServerConfigurationsManagementSettings `json:"serverConfigurationsManagementSettings,omitempty"`
+}
+
+type SqlVirtualMachineAssessmentSettings struct {
+	Enable         *bool                                `json:"enable,omitempty"`
+	RunImmediately *bool                                `json:"runImmediately,omitempty"`
+	Schedule       *SqlVirtualMachineAssessmentSchedule `json:"schedule,omitempty"`
+}
+
+type SqlVirtualMachineAssessmentSchedule struct {
+	Enable            *bool   `json:"enable,omitempty"`
+	WeeklyInterval    *int32  `json:"weeklyInterval,omitempty"`
+	MonthlyOccurrence *int32  `json:"monthlyOccurrence,omitempty"`
+	DayOfWeek         *string `json:"dayOfWeek,omitempty"`
+	StartTime         *string `json:"startTime,omitempty"`
+}
+
+type SqlVirtualMachineServerConfigurationsManagementSettings struct {
+	SqlInstanceSettings *SqlVirtualMachineSqlInstanceSettings `json:"sqlInstanceSettings,omitempty"`
+}
+
+type SqlVirtualMachineSqlInstanceSettings struct {
+	Collation                          *string `json:"collation,omitempty"`
+	MaxDop                             *int32  `json:"maxDop,omitempty"`
+	IsOptimizeForAdHocWorkloadsEnabled *bool   `json:"isOptimizeForAdHocWorkloadsEnabled,omitempty"`
+	MinServerMemoryMB                  *int32  `json:"minServerMemoryMB,omitempty"`
+	MaxServerMemoryMB                  *int32  `json:"maxServerMemoryMB,omitempty"`
+}
+
+// CreateOrUpdate creates or updates a SQL virtual machine, including the properties of the SQL virtual machine which
+// aren't available in the SDK.
+// Parameters:
+// resourceGroupName - name of the resource group that contains the resource.
+// SQLVirtualMachineName - name of the SQL virtual machine.
+// parameters - the SQL virtual machine.
+// workaroundProperties - the properties of the SQL virtual machine which aren't available in the SDK.
+func (client SqlVirtualMachinesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, SQLVirtualMachineName string, parameters sqlvirtualmachine.SQLVirtualMachine, workaroundProperties SqlVirtualMachineWorkaroundProperties) (result sqlvirtualmachine.SQLVirtualMachinesCreateOrUpdateFutureType, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, SQLVirtualMachineName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sqlvirtualmachine.SQLVirtualMachinesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withWorkaroundProperties(workaroundProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sqlvirtualmachine.SQLVirtualMachinesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sqlvirtualmachine.SQLVirtualMachinesClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetWorkaroundProperties returns the properties of the specified SQL virtual machine which aren't available in the SDK.
+// Parameters:
+// resourceGroupName - name of the resource group that contains the resource.
+// SQLVirtualMachineName - name of the SQL virtual machine.
+func (client SqlVirtualMachinesWorkaroundClient) GetWorkaroundProperties(ctx context.Context, resourceGroupName string, SQLVirtualMachineName string) (result SqlVirtualMachineWorkaroundResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, SQLVirtualMachineName, "*")
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sqlvirtualmachine.SQLVirtualMachinesClient", "GetWorkaroundProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", sqlVirtualMachinesWorkaroundApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "sqlvirtualmachine.SQLVirtualMachinesClient", "GetWorkaroundProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sqlvirtualmachine.SQLVirtualMachinesClient", "GetWorkaroundProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type SqlVirtualMachineWorkaroundResult struct {
+	autorest.Response `json:"-"`
+	Properties        *SqlVirtualMachineWorkaroundProperties `json:"properties,omitempty"`
+}
+
+// withWorkaroundProperties sets the specified properties within the properties of the request body and updates the
+// API Version used for the request to one which supports these fields.
+func withWorkaroundProperties(input SqlVirtualMachineWorkaroundProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			if input.AssessmentSettings != nil {
+				properties["assessmentSettings"] = input.AssessmentSettings
+			}
+			if input.ServerConfigurationsManagementSettings != nil && input.ServerConfigurationsManagementSettings.SqlInstanceSettings != nil {
+				// the other server configuration settings are available in the SDK, so these need to be merged
+				settings, ok := properties["serverConfigurationsManagementSettings"].(map[string]interface{})
+				if !ok {
+					settings = make(map[string]interface{})
+				}
+				settings["sqlInstanceSettings"] = input.ServerConfigurationsManagementSettings.SqlInstanceSettings
+				properties["serverConfigurationsManagementSettings"] = settings
+			}
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", sqlVirtualMachinesWorkaroundApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlJobTargetGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlJobTargetGroupCreateUpdate,
+		Read:   resourceMsSqlJobTargetGroupRead,
+		Update: resourceMsSqlJobTargetGroupCreateUpdate,
+		Delete: resourceMsSqlJobTargetGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.JobTargetGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_agent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobAgentID,
+			},
+
+			"job_target": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetTypeSQLDatabase),
+								string(sql.JobTargetTypeSQLElasticPool),
+								string(sql.JobTargetTypeSQLServer),
+								string(sql.JobTargetTypeSQLShardMap),
+							}, false),
+						},
+
+						"server_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateMsSqlServerName,
+						},
+
+						"membership_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(sql.JobTargetGroupMembershipTypeInclude),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetGroupMembershipTypeExclude),
+								string(sql.JobTargetGroupMembershipTypeInclude),
+							}, false),
+						},
+
+						"database_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"elastic_pool_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"shard_map_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"job_credential_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobTargetGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Job Target Group creation.")
+
+	jaId, err := parse.JobAgentID(d.Get("job_agent_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewJobTargetGroupID(jaId.SubscriptionId, jaId.ResourceGroup, jaId.ServerName, jaId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing MsSql %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_target_group", id.ID())
+		}
+	}
+
+	targets := d.Get("job_target").([]interface{})
+	if err := validateMsSqlJobTargets(targets); err != nil {
+		return err
+	}
+
+	jobTargetGroup := sql.JobTargetGroup{
+		JobTargetGroupProperties: &sql.JobTargetGroupProperties{
+			Members: expandMsSqlJobTargets(targets),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName, jobTargetGroup); err != nil {
+		return fmt.Errorf("creating/updating MsSql %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobTargetGroupRead(d, meta)
+}
+
+func resourceMsSqlJobTargetGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] MsSql %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("reading MsSql %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TargetGroupName)
+	d.Set("job_agent_id", parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName).ID())
+
+	var members *[]sql.JobTarget
+	if props := resp.JobTargetGroupProperties; props != nil {
+		members = props.Members
+	}
+
+	targets, err := flattenMsSqlJobTargets(members)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("job_target", targets); err != nil {
+		return fmt.Errorf("setting `job_target`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobTargetGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName); err != nil {
+		return fmt.Errorf("deleting MsSql %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// validateMsSqlJobTargets ensures each target only specifies the properties relevant to its type, and that the
+// Target Group includes at least one target - since a Target Group containing only exclusions has nothing to run against
+func validateMsSqlJobTargets(input []interface{}) error {
+	hasInclude := false
+
+	for i, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		targetType := sql.JobTargetType(v["type"].(string))
+		membershipType := sql.JobTargetGroupMembershipType(v["membership_type"].(string))
+		databaseName := v["database_name"].(string)
+		elasticPoolName := v["elastic_pool_name"].(string)
+		shardMapName := v["shard_map_name"].(string)
+		credentialId := v["job_credential_id"].(string)
+
+		switch targetType {
+		case sql.JobTargetTypeSQLDatabase:
+			if databaseName == "" {
+				return fmt.Errorf("`job_target.%d.database_name` must be specified when `type` is `%s`", i, targetType)
+			}
+			if elasticPoolName != "" || shardMapName != "" {
+				return fmt.Errorf("`job_target.%d.elastic_pool_name` and `job_target.%d.shard_map_name` cannot be specified when `type` is `%s`", i, i, targetType)
+			}
+
+		case sql.JobTargetTypeSQLElasticPool:
+			if elasticPoolName == "" {
+				return fmt.Errorf("`job_target.%d.elastic_pool_name` must be specified when `type` is `%s`", i, targetType)
+			}
+			if databaseName != "" || shardMapName != "" {
+				return fmt.Errorf("`job_target.%d.database_name` and `job_target.%d.shard_map_name` cannot be specified when `type` is `%s`", i, i, targetType)
+			}
+
+		case sql.JobTargetTypeSQLShardMap:
+			if shardMapName == "" || databaseName == "" {
+				return fmt.Errorf("`job_target.%d.shard_map_name` and `job_target.%d.database_name` must be specified when `type` is `%s`", i, i, targetType)
+			}
+			if elasticPoolName != "" {
+				return fmt.Errorf("`job_target.%d.elastic_pool_name` cannot be specified when `type` is `%s`", i, targetType)
+			}
+
+		case sql.JobTargetTypeSQLServer:
+			if databaseName != "" || elasticPoolName != "" || shardMapName != "" {
+				return fmt.Errorf("`job_target.%d.database_name`, `job_target.%d.elastic_pool_name` and `job_target.%d.shard_map_name` cannot be specified when `type` is `%s`", i, i, i, targetType)
+			}
+		}
+
+		if membershipType == sql.JobTargetGroupMembershipTypeExclude {
+			if credentialId != "" {
+				return fmt.Errorf("`job_target.%d.job_credential_id` cannot be specified when `membership_type` is `%s`", i, membershipType)
+			}
+			continue
+		}
+
+		hasInclude = true
+
+		// the members of Servers, Elastic Pools and Shard Maps are enumerated at runtime, which requires a credential
+		if targetType != sql.JobTargetTypeSQLDatabase && credentialId == "" {
+			return fmt.Errorf("`job_target.%d.job_credential_id` must be specified when `type` is `%s` and `membership_type` is `%s`", i, targetType, membershipType)
+		}
+	}
+
+	if !hasInclude {
+		return fmt.Errorf("at least one `job_target` with a `membership_type` of `%s` must be specified", sql.JobTargetGroupMembershipTypeInclude)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobTargets(input []interface{}) *[]sql.JobTarget {
+	results := make([]sql.JobTarget, 0)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		target := sql.JobTarget{
+			Type:           sql.JobTargetType(v["type"].(string)),
+			MembershipType: sql.JobTargetGroupMembershipType(v["membership_type"].(string)),
+			ServerName:     utils.String(v["server_name"].(string)),
+		}
+
+		if databaseName := v["database_name"].(string); databaseName != "" {
+			target.DatabaseName = utils.String(databaseName)
+		}
+
+		if elasticPoolName := v["elastic_pool_name"].(string); elasticPoolName != "" {
+			target.ElasticPoolName = utils.String(elasticPoolName)
+		}
+
+		if shardMapName := v["shard_map_name"].(string); shardMapName != "" {
+			target.ShardMapName = utils.String(shardMapName)
+		}
+
+		if credentialId := v["job_credential_id"].(string); credentialId != "" {
+			target.RefreshCredential = utils.String(credentialId)
+		}
+
+		results = append(results, target)
+	}
+
+	return &results
+}
+
+func flattenMsSqlJobTargets(input *[]sql.JobTarget) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		credentialId := ""
+		if item.RefreshCredential != nil && *item.RefreshCredential != "" {
+			id, err := parse.JobCredentialID(*item.RefreshCredential)
+			if err != nil {
+				return nil, err
+			}
+			credentialId = id.ID()
+		}
+
+		results = append(results, map[string]interface{}{
+			"type":              string(item.Type),
+			"server_name":       utils.NormalizeNilableString(item.ServerName),
+			"membership_type":   string(item.MembershipType),
+			"database_name":     utils.NormalizeNilableString(item.DatabaseName),
+			"elastic_pool_name": utils.NormalizeNilableString(item.ElasticPoolName),
+			"shard_map_name":    utils.NormalizeNilableString(item.ShardMapName),
+			"job_credential_id": credentialId,
+		})
+	}
+
+	return results, nil
+}
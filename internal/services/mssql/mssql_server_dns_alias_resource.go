@@ -0,0 +1,203 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlServerDNSAlias() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlServerDNSAliasCreate,
+		Read:   resourceMsSqlServerDNSAliasRead,
+		Update: resourceMsSqlServerDNSAliasUpdate,
+		Delete: resourceMsSqlServerDNSAliasDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ServerDNSAliasID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ServerDNSAliasName,
+			},
+
+			// changing the Server acquires the DNS Alias from the existing Server, rather than recreating it
+			"mssql_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ServerID,
+			},
+
+			"dns_record": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMsSqlServerDNSAliasCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ServerDNSAliasesClient
+	serversClient := meta.(*clients.Client).MSSQL.ServersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := parse.ServerID(d.Get("mssql_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewServerDNSAliasID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DnsAliaseName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_mssql_server_dns_alias", id.ID())
+	}
+
+	// DNS Aliases share the `database.windows.net` namespace with Servers, so the name must be globally unique
+	availability, err := serversClient.CheckNameAvailability(ctx, sql.CheckNameAvailabilityRequest{
+		Name: utils.String(id.DnsAliaseName),
+		Type: utils.String("Microsoft.Sql/servers"),
+	})
+	if err != nil {
+		return fmt.Errorf("checking the availability of the name for %s: %+v", id, err)
+	}
+	if availability.Available != nil && !*availability.Available {
+		message := ""
+		if availability.Message != nil {
+			message = *availability.Message
+		}
+		return fmt.Errorf("the name %q for %s is not available (%s): %s - to move an existing DNS Alias to this Server, update the `mssql_server_id` of the existing resource instead", id.DnsAliaseName, id, availability.Reason, message)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DnsAliaseName)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlServerDNSAliasRead(d, meta)
+}
+
+func resourceMsSqlServerDNSAliasRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ServerDNSAliasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ServerDNSAliasID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DnsAliaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DnsAliaseName)
+	d.Set("mssql_server_id", parse.NewServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName).ID())
+
+	dnsRecord := ""
+	if props := resp.ServerDNSAliasProperties; props != nil && props.AzureDNSRecord != nil {
+		dnsRecord = *props.AzureDNSRecord
+	}
+	d.Set("dns_record", dnsRecord)
+
+	return nil
+}
+
+func resourceMsSqlServerDNSAliasUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ServerDNSAliasesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	oldId, err := parse.ServerDNSAliasID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("mssql_server_id") {
+		serverId, err := parse.ServerID(d.Get("mssql_server_id").(string))
+		if err != nil {
+			return err
+		}
+
+		id := parse.NewServerDNSAliasID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, oldId.DnsAliaseName)
+
+		// the DNS Alias is acquired by the new Server from the existing Server, which keeps the DNS record in place
+		parameters := sql.ServerDNSAliasAcquisition{
+			OldServerDNSAliasID: utils.String(oldId.ID()),
+		}
+		future, err := client.Acquire(ctx, id.ResourceGroup, id.ServerName, id.DnsAliaseName, parameters)
+		if err != nil {
+			return fmt.Errorf("acquiring %s from %s: %+v", id, *oldId, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for %s to be acquired from %s: %+v", id, *oldId, err)
+		}
+
+		d.SetId(id.ID())
+	}
+
+	return resourceMsSqlServerDNSAliasRead(d, meta)
+}
+
+func resourceMsSqlServerDNSAliasDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ServerDNSAliasesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ServerDNSAliasID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.DnsAliaseName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sqlvirtualmachine/mgmt/2017-03-01-preview/sqlvirtualmachine"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	parseCompute "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
@@ -145,6 +148,67 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 				},
 			},
 
+			"assessment": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"run_immediately": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"schedule": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"weekly_interval": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+										ExactlyOneOf: []string{"assessment.0.schedule.0.weekly_interval", "assessment.0.schedule.0.monthly_occurrence"},
+									},
+
+									"monthly_occurrence": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 5),
+										ExactlyOneOf: []string{"assessment.0.schedule.0.weekly_interval", "assessment.0.schedule.0.monthly_occurrence"},
+									},
+
+									"day_of_week": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(sqlvirtualmachine.Monday),
+											string(sqlvirtualmachine.Tuesday),
+											string(sqlvirtualmachine.Wednesday),
+											string(sqlvirtualmachine.Thursday),
+											string(sqlvirtualmachine.Friday),
+											string(sqlvirtualmachine.Saturday),
+											string(sqlvirtualmachine.Sunday),
+										}, false),
+									},
+
+									"start_time": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringMatch(
+											regexp.MustCompile(`^([01][0-9]|2[0-3]):([0-5][0-9])$`),
+											"`start_time` must be in the format HH:mm",
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"auto_patching": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -258,6 +322,51 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: validate.SqlVirtualMachineLoginUserName,
 			},
 
+			// the API returns the current SQL Instance settings even when these haven't been configured
+			"sql_instance": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"adhoc_workloads_optimization_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"collation": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "SQL_Latin1_General_CP1_CI_AS",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"max_dop": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 32767),
+						},
+
+						"max_server_memory_mb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      2147483647,
+							ValidateFunc: validation.IntBetween(128, 2147483647),
+						},
+
+						"min_server_memory_mb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 2147483647),
+						},
+					},
+				},
+			},
+
 			"storage_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -302,6 +411,14 @@ func resourceMsSqlVirtualMachineCustomDiff(ctx context.Context, d *pluginsdk.Res
 		return d.ForceNew("auto_backup")
 	}
 
+	if v, ok := d.GetOk("sql_instance"); ok && len(v.([]interface{})) > 0 {
+		minServerMemory := d.Get("sql_instance.0.min_server_memory_mb").(int)
+		maxServerMemory := d.Get("sql_instance.0.max_server_memory_mb").(int)
+		if minServerMemory > maxServerMemory {
+			return fmt.Errorf("sql_instance: `min_server_memory_mb` (%d) cannot be greater than `max_server_memory_mb` (%d)", minServerMemory, maxServerMemory)
+		}
+	}
+
 	encryptionEnabled := d.Get("auto_backup.0.encryption_enabled")
 	v, ok := d.GetOk("auto_backup.0.encryption_password")
 
@@ -319,7 +436,7 @@ func resourceMsSqlVirtualMachineCustomDiff(ctx context.Context, d *pluginsdk.Res
 func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MSSQL.VirtualMachinesClient
 	vmclient := meta.(*clients.Client).Compute.VMClient
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	vmId := d.Get("virtual_machine_id").(string)
@@ -350,6 +467,15 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("Location is empty from making Read request on Azure Virtual Machine %s: %+v", id.Name, err)
 	}
 
+	storageConfiguration := d.Get("storage_configuration").([]interface{})
+	var dataDisks *[]compute.DataDisk
+	if props := respvm.VirtualMachineProperties; props != nil && props.StorageProfile != nil {
+		dataDisks = props.StorageProfile.DataDisks
+	}
+	if err := validateSqlVirtualMachineStorageConfigurationLuns(storageConfiguration, dataDisks); err != nil {
+		return fmt.Errorf("validating `storage_configuration` against Azure Virtual Machine %s: %+v", id.Name, err)
+	}
+
 	parameters := sqlvirtualmachine.SQLVirtualMachine{
 		Location: utils.String(*respvm.Location),
 		Properties: &sqlvirtualmachine.Properties{
@@ -370,12 +496,31 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 					SQLAuthUpdateUserName: utils.String(d.Get("sql_connectivity_update_username").(string)),
 				},
 			},
-			StorageConfigurationSettings: expandSqlVirtualMachineStorageConfigurationSettings(d.Get("storage_configuration").([]interface{})),
+			StorageConfigurationSettings: expandSqlVirtualMachineStorageConfigurationSettings(storageConfiguration),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	// `storage_configuration.0.storage_workload_type` is read back from the Server Configuration Management Settings,
+	// so this needs to be set there too to avoid a diff
+	if len(storageConfiguration) > 0 && storageConfiguration[0] != nil {
+		storageSettings := storageConfiguration[0].(map[string]interface{})
+		parameters.Properties.ServerConfigurationsManagementSettings.SQLWorkloadTypeUpdateSettings = &sqlvirtualmachine.SQLWorkloadTypeUpdateSettings{
+			SQLWorkloadType: sqlvirtualmachine.SQLWorkloadType(storageSettings["storage_workload_type"].(string)),
+		}
+	}
+
+	workaroundProperties := azuresdkhacks.SqlVirtualMachineWorkaroundProperties{
+		AssessmentSettings: expandSqlVirtualMachineAssessmentSettings(d.Get("assessment").([]interface{})),
+	}
+	if sqlInstanceSettings := expandSqlVirtualMachineSqlInstanceSettings(d.Get("sql_instance").([]interface{})); sqlInstanceSettings != nil {
+		workaroundProperties.ServerConfigurationsManagementSettings = &azuresdkhacks.SqlVirtualMachineServerConfigurationsManagementSettings{
+			SqlInstanceSettings: sqlInstanceSettings,
+		}
+	}
+
+	hack := azuresdkhacks.NewSqlVirtualMachinesWorkaroundClient(client)
+	future, err := hack.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters, workaroundProperties)
 	if err != nil {
 		return fmt.Errorf("creating Sql Virtual Machine (Sql Virtual Machine Name %q / Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -475,6 +620,35 @@ func resourceMsSqlVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 			return fmt.Errorf("setting `storage_configuration`: %+v", err)
 		}
 	}
+
+	// the `assessmentSettings` and `sqlInstanceSettings` aren't available in the version of the SDK we're using - the
+	// newer API Version used to retrieve these may not be available (e.g. in some National Clouds), in which case the
+	// existing values are retained rather than failing to read the Sql Virtual Machine
+	workaroundResp, err := azuresdkhacks.NewSqlVirtualMachinesWorkaroundClient(client).GetWorkaroundProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasBadRequest(workaroundResp.Response) && !utils.ResponseWasNotFound(workaroundResp.Response) {
+			return fmt.Errorf("retrieving Assessment and SQL Instance settings for Sql Virtual Machine (Sql Virtual Machine Name %q / Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] unable to retrieve Assessment and SQL Instance settings for Sql Virtual Machine (Sql Virtual Machine Name %q / Resource Group %q) - retaining the existing values: %+v", id.Name, id.ResourceGroup, err)
+	} else {
+		var assessmentSettings *azuresdkhacks.SqlVirtualMachineAssessmentSettings
+		var sqlInstanceSettings *azuresdkhacks.SqlVirtualMachineSqlInstanceSettings
+		if props := workaroundResp.Properties; props != nil {
+			assessmentSettings = props.AssessmentSettings
+			if props.ServerConfigurationsManagementSettings != nil {
+				sqlInstanceSettings = props.ServerConfigurationsManagementSettings.SqlInstanceSettings
+			}
+		}
+
+		if err := d.Set("assessment", flattenSqlVirtualMachineAssessmentSettings(assessmentSettings)); err != nil {
+			return fmt.Errorf("setting `assessment`: %+v", err)
+		}
+
+		if err := d.Set("sql_instance", flattenSqlVirtualMachineSqlInstanceSettings(sqlInstanceSettings)); err != nil {
+			return fmt.Errorf("setting `sql_instance`: %+v", err)
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -862,3 +1036,183 @@ func flattenSqlVirtualMachineStorageSettings(input *sqlvirtualmachine.SQLStorage
 
 	return []interface{}{attrs}
 }
+
+// validateSqlVirtualMachineStorageConfigurationLuns validates that the LUNs used in the `storage_configuration` block
+// are attached to the Virtual Machine, since the API otherwise accepts these and fails asynchronously
+func validateSqlVirtualMachineStorageConfigurationLuns(input []interface{}, dataDisks *[]compute.DataDisk) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	storageSettings := input[0].(map[string]interface{})
+
+	attachedLuns := make(map[int32]struct{})
+	if dataDisks != nil {
+		for _, disk := range *dataDisks {
+			if disk.Lun != nil {
+				attachedLuns[*disk.Lun] = struct{}{}
+			}
+		}
+	}
+
+	for _, key := range []string{"data_settings", "log_settings", "temp_db_settings"} {
+		settings, ok := storageSettings[key].([]interface{})
+		if !ok || len(settings) == 0 || settings[0] == nil {
+			continue
+		}
+
+		seen := make(map[int32]struct{})
+		for _, lun := range *expandSqlVirtualMachineStorageSettingsLuns(settings[0].(map[string]interface{})["luns"].([]interface{})) {
+			if _, ok := seen[lun]; ok {
+				return fmt.Errorf("`%s`: the LUN %d is specified more than once", key, lun)
+			}
+			seen[lun] = struct{}{}
+
+			if _, ok := attachedLuns[lun]; !ok {
+				return fmt.Errorf("`%s`: no data disk is attached to the Virtual Machine at LUN %d", key, lun)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandSqlVirtualMachineAssessmentSettings(input []interface{}) *azuresdkhacks.SqlVirtualMachineAssessmentSettings {
+	if len(input) == 0 || input[0] == nil {
+		// assessments remain enabled unless they're explicitly disabled
+		return &azuresdkhacks.SqlVirtualMachineAssessmentSettings{
+			Enable: utils.Bool(false),
+		}
+	}
+	assessmentSettings := input[0].(map[string]interface{})
+
+	output := azuresdkhacks.SqlVirtualMachineAssessmentSettings{
+		Enable:         utils.Bool(true),
+		RunImmediately: utils.Bool(assessmentSettings["run_immediately"].(bool)),
+		Schedule: &azuresdkhacks.SqlVirtualMachineAssessmentSchedule{
+			Enable: utils.Bool(false),
+		},
+	}
+
+	if v := assessmentSettings["schedule"].([]interface{}); len(v) > 0 && v[0] != nil {
+		schedule := v[0].(map[string]interface{})
+		output.Schedule = &azuresdkhacks.SqlVirtualMachineAssessmentSchedule{
+			Enable:    utils.Bool(true),
+			DayOfWeek: utils.String(schedule["day_of_week"].(string)),
+			StartTime: utils.String(schedule["start_time"].(string)),
+		}
+
+		if weeklyInterval := schedule["weekly_interval"].(int); weeklyInterval != 0 {
+			output.Schedule.WeeklyInterval = utils.Int32(int32(weeklyInterval))
+		}
+
+		if monthlyOccurrence := schedule["monthly_occurrence"].(int); monthlyOccurrence != 0 {
+			output.Schedule.MonthlyOccurrence = utils.Int32(int32(monthlyOccurrence))
+		}
+	}
+
+	return &output
+}
+
+func flattenSqlVirtualMachineAssessmentSettings(input *azuresdkhacks.SqlVirtualMachineAssessmentSettings) []interface{} {
+	if input == nil || input.Enable == nil || !*input.Enable {
+		return []interface{}{}
+	}
+
+	runImmediately := false
+	if input.RunImmediately != nil {
+		runImmediately = *input.RunImmediately
+	}
+
+	schedule := make([]interface{}, 0)
+	if v := input.Schedule; v != nil && v.Enable != nil && *v.Enable {
+		weeklyInterval := 0
+		if v.WeeklyInterval != nil {
+			weeklyInterval = int(*v.WeeklyInterval)
+		}
+
+		monthlyOccurrence := 0
+		if v.MonthlyOccurrence != nil {
+			monthlyOccurrence = int(*v.MonthlyOccurrence)
+		}
+
+		dayOfWeek := ""
+		if v.DayOfWeek != nil {
+			dayOfWeek = *v.DayOfWeek
+		}
+
+		startTime := ""
+		if v.StartTime != nil {
+			startTime = *v.StartTime
+		}
+
+		schedule = append(schedule, map[string]interface{}{
+			"weekly_interval":    weeklyInterval,
+			"monthly_occurrence": monthlyOccurrence,
+			"day_of_week":        dayOfWeek,
+			"start_time":         startTime,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"run_immediately": runImmediately,
+			"schedule":        schedule,
+		},
+	}
+}
+
+func expandSqlVirtualMachineSqlInstanceSettings(input []interface{}) *azuresdkhacks.SqlVirtualMachineSqlInstanceSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	sqlInstanceSettings := input[0].(map[string]interface{})
+
+	return &azuresdkhacks.SqlVirtualMachineSqlInstanceSettings{
+		Collation:                          utils.String(sqlInstanceSettings["collation"].(string)),
+		MaxDop:                             utils.Int32(int32(sqlInstanceSettings["max_dop"].(int))),
+		IsOptimizeForAdHocWorkloadsEnabled: utils.Bool(sqlInstanceSettings["adhoc_workloads_optimization_enabled"].(bool)),
+		MinServerMemoryMB:                  utils.Int32(int32(sqlInstanceSettings["min_server_memory_mb"].(int))),
+		MaxServerMemoryMB:                  utils.Int32(int32(sqlInstanceSettings["max_server_memory_mb"].(int))),
+	}
+}
+
+func flattenSqlVirtualMachineSqlInstanceSettings(input *azuresdkhacks.SqlVirtualMachineSqlInstanceSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	adhocWorkloadsOptimizationEnabled := false
+	if input.IsOptimizeForAdHocWorkloadsEnabled != nil {
+		adhocWorkloadsOptimizationEnabled = *input.IsOptimizeForAdHocWorkloadsEnabled
+	}
+
+	collation := ""
+	if input.Collation != nil {
+		collation = *input.Collation
+	}
+
+	maxDop := 0
+	if input.MaxDop != nil {
+		maxDop = int(*input.MaxDop)
+	}
+
+	maxServerMemory := 0
+	if input.MaxServerMemoryMB != nil {
+		maxServerMemory = int(*input.MaxServerMemoryMB)
+	}
+
+	minServerMemory := 0
+	if input.MinServerMemoryMB != nil {
+		minServerMemory = int(*input.MinServerMemoryMB)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"adhoc_workloads_optimization_enabled": adhocWorkloadsOptimizationEnabled,
+			"collation":                            collation,
+			"max_dop":                              maxDop,
+			"max_server_memory_mb":                 maxServerMemory,
+			"min_server_memory_mb":                 minServerMemory,
+		},
+	}
+}
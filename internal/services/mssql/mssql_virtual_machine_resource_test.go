@@ -3,6 +3,7 @@ package mssql_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -167,6 +168,13 @@ func TestAccMsSqlVirtualMachine_storageConfiguration(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.storageConfigurationUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
 		{
 			Config: r.storageConfigurationRevert(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -177,6 +185,69 @@ func TestAccMsSqlVirtualMachine_storageConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlVirtualMachine_sqlInstance(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine", "test")
+	r := MsSqlVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sqlInstance(data, 0, 1024, 2048),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.sqlInstance(data, 4, 2048, 4096),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlVirtualMachine_assessment(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine", "test")
+	r := MsSqlVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.assessmentWeekly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.assessmentMonthly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlVirtualMachine_storageConfigurationInvalidLun(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine", "test")
+	r := MsSqlVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.storageConfigurationInvalidLun(data),
+			ExpectError: regexp.MustCompile("no data disk is attached to the Virtual Machine at LUN 5"),
+		},
+	})
+}
+
 func (MsSqlVirtualMachineResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SqlVirtualMachineID(state.ID)
 	if err != nil {
@@ -660,10 +731,178 @@ resource "azurerm_mssql_virtual_machine" "test" {
       default_file_path = "F:\\SQLTemp"
     }
   }
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlVirtualMachineResource) storageConfigurationUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "accmd-sqlvm-%[2]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = azurerm_managed_disk.test.id
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  lun                = "0"
+  caching            = "None"
+}
+
+resource "azurerm_managed_disk" "log" {
+  name                 = "accmd-sqlvm-log-%[2]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "log" {
+  managed_disk_id    = azurerm_managed_disk.log.id
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  lun                = "1"
+  caching            = "None"
+}
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+
+  storage_configuration {
+    disk_type             = "ADD"
+    storage_workload_type = "GENERAL"
+
+    data_settings {
+      luns              = [0]
+      default_file_path = "F:\\SQLData"
+    }
+
+    log_settings {
+      luns              = [1]
+      default_file_path = "G:\\SQLLog"
+    }
+
+    temp_db_settings {
+      luns              = [0]
+      default_file_path = "F:\\SQLTemp"
+    }
+  }
+
+  depends_on = [
+    azurerm_virtual_machine_data_disk_attachment.test,
+    azurerm_virtual_machine_data_disk_attachment.log,
+  ]
 }
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MsSqlVirtualMachineResource) storageConfigurationInvalidLun(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "accmd-sqlvm-%[2]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = azurerm_managed_disk.test.id
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  lun                = "0"
+  caching            = "None"
+}
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+
+  storage_configuration {
+    disk_type             = "NEW"
+    storage_workload_type = "OLTP"
+
+    data_settings {
+      luns              = [5]
+      default_file_path = "F:\\SQLData"
+    }
+  }
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlVirtualMachineResource) sqlInstance(data acceptance.TestData, maxDop int, minServerMemory int, maxServerMemory int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+
+  sql_instance {
+    adhoc_workloads_optimization_enabled = true
+    collation                            = "SQL_Latin1_General_CP1_CI_AS"
+    max_dop                              = %[2]d
+    min_server_memory_mb                 = %[3]d
+    max_server_memory_mb                 = %[4]d
+  }
+}
+`, r.template(data), maxDop, minServerMemory, maxServerMemory)
+}
+
+func (r MsSqlVirtualMachineResource) assessmentWeekly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+
+  assessment {
+    schedule {
+      weekly_interval = 1
+      day_of_week     = "Monday"
+      start_time      = "00:00"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r MsSqlVirtualMachineResource) assessmentMonthly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+
+  assessment {
+    run_immediately = true
+
+    schedule {
+      monthly_occurrence = 2
+      day_of_week        = "Friday"
+      start_time         = "22:30"
+    }
+  }
+}
+`, r.template(data))
+}
+
 func (r MsSqlVirtualMachineResource) storageConfigurationRevert(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,19 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ServerDNSAliasName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// the alias becomes a part of the `database.windows.net` namespace, as such the same rules as a Server name apply
+	if !regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 1 and 63 characters long, can only contain lowercase letters, numbers and hyphens and cannot start or end with a hyphen: %q",
+			k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServerDNSAliasName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "alias1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "my-alias-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "My-Alias",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-alias",
+			ErrCount: 1,
+		},
+		{
+			Value:    "alias-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "my_alias",
+			ErrCount: 1,
+		},
+		{
+			Value:    "my.alias",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 63),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 64),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ServerDNSAliasName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected ServerDNSAliasName to return %d error(s) for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
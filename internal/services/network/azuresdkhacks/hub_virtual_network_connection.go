@@ -0,0 +1,163 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `inboundRouteMap` and `outboundRouteMap` fields within the `routingConfiguration` are only available from API
+// Version `2022-09-01` - as such the workaround client is only used to create/update when these are configured (or
+// removed), and the Read tolerates this API Version being unavailable
+const hubVirtualNetworkConnectionRouteMapsApiVersion = "2022-09-01"
+
+type HubVirtualNetworkConnectionsWorkaroundClient struct {
+	sdkClient *network.HubVirtualNetworkConnectionsClient
+}
+
+func NewHubVirtualNetworkConnectionsWorkaroundClient(client *network.HubVirtualNetworkConnectionsClient) HubVirtualNetworkConnectionsWorkaroundClient {
+	return HubVirtualNetworkConnectionsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type HubVirtualNetworkConnectionRouteMaps struct {
+	InboundRouteMap  *network.SubResource `json:"inboundRouteMap,omitempty"`
+	OutboundRouteMap *network.SubResource `json:"outboundRouteMap,omitempty"`
+}
+
+// CreateOrUpdate creates or updates a hub virtual network connection, including the `inboundRouteMap` and
+// `outboundRouteMap` of the routing configuration.
+// Parameters:
+// resourceGroupName - the resource group name of the HubVirtualNetworkConnection.
+// virtualHubName - the name of the VirtualHub.
+// connectionName - the name of the HubVirtualNetworkConnection.
+// parameters - parameters supplied to create or update a hub virtual network connection.
+// routeMaps - the Route Maps associated with the hub virtual network connection.
+func (client HubVirtualNetworkConnectionsWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, virtualHubName string, connectionName string, parameters network.HubVirtualNetworkConnection, routeMaps HubVirtualNetworkConnectionRouteMaps) (result network.HubVirtualNetworkConnectionsCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, virtualHubName, connectionName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.HubVirtualNetworkConnectionsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withHubVirtualNetworkConnectionRouteMaps(routeMaps))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.HubVirtualNetworkConnectionsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.HubVirtualNetworkConnectionsClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetRouteMaps returns the `inboundRouteMap` and `outboundRouteMap` of the specified hub virtual network connection.
+// Parameters:
+// resourceGroupName - the resource group name of the VirtualHub.
+// virtualHubName - the name of the VirtualHub.
+// connectionName - the name of the vpn connection.
+func (client HubVirtualNetworkConnectionsWorkaroundClient) GetRouteMaps(ctx context.Context, resourceGroupName string, virtualHubName string, connectionName string) (result HubVirtualNetworkConnectionRouteMapsGetResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, virtualHubName, connectionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.HubVirtualNetworkConnectionsClient", "GetRouteMaps", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", hubVirtualNetworkConnectionRouteMapsApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.HubVirtualNetworkConnectionsClient", "GetRouteMaps", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.HubVirtualNetworkConnectionsClient", "GetRouteMaps", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type HubVirtualNetworkConnectionRouteMapsGetResult struct {
+	autorest.Response `json:"-"`
+	Properties        *HubVirtualNetworkConnectionRouteMapsProperties `json:"properties,omitempty"`
+}
+
+type HubVirtualNetworkConnectionRouteMapsProperties struct {
+	RoutingConfiguration *HubVirtualNetworkConnectionRouteMaps `json:"routingConfiguration,omitempty"`
+}
+
+// withHubVirtualNetworkConnectionRouteMaps sets the `inboundRouteMap` and `outboundRouteMap` within the routing
+// configuration of the request body and updates the API Version used for the request to one which supports these fields.
+func withHubVirtualNetworkConnectionRouteMaps(input HubVirtualNetworkConnectionRouteMaps) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+
+			routingConfiguration, ok := properties["routingConfiguration"].(map[string]interface{})
+			if !ok {
+				routingConfiguration = make(map[string]interface{})
+			}
+
+			if input.InboundRouteMap != nil {
+				routingConfiguration["inboundRouteMap"] = input.InboundRouteMap
+			}
+			if input.OutboundRouteMap != nil {
+				routingConfiguration["outboundRouteMap"] = input.OutboundRouteMap
+			}
+
+			if len(routingConfiguration) > 0 {
+				properties["routingConfiguration"] = routingConfiguration
+			}
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", hubVirtualNetworkConnectionRouteMapsApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `auxiliaryMode` and `auxiliarySku` fields are only available from API Version `2023-02-01`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const networkInterfaceExtendedPropertiesApiVersion = "2023-02-01"
+
+type NetworkInterfacesWorkaroundClient struct {
+	sdkClient *network.InterfacesClient
+}
+
+func NewNetworkInterfacesWorkaroundClient(client *network.InterfacesClient) NetworkInterfacesWorkaroundClient {
+	return NetworkInterfacesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type NetworkInterfaceExtendedProperties struct {
+	AuxiliaryMode *string `json:"auxiliaryMode,omitempty"`
+	AuxiliarySku  *string `json:"auxiliarySku,omitempty"`
+}
+
+// CreateOrUpdate creates or updates a network interface, including the `auxiliaryMode` and `auxiliarySku` of the
+// network interface.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// networkInterfaceName - the name of the network interface.
+// parameters - parameters supplied to the create or update network interface operation.
+// extendedProperties - the properties of the network interface which aren't available in the SDK.
+func (client NetworkInterfacesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, networkInterfaceName string, parameters network.Interface, extendedProperties NetworkInterfaceExtendedProperties) (result network.InterfacesCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, networkInterfaceName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.InterfacesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withNetworkInterfaceExtendedProperties(extendedProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.InterfacesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.InterfacesClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetExtendedProperties returns the `auxiliaryMode` and `auxiliarySku` of the specified network interface.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// networkInterfaceName - the name of the network interface.
+func (client NetworkInterfacesWorkaroundClient) GetExtendedProperties(ctx context.Context, resourceGroupName string, networkInterfaceName string) (result NetworkInterfaceExtendedGetResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, networkInterfaceName, "")
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.InterfacesClient", "GetExtendedProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", networkInterfaceExtendedPropertiesApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.InterfacesClient", "GetExtendedProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.InterfacesClient", "GetExtendedProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type NetworkInterfaceExtendedGetResult struct {
+	autorest.Response `json:"-"`
+	Properties        *NetworkInterfaceExtendedProperties `json:"properties,omitempty"`
+}
+
+// withNetworkInterfaceExtendedProperties sets the `auxiliaryMode` and `auxiliarySku` within the properties of the
+// request body and updates the API Version used for the request to one which supports these fields.
+func withNetworkInterfaceExtendedProperties(input NetworkInterfaceExtendedProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+
+			if input.AuxiliaryMode != nil {
+				properties["auxiliaryMode"] = *input.AuxiliaryMode
+			}
+			if input.AuxiliarySku != nil {
+				properties["auxiliarySku"] = *input.AuxiliarySku
+			}
+
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", networkInterfaceExtendedPropertiesApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `flushConnection` field is only available from API Version `2022-05-01`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const networkSecurityGroupExtendedPropertiesApiVersion = "2022-05-01"
+
+type NetworkSecurityGroupsWorkaroundClient struct {
+	sdkClient *network.SecurityGroupsClient
+}
+
+func NewNetworkSecurityGroupsWorkaroundClient(client *network.SecurityGroupsClient) NetworkSecurityGroupsWorkaroundClient {
+	return NetworkSecurityGroupsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type NetworkSecurityGroupExtendedProperties struct {
+	FlushConnection *bool `json:"flushConnection,omitempty"`
+}
+
+// CreateOrUpdate creates or updates a network security group, including the `flushConnection` of the network
+// security group.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// networkSecurityGroupName - the name of the network security group.
+// parameters - parameters supplied to the create or update network security group operation.
+// extendedProperties - the properties of the network security group which aren't available in the SDK.
+func (client NetworkSecurityGroupsWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, networkSecurityGroupName string, parameters network.SecurityGroup, extendedProperties NetworkSecurityGroupExtendedProperties) (result network.SecurityGroupsCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, networkSecurityGroupName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.SecurityGroupsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withNetworkSecurityGroupExtendedProperties(extendedProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.SecurityGroupsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.SecurityGroupsClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetExtendedProperties returns the `flushConnection` of the specified network security group.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// networkSecurityGroupName - the name of the network security group.
+func (client NetworkSecurityGroupsWorkaroundClient) GetExtendedProperties(ctx context.Context, resourceGroupName string, networkSecurityGroupName string) (result NetworkSecurityGroupExtendedGetResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, networkSecurityGroupName, "")
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.SecurityGroupsClient", "GetExtendedProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", networkSecurityGroupExtendedPropertiesApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.SecurityGroupsClient", "GetExtendedProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.SecurityGroupsClient", "GetExtendedProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type NetworkSecurityGroupExtendedGetResult struct {
+	autorest.Response `json:"-"`
+	Properties        *NetworkSecurityGroupExtendedProperties `json:"properties,omitempty"`
+}
+
+// withNetworkSecurityGroupExtendedProperties sets the `flushConnection` within the properties of the request body
+// and updates the API Version used for the request to one which supports this field.
+func withNetworkSecurityGroupExtendedProperties(input NetworkSecurityGroupExtendedProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+
+			if input.FlushConnection != nil {
+				properties["flushConnection"] = *input.FlushConnection
+			}
+
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", networkSecurityGroupExtendedPropertiesApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
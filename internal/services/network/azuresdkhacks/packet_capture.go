@@ -0,0 +1,156 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `targetType` and `scope` fields (used to target a Virtual Machine Scale Set) are only available from API Version
+// `2022-01-01` (see `internal/azuresdkhacks` for when this workaround client is used)
+const packetCaptureExtendedPropertiesApiVersion = "2022-01-01"
+
+const PacketCaptureTargetTypeAzureVMSS = "AzureVMSS"
+
+type PacketCapturesWorkaroundClient struct {
+	sdkClient *network.PacketCapturesClient
+}
+
+func NewPacketCapturesWorkaroundClient(client *network.PacketCapturesClient) PacketCapturesWorkaroundClient {
+	return PacketCapturesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type PacketCaptureMachineScope struct {
+	Include *[]string `json:"include,omitempty"`
+	Exclude *[]string `json:"exclude,omitempty"`
+}
+
+type PacketCaptureExtendedProperties struct {
+	TargetType *string                    `json:"targetType,omitempty"`
+	Scope      *PacketCaptureMachineScope `json:"scope,omitempty"`
+}
+
+// Create creates a packet capture session, including the `targetType` and `scope` of the packet capture.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// networkWatcherName - the name of the network watcher.
+// packetCaptureName - the name of the packet capture session.
+// parameters - parameters that define the create packet capture operation.
+// extendedProperties - the properties of the packet capture which aren't available in the SDK.
+func (client PacketCapturesWorkaroundClient) Create(ctx context.Context, resourceGroupName string, networkWatcherName string, packetCaptureName string, parameters network.PacketCapture, extendedProperties PacketCaptureExtendedProperties) (result network.PacketCapturesCreateFuture, err error) {
+	req, err := client.sdkClient.CreatePreparer(ctx, resourceGroupName, networkWatcherName, packetCaptureName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.PacketCapturesClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withPacketCaptureExtendedProperties(extendedProperties))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.PacketCapturesClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.PacketCapturesClient", "Create", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetExtendedProperties returns the `targetType` and `scope` of the specified packet capture session.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// networkWatcherName - the name of the network watcher.
+// packetCaptureName - the name of the packet capture session.
+func (client PacketCapturesWorkaroundClient) GetExtendedProperties(ctx context.Context, resourceGroupName string, networkWatcherName string, packetCaptureName string) (result PacketCaptureExtendedGetResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, networkWatcherName, packetCaptureName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.PacketCapturesClient", "GetExtendedProperties", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", packetCaptureExtendedPropertiesApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.PacketCapturesClient", "GetExtendedProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.PacketCapturesClient", "GetExtendedProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type PacketCaptureExtendedGetResult struct {
+	autorest.Response `json:"-"`
+	Properties        *PacketCaptureExtendedProperties `json:"properties,omitempty"`
+}
+
+// withPacketCaptureExtendedProperties sets the `targetType` and `scope` within the properties of the request body and
+// updates the API Version used for the request to one which supports these fields.
+func withPacketCaptureExtendedProperties(input PacketCaptureExtendedProperties) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+
+			if input.TargetType != nil {
+				properties["targetType"] = *input.TargetType
+			}
+			if input.Scope != nil {
+				properties["scope"] = *input.Scope
+			}
+
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", packetCaptureExtendedPropertiesApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
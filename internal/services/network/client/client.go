@@ -3,6 +3,9 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagerconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/scopeconnections"
 )
 
 type Client struct {
@@ -24,6 +27,8 @@ type Client struct {
 	InterfacesClient                       *network.InterfacesClient
 	IPGroupsClient                         *network.IPGroupsClient
 	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
+	NetworkManagerConnectionsClient        *networkmanagerconnections.NetworkManagerConnectionsClient
+	NetworkManagerScopeConnectionsClient   *scopeconnections.ScopeConnectionsClient
 	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
 	ProfileClient                          *network.ProfilesClient
 	PacketCapturesClient                   *network.PacketCapturesClient
@@ -32,6 +37,7 @@ type Client struct {
 	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
 	RoutesClient                           *network.RoutesClient
 	RouteFiltersClient                     *network.RouteFiltersClient
+	RouteMapsClient                        *routemaps.RouteMapsClient
 	RouteTablesClient                      *network.RouteTablesClient
 	SecurityGroupClient                    *network.SecurityGroupsClient
 	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
@@ -116,6 +122,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	LocalNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LocalNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	NetworkManagerConnectionsClient := networkmanagerconnections.NewNetworkManagerConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkManagerScopeConnectionsClient := scopeconnections.NewScopeConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkManagerScopeConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	pointToSiteVpnGatewaysClient := network.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&pointToSiteVpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
@@ -155,6 +167,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	RouteFiltersClient := network.NewRouteFiltersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteFiltersClient.Client, o.ResourceManagerAuthorizer)
 
+	RouteMapsClient := routemaps.NewRouteMapsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RouteMapsClient.Client, o.ResourceManagerAuthorizer)
+
 	RouteTablesClient := network.NewRouteTablesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RouteTablesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -240,6 +255,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		InterfacesClient:                       &InterfacesClient,
 		IPGroupsClient:                         &IpGroupsClient,
 		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
+		NetworkManagerConnectionsClient:        &NetworkManagerConnectionsClient,
+		NetworkManagerScopeConnectionsClient:   &NetworkManagerScopeConnectionsClient,
 		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
 		ProfileClient:                          &ProfileClient,
 		PacketCapturesClient:                   &PacketCapturesClient,
@@ -248,6 +265,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
 		RoutesClient:                           &RoutesClient,
 		RouteFiltersClient:                     &RouteFiltersClient,
+		RouteMapsClient:                        &RouteMapsClient,
 		RouteTablesClient:                      &RouteTablesClient,
 		SecurityGroupClient:                    &SecurityGroupClient,
 		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
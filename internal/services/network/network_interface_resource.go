@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	lbvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -35,6 +37,8 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkInterfaceCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -120,6 +124,28 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 				},
 			},
 
+			"auxiliary_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AcceleratedConnections",
+					"Floating",
+				}, false),
+				RequiredWith: []string{"auxiliary_sku"},
+			},
+
+			"auxiliary_sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A1",
+					"A2",
+					"A4",
+					"A8",
+				}, false),
+				RequiredWith: []string{"auxiliary_mode"},
+			},
+
 			"dns_servers": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -266,7 +292,16 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags:                      tags.Expand(t),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
+	var future network.InterfacesCreateOrUpdateFuture
+	if auxiliaryMode, ok := d.GetOk("auxiliary_mode"); ok {
+		extendedProperties := azuresdkhacks.NetworkInterfaceExtendedProperties{
+			AuxiliaryMode: utils.String(auxiliaryMode.(string)),
+			AuxiliarySku:  utils.String(d.Get("auxiliary_sku").(string)),
+		}
+		future, err = azuresdkhacks.NewNetworkInterfacesWorkaroundClient(client).CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface, extendedProperties)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
+	}
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -368,7 +403,22 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	// this can be managed in another resource, so just port it over
 	update.InterfacePropertiesFormat.NetworkSecurityGroup = existing.InterfacePropertiesFormat.NetworkSecurityGroup
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, update)
+	var future network.InterfacesCreateOrUpdateFuture
+	auxiliaryMode := d.Get("auxiliary_mode").(string)
+	if auxiliaryMode != "" || d.HasChanges("auxiliary_mode", "auxiliary_sku") {
+		// removing the auxiliary mode requires explicitly setting both fields back to `None`
+		extendedProperties := azuresdkhacks.NetworkInterfaceExtendedProperties{
+			AuxiliaryMode: utils.String("None"),
+			AuxiliarySku:  utils.String("None"),
+		}
+		if auxiliaryMode != "" {
+			extendedProperties.AuxiliaryMode = utils.String(auxiliaryMode)
+			extendedProperties.AuxiliarySku = utils.String(d.Get("auxiliary_sku").(string))
+		}
+		future, err = azuresdkhacks.NewNetworkInterfacesWorkaroundClient(client).CreateOrUpdate(ctx, id.ResourceGroup, id.Name, update, extendedProperties)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, update)
+	}
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
@@ -471,9 +521,44 @@ func resourceNetworkInterfaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	// the auxiliary mode can only be used when accelerated networking is enabled, so the additional request (using a
+	// newer API Version) is only made when that's the case or the auxiliary mode is already configured
+	auxiliaryMode := ""
+	auxiliarySku := ""
+	acceleratedNetworkingEnabled := false
+	if props := resp.InterfacePropertiesFormat; props != nil && props.EnableAcceleratedNetworking != nil {
+		acceleratedNetworkingEnabled = *props.EnableAcceleratedNetworking
+	}
+	if acceleratedNetworkingEnabled || d.Get("auxiliary_mode").(string) != "" {
+		extendedResp, err := azuresdkhacks.NewNetworkInterfacesWorkaroundClient(client).GetExtendedProperties(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving the auxiliary properties for %s: %+v", *id, err)
+		}
+
+		if props := extendedResp.Properties; props != nil {
+			// `None` is returned when the auxiliary mode isn't configured
+			if props.AuxiliaryMode != nil && *props.AuxiliaryMode != "None" {
+				auxiliaryMode = *props.AuxiliaryMode
+			}
+			if props.AuxiliarySku != nil && *props.AuxiliarySku != "None" {
+				auxiliarySku = *props.AuxiliarySku
+			}
+		}
+	}
+	d.Set("auxiliary_mode", auxiliaryMode)
+	d.Set("auxiliary_sku", auxiliarySku)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+func networkInterfaceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Get("auxiliary_mode").(string) != "" && !diff.Get("enable_accelerated_networking").(bool) {
+		return fmt.Errorf("`enable_accelerated_networking` must be set to `true` when `auxiliary_mode` is specified")
+	}
+
+	return nil
+}
+
 func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
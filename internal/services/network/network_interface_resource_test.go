@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -93,6 +94,47 @@ func TestAccNetworkInterface_enableAcceleratedNetworking(t *testing.T) {
 	})
 }
 
+func TestAccNetworkInterface_auxiliary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.auxiliary(data, "AcceleratedConnections", "A1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.auxiliary(data, "Floating", "A2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.enableAcceleratedNetworking(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auxiliary_mode").HasValue(""),
+				check.That(data.ResourceName).Key("auxiliary_sku").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterface_auxiliaryWithoutAcceleratedNetworking(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.auxiliaryWithoutAcceleratedNetworking(data),
+			ExpectError: regexp.MustCompile("`enable_accelerated_networking` must be set to `true` when `auxiliary_mode` is specified"),
+		},
+	})
+}
+
 func TestAccNetworkInterface_enableIPForwarding(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
 	r := NetworkInterfaceResource{}
@@ -498,6 +540,51 @@ resource "azurerm_network_interface" "test" {
 `, r.template(data), data.RandomInteger, enabled)
 }
 
+func (r NetworkInterfaceResource) auxiliary(data acceptance.TestData, mode, sku string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                          = "acctestni-%d"
+  location                      = azurerm_resource_group.test.location
+  resource_group_name           = azurerm_resource_group.test.name
+  enable_accelerated_networking = true
+  auxiliary_mode                = "%s"
+  auxiliary_sku                 = "%s"
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+
+  tags = {
+    fastpathenabled = "true"
+  }
+}
+`, r.template(data), data.RandomInteger, mode, sku)
+}
+
+func (r NetworkInterfaceResource) auxiliaryWithoutAcceleratedNetworking(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  auxiliary_mode      = "AcceleratedConnections"
+  auxiliary_sku       = "A1"
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r NetworkInterfaceResource) enableIPForwarding(data acceptance.TestData, enabled bool) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,226 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagerconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkManagerManagementGroupConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkManagerManagementGroupConnectionCreate,
+		Read:   resourceNetworkManagerManagementGroupConnectionRead,
+		Update: resourceNetworkManagerManagementGroupConnectionUpdate,
+		Delete: resourceNetworkManagerManagementGroupConnectionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := networkmanagerconnections.ParseManagementGroupNetworkManagerConnectionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: managementGroupValidate.ManagementGroupID,
+			},
+
+			"network_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkManagerID,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"connection_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkManagerManagementGroupConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := managementGroupParse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := networkmanagerconnections.NewManagementGroupNetworkManagerConnectionID(managementGroupId.Name, d.Get("name").(string))
+
+	existing, err := client.ManagementGroupNetworkManagerConnectionsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_network_manager_management_group_connection", id.ID())
+	}
+
+	parameters := networkmanagerconnections.NetworkManagerConnection{
+		Properties: &networkmanagerconnections.NetworkManagerConnectionProperties{
+			NetworkManagerId: utils.String(d.Get("network_manager_id").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	resp, err := client.ManagementGroupNetworkManagerConnectionsCreateOrUpdate(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the connection is created regardless, however a connection in the `Conflict` state won't ever be usable - whereas
+	// one in the `Pending` state is awaiting approval from the Network Manager, so is surfaced via `connection_state`
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ConnectionState != nil {
+		if *model.Properties.ConnectionState == networkmanagerconnections.ScopeConnectionStateConflict {
+			return fmt.Errorf("%s was created but is in the `Conflict` state - this indicates the Management Group is already connected to this Network Manager", id)
+		}
+	}
+
+	return resourceNetworkManagerManagementGroupConnectionRead(d, meta)
+}
+
+func resourceNetworkManagerManagementGroupConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkmanagerconnections.ParseManagementGroupNetworkManagerConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ManagementGroupNetworkManagerConnectionsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("management_group_id", managementGroupParse.NewManagementGroupId(id.ManagementGroupName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			networkManagerId := ""
+			if props.NetworkManagerId != nil {
+				parsed, err := parse.NetworkManagerIDInsensitively(*props.NetworkManagerId)
+				if err != nil {
+					return err
+				}
+				networkManagerId = parsed.ID()
+			}
+			d.Set("network_manager_id", networkManagerId)
+			d.Set("description", props.Description)
+
+			connectionState := ""
+			if props.ConnectionState != nil {
+				connectionState = string(*props.ConnectionState)
+			}
+			d.Set("connection_state", connectionState)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkManagerManagementGroupConnectionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectionsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkmanagerconnections.ParseManagementGroupNetworkManagerConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.ManagementGroupNetworkManagerConnectionsGet(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	// the `connectionState` is read-only and can't be sent back to the API
+	parameters := networkmanagerconnections.NetworkManagerConnection{
+		Properties: &networkmanagerconnections.NetworkManagerConnectionProperties{
+			NetworkManagerId: existing.Model.Properties.NetworkManagerId,
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.ManagementGroupNetworkManagerConnectionsCreateOrUpdate(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceNetworkManagerManagementGroupConnectionRead(d, meta)
+}
+
+func resourceNetworkManagerManagementGroupConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkManagerConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkmanagerconnections.ParseManagementGroupNetworkManagerConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ManagementGroupNetworkManagerConnectionsDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
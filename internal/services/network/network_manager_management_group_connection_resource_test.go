@@ -0,0 +1,145 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagerconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerManagementGroupConnectionResource struct{}
+
+func TestAccNetworkManagerManagementGroupConnection_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_management_group_connection", "test")
+	r := NetworkManagerManagementGroupConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerManagementGroupConnection_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_management_group_connection", "test")
+	r := NetworkManagerManagementGroupConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerManagementGroupConnection_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_management_group_connection", "test")
+	r := NetworkManagerManagementGroupConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r NetworkManagerManagementGroupConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkmanagerconnections.ParseManagementGroupNetworkManagerConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkManagerConnectionsClient.ManagementGroupNetworkManagerConnectionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerManagementGroupConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctest-mg-%[1]d"
+}
+
+resource "azurerm_network_manager_management_group_connection" "test" {
+  name                = "acctest-nmmgc-%[1]d"
+  management_group_id = azurerm_management_group.test.id
+  network_manager_id  = "%[2]s"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_NETWORK_MANAGER_ID"))
+}
+
+func (r NetworkManagerManagementGroupConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_management_group_connection" "import" {
+  name                = azurerm_network_manager_management_group_connection.test.name
+  management_group_id = azurerm_network_manager_management_group_connection.test.management_group_id
+  network_manager_id  = azurerm_network_manager_management_group_connection.test.network_manager_id
+}
+`, r.basic(data))
+}
+
+func (r NetworkManagerManagementGroupConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctest-mg-%[1]d"
+}
+
+resource "azurerm_network_manager_management_group_connection" "test" {
+  name                = "acctest-nmmgc-%[1]d"
+  management_group_id = azurerm_management_group.test.id
+  network_manager_id  = "%[2]s"
+  description         = "Test Management Group Connection"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_NETWORK_MANAGER_ID"))
+}
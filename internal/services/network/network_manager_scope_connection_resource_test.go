@@ -0,0 +1,155 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/scopeconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerScopeConnectionResource struct{}
+
+func TestAccNetworkManagerScopeConnection_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_scope_connection", "test")
+	r := NetworkManagerScopeConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerScopeConnection_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_scope_connection", "test")
+	r := NetworkManagerScopeConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerScopeConnection_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_scope_connection", "test")
+	r := NetworkManagerScopeConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r NetworkManagerScopeConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := scopeconnections.ParseScopeConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkManagerScopeConnectionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerScopeConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_network_manager_scope_connection" "test" {
+  name               = "acctest-nmsc-%d"
+  network_manager_id = "%s"
+  tenant_id          = data.azurerm_client_config.current.tenant_id
+  resource_id        = data.azurerm_subscription.current.id
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_NETWORK_MANAGER_ID"))
+}
+
+func (r NetworkManagerScopeConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_scope_connection" "import" {
+  name               = azurerm_network_manager_scope_connection.test.name
+  network_manager_id = azurerm_network_manager_scope_connection.test.network_manager_id
+  tenant_id          = azurerm_network_manager_scope_connection.test.tenant_id
+  resource_id        = azurerm_network_manager_scope_connection.test.resource_id
+}
+`, r.basic(data))
+}
+
+func (r NetworkManagerScopeConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_network_manager_scope_connection" "test" {
+  name               = "acctest-nmsc-%d"
+  network_manager_id = "%s"
+  tenant_id          = data.azurerm_client_config.current.tenant_id
+  resource_id        = data.azurerm_subscription.current.id
+  description        = "Test Scope Connection"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_NETWORK_MANAGER_ID"))
+}
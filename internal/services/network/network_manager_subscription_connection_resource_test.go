@@ -0,0 +1,141 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/networkmanagerconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerSubscriptionConnectionResource struct{}
+
+func TestAccNetworkManagerSubscriptionConnection_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_subscription_connection", "test")
+	r := NetworkManagerSubscriptionConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkManagerSubscriptionConnection_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_subscription_connection", "test")
+	r := NetworkManagerSubscriptionConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkManagerSubscriptionConnection_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_NETWORK_MANAGER_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_NETWORK_MANAGER_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_subscription_connection", "test")
+	r := NetworkManagerSubscriptionConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r NetworkManagerSubscriptionConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkmanagerconnections.ParseNetworkManagerConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkManagerConnectionsClient.SubscriptionNetworkManagerConnectionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerSubscriptionConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_network_manager_subscription_connection" "test" {
+  name               = "acctest-nmsc-%d"
+  subscription_id    = data.azurerm_subscription.current.id
+  network_manager_id = "%s"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_NETWORK_MANAGER_ID"))
+}
+
+func (r NetworkManagerSubscriptionConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_subscription_connection" "import" {
+  name               = azurerm_network_manager_subscription_connection.test.name
+  subscription_id    = azurerm_network_manager_subscription_connection.test.subscription_id
+  network_manager_id = azurerm_network_manager_subscription_connection.test.network_manager_id
+}
+`, r.basic(data))
+}
+
+func (r NetworkManagerSubscriptionConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_network_manager_subscription_connection" "test" {
+  name               = "acctest-nmsc-%d"
+  subscription_id    = data.azurerm_subscription.current.id
+  network_manager_id = "%s"
+  description        = "Test Subscription Connection"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_NETWORK_MANAGER_ID"))
+}
@@ -2,6 +2,8 @@ package network
 
 import (
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
@@ -10,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -52,6 +55,12 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"flush_connection_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"security_rule": {
 				Type:       pluginsdk.TypeSet,
 				ConfigMode: pluginsdk.SchemaConfigModeAttr,
@@ -221,7 +230,18 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 		Tags: tags.Expand(t),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, sg)
+	var future network.SecurityGroupsCreateOrUpdateFuture
+	var err error
+	flushConnectionEnabled := d.Get("flush_connection_enabled").(bool)
+	if flushConnectionEnabled || d.HasChange("flush_connection_enabled") {
+		// the `flushConnection` field isn't available in the version of the SDK we're using, so we need to use a workaround client
+		extendedProperties := azuresdkhacks.NetworkSecurityGroupExtendedProperties{
+			FlushConnection: utils.Bool(flushConnectionEnabled),
+		}
+		future, err = azuresdkhacks.NewNetworkSecurityGroupsWorkaroundClient(client).CreateOrUpdate(ctx, resGroup, name, sg, extendedProperties)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, resGroup, name, sg)
+	}
 	if err != nil {
 		return fmt.Errorf("creating/updating NSG %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -275,6 +295,22 @@ func resourceNetworkSecurityGroupRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	// this is retrieved using a newer API Version, which may not be available (e.g. in some National Clouds) - in which
+	// case the existing value is retained rather than failing to read the Network Security Group
+	extendedResp, err := azuresdkhacks.NewNetworkSecurityGroupsWorkaroundClient(client).GetExtendedProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasBadRequest(extendedResp.Response) && !utils.ResponseWasNotFound(extendedResp.Response) {
+			return fmt.Errorf("retrieving the flush connection setting for Network Security Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] unable to retrieve the flush connection setting for Network Security Group %q (Resource Group %q) - retaining the existing value: %+v", id.Name, id.ResourceGroup, err)
+	} else {
+		flushConnectionEnabled := false
+		if props := extendedResp.Properties; props != nil && props.FlushConnection != nil {
+			flushConnectionEnabled = *props.FlushConnection
+		}
+		d.Set("flush_connection_enabled", flushConnectionEnabled)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -408,6 +444,11 @@ func flattenNetworkSecurityRules(rules *[]network.SecurityRule) []map[string]int
 
 	if rules != nil {
 		for _, rule := range *rules {
+			// the default rules are managed by Azure, so these shouldn't be treated as user defined rules
+			if isDefaultNetworkSecurityRule(rule) {
+				continue
+			}
+
 			sgRule := make(map[string]interface{})
 			sgRule["name"] = *rule.Name
 
@@ -472,6 +513,10 @@ func flattenNetworkSecurityRules(rules *[]network.SecurityRule) []map[string]int
 	return result
 }
 
+func isDefaultNetworkSecurityRule(rule network.SecurityRule) bool {
+	return rule.ID != nil && strings.Contains(strings.ToLower(*rule.ID), "/defaultsecurityrules/")
+}
+
 func validateSecurityRule(sgRule map[string]interface{}) error {
 	var err *multierror.Error
 
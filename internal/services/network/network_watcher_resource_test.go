@@ -67,6 +67,14 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"withFilters":                testAccNetworkPacketCapture_withFilters,
 			"requiresImport":             testAccNetworkPacketCapture_requiresImport,
 		},
+		"VirtualMachineScaleSetPacketCapture": {
+			"localDisk":               testAccVirtualMachineScaleSetPacketCapture_localDisk,
+			"storageAccount":          testAccVirtualMachineScaleSetPacketCapture_storageAccount,
+			"withFilters":             testAccVirtualMachineScaleSetPacketCapture_withFilters,
+			"machineScope":            testAccVirtualMachineScaleSetPacketCapture_machineScope,
+			"machineScopeOverlapping": testAccVirtualMachineScaleSetPacketCapture_machineScopeOverlapping,
+			"requiresImport":          testAccVirtualMachineScaleSetPacketCapture_requiresImport,
+		},
 		"FlowLog": {
 			"basic":                 testAccNetworkWatcherFlowLog_basic,
 			"disabled":              testAccNetworkWatcherFlowLog_disabled,
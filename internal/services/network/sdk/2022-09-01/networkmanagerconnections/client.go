@@ -0,0 +1,15 @@
+package networkmanagerconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkManagerConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkManagerConnectionsClientWithBaseURI(endpoint string) NetworkManagerConnectionsClient {
+	return NetworkManagerConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,76 @@
+package networkmanagerconnections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ManagementGroupNetworkManagerConnectionId is scoped to a Management Group rather than a Subscription, so is maintained by hand
+type ManagementGroupNetworkManagerConnectionId struct {
+	ManagementGroupName string
+	Name                string
+}
+
+func NewManagementGroupNetworkManagerConnectionID(managementGroupName, name string) ManagementGroupNetworkManagerConnectionId {
+	return ManagementGroupNetworkManagerConnectionId{
+		ManagementGroupName: managementGroupName,
+		Name:                name,
+	}
+}
+
+func (id ManagementGroupNetworkManagerConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Management Group Name %q", id.ManagementGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Group Network Manager Connection", segmentsStr)
+}
+
+func (id ManagementGroupNetworkManagerConnectionId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Network/networkManagerConnections/%s"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.Name)
+}
+
+// ParseManagementGroupNetworkManagerConnectionID parses a ManagementGroupNetworkManagerConnection ID into an ManagementGroupNetworkManagerConnectionId struct
+func ParseManagementGroupNetworkManagerConnectionID(input string) (*ManagementGroupNetworkManagerConnectionId, error) {
+	return parseManagementGroupNetworkManagerConnectionID(input, false)
+}
+
+// ParseManagementGroupNetworkManagerConnectionIDInsensitively parses an ManagementGroupNetworkManagerConnection ID into an ManagementGroupNetworkManagerConnectionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseManagementGroupNetworkManagerConnectionID method should be used instead for validation etc.
+func ParseManagementGroupNetworkManagerConnectionIDInsensitively(input string) (*ManagementGroupNetworkManagerConnectionId, error) {
+	return parseManagementGroupNetworkManagerConnectionID(input, true)
+}
+
+func parseManagementGroupNetworkManagerConnectionID(input string, insensitively bool) (*ManagementGroupNetworkManagerConnectionId, error) {
+	expected := []string{"providers", "Microsoft.Management", "managementGroups", "", "providers", "Microsoft.Network", "networkManagerConnections", ""}
+
+	components := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if !strings.HasPrefix(input, "/") || len(components) != len(expected) {
+		return nil, fmt.Errorf("expected the Management Group Network Manager Connection ID %q to be in the format %q", input, NewManagementGroupNetworkManagerConnectionID("{managementGroupName}", "{networkManagerConnectionName}").ID())
+	}
+
+	for i, segment := range expected {
+		if segment == "" {
+			if components[i] == "" {
+				return nil, fmt.Errorf("ID was missing a value for the '%s' element", expected[i-1])
+			}
+			continue
+		}
+
+		matches := components[i] == segment
+		if insensitively {
+			matches = strings.EqualFold(components[i], segment)
+		}
+		if !matches {
+			return nil, fmt.Errorf("ID was missing the '%s' element", segment)
+		}
+	}
+
+	return &ManagementGroupNetworkManagerConnectionId{
+		ManagementGroupName: components[3],
+		Name:                components[7],
+	}, nil
+}
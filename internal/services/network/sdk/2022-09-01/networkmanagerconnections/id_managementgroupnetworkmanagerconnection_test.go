@@ -0,0 +1,135 @@
+package networkmanagerconnections
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ManagementGroupNetworkManagerConnectionId{}
+
+func TestManagementGroupNetworkManagerConnectionIDFormatter(t *testing.T) {
+	actual := NewManagementGroupNetworkManagerConnectionID("group1", "connection1").ID()
+	expected := "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Network/networkManagerConnections/connection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseManagementGroupNetworkManagerConnectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementGroupNetworkManagerConnectionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing ManagementGroupName
+			Input: "/providers/Microsoft.Management/managementGroups/",
+			Error: true,
+		},
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Network/networkManagerConnections/",
+			Error: true,
+		},
+		{
+			// subscription scoped
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/networkManagerConnections/connection1",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Network/networkManagerConnections/connection1",
+			Expected: &ManagementGroupNetworkManagerConnectionId{
+				ManagementGroupName: "group1",
+				Name:                "connection1",
+			},
+		},
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.NETWORK/NETWORKMANAGERCONNECTIONS/connection1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseManagementGroupNetworkManagerConnectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestParseManagementGroupNetworkManagerConnectionIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementGroupNetworkManagerConnectionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Network/networkManagerConnections/connection1",
+			Expected: &ManagementGroupNetworkManagerConnectionId{
+				ManagementGroupName: "group1",
+				Name:                "connection1",
+			},
+		},
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.NETWORK/NETWORKMANAGERCONNECTIONS/connection1",
+			Expected: &ManagementGroupNetworkManagerConnectionId{
+				ManagementGroupName: "GROUP1",
+				Name:                "connection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseManagementGroupNetworkManagerConnectionIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package networkmanagerconnections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NetworkManagerConnectionId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func NewNetworkManagerConnectionID(subscriptionId, name string) NetworkManagerConnectionId {
+	return NetworkManagerConnectionId{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+func (id NetworkManagerConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Connection", segmentsStr)
+}
+
+func (id NetworkManagerConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Network/networkManagerConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// ParseNetworkManagerConnectionID parses a NetworkManagerConnection ID into an NetworkManagerConnectionId struct
+func ParseNetworkManagerConnectionID(input string) (*NetworkManagerConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkManagerConnectionId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("networkManagerConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseNetworkManagerConnectionIDInsensitively parses an NetworkManagerConnection ID into an NetworkManagerConnectionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseNetworkManagerConnectionID method should be used instead for validation etc.
+func ParseNetworkManagerConnectionIDInsensitively(input string) (*NetworkManagerConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkManagerConnectionId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	// find the correct casing for the 'networkManagerConnections' segment
+	networkManagerConnectionsKey := "networkManagerConnections"
+	for key := range id.Path {
+		if strings.EqualFold(key, networkManagerConnectionsKey) {
+			networkManagerConnectionsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(networkManagerConnectionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
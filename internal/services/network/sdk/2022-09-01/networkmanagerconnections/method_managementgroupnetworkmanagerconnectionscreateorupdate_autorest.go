@@ -0,0 +1,65 @@
+package networkmanagerconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ManagementGroupNetworkManagerConnectionsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerConnection
+}
+
+// ManagementGroupNetworkManagerConnectionsCreateOrUpdate ...
+func (c NetworkManagerConnectionsClient) ManagementGroupNetworkManagerConnectionsCreateOrUpdate(ctx context.Context, id ManagementGroupNetworkManagerConnectionId, input NetworkManagerConnection) (result ManagementGroupNetworkManagerConnectionsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForManagementGroupNetworkManagerConnectionsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForManagementGroupNetworkManagerConnectionsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForManagementGroupNetworkManagerConnectionsCreateOrUpdate prepares the ManagementGroupNetworkManagerConnectionsCreateOrUpdate request.
+func (c NetworkManagerConnectionsClient) preparerForManagementGroupNetworkManagerConnectionsCreateOrUpdate(ctx context.Context, id ManagementGroupNetworkManagerConnectionId, input NetworkManagerConnection) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForManagementGroupNetworkManagerConnectionsCreateOrUpdate handles the response to the ManagementGroupNetworkManagerConnectionsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerConnectionsClient) responderForManagementGroupNetworkManagerConnectionsCreateOrUpdate(resp *http.Response) (result ManagementGroupNetworkManagerConnectionsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package networkmanagerconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ManagementGroupNetworkManagerConnectionsDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// ManagementGroupNetworkManagerConnectionsDelete ...
+func (c NetworkManagerConnectionsClient) ManagementGroupNetworkManagerConnectionsDelete(ctx context.Context, id ManagementGroupNetworkManagerConnectionId) (result ManagementGroupNetworkManagerConnectionsDeleteResponse, err error) {
+	req, err := c.preparerForManagementGroupNetworkManagerConnectionsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForManagementGroupNetworkManagerConnectionsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForManagementGroupNetworkManagerConnectionsDelete prepares the ManagementGroupNetworkManagerConnectionsDelete request.
+func (c NetworkManagerConnectionsClient) preparerForManagementGroupNetworkManagerConnectionsDelete(ctx context.Context, id ManagementGroupNetworkManagerConnectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForManagementGroupNetworkManagerConnectionsDelete handles the response to the ManagementGroupNetworkManagerConnectionsDelete request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerConnectionsClient) responderForManagementGroupNetworkManagerConnectionsDelete(resp *http.Response) (result ManagementGroupNetworkManagerConnectionsDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
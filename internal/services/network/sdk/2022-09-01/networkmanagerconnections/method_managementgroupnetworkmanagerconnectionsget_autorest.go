@@ -0,0 +1,64 @@
+package networkmanagerconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ManagementGroupNetworkManagerConnectionsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerConnection
+}
+
+// ManagementGroupNetworkManagerConnectionsGet ...
+func (c NetworkManagerConnectionsClient) ManagementGroupNetworkManagerConnectionsGet(ctx context.Context, id ManagementGroupNetworkManagerConnectionId) (result ManagementGroupNetworkManagerConnectionsGetResponse, err error) {
+	req, err := c.preparerForManagementGroupNetworkManagerConnectionsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForManagementGroupNetworkManagerConnectionsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "ManagementGroupNetworkManagerConnectionsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForManagementGroupNetworkManagerConnectionsGet prepares the ManagementGroupNetworkManagerConnectionsGet request.
+func (c NetworkManagerConnectionsClient) preparerForManagementGroupNetworkManagerConnectionsGet(ctx context.Context, id ManagementGroupNetworkManagerConnectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForManagementGroupNetworkManagerConnectionsGet handles the response to the ManagementGroupNetworkManagerConnectionsGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerConnectionsClient) responderForManagementGroupNetworkManagerConnectionsGet(resp *http.Response) (result ManagementGroupNetworkManagerConnectionsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package networkmanagerconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SubscriptionNetworkManagerConnectionsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerConnection
+}
+
+// SubscriptionNetworkManagerConnectionsCreateOrUpdate ...
+func (c NetworkManagerConnectionsClient) SubscriptionNetworkManagerConnectionsCreateOrUpdate(ctx context.Context, id NetworkManagerConnectionId, input NetworkManagerConnection) (result SubscriptionNetworkManagerConnectionsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSubscriptionNetworkManagerConnectionsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSubscriptionNetworkManagerConnectionsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSubscriptionNetworkManagerConnectionsCreateOrUpdate prepares the SubscriptionNetworkManagerConnectionsCreateOrUpdate request.
+func (c NetworkManagerConnectionsClient) preparerForSubscriptionNetworkManagerConnectionsCreateOrUpdate(ctx context.Context, id NetworkManagerConnectionId, input NetworkManagerConnection) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSubscriptionNetworkManagerConnectionsCreateOrUpdate handles the response to the SubscriptionNetworkManagerConnectionsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerConnectionsClient) responderForSubscriptionNetworkManagerConnectionsCreateOrUpdate(resp *http.Response) (result SubscriptionNetworkManagerConnectionsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package networkmanagerconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SubscriptionNetworkManagerConnectionsDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// SubscriptionNetworkManagerConnectionsDelete ...
+func (c NetworkManagerConnectionsClient) SubscriptionNetworkManagerConnectionsDelete(ctx context.Context, id NetworkManagerConnectionId) (result SubscriptionNetworkManagerConnectionsDeleteResponse, err error) {
+	req, err := c.preparerForSubscriptionNetworkManagerConnectionsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSubscriptionNetworkManagerConnectionsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSubscriptionNetworkManagerConnectionsDelete prepares the SubscriptionNetworkManagerConnectionsDelete request.
+func (c NetworkManagerConnectionsClient) preparerForSubscriptionNetworkManagerConnectionsDelete(ctx context.Context, id NetworkManagerConnectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSubscriptionNetworkManagerConnectionsDelete handles the response to the SubscriptionNetworkManagerConnectionsDelete request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerConnectionsClient) responderForSubscriptionNetworkManagerConnectionsDelete(resp *http.Response) (result SubscriptionNetworkManagerConnectionsDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package networkmanagerconnections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SubscriptionNetworkManagerConnectionsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerConnection
+}
+
+// SubscriptionNetworkManagerConnectionsGet ...
+func (c NetworkManagerConnectionsClient) SubscriptionNetworkManagerConnectionsGet(ctx context.Context, id NetworkManagerConnectionId) (result SubscriptionNetworkManagerConnectionsGetResponse, err error) {
+	req, err := c.preparerForSubscriptionNetworkManagerConnectionsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSubscriptionNetworkManagerConnectionsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerconnections.NetworkManagerConnectionsClient", "SubscriptionNetworkManagerConnectionsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSubscriptionNetworkManagerConnectionsGet prepares the SubscriptionNetworkManagerConnectionsGet request.
+func (c NetworkManagerConnectionsClient) preparerForSubscriptionNetworkManagerConnectionsGet(ctx context.Context, id NetworkManagerConnectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSubscriptionNetworkManagerConnectionsGet handles the response to the SubscriptionNetworkManagerConnectionsGet request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerConnectionsClient) responderForSubscriptionNetworkManagerConnectionsGet(resp *http.Response) (result SubscriptionNetworkManagerConnectionsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
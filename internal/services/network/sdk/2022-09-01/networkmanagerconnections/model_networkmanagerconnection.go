@@ -0,0 +1,9 @@
+package networkmanagerconnections
+
+type NetworkManagerConnection struct {
+	Etag       *string                             `json:"etag,omitempty"`
+	Id         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties *NetworkManagerConnectionProperties `json:"properties,omitempty"`
+	Type       *string                             `json:"type,omitempty"`
+}
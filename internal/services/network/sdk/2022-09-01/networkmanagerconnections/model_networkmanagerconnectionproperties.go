@@ -0,0 +1,7 @@
+package networkmanagerconnections
+
+type NetworkManagerConnectionProperties struct {
+	ConnectionState  *ScopeConnectionState `json:"connectionState,omitempty"`
+	Description      *string               `json:"description,omitempty"`
+	NetworkManagerId *string               `json:"networkManagerId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package networkmanagerconnections
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networkmanagerconnections/%s", defaultApiVersion)
+}
@@ -0,0 +1,38 @@
+package routemaps
+
+type NextStep string
+
+const (
+	NextStepContinue  NextStep = "Continue"
+	NextStepTerminate NextStep = "Terminate"
+	NextStepUnknown   NextStep = "Unknown"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+type RouteMapActionType string
+
+const (
+	RouteMapActionTypeAdd     RouteMapActionType = "Add"
+	RouteMapActionTypeDrop    RouteMapActionType = "Drop"
+	RouteMapActionTypeRemove  RouteMapActionType = "Remove"
+	RouteMapActionTypeReplace RouteMapActionType = "Replace"
+	RouteMapActionTypeUnknown RouteMapActionType = "Unknown"
+)
+
+type RouteMapMatchCondition string
+
+const (
+	RouteMapMatchConditionContains    RouteMapMatchCondition = "Contains"
+	RouteMapMatchConditionEquals      RouteMapMatchCondition = "Equals"
+	RouteMapMatchConditionNotContains RouteMapMatchCondition = "NotContains"
+	RouteMapMatchConditionNotEquals   RouteMapMatchCondition = "NotEquals"
+	RouteMapMatchConditionUnknown     RouteMapMatchCondition = "Unknown"
+)
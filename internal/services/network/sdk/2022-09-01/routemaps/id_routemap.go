@@ -0,0 +1,126 @@
+package routemaps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RouteMapId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualHubName string
+	Name           string
+}
+
+func NewRouteMapID(subscriptionId, resourceGroup, virtualHubName, name string) RouteMapId {
+	return RouteMapId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualHubName: virtualHubName,
+		Name:           name,
+	}
+}
+
+func (id RouteMapId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Virtual Hub Name %q", id.VirtualHubName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Route Map", segmentsStr)
+}
+
+func (id RouteMapId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/routeMaps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
+}
+
+// ParseRouteMapID parses a RouteMap ID into an RouteMapId struct
+func ParseRouteMapID(input string) (*RouteMapId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RouteMapId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("routeMaps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseRouteMapIDInsensitively parses an RouteMap ID into an RouteMapId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseRouteMapID method should be used instead for validation etc.
+func ParseRouteMapIDInsensitively(input string) (*RouteMapId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RouteMapId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'virtualHubs' segment
+	virtualHubsKey := "virtualHubs"
+	for key := range id.Path {
+		if strings.EqualFold(key, virtualHubsKey) {
+			virtualHubsKey = key
+			break
+		}
+	}
+	if resourceId.VirtualHubName, err = id.PopSegment(virtualHubsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'routeMaps' segment
+	routeMapsKey := "routeMaps"
+	for key := range id.Path {
+		if strings.EqualFold(key, routeMapsKey) {
+			routeMapsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(routeMapsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package routemaps
+
+type Action struct {
+	Parameters *[]Parameter        `json:"parameters,omitempty"`
+	Type       *RouteMapActionType `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package scopeconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ScopeConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewScopeConnectionsClientWithBaseURI(endpoint string) ScopeConnectionsClient {
+	return ScopeConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,11 @@
+package scopeconnections
+
+type ScopeConnectionState string
+
+const (
+	ScopeConnectionStateConflict  ScopeConnectionState = "Conflict"
+	ScopeConnectionStateConnected ScopeConnectionState = "Connected"
+	ScopeConnectionStatePending   ScopeConnectionState = "Pending"
+	ScopeConnectionStateRejected  ScopeConnectionState = "Rejected"
+	ScopeConnectionStateRevoked   ScopeConnectionState = "Revoked"
+)
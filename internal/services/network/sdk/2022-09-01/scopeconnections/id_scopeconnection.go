@@ -0,0 +1,126 @@
+package scopeconnections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ScopeConnectionId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	Name               string
+}
+
+func NewScopeConnectionID(subscriptionId, resourceGroup, networkManagerName, name string) ScopeConnectionId {
+	return ScopeConnectionId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		Name:               name,
+	}
+}
+
+func (id ScopeConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Scope Connection", segmentsStr)
+}
+
+func (id ScopeConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/scopeConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.Name)
+}
+
+// ParseScopeConnectionID parses a ScopeConnection ID into an ScopeConnectionId struct
+func ParseScopeConnectionID(input string) (*ScopeConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ScopeConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkManagerName, err = id.PopSegment("networkManagers"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("scopeConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseScopeConnectionIDInsensitively parses an ScopeConnection ID into an ScopeConnectionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseScopeConnectionID method should be used instead for validation etc.
+func ParseScopeConnectionIDInsensitively(input string) (*ScopeConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ScopeConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'networkManagers' segment
+	networkManagersKey := "networkManagers"
+	for key := range id.Path {
+		if strings.EqualFold(key, networkManagersKey) {
+			networkManagersKey = key
+			break
+		}
+	}
+	if resourceId.NetworkManagerName, err = id.PopSegment(networkManagersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'scopeConnections' segment
+	scopeConnectionsKey := "scopeConnections"
+	for key := range id.Path {
+		if strings.EqualFold(key, scopeConnectionsKey) {
+			scopeConnectionsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(scopeConnectionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,9 @@
+package scopeconnections
+
+type ScopeConnection struct {
+	Etag       *string                    `json:"etag,omitempty"`
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *ScopeConnectionProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
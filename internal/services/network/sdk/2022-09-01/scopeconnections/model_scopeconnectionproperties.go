@@ -0,0 +1,8 @@
+package scopeconnections
+
+type ScopeConnectionProperties struct {
+	ConnectionState *ScopeConnectionState `json:"connectionState,omitempty"`
+	Description     *string               `json:"description,omitempty"`
+	ResourceId      *string               `json:"resourceId,omitempty"`
+	TenantId        *string               `json:"tenantId,omitempty"`
+}
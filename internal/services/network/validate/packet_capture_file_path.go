@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PacketCaptureFilePath validates that the value is an absolute path on the target machine (either a Linux or a
+// Windows path) to a capture file, which must have the extension `.cap`
+func PacketCaptureFilePath(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if !strings.HasPrefix(value, "/") && !regexp.MustCompile(`^[a-zA-Z]:\\`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be an absolute path on the target machine, e.g. `/var/captures/capture.cap` or `C:\\captures\\capture.cap`, got %q", k, value))
+		return warnings, errors
+	}
+
+	fileName := value[strings.LastIndexAny(value, "/\\")+1:]
+	if !strings.HasSuffix(strings.ToLower(fileName), ".cap") || len(fileName) == len(".cap") {
+		errors = append(errors, fmt.Errorf("%q must include the name of the capture file, which must have the extension `.cap`, got %q", k, value))
+	}
+
+	return warnings, errors
+}
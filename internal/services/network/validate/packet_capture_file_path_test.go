@@ -0,0 +1,61 @@
+package validate
+
+import "testing"
+
+func TestPacketCaptureFilePath(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "captures/packet.cap",
+			Errors: 1,
+		},
+		{
+			Value:  "/var/captures/packet.cap",
+			Errors: 0,
+		},
+		{
+			Value:  "/var/captures/PACKET.CAP",
+			Errors: 0,
+		},
+		{
+			Value:  "/var/captures/packet.pcap",
+			Errors: 1,
+		},
+		{
+			Value:  "/var/captures/",
+			Errors: 1,
+		},
+		{
+			Value:  "/var/captures/.cap",
+			Errors: 1,
+		},
+		{
+			Value:  "C:\\captures\\packet.cap",
+			Errors: 0,
+		},
+		{
+			Value:  "C:\\captures\\.cap",
+			Errors: 1,
+		},
+		{
+			Value:  "C:captures\\packet.cap",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := PacketCaptureFilePath(tc.Value, "file_path")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PacketCaptureFilePath to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
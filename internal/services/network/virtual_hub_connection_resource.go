@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -92,7 +93,21 @@ func resourceVirtualHubConnection() *pluginsdk.Resource {
 							Optional:     true,
 							Computed:     true,
 							ValidateFunc: validate.HubRouteTableID,
-							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
+							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.inbound_route_map_id", "routing.0.outbound_route_map_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
+						},
+
+						"inbound_route_map_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.RouteMapID,
+							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.inbound_route_map_id", "routing.0.outbound_route_map_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
+						},
+
+						"outbound_route_map_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.RouteMapID,
+							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.inbound_route_map_id", "routing.0.outbound_route_map_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
 						},
 
 						"propagated_route_table": {
@@ -125,7 +140,7 @@ func resourceVirtualHubConnection() *pluginsdk.Resource {
 									},
 								},
 							},
-							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
+							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.inbound_route_map_id", "routing.0.outbound_route_map_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
 						},
 
 						//lintignore:XS003
@@ -156,7 +171,7 @@ func resourceVirtualHubConnection() *pluginsdk.Resource {
 									},
 								},
 							},
-							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
+							AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.inbound_route_map_id", "routing.0.outbound_route_map_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
 						},
 					},
 				},
@@ -214,7 +229,15 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 		connection.HubVirtualNetworkConnectionProperties.RoutingConfiguration = expandVirtualHubConnectionRouting(v.([]interface{}))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.Name, connection)
+	var future network.HubVirtualNetworkConnectionsCreateOrUpdateFuture
+	routeMaps := expandVirtualHubConnectionRouteMaps(d.Get("routing").([]interface{}))
+	// the Route Maps are only available in a newer API Version, so the workaround client is only used when these are
+	// configured (or are being removed)
+	if routeMaps.InboundRouteMap != nil || routeMaps.OutboundRouteMap != nil || d.HasChanges("routing.0.inbound_route_map_id", "routing.0.outbound_route_map_id") {
+		future, err = azuresdkhacks.NewHubVirtualNetworkConnectionsWorkaroundClient(client).CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.Name, connection, routeMaps)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.Name, connection)
+	}
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -278,7 +301,21 @@ func resourceVirtualHubConnectionRead(d *pluginsdk.ResourceData, meta interface{
 		}
 		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
 
-		if err := d.Set("routing", flattenVirtualHubConnectionRouting(props.RoutingConfiguration)); err != nil {
+		var routeMaps *azuresdkhacks.HubVirtualNetworkConnectionRouteMaps
+		routeMapsResp, err := azuresdkhacks.NewHubVirtualNetworkConnectionsWorkaroundClient(client).GetRouteMaps(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasBadRequest(routeMapsResp.Response) && !utils.ResponseWasNotFound(routeMapsResp.Response) {
+				return fmt.Errorf("retrieving the route maps for %s: %+v", *id, err)
+			}
+
+			log.Printf("[DEBUG] unable to retrieve the route maps for %s - retaining the existing value(s): %+v", *id, err)
+			existing := expandVirtualHubConnectionRouteMaps(d.Get("routing").([]interface{}))
+			routeMaps = &existing
+		} else if routeMapsProps := routeMapsResp.Properties; routeMapsProps != nil {
+			routeMaps = routeMapsProps.RoutingConfiguration
+		}
+
+		if err := d.Set("routing", flattenVirtualHubConnectionRouting(props.RoutingConfiguration, routeMaps)); err != nil {
 			return fmt.Errorf("setting `routing`: %+v", err)
 		}
 	}
@@ -336,6 +373,29 @@ func expandVirtualHubConnectionRouting(input []interface{}) *network.RoutingConf
 	return &result
 }
 
+func expandVirtualHubConnectionRouteMaps(input []interface{}) azuresdkhacks.HubVirtualNetworkConnectionRouteMaps {
+	result := azuresdkhacks.HubVirtualNetworkConnectionRouteMaps{}
+	if len(input) == 0 || input[0] == nil {
+		return result
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if inboundRouteMapId := v["inbound_route_map_id"].(string); inboundRouteMapId != "" {
+		result.InboundRouteMap = &network.SubResource{
+			ID: utils.String(inboundRouteMapId),
+		}
+	}
+
+	if outboundRouteMapId := v["outbound_route_map_id"].(string); outboundRouteMapId != "" {
+		result.OutboundRouteMap = &network.SubResource{
+			ID: utils.String(outboundRouteMapId),
+		}
+	}
+
+	return result
+}
+
 func expandVirtualHubConnectionPropagatedRouteTable(input []interface{}) *network.PropagatedRouteTable {
 	if len(input) == 0 {
 		return &network.PropagatedRouteTable{}
@@ -404,7 +464,7 @@ func expandIDsToSubResources(input []interface{}) *[]network.SubResource {
 	return &ids
 }
 
-func flattenVirtualHubConnectionRouting(input *network.RoutingConfiguration) []interface{} {
+func flattenVirtualHubConnectionRouting(input *network.RoutingConfiguration, routeMaps *azuresdkhacks.HubVirtualNetworkConnectionRouteMaps) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -414,9 +474,22 @@ func flattenVirtualHubConnectionRouting(input *network.RoutingConfiguration) []i
 		associatedRouteTableId = *input.AssociatedRouteTable.ID
 	}
 
+	inboundRouteMapId := ""
+	outboundRouteMapId := ""
+	if routeMaps != nil {
+		if routeMaps.InboundRouteMap != nil && routeMaps.InboundRouteMap.ID != nil {
+			inboundRouteMapId = *routeMaps.InboundRouteMap.ID
+		}
+		if routeMaps.OutboundRouteMap != nil && routeMaps.OutboundRouteMap.ID != nil {
+			outboundRouteMapId = *routeMaps.OutboundRouteMap.ID
+		}
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"associated_route_table_id": associatedRouteTableId,
+			"inbound_route_map_id":      inboundRouteMapId,
+			"outbound_route_map_id":     outboundRouteMapId,
 			"propagated_route_table":    flattenVirtualHubConnectionPropagatedRouteTable(input.PropagatedRouteTables),
 			"static_vnet_route":         flattenVirtualHubConnectionVnetStaticRoute(input.VnetRoutes),
 		},
@@ -248,6 +248,28 @@ func TestAccVirtualHubConnection_updateRoutingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccVirtualHubConnection_routeMaps(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_connection", "test")
+	r := VirtualHubConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withRouteMaps(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withRoutingConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualHubConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.HubVirtualNetworkConnectionID(state.ID)
 	if err != nil {
@@ -567,3 +589,65 @@ resource "azurerm_virtual_hub_connection" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VirtualHubConnectionResource) withRouteMaps(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_hub_route_map" "test" {
+  name           = "acctestrm-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+  }
+}
+
+resource "azurerm_virtual_hub_route_map" "test2" {
+  name           = "acctestrm2-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name = "rule1"
+
+    action {
+      type = "Drop"
+    }
+
+    match_criterion {
+      match_condition = "Equals"
+      community       = ["65535:1"]
+    }
+  }
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctest-vhubconn-%[2]d"
+  virtual_hub_id            = azurerm_virtual_hub.test.id
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+
+  routing {
+    inbound_route_map_id  = azurerm_virtual_hub_route_map.test.id
+    outbound_route_map_id = azurerm_virtual_hub_route_map.test2.id
+
+    propagated_route_table {
+      labels = ["label1", "label2"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
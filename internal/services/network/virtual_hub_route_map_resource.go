@@ -0,0 +1,538 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualHubRouteMap() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualHubRouteMapCreate,
+		Read:   resourceVirtualHubRouteMapRead,
+		Update: resourceVirtualHubRouteMapUpdate,
+		Delete: resourceVirtualHubRouteMapDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := routemaps.ParseRouteMapID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualHubRouteMapCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualHubID,
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(routemaps.RouteMapActionTypeAdd),
+											string(routemaps.RouteMapActionTypeDrop),
+											string(routemaps.RouteMapActionTypeRemove),
+											string(routemaps.RouteMapActionTypeReplace),
+										}, false),
+									},
+
+									"parameter": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"as_path": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.StringIsNotEmpty,
+													},
+												},
+
+												"community": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.StringIsNotEmpty,
+													},
+												},
+
+												"route_prefix": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Schema{
+														Type:         pluginsdk.TypeString,
+														ValidateFunc: validation.IsCIDR,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"match_criterion": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"match_condition": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(routemaps.RouteMapMatchConditionContains),
+											string(routemaps.RouteMapMatchConditionEquals),
+											string(routemaps.RouteMapMatchConditionNotContains),
+											string(routemaps.RouteMapMatchConditionNotEquals),
+										}, false),
+									},
+
+									"as_path": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"community": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"route_prefix": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.IsCIDR,
+										},
+									},
+								},
+							},
+						},
+
+						"next_step_if_matched": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(routemaps.NextStepUnknown),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(routemaps.NextStepContinue),
+								string(routemaps.NextStepTerminate),
+								string(routemaps.NextStepUnknown),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualHubRouteMapCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := routemaps.NewRouteMapID(virtualHubId.SubscriptionId, virtualHubId.ResourceGroup, virtualHubId.Name, d.Get("name").(string))
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_virtual_hub_route_map", id.ID())
+	}
+
+	parameters := routemaps.RouteMap{
+		Properties: &routemaps.RouteMapProperties{
+			Rules: expandVirtualHubRouteMapRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualHubRouteMapRead(d, meta)
+}
+
+func resourceVirtualHubRouteMapRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routemaps.ParseRouteMapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("virtual_hub_id", parse.NewVirtualHubID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("rule", flattenVirtualHubRouteMapRules(props.Rules)); err != nil {
+				return fmt.Errorf("setting `rule`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualHubRouteMapUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routemaps.ParseRouteMapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	parameters := *existing.Model
+
+	if d.HasChange("rule") {
+		parameters.Properties.Rules = expandVirtualHubRouteMapRules(d.Get("rule").([]interface{}))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVirtualHubRouteMapRead(d, meta)
+}
+
+func resourceVirtualHubRouteMapDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.RouteMapsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := routemaps.ParseRouteMapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func virtualHubRouteMapCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	for i, r := range diff.Get("rule").([]interface{}) {
+		if r == nil {
+			continue
+		}
+		rule := r.(map[string]interface{})
+
+		for j, c := range rule["match_criterion"].([]interface{}) {
+			if c == nil {
+				return fmt.Errorf("at least one of `as_path`, `community` or `route_prefix` must be specified within `rule.%d.match_criterion.%d`", i, j)
+			}
+			criterion := c.(map[string]interface{})
+
+			if len(criterion["as_path"].([]interface{})) == 0 && len(criterion["community"].([]interface{})) == 0 && len(criterion["route_prefix"].([]interface{})) == 0 {
+				return fmt.Errorf("at least one of `as_path`, `community` or `route_prefix` must be specified within `rule.%d.match_criterion.%d`", i, j)
+			}
+		}
+
+		for j, a := range rule["action"].([]interface{}) {
+			if a == nil {
+				continue
+			}
+			action := a.(map[string]interface{})
+			actionType := action["type"].(string)
+			parameters := action["parameter"].([]interface{})
+
+			if actionType == string(routemaps.RouteMapActionTypeDrop) {
+				if len(parameters) > 0 {
+					return fmt.Errorf("`parameter` cannot be specified within `rule.%d.action.%d` when `type` is `%s`", i, j, actionType)
+				}
+				continue
+			}
+
+			if len(parameters) == 0 {
+				return fmt.Errorf("at least one `parameter` must be specified within `rule.%d.action.%d` when `type` is `%s`", i, j, actionType)
+			}
+
+			for k, p := range parameters {
+				if p == nil {
+					return fmt.Errorf("at least one of `as_path`, `community` or `route_prefix` must be specified within `rule.%d.action.%d.parameter.%d`", i, j, k)
+				}
+				parameter := p.(map[string]interface{})
+
+				// route prefixes can only be replaced, rather than added to or removed from a route
+				if len(parameter["route_prefix"].([]interface{})) > 0 && actionType != string(routemaps.RouteMapActionTypeReplace) {
+					return fmt.Errorf("`route_prefix` can only be specified within `rule.%d.action.%d.parameter.%d` when `type` is `%s`", i, j, k, routemaps.RouteMapActionTypeReplace)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandVirtualHubRouteMapRules(input []interface{}) *[]routemaps.RouteMapRule {
+	results := make([]routemaps.RouteMapRule, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		nextStepIfMatched := routemaps.NextStep(v["next_step_if_matched"].(string))
+		result := routemaps.RouteMapRule{
+			Name:              utils.String(v["name"].(string)),
+			Actions:           expandVirtualHubRouteMapActions(v["action"].([]interface{})),
+			MatchCriteria:     expandVirtualHubRouteMapCriteria(v["match_criterion"].([]interface{})),
+			NextStepIfMatched: &nextStepIfMatched,
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func expandVirtualHubRouteMapActions(input []interface{}) *[]routemaps.Action {
+	results := make([]routemaps.Action, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		actionType := routemaps.RouteMapActionType(v["type"].(string))
+		result := routemaps.Action{
+			Type:       &actionType,
+			Parameters: expandVirtualHubRouteMapParameters(v["parameter"].([]interface{})),
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func expandVirtualHubRouteMapParameters(input []interface{}) *[]routemaps.Parameter {
+	results := make([]routemaps.Parameter, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		result := routemaps.Parameter{
+			AsPath:      utils.ExpandStringSlice(v["as_path"].([]interface{})),
+			Community:   utils.ExpandStringSlice(v["community"].([]interface{})),
+			RoutePrefix: utils.ExpandStringSlice(v["route_prefix"].([]interface{})),
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func expandVirtualHubRouteMapCriteria(input []interface{}) *[]routemaps.Criterion {
+	results := make([]routemaps.Criterion, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		matchCondition := routemaps.RouteMapMatchCondition(v["match_condition"].(string))
+		result := routemaps.Criterion{
+			MatchCondition: &matchCondition,
+			AsPath:         utils.ExpandStringSlice(v["as_path"].([]interface{})),
+			Community:      utils.ExpandStringSlice(v["community"].([]interface{})),
+			RoutePrefix:    utils.ExpandStringSlice(v["route_prefix"].([]interface{})),
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenVirtualHubRouteMapRules(input *[]routemaps.RouteMapRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		nextStepIfMatched := ""
+		if item.NextStepIfMatched != nil {
+			nextStepIfMatched = string(*item.NextStepIfMatched)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"action":               flattenVirtualHubRouteMapActions(item.Actions),
+			"match_criterion":      flattenVirtualHubRouteMapCriteria(item.MatchCriteria),
+			"next_step_if_matched": nextStepIfMatched,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualHubRouteMapActions(input *[]routemaps.Action) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		actionType := ""
+		if item.Type != nil {
+			actionType = string(*item.Type)
+		}
+
+		parameters := make([]interface{}, 0)
+		if item.Parameters != nil {
+			for _, parameter := range *item.Parameters {
+				parameters = append(parameters, map[string]interface{}{
+					"as_path":      utils.FlattenStringSlice(parameter.AsPath),
+					"community":    utils.FlattenStringSlice(parameter.Community),
+					"route_prefix": utils.FlattenStringSlice(parameter.RoutePrefix),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"type":      actionType,
+			"parameter": parameters,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualHubRouteMapCriteria(input *[]routemaps.Criterion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		matchCondition := ""
+		if item.MatchCondition != nil {
+			matchCondition = string(*item.MatchCondition)
+		}
+
+		results = append(results, map[string]interface{}{
+			"match_condition": matchCondition,
+			"as_path":         utils.FlattenStringSlice(item.AsPath),
+			"community":       utils.FlattenStringSlice(item.Community),
+			"route_prefix":    utils.FlattenStringSlice(item.RoutePrefix),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,253 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2022-09-01/routemaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualHubRouteMapResource struct{}
+
+func TestAccVirtualHubRouteMap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_map", "test")
+	r := VirtualHubRouteMapResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRouteMap_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_map", "test")
+	r := VirtualHubRouteMapResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualHubRouteMap_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_map", "test")
+	r := VirtualHubRouteMapResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRouteMap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_map", "test")
+	r := VirtualHubRouteMapResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualHubRouteMap_invalidAction(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_route_map", "test")
+	r := VirtualHubRouteMapResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidAction(data),
+			ExpectError: regexp.MustCompile("`route_prefix` can only be specified"),
+		},
+	})
+}
+
+func (r VirtualHubRouteMapResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := routemaps.ParseRouteMapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.RouteMapsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VirtualHubRouteMapResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctest-VHUB-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_wan_id      = azurerm_virtual_wan.test.id
+  address_prefix      = "10.0.1.0/24"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r VirtualHubRouteMapResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_map" "test" {
+  name           = "acctestrm-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRouteMapResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_map" "import" {
+  name           = azurerm_virtual_hub_route_map.test.name
+  virtual_hub_id = azurerm_virtual_hub_route_map.test.virtual_hub_id
+}
+`, r.basic(data))
+}
+
+func (r VirtualHubRouteMapResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_map" "test" {
+  name           = "acctestrm-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name                 = "rule1"
+    next_step_if_matched = "Continue"
+
+    action {
+      type = "Add"
+
+      parameter {
+        as_path = ["22334"]
+      }
+    }
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+  }
+
+  rule {
+    name                 = "rule2"
+    next_step_if_matched = "Terminate"
+
+    action {
+      type = "Replace"
+
+      parameter {
+        route_prefix = ["10.10.0.0/16"]
+      }
+    }
+
+    match_criterion {
+      match_condition = "Equals"
+      community       = ["65535:1"]
+    }
+  }
+
+  rule {
+    name = "rule3"
+
+    action {
+      type = "Drop"
+    }
+
+    match_criterion {
+      match_condition = "NotContains"
+      as_path         = ["223344"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRouteMapResource) invalidAction(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_route_map" "test" {
+  name           = "acctestrm-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  rule {
+    name = "rule1"
+
+    action {
+      type = "Add"
+
+      parameter {
+        route_prefix = ["10.10.0.0/16"]
+      }
+    }
+
+    match_criterion {
+      match_condition = "Contains"
+      route_prefix    = ["10.0.0.0/8"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
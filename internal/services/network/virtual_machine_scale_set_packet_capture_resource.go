@@ -0,0 +1,400 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineScaleSetPacketCaptureCreate,
+		Read:   resourceVirtualMachineScaleSetPacketCaptureRead,
+		Delete: resourceVirtualMachineScaleSetPacketCaptureDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PacketCaptureID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.VirtualMachineScaleSetID,
+			},
+
+			"maximum_bytes_per_packet": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"maximum_bytes_per_session": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1073741824,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"maximum_capture_duration_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      18000,
+				ValidateFunc: validation.IntBetween(1, 18000),
+			},
+
+			"machine_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"include_instance_ids": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"machine_scope.0.include_instance_ids", "machine_scope.0.exclude_instance_ids"},
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"exclude_instance_ids": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"machine_scope.0.include_instance_ids", "machine_scope.0.exclude_instance_ids"},
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"storage_location": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"file_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"storage_location.0.file_path", "storage_location.0.storage_account_id"},
+							ValidateFunc: validate.PacketCaptureFilePath,
+						},
+
+						"storage_account_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							AtLeastOneOf: []string{"storage_location.0.file_path", "storage_location.0.storage_account_id"},
+							ValidateFunc: storageValidate.StorageAccountID,
+						},
+
+						"storage_path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"filter": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"local_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"local_port": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.PcProtocolAny),
+								string(network.PcProtocolTCP),
+								string(network.PcProtocolUDP),
+							}, false),
+						},
+						"remote_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"remote_port": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineScaleSetPacketCaptureCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PacketCapturesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewPacketCaptureID(watcherId.SubscriptionId, watcherId.ResourceGroup, watcherId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_packet_capture", id.ID())
+	}
+
+	machineScope, err := expandVirtualMachineScaleSetPacketCaptureMachineScope(d.Get("machine_scope").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	storageLocation, err := expandNetworkPacketCaptureStorageLocation(d)
+	if err != nil {
+		return err
+	}
+
+	properties := network.PacketCapture{
+		PacketCaptureParameters: &network.PacketCaptureParameters{
+			Target:                  utils.String(d.Get("virtual_machine_scale_set_id").(string)),
+			StorageLocation:         storageLocation,
+			BytesToCapturePerPacket: utils.Int64(int64(d.Get("maximum_bytes_per_packet").(int))),
+			TimeLimitInSeconds:      utils.Int32(int32(d.Get("maximum_capture_duration_in_seconds").(int))),
+			TotalBytesPerSession:    utils.Int64(int64(d.Get("maximum_bytes_per_session").(int))),
+			Filters:                 expandNetworkPacketCaptureFilters(d),
+		},
+	}
+
+	extendedProperties := azuresdkhacks.PacketCaptureExtendedProperties{
+		TargetType: utils.String(azuresdkhacks.PacketCaptureTargetTypeAzureVMSS),
+		Scope:      machineScope,
+	}
+
+	future, err := azuresdkhacks.NewPacketCapturesWorkaroundClient(client).Create(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name, properties, extendedProperties)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineScaleSetPacketCaptureRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetPacketCaptureRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PacketCapturesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PacketCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] %s not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	extendedResp, err := azuresdkhacks.NewPacketCapturesWorkaroundClient(client).GetExtendedProperties(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Target Type and Machine Scope for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("network_watcher_id", parse.NewNetworkWatcherID(id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName).ID())
+
+	if props := resp.PacketCaptureResultProperties; props != nil {
+		d.Set("virtual_machine_scale_set_id", props.Target)
+
+		maximumBytesPerPacket := 0
+		if props.BytesToCapturePerPacket != nil {
+			maximumBytesPerPacket = int(*props.BytesToCapturePerPacket)
+		}
+		d.Set("maximum_bytes_per_packet", maximumBytesPerPacket)
+
+		maximumBytesPerSession := 0
+		if props.TotalBytesPerSession != nil {
+			maximumBytesPerSession = int(*props.TotalBytesPerSession)
+		}
+		d.Set("maximum_bytes_per_session", maximumBytesPerSession)
+
+		maximumCaptureDuration := 0
+		if props.TimeLimitInSeconds != nil {
+			maximumCaptureDuration = int(*props.TimeLimitInSeconds)
+		}
+		d.Set("maximum_capture_duration_in_seconds", maximumCaptureDuration)
+
+		if err := d.Set("storage_location", flattenNetworkPacketCaptureStorageLocation(props.StorageLocation)); err != nil {
+			return fmt.Errorf("setting `storage_location`: %+v", err)
+		}
+
+		if err := d.Set("filter", flattenNetworkPacketCaptureFilters(props.Filters)); err != nil {
+			return fmt.Errorf("setting `filter`: %+v", err)
+		}
+	}
+
+	var machineScope *azuresdkhacks.PacketCaptureMachineScope
+	if props := extendedResp.Properties; props != nil {
+		machineScope = props.Scope
+	}
+	if err := d.Set("machine_scope", flattenVirtualMachineScaleSetPacketCaptureMachineScope(machineScope)); err != nil {
+		return fmt.Errorf("setting `machine_scope`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceVirtualMachineScaleSetPacketCaptureDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PacketCapturesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PacketCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualMachineScaleSetPacketCaptureMachineScope(input []interface{}) (*azuresdkhacks.PacketCaptureMachineScope, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	include := utils.ExpandStringSlice(raw["include_instance_ids"].([]interface{}))
+	exclude := utils.ExpandStringSlice(raw["exclude_instance_ids"].([]interface{}))
+
+	// an instance can't be both included and excluded (or listed twice), so we'll catch this before sending the request
+	seen := make(map[string]string)
+	for _, v := range []struct {
+		key         string
+		instanceIds []string
+	}{
+		{key: "include_instance_ids", instanceIds: *include},
+		{key: "exclude_instance_ids", instanceIds: *exclude},
+	} {
+		for _, instanceId := range v.instanceIds {
+			normalized := strings.ToLower(instanceId)
+			if existing, ok := seen[normalized]; ok {
+				if existing == v.key {
+					return nil, fmt.Errorf("the instance %q is specified more than once within `machine_scope.0.%s`", instanceId, v.key)
+				}
+				return nil, fmt.Errorf("the instance %q cannot be specified within both `machine_scope.0.include_instance_ids` and `machine_scope.0.exclude_instance_ids`", instanceId)
+			}
+			seen[normalized] = v.key
+		}
+	}
+
+	return &azuresdkhacks.PacketCaptureMachineScope{
+		Include: include,
+		Exclude: exclude,
+	}, nil
+}
+
+func flattenVirtualMachineScaleSetPacketCaptureMachineScope(input *azuresdkhacks.PacketCaptureMachineScope) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	include := utils.FlattenStringSlice(input.Include)
+	exclude := utils.FlattenStringSlice(input.Exclude)
+	if len(include) == 0 && len(exclude) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"include_instance_ids": include,
+			"exclude_instance_ids": exclude,
+		},
+	}
+}
@@ -69,6 +69,11 @@ func resourceVirtualNetworkDnsServersCreateUpdate(d *pluginsdk.ResourceData, met
 	// This is a virtual resource so the last segment is hardcoded
 	id := parse.NewVirtualNetworkDnsServersID(vnetId.SubscriptionId, vnetId.ResourceGroup, vnetId.Name, "default")
 
+	// the lock needs to be obtained prior to retrieving the Virtual Network, otherwise changes made by other
+	// resources (e.g. Subnets) between the retrieval and the update would be overwritten
+	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+
 	vnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(vnet.Response) {
@@ -77,9 +82,6 @@ func resourceVirtualNetworkDnsServersCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
 	}
@@ -158,6 +160,9 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 
 	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
 
+	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+
 	vnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(vnet.Response) {
@@ -167,9 +172,6 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
 	}
@@ -189,5 +191,18 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting to delete %s: %+v", id, err)
 	}
 
+	timeout, _ := ctx.Deadline()
+
+	vnetStateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(network.ProvisioningStateUpdating)},
+		Target:     []string{string(network.ProvisioningStateSucceeded)},
+		Refresh:    VirtualNetworkProvisioningStateRefreshFunc(ctx, client, vnetId),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err = vnetStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for provisioning state of virtual network for %s: %+v", id, err)
+	}
+
 	return nil
 }
@@ -30,6 +30,46 @@ func TestAccVirtualNetworkDnsServers_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkDnsServers_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_dns_servers", "test")
+	r := VirtualNetworkDnsServersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dns_servers.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkDnsServers_withSubnets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_dns_servers", "test")
+	r := VirtualNetworkDnsServersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withSubnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_subnet.first").ExistsInAzure(SubnetResource{}),
+				check.That("azurerm_subnet.second").ExistsInAzure(SubnetResource{}),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualNetworkDnsServersResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VirtualNetworkDnsServersID(state.ID)
 	if err != nil {
@@ -76,3 +116,72 @@ resource "azurerm_virtual_network_dns_servers" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (VirtualNetworkDnsServersResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  subnet {
+    name           = "subnet1"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+
+resource "azurerm_virtual_network_dns_servers" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  dns_servers        = ["10.7.7.7", "10.7.7.8"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (VirtualNetworkDnsServersResource) withSubnets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_virtual_network_dns_servers" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  dns_servers        = ["10.7.7.2", "10.7.7.7"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -76,9 +76,10 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 			},
 
 			"dpd_timeout_seconds": {
-				Type:     pluginsdk.TypeInt,
-				Optional: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(9, 3600),
 			},
 
 			"express_route_circuit_id": {
@@ -119,6 +120,18 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"connection_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.VirtualNetworkGatewayConnectionModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.VirtualNetworkGatewayConnectionModeDefault),
+					string(network.VirtualNetworkGatewayConnectionModeInitiatorOnly),
+					string(network.VirtualNetworkGatewayConnectionModeResponderOnly),
+				}, false),
+			},
+
 			"routing_weight": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -433,6 +446,12 @@ func resourceVirtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, meta
 		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
 	}
 
+	connectionMode := string(network.VirtualNetworkGatewayConnectionModeDefault)
+	if conn.ConnectionMode != "" {
+		connectionMode = string(conn.ConnectionMode)
+	}
+	d.Set("connection_mode", connectionMode)
+
 	if conn.RoutingWeight != nil {
 		d.Set("routing_weight", conn.RoutingWeight)
 	}
@@ -489,6 +508,7 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 	connectionType := network.VirtualNetworkGatewayConnectionType(d.Get("type").(string))
 
 	props := &network.VirtualNetworkGatewayConnectionPropertiesFormat{
+		ConnectionMode:                 network.VirtualNetworkGatewayConnectionMode(d.Get("connection_mode").(string)),
 		ConnectionType:                 connectionType,
 		EnableBgp:                      utils.Bool(d.Get("enable_bgp").(bool)),
 		ExpressRouteGatewayBypass:      utils.Bool(d.Get("express_route_gateway_bypass").(bool)),
@@ -602,6 +622,12 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 		}
 	}
 
+	if props.UsePolicyBasedTrafficSelectors != nil && *props.UsePolicyBasedTrafficSelectors {
+		if props.IpsecPolicies == nil || len(*props.IpsecPolicies) == 0 {
+			return nil, fmt.Errorf("an `ipsec_policy` block must be specified when `use_policy_based_traffic_selectors` is enabled")
+		}
+	}
+
 	return props, nil
 }
 
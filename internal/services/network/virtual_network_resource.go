@@ -108,6 +108,12 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 				Deprecated: "This is deprecated in favor of `ddos_protection_plan`",
 			},
 
+			"flow_timeout_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(4, 30),
+			},
+
 			"guid": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -284,6 +290,12 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `bgp_community`: %+v", err)
 		}
 
+		flowTimeoutInMinutes := 0
+		if props.FlowTimeoutInMinutes != nil {
+			flowTimeoutInMinutes = int(*props.FlowTimeoutInMinutes)
+		}
+		d.Set("flow_timeout_in_minutes", flowTimeoutInMinutes)
+
 		d.Set("vm_protection_enabled", props.EnableVMProtection)
 	}
 
@@ -397,6 +409,10 @@ func expandVirtualNetworkProperties(ctx context.Context, d *pluginsdk.ResourceDa
 		properties.BgpCommunities = &network.VirtualNetworkBgpCommunities{VirtualNetworkCommunity: utils.String(v.(string))}
 	}
 
+	if v, ok := d.GetOk("flow_timeout_in_minutes"); ok {
+		properties.FlowTimeoutInMinutes = utils.Int32(int32(v.(int)))
+	}
+
 	return properties, nil
 }
 
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/collectorpolicies"
+)
+
+type Client struct {
+	AzureTrafficCollectorsClient *azuretrafficcollectors.AzureTrafficCollectorsClient
+	CollectorPoliciesClient      *collectorpolicies.CollectorPoliciesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	azureTrafficCollectorsClient := azuretrafficcollectors.NewAzureTrafficCollectorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&azureTrafficCollectorsClient.Client, o.ResourceManagerAuthorizer)
+
+	collectorPoliciesClient := collectorpolicies.NewCollectorPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&collectorPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AzureTrafficCollectorsClient: &azureTrafficCollectorsClient,
+		CollectorPoliciesClient:      &collectorPoliciesClient,
+	}
+}
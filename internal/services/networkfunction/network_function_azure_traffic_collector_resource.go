@@ -0,0 +1,223 @@
+package networkfunction
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceNetworkFunctionAzureTrafficCollector() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkFunctionAzureTrafficCollectorCreate,
+		Read:   resourceNetworkFunctionAzureTrafficCollectorRead,
+		Update: resourceNetworkFunctionAzureTrafficCollectorUpdate,
+		Delete: resourceNetworkFunctionAzureTrafficCollectorDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AzureTrafficCollectorName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"collector_policy_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_hub_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkFunctionAzureTrafficCollectorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.AzureTrafficCollectorsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := azuretrafficcollectors.NewAzureTrafficCollectorID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_network_function_azure_traffic_collector", id.ID())
+	}
+
+	parameters := azuretrafficcollectors.AzureTrafficCollector{
+		Location:   location.Normalize(d.Get("location").(string)),
+		Properties: &azuretrafficcollectors.AzureTrafficCollectorPropertiesFormat{},
+		Tags:       expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := checkAzureTrafficCollectorProvisioningState(ctx, client, id); err != nil {
+		return err
+	}
+
+	return resourceNetworkFunctionAzureTrafficCollectorRead(d, meta)
+}
+
+func resourceNetworkFunctionAzureTrafficCollectorRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.AzureTrafficCollectorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		collectorPolicyIds := make([]interface{}, 0)
+		provisioningState := ""
+		virtualHubId := ""
+		if props := model.Properties; props != nil {
+			if props.CollectorPolicies != nil {
+				for _, v := range *props.CollectorPolicies {
+					if v.Id != nil {
+						collectorPolicyIds = append(collectorPolicyIds, *v.Id)
+					}
+				}
+			}
+
+			if props.ProvisioningState != nil {
+				provisioningState = string(*props.ProvisioningState)
+			}
+
+			if props.VirtualHub != nil && props.VirtualHub.Id != nil {
+				virtualHubId = *props.VirtualHub.Id
+			}
+		}
+		d.Set("collector_policy_ids", collectorPolicyIds)
+		d.Set("provisioning_state", provisioningState)
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkFunctionAzureTrafficCollectorUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.AzureTrafficCollectorsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := azuretrafficcollectors.TagsObject{
+			Tags: expandTags(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateTags(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceNetworkFunctionAzureTrafficCollectorRead(d, meta)
+}
+
+func resourceNetworkFunctionAzureTrafficCollectorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.AzureTrafficCollectorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// checkAzureTrafficCollectorProvisioningState surfaces a failed deployment of the Azure Traffic Collector, since the
+// long running operation can complete successfully whilst the Collector itself failed to provision
+func checkAzureTrafficCollectorProvisioningState(ctx context.Context, client *azuretrafficcollectors.AzureTrafficCollectorsClient, id azuretrafficcollectors.AzureTrafficCollectorId) error {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ProvisioningState != nil {
+		if state := *model.Properties.ProvisioningState; state == azuretrafficcollectors.ProvisioningStateFailed {
+			return fmt.Errorf("provisioning %s: the Provisioning State was %q", id, string(state))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package networkfunction_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkFunctionAzureTrafficCollectorResource struct{}
+
+func TestAccNetworkFunctionAzureTrafficCollector_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_azure_traffic_collector", "test")
+	r := NetworkFunctionAzureTrafficCollectorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkFunctionAzureTrafficCollector_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_azure_traffic_collector", "test")
+	r := NetworkFunctionAzureTrafficCollectorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkFunctionAzureTrafficCollector_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_azure_traffic_collector", "test")
+	r := NetworkFunctionAzureTrafficCollectorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r NetworkFunctionAzureTrafficCollectorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.NetworkFunction.AzureTrafficCollectorsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkFunctionAzureTrafficCollectorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nf-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkFunctionAzureTrafficCollectorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_function_azure_traffic_collector" "test" {
+  name                = "acctest-nfatc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkFunctionAzureTrafficCollectorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_function_azure_traffic_collector" "import" {
+  name                = azurerm_network_function_azure_traffic_collector.test.name
+  resource_group_name = azurerm_network_function_azure_traffic_collector.test.resource_group_name
+  location            = azurerm_network_function_azure_traffic_collector.test.location
+}
+`, r.basic(data))
+}
+
+func (r NetworkFunctionAzureTrafficCollectorResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_function_azure_traffic_collector" "test" {
+  name                = "acctest-nfatc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
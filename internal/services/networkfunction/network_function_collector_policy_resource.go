@@ -0,0 +1,367 @@
+package networkfunction
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/collectorpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkFunctionCollectorPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkFunctionCollectorPolicyCreate,
+		Read:   resourceNetworkFunctionCollectorPolicyRead,
+		Update: resourceNetworkFunctionCollectorPolicyUpdate,
+		Delete: resourceNetworkFunctionCollectorPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := collectorpolicies.ParseCollectorPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CollectorPolicyName,
+			},
+
+			"traffic_collector_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AzureTrafficCollectorID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"ipfx_emission": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"destination_types": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(collectorpolicies.DestinationTypeAzureMonitor),
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
+			"ipfx_ingestion": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_resource_ids": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: networkValidate.ExpressRouteCircuitID,
+							},
+						},
+					},
+				},
+			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkFunctionCollectorPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.CollectorPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	trafficCollectorId, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(d.Get("traffic_collector_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := collectorpolicies.NewCollectorPolicyID(trafficCollectorId.SubscriptionId, trafficCollectorId.ResourceGroup, trafficCollectorId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_network_function_collector_policy", id.ID())
+	}
+
+	ingestionPolicy, err := expandCollectorPolicyIngestionPolicy(ctx, meta, d.Get("ipfx_ingestion").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `ipfx_ingestion`: %+v", err)
+	}
+
+	parameters := collectorpolicies.CollectorPolicy{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &collectorpolicies.CollectorPolicyPropertiesFormat{
+			EmissionPolicies: expandCollectorPolicyEmissionPolicies(d.Get("ipfx_emission").([]interface{})),
+			IngestionPolicy:  ingestionPolicy,
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ProvisioningState != nil {
+		if state := *model.Properties.ProvisioningState; state == collectorpolicies.ProvisioningStateFailed {
+			return fmt.Errorf("provisioning %s: the Provisioning State was %q", id, string(state))
+		}
+	}
+
+	return resourceNetworkFunctionCollectorPolicyRead(d, meta)
+}
+
+func resourceNetworkFunctionCollectorPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.CollectorPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := collectorpolicies.ParseCollectorPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("traffic_collector_id", azuretrafficcollectors.NewAzureTrafficCollectorID(id.SubscriptionId, id.ResourceGroup, id.AzureTrafficCollectorName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		provisioningState := ""
+		if props := model.Properties; props != nil {
+			if err := d.Set("ipfx_emission", flattenCollectorPolicyEmissionPolicies(props.EmissionPolicies)); err != nil {
+				return fmt.Errorf("setting `ipfx_emission`: %+v", err)
+			}
+
+			if err := d.Set("ipfx_ingestion", flattenCollectorPolicyIngestionPolicy(props.IngestionPolicy)); err != nil {
+				return fmt.Errorf("setting `ipfx_ingestion`: %+v", err)
+			}
+
+			if props.ProvisioningState != nil {
+				provisioningState = string(*props.ProvisioningState)
+			}
+		}
+		d.Set("provisioning_state", provisioningState)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkFunctionCollectorPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.CollectorPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := collectorpolicies.ParseCollectorPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := collectorpolicies.TagsObject{
+			Tags: expandTags(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateTags(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceNetworkFunctionCollectorPolicyRead(d, meta)
+}
+
+func resourceNetworkFunctionCollectorPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetworkFunction.CollectorPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := collectorpolicies.ParseCollectorPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCollectorPolicyEmissionPolicies(input []interface{}) *[]collectorpolicies.EmissionPoliciesPropertiesFormat {
+	output := make([]collectorpolicies.EmissionPoliciesPropertiesFormat, 0)
+	if len(input) == 0 || input[0] == nil {
+		return &output
+	}
+	v := input[0].(map[string]interface{})
+
+	destinations := make([]collectorpolicies.EmissionPolicyDestination, 0)
+	for _, destinationType := range v["destination_types"].([]interface{}) {
+		destinationType := collectorpolicies.DestinationType(destinationType.(string))
+		destinations = append(destinations, collectorpolicies.EmissionPolicyDestination{
+			DestinationType: &destinationType,
+		})
+	}
+
+	emissionType := collectorpolicies.EmissionTypeIPFIX
+	output = append(output, collectorpolicies.EmissionPoliciesPropertiesFormat{
+		EmissionDestinations: &destinations,
+		EmissionType:         &emissionType,
+	})
+
+	return &output
+}
+
+func flattenCollectorPolicyEmissionPolicies(input *[]collectorpolicies.EmissionPoliciesPropertiesFormat) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	for _, v := range *input {
+		if v.EmissionType == nil || *v.EmissionType != collectorpolicies.EmissionTypeIPFIX {
+			continue
+		}
+
+		destinationTypes := make([]interface{}, 0)
+		if v.EmissionDestinations != nil {
+			for _, destination := range *v.EmissionDestinations {
+				if destination.DestinationType != nil {
+					destinationTypes = append(destinationTypes, string(*destination.DestinationType))
+				}
+			}
+		}
+
+		return []interface{}{
+			map[string]interface{}{
+				"destination_types": destinationTypes,
+			},
+		}
+	}
+
+	return []interface{}{}
+}
+
+func expandCollectorPolicyIngestionPolicy(ctx context.Context, meta interface{}, input []interface{}) (*collectorpolicies.IngestionPolicyPropertiesFormat, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	v := input[0].(map[string]interface{})
+
+	circuitsClient := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	sources := make([]collectorpolicies.IngestionSourcesPropertiesFormat, 0)
+	for _, raw := range v["source_resource_ids"].(*pluginsdk.Set).List() {
+		circuitId, err := networkParse.ExpressRouteCircuitID(raw.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		// the ExpressRoute Circuit has to exist and be provisioned before flow logs can be collected from it
+		circuit, err := circuitsClient.Get(ctx, circuitId.ResourceGroup, circuitId.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(circuit.Response) {
+				return nil, fmt.Errorf("the %s was not found", circuitId)
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", circuitId, err)
+		}
+
+		sourceType := collectorpolicies.SourceTypeResource
+		sources = append(sources, collectorpolicies.IngestionSourcesPropertiesFormat{
+			ResourceId: utils.String(circuitId.ID()),
+			SourceType: &sourceType,
+		})
+	}
+
+	ingestionType := collectorpolicies.IngestionTypeIPFIX
+	return &collectorpolicies.IngestionPolicyPropertiesFormat{
+		IngestionSources: &sources,
+		IngestionType:    &ingestionType,
+	}, nil
+}
+
+func flattenCollectorPolicyIngestionPolicy(input *collectorpolicies.IngestionPolicyPropertiesFormat) []interface{} {
+	if input == nil || input.IngestionType == nil || *input.IngestionType != collectorpolicies.IngestionTypeIPFIX {
+		return []interface{}{}
+	}
+
+	sourceResourceIds := make([]interface{}, 0)
+	if input.IngestionSources != nil {
+		for _, v := range *input.IngestionSources {
+			if v.ResourceId != nil {
+				sourceResourceIds = append(sourceResourceIds, *v.ResourceId)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"source_resource_ids": sourceResourceIds,
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package networkfunction_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/networkfunction/sdk/2022-11-01/collectorpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkFunctionCollectorPolicyResource struct{}
+
+func TestAccNetworkFunctionCollectorPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_collector_policy", "test")
+	r := NetworkFunctionCollectorPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkFunctionCollectorPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_collector_policy", "test")
+	r := NetworkFunctionCollectorPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkFunctionCollectorPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_collector_policy", "test")
+	r := NetworkFunctionCollectorPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r NetworkFunctionCollectorPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := collectorpolicies.ParseCollectorPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.NetworkFunction.CollectorPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkFunctionCollectorPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nf-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_port" "test" {
+  name                = "acctest-erp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  peering_location    = "Airtel-Chennai2-CLS"
+  bandwidth_in_gbps   = 10
+  encapsulation       = "Dot1Q"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%[1]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  express_route_port_id = azurerm_express_route_port.test.id
+  bandwidth_in_gbps     = 1
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_network_function_azure_traffic_collector" "test" {
+  name                = "acctest-nfatc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkFunctionCollectorPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_function_collector_policy" "test" {
+  name                 = "acctest-nfcp-%d"
+  traffic_collector_id = azurerm_network_function_azure_traffic_collector.test.id
+  location             = azurerm_resource_group.test.location
+
+  ipfx_emission {
+    destination_types = ["AzureMonitor"]
+  }
+
+  ipfx_ingestion {
+    source_resource_ids = [azurerm_express_route_circuit.test.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkFunctionCollectorPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_function_collector_policy" "import" {
+  name                 = azurerm_network_function_collector_policy.test.name
+  traffic_collector_id = azurerm_network_function_collector_policy.test.traffic_collector_id
+  location             = azurerm_network_function_collector_policy.test.location
+
+  ipfx_emission {
+    destination_types = ["AzureMonitor"]
+  }
+
+  ipfx_ingestion {
+    source_resource_ids = [azurerm_express_route_circuit.test.id]
+  }
+}
+`, r.basic(data))
+}
+
+func (r NetworkFunctionCollectorPolicyResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_function_collector_policy" "test" {
+  name                 = "acctest-nfcp-%d"
+  traffic_collector_id = azurerm_network_function_azure_traffic_collector.test.id
+  location             = azurerm_resource_group.test.location
+
+  ipfx_emission {
+    destination_types = ["AzureMonitor"]
+  }
+
+  ipfx_ingestion {
+    source_resource_ids = [azurerm_express_route_circuit.test.id]
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
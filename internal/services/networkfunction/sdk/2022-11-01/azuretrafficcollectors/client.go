@@ -0,0 +1,15 @@
+package azuretrafficcollectors
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AzureTrafficCollectorsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAzureTrafficCollectorsClientWithBaseURI(endpoint string) AzureTrafficCollectorsClient {
+	return AzureTrafficCollectorsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,108 @@
+package azuretrafficcollectors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AzureTrafficCollectorId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewAzureTrafficCollectorID(subscriptionId, resourceGroup, name string) AzureTrafficCollectorId {
+	return AzureTrafficCollectorId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id AzureTrafficCollectorId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Azure Traffic Collector", segmentsStr)
+}
+
+func (id AzureTrafficCollectorId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NetworkFunction/azureTrafficCollectors/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAzureTrafficCollectorID parses a AzureTrafficCollector ID into an AzureTrafficCollectorId struct
+func ParseAzureTrafficCollectorID(input string) (*AzureTrafficCollectorId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AzureTrafficCollectorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("azureTrafficCollectors"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseAzureTrafficCollectorIDInsensitively parses an AzureTrafficCollector ID into an AzureTrafficCollectorId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseAzureTrafficCollectorID method should be used instead for validation etc.
+func ParseAzureTrafficCollectorIDInsensitively(input string) (*AzureTrafficCollectorId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AzureTrafficCollectorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'azureTrafficCollectors' segment
+	azureTrafficCollectorsKey := "azureTrafficCollectors"
+	for key := range id.Path {
+		if strings.EqualFold(key, azureTrafficCollectorsKey) {
+			azureTrafficCollectorsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(azureTrafficCollectorsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
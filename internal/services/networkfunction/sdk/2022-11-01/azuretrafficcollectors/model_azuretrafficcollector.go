@@ -0,0 +1,11 @@
+package azuretrafficcollectors
+
+type AzureTrafficCollector struct {
+	Etag       *string                                `json:"etag,omitempty"`
+	Id         *string                                `json:"id,omitempty"`
+	Location   string                                 `json:"location"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties *AzureTrafficCollectorPropertiesFormat `json:"properties,omitempty"`
+	Tags       *map[string]string                     `json:"tags,omitempty"`
+	Type       *string                                `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package azuretrafficcollectors
+
+type AzureTrafficCollectorPropertiesFormat struct {
+	CollectorPolicies *[]ResourceReference `json:"collectorPolicies,omitempty"`
+	ProvisioningState *ProvisioningState   `json:"provisioningState,omitempty"`
+	VirtualHub        *ResourceReference   `json:"virtualHub,omitempty"`
+}
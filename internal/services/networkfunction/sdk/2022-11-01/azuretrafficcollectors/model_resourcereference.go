@@ -0,0 +1,5 @@
+package azuretrafficcollectors
+
+type ResourceReference struct {
+	Id *string `json:"id,omitempty"`
+}
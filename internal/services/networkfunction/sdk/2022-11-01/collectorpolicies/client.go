@@ -0,0 +1,15 @@
+package collectorpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CollectorPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCollectorPoliciesClientWithBaseURI(endpoint string) CollectorPoliciesClient {
+	return CollectorPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,34 @@
+package collectorpolicies
+
+type DestinationType string
+
+const (
+	DestinationTypeAzureMonitor DestinationType = "AzureMonitor"
+)
+
+type EmissionType string
+
+const (
+	EmissionTypeIPFIX EmissionType = "IPFIX"
+)
+
+type IngestionType string
+
+const (
+	IngestionTypeIPFIX IngestionType = "IPFIX"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+type SourceType string
+
+const (
+	SourceTypeResource SourceType = "Resource"
+)
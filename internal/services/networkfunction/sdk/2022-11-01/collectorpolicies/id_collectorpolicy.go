@@ -0,0 +1,126 @@
+package collectorpolicies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type CollectorPolicyId struct {
+	SubscriptionId            string
+	ResourceGroup             string
+	AzureTrafficCollectorName string
+	Name                      string
+}
+
+func NewCollectorPolicyID(subscriptionId, resourceGroup, azureTrafficCollectorName, name string) CollectorPolicyId {
+	return CollectorPolicyId{
+		SubscriptionId:            subscriptionId,
+		ResourceGroup:             resourceGroup,
+		AzureTrafficCollectorName: azureTrafficCollectorName,
+		Name:                      name,
+	}
+}
+
+func (id CollectorPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Azure Traffic Collector Name %q", id.AzureTrafficCollectorName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Collector Policy", segmentsStr)
+}
+
+func (id CollectorPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NetworkFunction/azureTrafficCollectors/%s/collectorPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureTrafficCollectorName, id.Name)
+}
+
+// ParseCollectorPolicyID parses a CollectorPolicy ID into an CollectorPolicyId struct
+func ParseCollectorPolicyID(input string) (*CollectorPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CollectorPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.AzureTrafficCollectorName, err = id.PopSegment("azureTrafficCollectors"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("collectorPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseCollectorPolicyIDInsensitively parses an CollectorPolicy ID into an CollectorPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseCollectorPolicyID method should be used instead for validation etc.
+func ParseCollectorPolicyIDInsensitively(input string) (*CollectorPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CollectorPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'azureTrafficCollectors' segment
+	azureTrafficCollectorsKey := "azureTrafficCollectors"
+	for key := range id.Path {
+		if strings.EqualFold(key, azureTrafficCollectorsKey) {
+			azureTrafficCollectorsKey = key
+			break
+		}
+	}
+	if resourceId.AzureTrafficCollectorName, err = id.PopSegment(azureTrafficCollectorsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'collectorPolicies' segment
+	collectorPoliciesKey := "collectorPolicies"
+	for key := range id.Path {
+		if strings.EqualFold(key, collectorPoliciesKey) {
+			collectorPoliciesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(collectorPoliciesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,11 @@
+package collectorpolicies
+
+type CollectorPolicy struct {
+	Etag       *string                          `json:"etag,omitempty"`
+	Id         *string                          `json:"id,omitempty"`
+	Location   string                           `json:"location"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *CollectorPolicyPropertiesFormat `json:"properties,omitempty"`
+	Tags       *map[string]string               `json:"tags,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
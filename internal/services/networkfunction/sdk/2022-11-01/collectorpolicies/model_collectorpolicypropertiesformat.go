@@ -0,0 +1,7 @@
+package collectorpolicies
+
+type CollectorPolicyPropertiesFormat struct {
+	EmissionPolicies  *[]EmissionPoliciesPropertiesFormat `json:"emissionPolicies,omitempty"`
+	IngestionPolicy   *IngestionPolicyPropertiesFormat    `json:"ingestionPolicy,omitempty"`
+	ProvisioningState *ProvisioningState                  `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,6 @@
+package collectorpolicies
+
+type EmissionPoliciesPropertiesFormat struct {
+	EmissionDestinations *[]EmissionPolicyDestination `json:"emissionDestinations,omitempty"`
+	EmissionType         *EmissionType                `json:"emissionType,omitempty"`
+}
@@ -0,0 +1,5 @@
+package collectorpolicies
+
+type EmissionPolicyDestination struct {
+	DestinationType *DestinationType `json:"destinationType,omitempty"`
+}
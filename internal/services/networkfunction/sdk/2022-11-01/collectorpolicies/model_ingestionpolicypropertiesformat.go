@@ -0,0 +1,6 @@
+package collectorpolicies
+
+type IngestionPolicyPropertiesFormat struct {
+	IngestionSources *[]IngestionSourcesPropertiesFormat `json:"ingestionSources,omitempty"`
+	IngestionType    *IngestionType                      `json:"ingestionType,omitempty"`
+}
@@ -0,0 +1,6 @@
+package collectorpolicies
+
+type IngestionSourcesPropertiesFormat struct {
+	ResourceId *string     `json:"resourceId,omitempty"`
+	SourceType *SourceType `json:"sourceType,omitempty"`
+}
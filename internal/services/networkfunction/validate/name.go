@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// AzureTrafficCollectorName validates the name of an Azure Traffic Collector
+func AzureTrafficCollectorName(i interface{}, k string) (warnings []string, errors []error) {
+	return validateName(i, k, "Azure Traffic Collector")
+}
+
+// CollectorPolicyName validates the name of a Collector Policy
+func CollectorPolicyName(i interface{}, k string) (warnings []string, errors []error) {
+	return validateName(i, k, "Collector Policy")
+}
+
+func validateName(i interface{}, k string, resourceType string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("the name of the %s %q must be between 1 and 80 characters, begin with a letter or number, end with a letter, number or underscore and may only contain letters, numbers, underscores, periods and hyphens", resourceType, k))
+	}
+
+	return
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAzureTrafficCollectorName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "example-collector",
+			Expected: true,
+		},
+		{
+			Input:    "example.collector_",
+			Expected: true,
+		},
+		{
+			Input:    "-example",
+			Expected: false,
+		},
+		{
+			Input:    "example-",
+			Expected: false,
+		},
+		{
+			Input:    "example collector",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 80),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 81),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := AzureTrafficCollectorName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
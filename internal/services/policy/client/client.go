@@ -5,11 +5,13 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-09-01/policy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2020-07-01-preview/policyexemptions"
 )
 
 type Client struct {
 	AssignmentsClient                   *policy.AssignmentsClient
 	DefinitionsClient                   *policy.DefinitionsClient
+	ExemptionsClient                    *policyexemptions.PolicyExemptionsClient
 	SetDefinitionsClient                *policy.SetDefinitionsClient
 	RemediationsClient                  *policyinsights.RemediationsClient
 	GuestConfigurationAssignmentsClient *guestconfiguration.AssignmentsClient
@@ -22,6 +24,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	definitionsClient := policy.NewDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&definitionsClient.Client, o.ResourceManagerAuthorizer)
 
+	exemptionsClient := policyexemptions.NewPolicyExemptionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&exemptionsClient.Client, o.ResourceManagerAuthorizer)
+
 	setDefinitionsClient := policy.NewSetDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&setDefinitionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -34,6 +39,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		AssignmentsClient:                   &assignmentsClient,
 		DefinitionsClient:                   &definitionsClient,
+		ExemptionsClient:                    &exemptionsClient,
 		SetDefinitionsClient:                &setDefinitionsClient,
 		RemediationsClient:                  &remediationsClient,
 		GuestConfigurationAssignmentsClient: &guestConfigurationAssignmentsClient,
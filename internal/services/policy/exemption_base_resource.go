@@ -0,0 +1,296 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2020-07-01-preview/policyexemptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type exemptionBaseResource struct{}
+
+func (br exemptionBaseResource) createFunc(resourceName, scopeFieldName string) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.ExemptionsClient
+			id := policyexemptions.NewScopedPolicyExemptionID(metadata.ResourceData.Get(scopeFieldName).(string), metadata.ResourceData.Get("name").(string))
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(resourceName, id.ID())
+			}
+
+			exemption, err := br.expand(ctx, metadata)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *exemption); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (br exemptionBaseResource) deleteFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.ExemptionsClient
+
+			id, err := policyexemptions.ParseScopedPolicyExemptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (br exemptionBaseResource) readFunc(scopeFieldName string) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.ExemptionsClient
+
+			id, err := policyexemptions.ParseScopedPolicyExemptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			metadata.ResourceData.Set("name", id.Name)
+			// lintignore:R001
+			metadata.ResourceData.Set(scopeFieldName, id.Scope)
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				metadata.ResourceData.Set("description", props.Description)
+				metadata.ResourceData.Set("display_name", props.DisplayName)
+				metadata.ResourceData.Set("exemption_category", string(props.ExemptionCategory))
+				metadata.ResourceData.Set("expires_on", props.ExpiresOn)
+				metadata.ResourceData.Set("policy_assignment_id", props.PolicyAssignmentId)
+				metadata.ResourceData.Set("policy_definition_reference_ids", utils.FlattenStringSlice(props.PolicyDefinitionReferenceIds))
+
+				var flattenedMetaData string
+				if props.Metadata != nil {
+					flattenedMetaData = flattenJSON(*props.Metadata)
+				}
+				metadata.ResourceData.Set("metadata", flattenedMetaData)
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (br exemptionBaseResource) updateFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.ExemptionsClient
+
+			id, err := policyexemptions.ParseScopedPolicyExemptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			exemption, err := br.expand(ctx, metadata)
+			if err != nil {
+				return err
+			}
+
+			// NOTE: there isn't an Update endpoint
+			if _, err := client.CreateOrUpdate(ctx, *id, *exemption); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (br exemptionBaseResource) arguments(fields map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := map[string]*pluginsdk.Schema{
+		// NOTE: `name` and the scope field aren't included since they vary depending on the resource, so are expected to be passed in
+		"policy_assignment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PolicyAssignmentID,
+		},
+
+		"exemption_category": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(policyexemptions.ExemptionCategoryMitigated),
+				string(policyexemptions.ExemptionCategoryWaiver),
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"expires_on": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"metadata": metadataSchema(),
+
+		"policy_definition_reference_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+
+	for k, v := range fields {
+		output[k] = v
+	}
+
+	return output
+}
+
+func (br exemptionBaseResource) attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (br exemptionBaseResource) expand(ctx context.Context, metadata sdk.ResourceMetaData) (*policyexemptions.PolicyExemption, error) {
+	policyAssignmentId := metadata.ResourceData.Get("policy_assignment_id").(string)
+	referenceIds := utils.ExpandStringSlice(metadata.ResourceData.Get("policy_definition_reference_ids").([]interface{}))
+	if err := validatePolicyDefinitionReferenceIds(ctx, metadata.Client, policyAssignmentId, *referenceIds); err != nil {
+		return nil, err
+	}
+
+	exemption := policyexemptions.PolicyExemption{
+		Properties: policyexemptions.PolicyExemptionProperties{
+			ExemptionCategory:            policyexemptions.ExemptionCategory(metadata.ResourceData.Get("exemption_category").(string)),
+			PolicyAssignmentId:           policyAssignmentId,
+			PolicyDefinitionReferenceIds: referenceIds,
+		},
+	}
+
+	if v := metadata.ResourceData.Get("description").(string); v != "" {
+		exemption.Properties.Description = utils.String(v)
+	}
+
+	if v := metadata.ResourceData.Get("display_name").(string); v != "" {
+		exemption.Properties.DisplayName = utils.String(v)
+	}
+
+	if v := metadata.ResourceData.Get("expires_on").(string); v != "" {
+		exemption.Properties.ExpiresOn = utils.String(v)
+	}
+
+	if metaDataString := metadata.ResourceData.Get("metadata").(string); metaDataString != "" {
+		metaData, err := pluginsdk.ExpandJsonFromString(metaDataString)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse metadata: %s", err)
+		}
+		var metaDataValue interface{} = metaData
+		exemption.Properties.Metadata = &metaDataValue
+	}
+
+	return &exemption, nil
+}
+
+// validatePolicyDefinitionReferenceIds ensures that each of the Policy Definition Reference IDs exist within the
+// Policy Set Definition assigned by the Policy Assignment, since the API otherwise fails with an unhelpful error
+func validatePolicyDefinitionReferenceIds(ctx context.Context, client *clients.Client, policyAssignmentId string, referenceIds []string) error {
+	if len(referenceIds) == 0 {
+		return nil
+	}
+
+	assignmentId, err := parse.PolicyAssignmentID(policyAssignmentId)
+	if err != nil {
+		return err
+	}
+
+	assignment, err := client.Policy.AssignmentsClient.Get(ctx, assignmentId.Scope, assignmentId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *assignmentId, err)
+	}
+	if assignment.AssignmentProperties == nil || assignment.AssignmentProperties.PolicyDefinitionID == nil {
+		return fmt.Errorf("retrieving %s: `properties.policyDefinitionId` was nil", *assignmentId)
+	}
+
+	setDefinitionId, err := parse.PolicySetDefinitionID(*assignment.AssignmentProperties.PolicyDefinitionID)
+	if err != nil {
+		return fmt.Errorf("`policy_definition_reference_ids` can only be specified when the Policy Assignment assigns a Policy Set Definition")
+	}
+
+	managementGroupName := ""
+	if scope, ok := setDefinitionId.PolicyScopeId.(parse.ScopeAtManagementGroup); ok {
+		managementGroupName = scope.ManagementGroupName
+	}
+
+	setDefinition, err := getPolicySetDefinitionByName(ctx, client.Policy.SetDefinitionsClient, setDefinitionId.Name, managementGroupName)
+	if err != nil {
+		return fmt.Errorf("retrieving Policy Set Definition %q: %+v", setDefinitionId.Name, err)
+	}
+
+	available := make(map[string]struct{})
+	if props := setDefinition.SetDefinitionProperties; props != nil && props.PolicyDefinitions != nil {
+		for _, definition := range *props.PolicyDefinitions {
+			if definition.PolicyDefinitionReferenceID != nil {
+				available[strings.ToLower(*definition.PolicyDefinitionReferenceID)] = struct{}{}
+			}
+		}
+	}
+
+	for _, referenceId := range referenceIds {
+		if _, ok := available[strings.ToLower(referenceId)]; !ok {
+			return fmt.Errorf("the Policy Definition Reference ID %q was not found within the Policy Set Definition %q assigned by %s", referenceId, setDefinitionId.Name, *assignmentId)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ManagementGroupPolicyExemptionResource{}
+
+type ManagementGroupPolicyExemptionResource struct {
+	base exemptionBaseResource
+}
+
+func (r ManagementGroupPolicyExemptionResource) Arguments() map[string]*pluginsdk.Schema {
+	schema := map[string]*pluginsdk.Schema{
+		"management_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managementGroupValidate.ManagementGroupID,
+		},
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	}
+	return r.base.arguments(schema)
+}
+
+func (r ManagementGroupPolicyExemptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base.attributes()
+}
+
+func (r ManagementGroupPolicyExemptionResource) Create() sdk.ResourceFunc {
+	return r.base.createFunc(r.ResourceType(), "management_group_id")
+}
+
+func (r ManagementGroupPolicyExemptionResource) Delete() sdk.ResourceFunc {
+	return r.base.deleteFunc()
+}
+
+func (r ManagementGroupPolicyExemptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagementGroupPolicyExemptionID
+}
+
+func (r ManagementGroupPolicyExemptionResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ManagementGroupPolicyExemptionResource) Read() sdk.ResourceFunc {
+	return r.base.readFunc("management_group_id")
+}
+
+func (r ManagementGroupPolicyExemptionResource) ResourceType() string {
+	return "azurerm_management_group_policy_exemption"
+}
+
+func (r ManagementGroupPolicyExemptionResource) Update() sdk.ResourceFunc {
+	return r.base.updateFunc()
+}
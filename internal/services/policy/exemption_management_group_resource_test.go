@@ -0,0 +1,172 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2020-07-01-preview/policyexemptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagementGroupPolicyExemptionResource struct{}
+
+func TestAccManagementGroupPolicyExemption_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_exemption", "test")
+	r := ManagementGroupPolicyExemptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupPolicyExemption_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_exemption", "test")
+	r := ManagementGroupPolicyExemptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagementGroupPolicyExemption_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_exemption", "test")
+	r := ManagementGroupPolicyExemptionResource{}
+	expiresOn := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, expiresOn),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagementGroupPolicyExemptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := policyexemptions.ParseScopedPolicyExemptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Policy.ExemptionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagementGroupPolicyExemptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_exemption" "test" {
+  name                 = "acctest-exemption-%d"
+  management_group_id  = azurerm_management_group.test.id
+  policy_assignment_id = azurerm_management_group_policy_assignment.test.id
+  exemption_category   = "Mitigated"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagementGroupPolicyExemptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_exemption" "import" {
+  name                 = azurerm_management_group_policy_exemption.test.name
+  management_group_id  = azurerm_management_group_policy_exemption.test.management_group_id
+  policy_assignment_id = azurerm_management_group_policy_exemption.test.policy_assignment_id
+  exemption_category   = azurerm_management_group_policy_exemption.test.exemption_category
+}
+`, r.basic(data))
+}
+
+func (r ManagementGroupPolicyExemptionResource) complete(data acceptance.TestData, expiresOn string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_exemption" "test" {
+  name                            = "acctest-exemption-%d"
+  management_group_id             = azurerm_management_group.test.id
+  policy_assignment_id            = azurerm_management_group_policy_assignment.test.id
+  exemption_category              = "Waiver"
+  display_name                    = "Policy Exemption for acceptance test"
+  description                     = "Policy Exemption created in an acceptance test"
+  expires_on                      = "%s"
+  policy_definition_reference_ids = [data.azurerm_policy_set_definition.test.policy_definition_reference.0.reference_id]
+
+  metadata = <<METADATA
+    {
+        "foo": "bar"
+    }
+METADATA
+}
+`, r.template(data), data.RandomInteger, expiresOn)
+}
+
+func (r ManagementGroupPolicyExemptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "Acceptance Test MgmtGroup %[1]d"
+}
+
+data "azurerm_policy_set_definition" "test" {
+  display_name = "Audit machines with insecure password security settings"
+}
+
+resource "azurerm_management_group_policy_assignment" "test" {
+  name                 = "acctestpol-%[2]s"
+  management_group_id  = azurerm_management_group.test.id
+  policy_definition_id = data.azurerm_policy_set_definition.test.id
+  location             = %[3]q
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.RandomString, data.Locations.Primary)
+}
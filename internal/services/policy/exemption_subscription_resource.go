@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	subscriptionValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = SubscriptionPolicyExemptionResource{}
+
+type SubscriptionPolicyExemptionResource struct {
+	base exemptionBaseResource
+}
+
+func (r SubscriptionPolicyExemptionResource) Arguments() map[string]*pluginsdk.Schema {
+	schema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: subscriptionValidate.SubscriptionID,
+		},
+	}
+	return r.base.arguments(schema)
+}
+
+func (r SubscriptionPolicyExemptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base.attributes()
+}
+
+func (r SubscriptionPolicyExemptionResource) Create() sdk.ResourceFunc {
+	return r.base.createFunc(r.ResourceType(), "subscription_id")
+}
+
+func (r SubscriptionPolicyExemptionResource) Delete() sdk.ResourceFunc {
+	return r.base.deleteFunc()
+}
+
+func (r SubscriptionPolicyExemptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SubscriptionPolicyExemptionID
+}
+
+func (r SubscriptionPolicyExemptionResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r SubscriptionPolicyExemptionResource) Read() sdk.ResourceFunc {
+	return r.base.readFunc("subscription_id")
+}
+
+func (r SubscriptionPolicyExemptionResource) ResourceType() string {
+	return "azurerm_subscription_policy_exemption"
+}
+
+func (r SubscriptionPolicyExemptionResource) Update() sdk.ResourceFunc {
+	return r.base.updateFunc()
+}
@@ -0,0 +1,213 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/sdk/2020-07-01-preview/policyexemptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SubscriptionPolicyExemptionResource struct{}
+
+func TestAccSubscriptionPolicyExemption_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSubscriptionPolicyExemption_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSubscriptionPolicyExemption_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+	expiresOn := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, expiresOn),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSubscriptionPolicyExemption_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+	expiresOn := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, expiresOn),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSubscriptionPolicyExemption_invalidReferenceId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_policy_exemption", "test")
+	r := SubscriptionPolicyExemptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidReferenceId(data),
+			ExpectError: regexp.MustCompile("was not found within the Policy Set Definition"),
+		},
+	})
+}
+
+func (r SubscriptionPolicyExemptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := policyexemptions.ParseScopedPolicyExemptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Policy.ExemptionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SubscriptionPolicyExemptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_exemption" "test" {
+  name                 = "acctest-exemption-%d"
+  subscription_id      = data.azurerm_subscription.test.id
+  policy_assignment_id = azurerm_subscription_policy_assignment.test.id
+  exemption_category   = "Mitigated"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SubscriptionPolicyExemptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_exemption" "import" {
+  name                 = azurerm_subscription_policy_exemption.test.name
+  subscription_id      = azurerm_subscription_policy_exemption.test.subscription_id
+  policy_assignment_id = azurerm_subscription_policy_exemption.test.policy_assignment_id
+  exemption_category   = azurerm_subscription_policy_exemption.test.exemption_category
+}
+`, r.basic(data))
+}
+
+func (r SubscriptionPolicyExemptionResource) complete(data acceptance.TestData, expiresOn string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_exemption" "test" {
+  name                            = "acctest-exemption-%d"
+  subscription_id                 = data.azurerm_subscription.test.id
+  policy_assignment_id            = azurerm_subscription_policy_assignment.test.id
+  exemption_category              = "Waiver"
+  display_name                    = "Policy Exemption for acceptance test"
+  description                     = "Policy Exemption created in an acceptance test"
+  expires_on                      = "%s"
+  policy_definition_reference_ids = [data.azurerm_policy_set_definition.test.policy_definition_reference.0.reference_id]
+
+  metadata = <<METADATA
+    {
+        "foo": "bar"
+    }
+METADATA
+}
+`, r.template(data), data.RandomInteger, expiresOn)
+}
+
+func (r SubscriptionPolicyExemptionResource) invalidReferenceId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_policy_exemption" "test" {
+  name                            = "acctest-exemption-%d"
+  subscription_id                 = data.azurerm_subscription.test.id
+  policy_assignment_id            = azurerm_subscription_policy_assignment.test.id
+  exemption_category              = "Waiver"
+  policy_definition_reference_ids = ["doesnotexist"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SubscriptionPolicyExemptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {}
+
+data "azurerm_policy_set_definition" "test" {
+  display_name = "Audit machines with insecure password security settings"
+}
+
+resource "azurerm_subscription_policy_assignment" "test" {
+  name                 = "acctestpa-%[1]d"
+  subscription_id      = data.azurerm_subscription.test.id
+  policy_definition_id = data.azurerm_policy_set_definition.test.id
+  location             = %[2]q
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
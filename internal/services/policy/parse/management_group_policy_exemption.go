@@ -0,0 +1,58 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ManagementGroupPolicyExemptionId struct {
+	ManagementGroupName string
+	PolicyExemptionName string
+}
+
+func NewManagementGroupPolicyExemptionID(managementGroupName, policyExemptionName string) ManagementGroupPolicyExemptionId {
+	return ManagementGroupPolicyExemptionId{
+		ManagementGroupName: managementGroupName,
+		PolicyExemptionName: policyExemptionName,
+	}
+}
+
+func (id ManagementGroupPolicyExemptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Policy Exemption Name %q", id.PolicyExemptionName),
+		fmt.Sprintf("Management Group Name %q", id.ManagementGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Group Policy Exemption", segmentsStr)
+}
+
+func (id ManagementGroupPolicyExemptionId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Authorization/policyExemptions/%s"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.PolicyExemptionName)
+}
+
+// ManagementGroupPolicyExemptionID parses a ManagementGroupPolicyExemption ID into an ManagementGroupPolicyExemptionId struct
+func ManagementGroupPolicyExemptionID(input string) (*ManagementGroupPolicyExemptionId, error) {
+	// TODO: the generator should support outputting this method too
+	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagementGroupPolicyExemptionId{}
+
+	if resourceId.ManagementGroupName, err = id.PopSegment("managementGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.PolicyExemptionName, err = id.PopSegment("policyExemptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -18,9 +18,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ManagementGroupAssignmentResource{},
+		ManagementGroupPolicyExemptionResource{},
 		ResourceAssignmentResource{},
 		ResourceGroupAssignmentResource{},
 		SubscriptionAssignmentResource{},
+		SubscriptionPolicyExemptionResource{},
 	}
 }
 
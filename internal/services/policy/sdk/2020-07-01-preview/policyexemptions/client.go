@@ -0,0 +1,15 @@
+package policyexemptions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PolicyExemptionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPolicyExemptionsClientWithBaseURI(endpoint string) PolicyExemptionsClient {
+	return PolicyExemptionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
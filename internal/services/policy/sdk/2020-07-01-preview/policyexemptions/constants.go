@@ -0,0 +1,8 @@
+package policyexemptions
+
+type ExemptionCategory string
+
+const (
+	ExemptionCategoryMitigated ExemptionCategory = "Mitigated"
+	ExemptionCategoryWaiver    ExemptionCategory = "Waiver"
+)
@@ -0,0 +1,70 @@
+package policyexemptions
+
+import (
+	"fmt"
+	"strings"
+)
+
+const policyExemptionsSegment = "/providers/Microsoft.Authorization/policyExemptions/"
+
+type ScopedPolicyExemptionId struct {
+	Scope string
+	Name  string
+}
+
+func NewScopedPolicyExemptionID(scope, name string) ScopedPolicyExemptionId {
+	return ScopedPolicyExemptionId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id ScopedPolicyExemptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Scoped Policy Exemption", segmentsStr)
+}
+
+func (id ScopedPolicyExemptionId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/policyExemptions/%s"
+	return fmt.Sprintf(fmtString, strings.TrimSuffix(id.Scope, "/"), id.Name)
+}
+
+// ParseScopedPolicyExemptionID parses a ScopedPolicyExemption ID into an ScopedPolicyExemptionId struct
+func ParseScopedPolicyExemptionID(input string) (*ScopedPolicyExemptionId, error) {
+	return parseScopedPolicyExemptionID(input, false)
+}
+
+// ParseScopedPolicyExemptionIDInsensitively parses an ScopedPolicyExemption ID into an ScopedPolicyExemptionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseScopedPolicyExemptionID method should be used instead for validation etc.
+func ParseScopedPolicyExemptionIDInsensitively(input string) (*ScopedPolicyExemptionId, error) {
+	return parseScopedPolicyExemptionID(input, true)
+}
+
+func parseScopedPolicyExemptionID(input string, insensitively bool) (*ScopedPolicyExemptionId, error) {
+	index := strings.LastIndex(input, policyExemptionsSegment)
+	if insensitively {
+		index = strings.LastIndex(strings.ToLower(input), strings.ToLower(policyExemptionsSegment))
+	}
+	if index == -1 {
+		return nil, fmt.Errorf("expected %q to be in the format `{scope}%s{name}`", input, policyExemptionsSegment)
+	}
+
+	scope := input[:index]
+	name := input[index+len(policyExemptionsSegment):]
+	if scope == "" || !strings.HasPrefix(scope, "/") {
+		return nil, fmt.Errorf("ID was missing the 'scope' element")
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("ID was missing the 'policyExemptions' element")
+	}
+
+	return &ScopedPolicyExemptionId{
+		Scope: scope,
+		Name:  name,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package policyexemptions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ScopedPolicyExemptionId{}
+
+func TestNewScopedPolicyExemptionID(t *testing.T) {
+	id := NewScopedPolicyExemptionID("/subscriptions/12345678-1234-9876-4563-123456789012", "exemption1")
+
+	if id.Scope != "/subscriptions/12345678-1234-9876-4563-123456789012" {
+		t.Fatalf("Expected %q but got %q for Segment 'Scope'", id.Scope, "/subscriptions/12345678-1234-9876-4563-123456789012")
+	}
+
+	if id.Name != "exemption1" {
+		t.Fatalf("Expected %q but got %q for Segment 'Name'", id.Name, "exemption1")
+	}
+}
+
+func TestFormatScopedPolicyExemptionID(t *testing.T) {
+	actual := NewScopedPolicyExemptionID("/providers/Microsoft.Management/managementGroups/group1", "exemption1").ID()
+	expected := "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyExemptions/exemption1"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", expected, actual)
+	}
+}
+
+func TestParseScopedPolicyExemptionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScopedPolicyExemptionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing scope
+			Input: "/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyExemptions/",
+			Error: true,
+		},
+		{
+			// subscription scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "exemption1",
+			},
+		},
+		{
+			// management group scope
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope: "/providers/Microsoft.Management/managementGroups/group1",
+				Name:  "exemption1",
+			},
+		},
+		{
+			// upper-cased
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/POLICYEXEMPTIONS/exemption1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScopedPolicyExemptionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestParseScopedPolicyExemptionIDInsensitively(t *testing.T) {
+	actual, err := ParseScopedPolicyExemptionIDInsensitively("/subscriptions/12345678-1234-9876-4563-123456789012/providers/microsoft.authorization/POLICYEXEMPTIONS/exemption1")
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+
+	if actual.Scope != "/subscriptions/12345678-1234-9876-4563-123456789012" {
+		t.Fatalf("Expected %q but got %q for Scope", "/subscriptions/12345678-1234-9876-4563-123456789012", actual.Scope)
+	}
+
+	if actual.Name != "exemption1" {
+		t.Fatalf("Expected %q but got %q for Name", "exemption1", actual.Name)
+	}
+}
@@ -0,0 +1,8 @@
+package policyexemptions
+
+type PolicyExemption struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties PolicyExemptionProperties `json:"properties"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package policyexemptions
+
+type PolicyExemptionProperties struct {
+	Description                  *string           `json:"description,omitempty"`
+	DisplayName                  *string           `json:"displayName,omitempty"`
+	ExemptionCategory            ExemptionCategory `json:"exemptionCategory"`
+	ExpiresOn                    *string           `json:"expiresOn,omitempty"`
+	Metadata                     *interface{}      `json:"metadata,omitempty"`
+	PolicyAssignmentId           string            `json:"policyAssignmentId"`
+	PolicyDefinitionReferenceIds *[]string         `json:"policyDefinitionReferenceIds,omitempty"`
+}
@@ -0,0 +1,9 @@
+package policyexemptions
+
+import "fmt"
+
+const defaultApiVersion = "2020-07-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/policyexemptions/%s", defaultApiVersion)
+}
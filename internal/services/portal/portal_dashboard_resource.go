@@ -47,10 +47,11 @@ func resourceDashboard() *pluginsdk.Resource {
 			"location":            azure.SchemaLocation(),
 			"tags":                tags.Schema(),
 			"dashboard_properties": {
-				Type:      pluginsdk.TypeString,
-				Optional:  true,
-				Computed:  true,
-				StateFunc: utils.NormalizeJson,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				StateFunc:    utils.NormalizeJson,
+				ValidateFunc: validate.DashboardProperties,
 			},
 		},
 	}
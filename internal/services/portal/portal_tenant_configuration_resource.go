@@ -98,9 +98,12 @@ func resourcePortalTenantConfigurationRead(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	if props := resp.ConfigurationProperties; props != nil {
-		d.Set("private_markdown_storage_enforced", props.EnforcePrivateMarkdownStorage)
+	// the API omits `enforcePrivateMarkdownStorage` when it's disabled, so this is treated as false
+	privateMarkdownStorageEnforced := false
+	if props := resp.ConfigurationProperties; props != nil && props.EnforcePrivateMarkdownStorage != nil {
+		privateMarkdownStorageEnforced = *props.EnforcePrivateMarkdownStorage
 	}
+	d.Set("private_markdown_storage_enforced", privateMarkdownStorageEnforced)
 
 	return nil
 }
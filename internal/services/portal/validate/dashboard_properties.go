@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DashboardProperties validates that the value is a JSON object which can be deserialized into the properties
+// of a Dashboard - that is, that any `lenses` and `metadata` present are both objects.
+func DashboardProperties(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &properties); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %+v", k, err))
+		return warnings, errors
+	}
+
+	for _, key := range []string{"lenses", "metadata"} {
+		raw, ok := properties[key]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+
+		var value map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &value); err != nil {
+			errors = append(errors, fmt.Errorf("%q must contain a JSON object for `%s`: %+v", k, key, err))
+		}
+	}
+
+	return warnings, errors
+}
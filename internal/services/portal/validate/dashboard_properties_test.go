@@ -0,0 +1,53 @@
+package validate
+
+import "testing"
+
+func TestDashboardProperties(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "not-json",
+			Valid: false,
+		},
+		{
+			Input: `["lenses"]`,
+			Valid: false,
+		},
+		{
+			Input: `{"lenses": []}`,
+			Valid: false,
+		},
+		{
+			Input: `{"lenses": {}, "metadata": "hello"}`,
+			Valid: false,
+		},
+		{
+			Input: `{}`,
+			Valid: true,
+		},
+		{
+			Input: `{"lenses": {"0": {"order": 0, "parts": {}}}, "metadata": {"model": {}}}`,
+			Valid: true,
+		},
+		{
+			Input: `{"lenses": null}`,
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := DashboardProperties(tc.Input, "dashboard_properties")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverdomainlists"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverpolicyvirtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnssecurityrules"
+)
+
+type Client struct {
+	DnsResolverDomainListsClient               *dnsresolverdomainlists.DnsResolverDomainListsClient
+	DnsResolverPoliciesClient                  *dnsresolverpolicies.DnsResolverPoliciesClient
+	DnsResolverPolicyVirtualNetworkLinksClient *dnsresolverpolicyvirtualnetworklinks.DnsResolverPolicyVirtualNetworkLinksClient
+	DnsSecurityRulesClient                     *dnssecurityrules.DnsSecurityRulesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	dnsResolverDomainListsClient := dnsresolverdomainlists.NewDnsResolverDomainListsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsResolverDomainListsClient.Client, o.ResourceManagerAuthorizer)
+
+	dnsResolverPoliciesClient := dnsresolverpolicies.NewDnsResolverPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsResolverPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	dnsResolverPolicyVirtualNetworkLinksClient := dnsresolverpolicyvirtualnetworklinks.NewDnsResolverPolicyVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsResolverPolicyVirtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
+
+	dnsSecurityRulesClient := dnssecurityrules.NewDnsSecurityRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsSecurityRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DnsResolverDomainListsClient:               &dnsResolverDomainListsClient,
+		DnsResolverPoliciesClient:                  &dnsResolverPoliciesClient,
+		DnsResolverPolicyVirtualNetworkLinksClient: &dnsResolverPolicyVirtualNetworkLinksClient,
+		DnsSecurityRulesClient:                     &dnsSecurityRulesClient,
+	}
+}
@@ -0,0 +1,195 @@
+package privatednsresolver
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverpolicyvirtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePrivateDnsResolverPolicyVirtualNetworkLink() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverPolicyVirtualNetworkLinkCreate,
+		Read:   resourcePrivateDnsResolverPolicyVirtualNetworkLinkRead,
+		Update: resourcePrivateDnsResolverPolicyVirtualNetworkLinkUpdate,
+		Delete: resourcePrivateDnsResolverPolicyVirtualNetworkLinkDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := dnsresolverpolicyvirtualnetworklinks.ParseDnsResolverPolicyVirtualNetworkLinkID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateDnsResolverName,
+			},
+
+			"dns_resolver_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverPolicyID,
+			},
+
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualNetworkID,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourcePrivateDnsResolverPolicyVirtualNetworkLinkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsResolverPolicyVirtualNetworkLinksClient
+	policiesClient := meta.(*clients.Client).PrivateDnsResolver.DnsResolverPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId, err := dnsresolverpolicies.ParseDnsResolverPolicyID(d.Get("dns_resolver_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := dnsresolverpolicyvirtualnetworklinks.NewDnsResolverPolicyVirtualNetworkLinkID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_private_dns_resolver_policy_virtual_network_link", id.ID())
+	}
+
+	// the Virtual Network Link must be created in the same location as the DNS Resolver Policy
+	policy, err := policiesClient.Get(ctx, *policyId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *policyId, err)
+	}
+	if policy.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *policyId)
+	}
+
+	parameters := dnsresolverpolicyvirtualnetworklinks.DnsResolverPolicyVirtualNetworkLink{
+		Location: policy.Model.Location,
+		Properties: dnsresolverpolicyvirtualnetworklinks.DnsResolverPolicyVirtualNetworkLinkProperties{
+			VirtualNetwork: dnsresolverpolicyvirtualnetworklinks.SubResource{
+				Id: d.Get("virtual_network_id").(string),
+			},
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateDnsResolverPolicyVirtualNetworkLinkRead(d, meta)
+}
+
+func resourcePrivateDnsResolverPolicyVirtualNetworkLinkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsResolverPolicyVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dnsresolverpolicyvirtualnetworklinks.ParseDnsResolverPolicyVirtualNetworkLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("dns_resolver_policy_id", dnsresolverpolicies.NewDnsResolverPolicyID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverPolicyName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("virtual_network_id", model.Properties.VirtualNetwork.Id)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverPolicyVirtualNetworkLinkUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsResolverPolicyVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dnsresolverpolicyvirtualnetworklinks.ParseDnsResolverPolicyVirtualNetworkLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	parameters := *existing.Model
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourcePrivateDnsResolverPolicyVirtualNetworkLinkRead(d, meta)
+}
+
+func resourcePrivateDnsResolverPolicyVirtualNetworkLinkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsResolverPolicyVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dnsresolverpolicyvirtualnetworklinks.ParseDnsResolverPolicyVirtualNetworkLinkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverdomainlists"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnsresolverpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnssecurityrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePrivateDnsResolverSecurityRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsResolverSecurityRuleCreate,
+		Read:   resourcePrivateDnsResolverSecurityRuleRead,
+		Update: resourcePrivateDnsResolverSecurityRuleUpdate,
+		Delete: resourcePrivateDnsResolverSecurityRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := dnssecurityrules.ParseDnsSecurityRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateDnsResolverName,
+			},
+
+			"dns_resolver_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DnsResolverPolicyID,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 9000),
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(dnssecurityrules.ActionTypeAlert),
+					string(dnssecurityrules.ActionTypeAllow),
+					string(dnssecurityrules.ActionTypeBlock),
+				}, false),
+			},
+
+			"dns_resolver_domain_list_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.DnsResolverDomainListID,
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourcePrivateDnsResolverSecurityRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsSecurityRulesClient
+	policiesClient := meta.(*clients.Client).PrivateDnsResolver.DnsResolverPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId, err := dnsresolverpolicies.ParseDnsResolverPolicyID(d.Get("dns_resolver_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := dnssecurityrules.NewDnsSecurityRuleID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_private_dns_resolver_security_rule", id.ID())
+	}
+
+	// the DNS Security Rule must be created in the same location as the DNS Resolver Policy
+	policy, err := policiesClient.Get(ctx, *policyId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *policyId, err)
+	}
+	if policy.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *policyId)
+	}
+
+	domainLists, err := expandPrivateDnsResolverSecurityRuleDomainLists(ctx, meta, d.Get("dns_resolver_domain_list_ids").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	priority := int64(d.Get("priority").(int))
+	if err := validatePrivateDnsResolverSecurityRulePriority(ctx, client, id, priority); err != nil {
+		return err
+	}
+
+	parameters := dnssecurityrules.DnsSecurityRule{
+		Location: policy.Model.Location,
+		Properties: dnssecurityrules.DnsSecurityRuleProperties{
+			Action:                 expandPrivateDnsResolverSecurityRuleAction(d.Get("action").(string)),
+			DnsResolverDomainLists: domainLists,
+			DnsSecurityRuleState:   expandPrivateDnsResolverSecurityRuleState(d.Get("enabled").(bool)),
+			Priority:               priority,
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateDnsResolverSecurityRuleRead(d, meta)
+}
+
+func resourcePrivateDnsResolverSecurityRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsSecurityRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dnssecurityrules.ParseDnsSecurityRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("dns_resolver_policy_id", dnsresolverpolicies.NewDnsResolverPolicyID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverPolicyName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("priority", int(props.Priority))
+
+		action := ""
+		if props.Action.ActionType != nil {
+			action = string(*props.Action.ActionType)
+		}
+		d.Set("action", action)
+
+		domainListIds := make([]interface{}, 0)
+		for _, v := range props.DnsResolverDomainLists {
+			domainListId, err := dnsresolverdomainlists.ParseDnsResolverDomainListIDInsensitively(v.Id)
+			if err != nil {
+				return err
+			}
+			domainListIds = append(domainListIds, domainListId.ID())
+		}
+		d.Set("dns_resolver_domain_list_ids", domainListIds)
+
+		d.Set("enabled", props.DnsSecurityRuleState == nil || *props.DnsSecurityRuleState == dnssecurityrules.DnsSecurityRuleStateEnabled)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsResolverSecurityRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsSecurityRulesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dnssecurityrules.ParseDnsSecurityRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	parameters := *existing.Model
+
+	if d.HasChange("priority") {
+		priority := int64(d.Get("priority").(int))
+		if err := validatePrivateDnsResolverSecurityRulePriority(ctx, client, *id, priority); err != nil {
+			return err
+		}
+		parameters.Properties.Priority = priority
+	}
+
+	if d.HasChange("action") {
+		parameters.Properties.Action = expandPrivateDnsResolverSecurityRuleAction(d.Get("action").(string))
+	}
+
+	if d.HasChange("dns_resolver_domain_list_ids") {
+		domainLists, err := expandPrivateDnsResolverSecurityRuleDomainLists(ctx, meta, d.Get("dns_resolver_domain_list_ids").(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		parameters.Properties.DnsResolverDomainLists = domainLists
+	}
+
+	if d.HasChange("enabled") {
+		parameters.Properties.DnsSecurityRuleState = expandPrivateDnsResolverSecurityRuleState(d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = expandTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourcePrivateDnsResolverSecurityRuleRead(d, meta)
+}
+
+func resourcePrivateDnsResolverSecurityRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsSecurityRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dnssecurityrules.ParseDnsSecurityRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// validatePrivateDnsResolverSecurityRulePriority ensures that no other DNS Security Rule within the same DNS Resolver
+// Policy uses the specified priority, since the API otherwise fails with a fairly unhelpful error
+func validatePrivateDnsResolverSecurityRulePriority(ctx context.Context, client *dnssecurityrules.DnsSecurityRulesClient, id dnssecurityrules.DnsSecurityRuleId, priority int64) error {
+	policyId := dnssecurityrules.NewDnsResolverPolicyID(id.SubscriptionId, id.ResourceGroup, id.DnsResolverPolicyName)
+	resp, err := client.ListByDnsResolverPolicy(ctx, policyId)
+	if err != nil {
+		return fmt.Errorf("listing DNS Security Rules for %s: %+v", policyId, err)
+	}
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil
+	}
+
+	for _, rule := range *resp.Model.Value {
+		if rule.Id == nil || strings.EqualFold(*rule.Id, id.ID()) {
+			continue
+		}
+
+		if rule.Properties.Priority == priority {
+			return fmt.Errorf("the priority %d is already in use by the DNS Security Rule %q within %s - priorities must be unique within a DNS Resolver Policy", priority, *rule.Id, policyId)
+		}
+	}
+
+	return nil
+}
+
+// expandPrivateDnsResolverSecurityRuleDomainLists ensures that each of the referenced DNS Resolver Domain Lists exists
+func expandPrivateDnsResolverSecurityRuleDomainLists(ctx context.Context, meta interface{}, input []interface{}) ([]dnssecurityrules.SubResource, error) {
+	client := meta.(*clients.Client).PrivateDnsResolver.DnsResolverDomainListsClient
+
+	output := make([]dnssecurityrules.SubResource, 0)
+	for _, v := range input {
+		domainListId, err := dnsresolverdomainlists.ParseDnsResolverDomainListID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Get(ctx, *domainListId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, fmt.Errorf("the %s referenced in `dns_resolver_domain_list_ids` was not found", *domainListId)
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *domainListId, err)
+		}
+
+		output = append(output, dnssecurityrules.SubResource{
+			Id: domainListId.ID(),
+		})
+	}
+
+	return output, nil
+}
+
+func expandPrivateDnsResolverSecurityRuleAction(input string) dnssecurityrules.DnsSecurityRuleAction {
+	actionType := dnssecurityrules.ActionType(input)
+	return dnssecurityrules.DnsSecurityRuleAction{
+		ActionType: &actionType,
+	}
+}
+
+func expandPrivateDnsResolverSecurityRuleState(enabled bool) *dnssecurityrules.DnsSecurityRuleState {
+	state := dnssecurityrules.DnsSecurityRuleStateDisabled
+	if enabled {
+		state = dnssecurityrules.DnsSecurityRuleStateEnabled
+	}
+	return &state
+}
@@ -0,0 +1,210 @@
+package privatednsresolver_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/sdk/2023-07-01-preview/dnssecurityrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverSecurityRuleResource struct{}
+
+func TestAccPrivateDnsResolverSecurityRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_security_rule", "test")
+	r := PrivateDnsResolverSecurityRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverSecurityRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_security_rule", "test")
+	r := PrivateDnsResolverSecurityRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsResolverSecurityRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_security_rule", "test")
+	r := PrivateDnsResolverSecurityRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("action").HasValue("Alert"),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverSecurityRule_duplicatePriority(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_security_rule", "test")
+	r := PrivateDnsResolverSecurityRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.duplicatePriority(data),
+			ExpectError: regexp.MustCompile("the priority 100 is already in use"),
+		},
+	})
+}
+
+func (r PrivateDnsResolverSecurityRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dnssecurityrules.ParseDnsSecurityRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDnsResolver.DnsSecurityRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PrivateDnsResolverSecurityRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dnsrp-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_resolver_policy" "test" {
+  name                = "acctest-dnsrp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_private_dns_resolver_domain_list" "test" {
+  name                = "acctest-dnsrdl-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  domains             = ["contoso.com."]
+}
+
+resource "azurerm_private_dns_resolver_domain_list" "other" {
+  name                = "acctest-dnsrdl-other-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  domains             = ["fabrikam.com."]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PrivateDnsResolverSecurityRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_security_rule" "test" {
+  name                         = "acctest-dnssr-%d"
+  dns_resolver_policy_id       = azurerm_private_dns_resolver_policy.test.id
+  priority                     = 100
+  action                       = "Block"
+  dns_resolver_domain_list_ids = [azurerm_private_dns_resolver_domain_list.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverSecurityRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_security_rule" "import" {
+  name                         = azurerm_private_dns_resolver_security_rule.test.name
+  dns_resolver_policy_id       = azurerm_private_dns_resolver_security_rule.test.dns_resolver_policy_id
+  priority                     = azurerm_private_dns_resolver_security_rule.test.priority
+  action                       = azurerm_private_dns_resolver_security_rule.test.action
+  dns_resolver_domain_list_ids = azurerm_private_dns_resolver_security_rule.test.dns_resolver_domain_list_ids
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsResolverSecurityRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_security_rule" "test" {
+  name                   = "acctest-dnssr-%d"
+  dns_resolver_policy_id = azurerm_private_dns_resolver_policy.test.id
+  priority               = 200
+  action                 = "Alert"
+  enabled                = false
+
+  dns_resolver_domain_list_ids = [
+    azurerm_private_dns_resolver_domain_list.test.id,
+    azurerm_private_dns_resolver_domain_list.other.id,
+  ]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverSecurityRuleResource) duplicatePriority(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_security_rule" "other" {
+  name                         = "acctest-dnssr-other-%d"
+  dns_resolver_policy_id       = azurerm_private_dns_resolver_policy.test.id
+  priority                     = 100
+  action                       = "Allow"
+  dns_resolver_domain_list_ids = [azurerm_private_dns_resolver_domain_list.other.id]
+
+  depends_on = [azurerm_private_dns_resolver_security_rule.test]
+}
+`, r.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,34 @@
+package privatednsresolver
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Private DNS Resolver"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Private DNS Resolver",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_private_dns_resolver_domain_list":                 resourcePrivateDnsResolverDomainList(),
+		"azurerm_private_dns_resolver_policy":                      resourcePrivateDnsResolverPolicy(),
+		"azurerm_private_dns_resolver_policy_virtual_network_link": resourcePrivateDnsResolverPolicyVirtualNetworkLink(),
+		"azurerm_private_dns_resolver_security_rule":               resourcePrivateDnsResolverSecurityRule(),
+	}
+}
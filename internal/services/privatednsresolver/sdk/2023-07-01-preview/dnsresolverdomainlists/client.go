@@ -0,0 +1,15 @@
+package dnsresolverdomainlists
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnsResolverDomainListsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnsResolverDomainListsClientWithBaseURI(endpoint string) DnsResolverDomainListsClient {
+	return DnsResolverDomainListsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,108 @@
+package dnsresolverdomainlists
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsResolverDomainListId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewDnsResolverDomainListID(subscriptionId, resourceGroup, name string) DnsResolverDomainListId {
+	return DnsResolverDomainListId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id DnsResolverDomainListId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Resolver Domain List", segmentsStr)
+}
+
+func (id DnsResolverDomainListId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolverDomainLists/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDnsResolverDomainListID parses a DnsResolverDomainList ID into an DnsResolverDomainListId struct
+func ParseDnsResolverDomainListID(input string) (*DnsResolverDomainListId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverDomainListId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("dnsResolverDomainLists"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDnsResolverDomainListIDInsensitively parses an DnsResolverDomainList ID into an DnsResolverDomainListId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDnsResolverDomainListID method should be used instead for validation etc.
+func ParseDnsResolverDomainListIDInsensitively(input string) (*DnsResolverDomainListId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverDomainListId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnsResolverDomainLists' segment
+	dnsResolverDomainListsKey := "dnsResolverDomainLists"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsResolverDomainListsKey) {
+			dnsResolverDomainListsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(dnsResolverDomainListsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
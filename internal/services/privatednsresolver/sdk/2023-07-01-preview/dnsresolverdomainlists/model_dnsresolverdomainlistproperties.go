@@ -0,0 +1,7 @@
+package dnsresolverdomainlists
+
+type DnsResolverDomainListProperties struct {
+	Domains           []string           `json:"domains"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+}
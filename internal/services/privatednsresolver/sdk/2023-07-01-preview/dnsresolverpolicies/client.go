@@ -0,0 +1,15 @@
+package dnsresolverpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnsResolverPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnsResolverPoliciesClientWithBaseURI(endpoint string) DnsResolverPoliciesClient {
+	return DnsResolverPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
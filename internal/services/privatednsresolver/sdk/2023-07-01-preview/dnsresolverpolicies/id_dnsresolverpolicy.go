@@ -0,0 +1,108 @@
+package dnsresolverpolicies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsResolverPolicyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewDnsResolverPolicyID(subscriptionId, resourceGroup, name string) DnsResolverPolicyId {
+	return DnsResolverPolicyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id DnsResolverPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Resolver Policy", segmentsStr)
+}
+
+func (id DnsResolverPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolverPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDnsResolverPolicyID parses a DnsResolverPolicy ID into an DnsResolverPolicyId struct
+func ParseDnsResolverPolicyID(input string) (*DnsResolverPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("dnsResolverPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDnsResolverPolicyIDInsensitively parses an DnsResolverPolicy ID into an DnsResolverPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDnsResolverPolicyID method should be used instead for validation etc.
+func ParseDnsResolverPolicyIDInsensitively(input string) (*DnsResolverPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnsResolverPolicies' segment
+	dnsResolverPoliciesKey := "dnsResolverPolicies"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsResolverPoliciesKey) {
+			dnsResolverPoliciesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(dnsResolverPoliciesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
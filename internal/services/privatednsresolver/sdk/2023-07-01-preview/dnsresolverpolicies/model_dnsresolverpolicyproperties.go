@@ -0,0 +1,6 @@
+package dnsresolverpolicies
+
+type DnsResolverPolicyProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+}
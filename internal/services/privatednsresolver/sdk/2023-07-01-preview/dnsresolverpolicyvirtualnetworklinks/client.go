@@ -0,0 +1,15 @@
+package dnsresolverpolicyvirtualnetworklinks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnsResolverPolicyVirtualNetworkLinksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnsResolverPolicyVirtualNetworkLinksClientWithBaseURI(endpoint string) DnsResolverPolicyVirtualNetworkLinksClient {
+	return DnsResolverPolicyVirtualNetworkLinksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
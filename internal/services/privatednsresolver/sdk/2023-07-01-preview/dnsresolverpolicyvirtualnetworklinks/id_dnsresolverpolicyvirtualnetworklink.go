@@ -0,0 +1,126 @@
+package dnsresolverpolicyvirtualnetworklinks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsResolverPolicyVirtualNetworkLinkId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	DnsResolverPolicyName string
+	Name                  string
+}
+
+func NewDnsResolverPolicyVirtualNetworkLinkID(subscriptionId, resourceGroup, dnsResolverPolicyName, name string) DnsResolverPolicyVirtualNetworkLinkId {
+	return DnsResolverPolicyVirtualNetworkLinkId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		DnsResolverPolicyName: dnsResolverPolicyName,
+		Name:                  name,
+	}
+}
+
+func (id DnsResolverPolicyVirtualNetworkLinkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Dns Resolver Policy Name %q", id.DnsResolverPolicyName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Resolver Policy Virtual Network Link", segmentsStr)
+}
+
+func (id DnsResolverPolicyVirtualNetworkLinkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolverPolicies/%s/virtualNetworkLinks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsResolverPolicyName, id.Name)
+}
+
+// ParseDnsResolverPolicyVirtualNetworkLinkID parses a DnsResolverPolicyVirtualNetworkLink ID into an DnsResolverPolicyVirtualNetworkLinkId struct
+func ParseDnsResolverPolicyVirtualNetworkLinkID(input string) (*DnsResolverPolicyVirtualNetworkLinkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverPolicyVirtualNetworkLinkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsResolverPolicyName, err = id.PopSegment("dnsResolverPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("virtualNetworkLinks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDnsResolverPolicyVirtualNetworkLinkIDInsensitively parses an DnsResolverPolicyVirtualNetworkLink ID into an DnsResolverPolicyVirtualNetworkLinkId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDnsResolverPolicyVirtualNetworkLinkID method should be used instead for validation etc.
+func ParseDnsResolverPolicyVirtualNetworkLinkIDInsensitively(input string) (*DnsResolverPolicyVirtualNetworkLinkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsResolverPolicyVirtualNetworkLinkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnsResolverPolicies' segment
+	dnsResolverPoliciesKey := "dnsResolverPolicies"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsResolverPoliciesKey) {
+			dnsResolverPoliciesKey = key
+			break
+		}
+	}
+	if resourceId.DnsResolverPolicyName, err = id.PopSegment(dnsResolverPoliciesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'virtualNetworkLinks' segment
+	virtualNetworkLinksKey := "virtualNetworkLinks"
+	for key := range id.Path {
+		if strings.EqualFold(key, virtualNetworkLinksKey) {
+			virtualNetworkLinksKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(virtualNetworkLinksKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,262 @@
+package dnsresolverpolicyvirtualnetworklinks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = DnsResolverPolicyVirtualNetworkLinkId{}
+
+func TestDnsResolverPolicyVirtualNetworkLinkIDFormatter(t *testing.T) {
+	actual := NewDnsResolverPolicyVirtualNetworkLinkID("{subscriptionId}", "{resourceGroupName}", "{dnsResolverPolicyName}", "{virtualNetworkLinkName}").ID()
+	expected := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/virtualNetworkLinks/{virtualNetworkLinkName}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseDnsResolverPolicyVirtualNetworkLinkID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DnsResolverPolicyVirtualNetworkLinkId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing DnsResolverPolicyName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for DnsResolverPolicyName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/virtualNetworkLinks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/virtualNetworkLinks/{virtualNetworkLinkName}",
+			Expected: &DnsResolverPolicyVirtualNetworkLinkId{
+				SubscriptionId:        "{subscriptionId}",
+				ResourceGroup:         "{resourceGroupName}",
+				DnsResolverPolicyName: "{dnsResolverPolicyName}",
+				Name:                  "{virtualNetworkLinkName}",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/{SUBSCRIPTIONID}/RESOURCEGROUPS/{RESOURCEGROUPNAME}/PROVIDERS/MICROSOFT.NETWORK/DNSRESOLVERPOLICIES/{DNSRESOLVERPOLICYNAME}/VIRTUALNETWORKLINKS/{VIRTUALNETWORKLINKNAME}",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseDnsResolverPolicyVirtualNetworkLinkID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.DnsResolverPolicyName != v.Expected.DnsResolverPolicyName {
+			t.Fatalf("Expected %q but got %q for DnsResolverPolicyName", v.Expected.DnsResolverPolicyName, actual.DnsResolverPolicyName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestParseDnsResolverPolicyVirtualNetworkLinkIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DnsResolverPolicyVirtualNetworkLinkId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing DnsResolverPolicyName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for DnsResolverPolicyName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/virtualNetworkLinks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsResolverPolicies/{dnsResolverPolicyName}/virtualNetworkLinks/{virtualNetworkLinkName}",
+			Expected: &DnsResolverPolicyVirtualNetworkLinkId{
+				SubscriptionId:        "{subscriptionId}",
+				ResourceGroup:         "{resourceGroupName}",
+				DnsResolverPolicyName: "{dnsResolverPolicyName}",
+				Name:                  "{virtualNetworkLinkName}",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/dnsresolverpolicies/{dnsResolverPolicyName}/virtualnetworklinks/{virtualNetworkLinkName}",
+			Expected: &DnsResolverPolicyVirtualNetworkLinkId{
+				SubscriptionId:        "{subscriptionId}",
+				ResourceGroup:         "{resourceGroupName}",
+				DnsResolverPolicyName: "{dnsResolverPolicyName}",
+				Name:                  "{virtualNetworkLinkName}",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/DNSRESOLVERPOLICIES/{dnsResolverPolicyName}/VIRTUALNETWORKLINKS/{virtualNetworkLinkName}",
+			Expected: &DnsResolverPolicyVirtualNetworkLinkId{
+				SubscriptionId:        "{subscriptionId}",
+				ResourceGroup:         "{resourceGroupName}",
+				DnsResolverPolicyName: "{dnsResolverPolicyName}",
+				Name:                  "{virtualNetworkLinkName}",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/DnSrEsOlVeRpOlIcIeS/{dnsResolverPolicyName}/ViRtUaLnEtWoRkLiNkS/{virtualNetworkLinkName}",
+			Expected: &DnsResolverPolicyVirtualNetworkLinkId{
+				SubscriptionId:        "{subscriptionId}",
+				ResourceGroup:         "{resourceGroupName}",
+				DnsResolverPolicyName: "{dnsResolverPolicyName}",
+				Name:                  "{virtualNetworkLinkName}",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseDnsResolverPolicyVirtualNetworkLinkIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.DnsResolverPolicyName != v.Expected.DnsResolverPolicyName {
+			t.Fatalf("Expected %q but got %q for DnsResolverPolicyName", v.Expected.DnsResolverPolicyName, actual.DnsResolverPolicyName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package dnsresolverpolicyvirtualnetworklinks
+
+type DnsResolverPolicyVirtualNetworkLink struct {
+	Etag       *string                                       `json:"etag,omitempty"`
+	Id         *string                                       `json:"id,omitempty"`
+	Location   string                                        `json:"location"`
+	Name       *string                                       `json:"name,omitempty"`
+	Properties DnsResolverPolicyVirtualNetworkLinkProperties `json:"properties"`
+	Tags       *map[string]string                            `json:"tags,omitempty"`
+	Type       *string                                       `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package dnsresolverpolicyvirtualnetworklinks
+
+type DnsResolverPolicyVirtualNetworkLinkProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	VirtualNetwork    SubResource        `json:"virtualNetwork"`
+}
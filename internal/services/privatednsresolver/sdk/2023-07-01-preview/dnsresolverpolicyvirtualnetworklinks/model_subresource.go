@@ -0,0 +1,5 @@
+package dnsresolverpolicyvirtualnetworklinks
+
+type SubResource struct {
+	Id string `json:"id"`
+}
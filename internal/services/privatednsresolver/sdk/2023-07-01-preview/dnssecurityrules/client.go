@@ -0,0 +1,15 @@
+package dnssecurityrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DnsSecurityRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDnsSecurityRulesClientWithBaseURI(endpoint string) DnsSecurityRulesClient {
+	return DnsSecurityRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,27 @@
+package dnssecurityrules
+
+type ActionType string
+
+const (
+	ActionTypeAlert ActionType = "Alert"
+	ActionTypeAllow ActionType = "Allow"
+	ActionTypeBlock ActionType = "Block"
+)
+
+type DnsSecurityRuleState string
+
+const (
+	DnsSecurityRuleStateDisabled DnsSecurityRuleState = "Disabled"
+	DnsSecurityRuleStateEnabled  DnsSecurityRuleState = "Enabled"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
@@ -0,0 +1,126 @@
+package dnssecurityrules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsSecurityRuleId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	DnsResolverPolicyName string
+	Name                  string
+}
+
+func NewDnsSecurityRuleID(subscriptionId, resourceGroup, dnsResolverPolicyName, name string) DnsSecurityRuleId {
+	return DnsSecurityRuleId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		DnsResolverPolicyName: dnsResolverPolicyName,
+		Name:                  name,
+	}
+}
+
+func (id DnsSecurityRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Dns Resolver Policy Name %q", id.DnsResolverPolicyName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Security Rule", segmentsStr)
+}
+
+func (id DnsSecurityRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolverPolicies/%s/dnsSecurityRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsResolverPolicyName, id.Name)
+}
+
+// ParseDnsSecurityRuleID parses a DnsSecurityRule ID into an DnsSecurityRuleId struct
+func ParseDnsSecurityRuleID(input string) (*DnsSecurityRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsSecurityRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsResolverPolicyName, err = id.PopSegment("dnsResolverPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("dnsSecurityRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDnsSecurityRuleIDInsensitively parses an DnsSecurityRule ID into an DnsSecurityRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDnsSecurityRuleID method should be used instead for validation etc.
+func ParseDnsSecurityRuleIDInsensitively(input string) (*DnsSecurityRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsSecurityRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnsResolverPolicies' segment
+	dnsResolverPoliciesKey := "dnsResolverPolicies"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsResolverPoliciesKey) {
+			dnsResolverPoliciesKey = key
+			break
+		}
+	}
+	if resourceId.DnsResolverPolicyName, err = id.PopSegment(dnsResolverPoliciesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'dnsSecurityRules' segment
+	dnsSecurityRulesKey := "dnsSecurityRules"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsSecurityRulesKey) {
+			dnsSecurityRulesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(dnsSecurityRulesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
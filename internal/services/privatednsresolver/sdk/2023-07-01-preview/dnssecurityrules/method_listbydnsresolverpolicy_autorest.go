@@ -0,0 +1,65 @@
+package dnssecurityrules
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByDnsResolverPolicyResponse struct {
+	HttpResponse *http.Response
+	Model        *DnsSecurityRuleListResult
+}
+
+// ListByDnsResolverPolicy ...
+func (c DnsSecurityRulesClient) ListByDnsResolverPolicy(ctx context.Context, id DnsResolverPolicyId) (result ListByDnsResolverPolicyResponse, err error) {
+	req, err := c.preparerForListByDnsResolverPolicy(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnssecurityrules.DnsSecurityRulesClient", "ListByDnsResolverPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnssecurityrules.DnsSecurityRulesClient", "ListByDnsResolverPolicy", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListByDnsResolverPolicy(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dnssecurityrules.DnsSecurityRulesClient", "ListByDnsResolverPolicy", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListByDnsResolverPolicy prepares the ListByDnsResolverPolicy request.
+func (c DnsSecurityRulesClient) preparerForListByDnsResolverPolicy(ctx context.Context, id DnsResolverPolicyId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/dnsSecurityRules", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByDnsResolverPolicy handles the response to the ListByDnsResolverPolicy request. The method always
+// closes the http.Response Body.
+func (c DnsSecurityRulesClient) responderForListByDnsResolverPolicy(resp *http.Response) (result ListByDnsResolverPolicyResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
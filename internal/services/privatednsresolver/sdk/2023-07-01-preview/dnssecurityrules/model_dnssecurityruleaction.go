@@ -0,0 +1,5 @@
+package dnssecurityrules
+
+type DnsSecurityRuleAction struct {
+	ActionType *ActionType `json:"actionType,omitempty"`
+}
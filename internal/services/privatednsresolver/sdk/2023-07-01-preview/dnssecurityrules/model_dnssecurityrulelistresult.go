@@ -0,0 +1,6 @@
+package dnssecurityrules
+
+type DnsSecurityRuleListResult struct {
+	NextLink *string            `json:"nextLink,omitempty"`
+	Value    *[]DnsSecurityRule `json:"value,omitempty"`
+}
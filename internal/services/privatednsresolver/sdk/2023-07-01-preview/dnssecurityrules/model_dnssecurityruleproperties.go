@@ -0,0 +1,9 @@
+package dnssecurityrules
+
+type DnsSecurityRuleProperties struct {
+	Action                 DnsSecurityRuleAction `json:"action"`
+	DnsResolverDomainLists []SubResource         `json:"dnsResolverDomainLists"`
+	DnsSecurityRuleState   *DnsSecurityRuleState `json:"dnsSecurityRuleState,omitempty"`
+	Priority               int64                 `json:"priority"`
+	ProvisioningState      *ProvisioningState    `json:"provisioningState,omitempty"`
+}
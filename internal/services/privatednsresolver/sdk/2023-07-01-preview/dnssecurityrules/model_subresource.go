@@ -0,0 +1,5 @@
+package dnssecurityrules
+
+type SubResource struct {
+	Id string `json:"id"`
+}
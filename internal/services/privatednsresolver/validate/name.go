@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PrivateDnsResolverName validates the name of a DNS Resolver Policy, or of one of its Virtual Network Links or
+// DNS Security Rules, or of a DNS Resolver Domain List
+func PrivateDnsResolverName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9_])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters, start with a letter or number, end with a letter, number or underscore and may only contain letters, numbers, underscores and hyphens", k))
+	}
+
+	return
+}
@@ -1,28 +1,29 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-12-01/redis"
+	legacy "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-12-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2022-06-01/redis"
 )
 
 type Client struct {
-	Client               *redis.Client
-	FirewallRulesClient  *redis.FirewallRulesClient
-	PatchSchedulesClient *redis.PatchSchedulesClient
-	LinkedServerClient   *redis.LinkedServerClient
+	Client               *redis.RedisClient
+	FirewallRulesClient  *legacy.FirewallRulesClient
+	PatchSchedulesClient *legacy.PatchSchedulesClient
+	LinkedServerClient   *legacy.LinkedServerClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	client := redis.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	client := redis.NewRedisClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
 
-	FirewallRulesClient := redis.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	FirewallRulesClient := legacy.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
-	PatchSchedulesClient := redis.NewPatchSchedulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	PatchSchedulesClient := legacy.NewPatchSchedulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PatchSchedulesClient.Client, o.ResourceManagerAuthorizer)
 
-	LinkedServerClient := redis.NewLinkedServerClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	LinkedServerClient := legacy.NewLinkedServerClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedServerClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
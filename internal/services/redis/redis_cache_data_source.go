@@ -4,14 +4,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2022-06-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceRedisCache() *pluginsdk.Resource {
@@ -150,6 +150,16 @@ func dataSourceRedisCache() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeBool,
 							Computed: true,
 						},
+
+						"data_persistence_authentication_method": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"storage_account_subscription_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -228,73 +238,78 @@ func dataSourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewCacheID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	resp, err := client.Get(ctx, id.ResourceGroup, id.RediName)
+	id := redis.NewRedisID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Redis Cache %q (Resource Group %q) was not found", id.RediName, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
 		}
-		return fmt.Errorf("retrieving Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if zones := resp.Zones; zones != nil {
-		d.Set("zones", zones)
+	schedule, err := patchSchedulesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err == nil {
+		patchSchedule := flattenRedisPatchSchedules(schedule)
+		if err = d.Set("patch_schedule", patchSchedule); err != nil {
+			return fmt.Errorf("setting `patch_schedule`: %+v", err)
+		}
+	} else {
+		d.Set("patch_schedule", []interface{}{})
 	}
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("capacity", sku.Capacity)
-		d.Set("family", sku.Family)
-		d.Set("sku_name", sku.Name)
+	keys, err := client.ListKeys(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", id, err)
 	}
 
-	props := resp.Properties
-	if props != nil {
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if zones := model.Zones; zones != nil {
+			d.Set("zones", zones)
+		}
+
+		props := model.Properties
+		d.Set("capacity", props.Sku.Capacity)
+		d.Set("family", string(props.Sku.Family))
+		d.Set("sku_name", string(props.Sku.Name))
+
 		d.Set("ssl_port", props.SslPort)
 		d.Set("hostname", props.HostName)
-		d.Set("minimum_tls_version", string(props.MinimumTLSVersion))
+		minimumTlsVersion := ""
+		if props.MinimumTlsVersion != nil {
+			minimumTlsVersion = string(*props.MinimumTlsVersion)
+		}
+		d.Set("minimum_tls_version", minimumTlsVersion)
 		d.Set("port", props.Port)
 		d.Set("enable_non_ssl_port", props.EnableNonSslPort)
 		if props.ShardCount != nil {
 			d.Set("shard_count", props.ShardCount)
 		}
 		d.Set("private_static_ip_address", props.StaticIP)
-		d.Set("subnet_id", props.SubnetID)
-	}
+		d.Set("subnet_id", props.SubnetId)
 
-	redisConfiguration, err := flattenRedisConfiguration(resp.RedisConfiguration)
-	if err != nil {
-		return fmt.Errorf("flattening `redis_configuration`: %+v", err)
-	}
-	if err := d.Set("redis_configuration", redisConfiguration); err != nil {
-		return fmt.Errorf("setting `redis_configuration`: %+v", err)
-	}
-
-	schedule, err := patchSchedulesClient.Get(ctx, id.ResourceGroup, id.RediName)
-	if err == nil {
-		patchSchedule := flattenRedisPatchSchedules(schedule)
-		if err = d.Set("patch_schedule", patchSchedule); err != nil {
-			return fmt.Errorf("setting `patch_schedule`: %+v", err)
+		redisConfiguration, err := flattenRedisConfiguration(props.RedisConfiguration)
+		if err != nil {
+			return fmt.Errorf("flattening `redis_configuration`: %+v", err)
+		}
+		if err := d.Set("redis_configuration", redisConfiguration); err != nil {
+			return fmt.Errorf("setting `redis_configuration`: %+v", err)
 		}
-	} else {
-		d.Set("patch_schedule", []interface{}{})
-	}
 
-	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.RediName)
-	if err != nil {
-		return err
-	}
+		if keys := keys.Model; keys != nil {
+			d.Set("primary_access_key", keys.PrimaryKey)
+			d.Set("secondary_access_key", keys.SecondaryKey)
 
-	d.Set("primary_access_key", keys.PrimaryKey)
-	d.Set("secondary_access_key", keys.SecondaryKey)
+			enableSslPort := !*props.EnableNonSslPort
+			d.Set("primary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keys.PrimaryKey, enableSslPort))
+			d.Set("secondary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keys.SecondaryKey, enableSslPort))
+		}
 
-	if props != nil {
-		enableSslPort := !*props.EnableNonSslPort
-		d.Set("primary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keys.PrimaryKey, enableSslPort))
-		d.Set("secondary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keys.SecondaryKey, enableSslPort))
+		return tags.FlattenAndSet(d, flattenTags(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
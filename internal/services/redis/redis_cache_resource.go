@@ -8,18 +8,19 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-12-01/redis"
+	legacy "github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-12-01/redis"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/sdk/2022-06-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,6 +30,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+type redisCacheIdentity = identity.SystemAssignedUserAssigned
+
+const (
+	redisDataPersistenceAuthenticationMethodManagedIdentity = "ManagedIdentity"
+	redisDataPersistenceAuthenticationMethodSAS             = "SAS"
+)
+
 func resourceRedisCache() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisCacheCreate,
@@ -36,7 +44,7 @@ func resourceRedisCache() *pluginsdk.Resource {
 		Update: resourceRedisCacheUpdate,
 		Delete: resourceRedisCacheDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.CacheID(id)
+			_, err := redis.ParseRedisID(id)
 			return err
 		}),
 
@@ -91,14 +99,16 @@ func resourceRedisCache() *pluginsdk.Resource {
 			"minimum_tls_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  redis.TLSVersionOneFullStopZero,
+				Default:  string(redis.TlsVersionOnePointZero),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(redis.TLSVersionOneFullStopZero),
-					string(redis.TLSVersionOneFullStopOne),
-					string(redis.TLSVersionOneFullStopTwo),
+					string(redis.TlsVersionOnePointZero),
+					string(redis.TlsVersionOnePointOne),
+					string(redis.TlsVersionOnePointTwo),
 				}, false),
 			},
 
+			"identity": redisCacheIdentity{}.Schema(),
+
 			"shard_count": {
 				Type:     pluginsdk.TypeInt,
 				Optional: true,
@@ -209,6 +219,21 @@ func resourceRedisCache() *pluginsdk.Resource {
 							Optional: true,
 							Default:  true,
 						},
+
+						"data_persistence_authentication_method": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								redisDataPersistenceAuthenticationMethodManagedIdentity,
+								redisDataPersistenceAuthenticationMethodSAS,
+							}, false),
+						},
+
+						"storage_account_subscription_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
 					},
 				},
 			},
@@ -327,9 +352,33 @@ func resourceRedisCache() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceRedisCacheCustomizeDiff),
 	}
 }
 
+func resourceRedisCacheCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	configs := diff.Get("redis_configuration").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+	config := configs[0].(map[string]interface{})
+
+	rdbBackupEnabled := config["rdb_backup_enabled"].(bool)
+	aofBackupEnabled := config["aof_backup_enabled"].(bool)
+	if (rdbBackupEnabled || aofBackupEnabled) && !strings.EqualFold(diff.Get("sku_name").(string), string(redis.SkuNamePremium)) {
+		return fmt.Errorf("data persistence (`rdb_backup_enabled` or `aof_backup_enabled`) is only supported when `sku_name` is `Premium`")
+	}
+
+	if config["data_persistence_authentication_method"].(string) == redisDataPersistenceAuthenticationMethodManagedIdentity {
+		if identities := diff.Get("identity").([]interface{}); len(identities) == 0 || identities[0] == nil {
+			return fmt.Errorf("an `identity` block must be specified when `data_persistence_authentication_method` is `%s`", redisDataPersistenceAuthenticationMethodManagedIdentity)
+		}
+	}
+
+	return nil
+}
+
 func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Redis.Client
 	patchClient := meta.(*clients.Client).Redis.PatchSchedulesClient
@@ -341,21 +390,18 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	enableNonSSLPort := d.Get("enable_non_ssl_port").(bool)
 
-	capacity := int32(d.Get("capacity").(int))
+	capacity := int64(d.Get("capacity").(int))
 	family := redis.SkuFamily(d.Get("family").(string))
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
-	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
-
-	id := parse.NewCacheID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	existing, err := client.Get(ctx, id.ResourceGroup, id.RediName)
+	id := redis.NewRedisID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for presence of existing Redis Instance %s (resource group %q): %+v", id.RediName, id.ResourceGroup, err)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		return tf.ImportAsExistsError("azurerm_redis_cache", id.ID())
 	}
 
@@ -365,50 +411,56 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("parsing Redis Configuration: %+v", err)
 	}
 
+	identity, err := expandRedisCacheIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
 	publicNetworkAccess := redis.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
 		publicNetworkAccess = redis.PublicNetworkAccessDisabled
 	}
 
-	parameters := redis.CreateParameters{
-		Location: utils.String(location),
-		CreateProperties: &redis.CreateProperties{
+	minimumTlsVersion := redis.TlsVersion(d.Get("minimum_tls_version").(string))
+	parameters := redis.RedisCreateParameters{
+		Identity: identity,
+		Location: location,
+		Properties: redis.RedisCreateProperties{
 			EnableNonSslPort: utils.Bool(enableNonSSLPort),
-			Sku: &redis.Sku{
-				Capacity: utils.Int32(capacity),
+			Sku: redis.Sku{
+				Capacity: capacity,
 				Family:   family,
 				Name:     sku,
 			},
-			MinimumTLSVersion:   redis.TLSVersion(d.Get("minimum_tls_version").(string)),
+			MinimumTlsVersion:   &minimumTlsVersion,
 			RedisConfiguration:  redisConfiguration,
-			PublicNetworkAccess: publicNetworkAccess,
+			PublicNetworkAccess: &publicNetworkAccess,
 		},
-		Tags: expandedTags,
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("shard_count"); ok {
-		shardCount := int32(v.(int))
-		parameters.ShardCount = &shardCount
+		parameters.Properties.ShardCount = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("replicas_per_master"); ok {
-		parameters.ReplicasPerMaster = utils.Int32(int32(v.(int)))
+		parameters.Properties.ReplicasPerMaster = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("replicas_per_primary"); ok {
-		parameters.ReplicasPerPrimary = utils.Int32(int32(v.(int)))
+		parameters.Properties.ReplicasPerPrimary = utils.Int64(int64(v.(int)))
 	}
 
 	if v, ok := d.GetOk("redis_version"); ok {
-		parameters.RedisVersion = utils.String(v.(string))
+		parameters.Properties.RedisVersion = utils.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("tenant_settings"); ok {
-		parameters.TenantSettings = expandTenantSettings(v.(map[string]interface{}))
+		parameters.Properties.TenantSettings = expandTenantSettings(v.(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("private_static_ip_address"); ok {
-		parameters.StaticIP = utils.String(v.(string))
+		parameters.Properties.StaticIP = utils.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("subnet_id"); ok {
@@ -423,39 +475,34 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		locks.ByName(parsed.Name, network.SubnetResourceName)
 		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
 
-		parameters.SubnetID = utils.String(v.(string))
+		parameters.Properties.SubnetId = utils.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("zones"); ok {
 		parameters.Zones = azure.ExpandZones(v.([]interface{}))
 	}
 
-	future, err := client.Create(ctx, id.ResourceGroup, id.RediName, parameters)
-	if err != nil {
-		return fmt.Errorf("creating Redis Cache %q (Resource Group %q): %v", id.RediName, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the create of Redis Cache %q (Resource Group %q): %v", id.RediName, id.ResourceGroup, err)
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	log.Printf("[DEBUG] Waiting for Redis Cache %q (Resource Group %q) to become available", id.RediName, id.ResourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to become available", id)
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{"Scaling", "Updating", "Creating"},
 		Target:     []string{"Succeeded"},
-		Refresh:    redisStateRefreshFunc(ctx, client, id.ResourceGroup, id.RediName),
+		Refresh:    redisStateRefreshFunc(ctx, client, id),
 		MinTimeout: 15 * time.Second,
 		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Redis Cache %q (Resource Group %q) to become available: %s", id.RediName, id.ResourceGroup, err)
+		return fmt.Errorf("waiting for %s to become available: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
 
 	if patchSchedule != nil {
-		if _, err = patchClient.CreateOrUpdate(ctx, id.ResourceGroup, id.RediName, *patchSchedule); err != nil {
+		if _, err = patchClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, *patchSchedule); err != nil {
 			return fmt.Errorf("setting Redis Patch Schedule: %+v", err)
 		}
 	}
@@ -470,61 +517,58 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache update.")
 
-	id, err := parse.CacheID(d.Id())
+	id, err := redis.ParseRedisID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	enableNonSSLPort := d.Get("enable_non_ssl_port").(bool)
 
-	capacity := int32(d.Get("capacity").(int))
+	capacity := int64(d.Get("capacity").(int))
 	family := redis.SkuFamily(d.Get("family").(string))
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
-	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
-
-	parameters := redis.UpdateParameters{
-		UpdateProperties: &redis.UpdateProperties{
-			MinimumTLSVersion: redis.TLSVersion(d.Get("minimum_tls_version").(string)),
+	minimumTlsVersion := redis.TlsVersion(d.Get("minimum_tls_version").(string))
+	parameters := redis.RedisUpdateParameters{
+		Properties: &redis.RedisUpdateProperties{
+			MinimumTlsVersion: &minimumTlsVersion,
 			EnableNonSslPort:  utils.Bool(enableNonSSLPort),
 			Sku: &redis.Sku{
-				Capacity: utils.Int32(capacity),
+				Capacity: capacity,
 				Family:   family,
 				Name:     sku,
 			},
 		},
-		Tags: expandedTags,
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("shard_count"); ok {
 		if d.HasChange("shard_count") {
-			shardCount := int32(v.(int))
-			parameters.ShardCount = &shardCount
+			parameters.Properties.ShardCount = utils.Int64(int64(v.(int)))
 		}
 	}
 
 	if v, ok := d.GetOk("replicas_per_master"); ok {
 		if d.HasChange("replicas_per_master") {
-			parameters.ReplicasPerMaster = utils.Int32(int32(v.(int)))
+			parameters.Properties.ReplicasPerMaster = utils.Int64(int64(v.(int)))
 		}
 	}
 
 	if v, ok := d.GetOk("replicas_per_primary"); ok {
 		if d.HasChange("replicas_per_primary") {
-			parameters.ReplicasPerPrimary = utils.Int32(int32(v.(int)))
+			parameters.Properties.ReplicasPerPrimary = utils.Int64(int64(v.(int)))
 		}
 	}
 
 	if v, ok := d.GetOk("redis_version"); ok {
 		if d.HasChange("redis_version") {
-			parameters.RedisVersion = utils.String(v.(string))
+			parameters.Properties.RedisVersion = utils.String(v.(string))
 		}
 	}
 
 	if v, ok := d.GetOk("tenant_settings"); ok {
 		if d.HasChange("tenant_settings") {
-			parameters.TenantSettings = expandTenantSettings(v.(map[string]interface{}))
+			parameters.Properties.TenantSettings = expandTenantSettings(v.(map[string]interface{}))
 		}
 	}
 
@@ -533,7 +577,15 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		if !d.Get("public_network_access_enabled").(bool) {
 			publicNetworkAccess = redis.PublicNetworkAccessDisabled
 		}
-		parameters.PublicNetworkAccess = publicNetworkAccess
+		parameters.Properties.PublicNetworkAccess = &publicNetworkAccess
+	}
+
+	if d.HasChange("identity") {
+		identity, err := expandRedisCacheIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = identity
 	}
 
 	if d.HasChange("redis_configuration") {
@@ -541,35 +593,35 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		if err != nil {
 			return fmt.Errorf("parsing Redis Configuration: %+v", err)
 		}
-		parameters.RedisConfiguration = redisConfiguration
+		parameters.Properties.RedisConfiguration = redisConfiguration
 	}
 
-	if _, err := client.Update(ctx, id.ResourceGroup, id.RediName, parameters); err != nil {
-		return fmt.Errorf("updating Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
-	log.Printf("[DEBUG] Waiting for Redis Cache %q (Resource Group %q) to become available", id.RediName, id.ResourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to become available", *id)
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{"Scaling", "Updating", "Creating"},
 		Target:     []string{"Succeeded"},
-		Refresh:    redisStateRefreshFunc(ctx, client, id.ResourceGroup, id.RediName),
+		Refresh:    redisStateRefreshFunc(ctx, client, *id),
 		MinTimeout: 15 * time.Second,
 		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Redis Cache %q (Resource Group %q) to become available: %+v", id.RediName, id.ResourceGroup, err)
+		return fmt.Errorf("waiting for %s to become available: %+v", *id, err)
 	}
 
 	patchSchedule := expandRedisPatchSchedule(d)
 
 	if patchSchedule == nil || len(*patchSchedule.ScheduleEntries.ScheduleEntries) == 0 {
-		_, err = patchClient.Delete(ctx, id.ResourceGroup, id.RediName)
+		_, err = patchClient.Delete(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
 			return fmt.Errorf("deleting Redis Patch Schedule: %+v", err)
 		}
 	} else {
-		_, err = patchClient.CreateOrUpdate(ctx, id.ResourceGroup, id.RediName, *patchSchedule)
+		_, err = patchClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, *patchSchedule)
 		if err != nil {
 			return fmt.Errorf("setting Redis Patch Schedule: %+v", err)
 		}
@@ -584,26 +636,27 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.CacheID(d.Id())
+	id, err := redis.ParseRedisID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.RediName)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.RediName)
+	keysResp, err := client.ListKeys(ctx, *id)
 	if err != nil {
-		return fmt.Errorf("listing keys for Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+		return fmt.Errorf("listing keys for %s: %+v", *id, err)
 	}
 
-	schedule, err := patchSchedulesClient.Get(ctx, id.ResourceGroup, id.RediName)
+	schedule, err := patchSchedulesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err == nil {
 		patchSchedule := flattenRedisPatchSchedules(schedule)
 		if err = d.Set("patch_schedule", patchSchedule); err != nil {
@@ -611,24 +664,32 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.Set("name", id.RediName)
+	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if zones := resp.Zones; zones != nil {
-		d.Set("zones", zones)
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("capacity", sku.Capacity)
-		d.Set("family", sku.Family)
-		d.Set("sku_name", sku.Name)
-	}
+		if zones := model.Zones; zones != nil {
+			d.Set("zones", zones)
+		}
+
+		if err := d.Set("identity", flattenRedisCacheIdentity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		props := model.Properties
+		d.Set("capacity", props.Sku.Capacity)
+		d.Set("family", string(props.Sku.Family))
+		d.Set("sku_name", string(props.Sku.Name))
 
-	if props := resp.Properties; props != nil {
 		d.Set("ssl_port", props.SslPort)
 		d.Set("hostname", props.HostName)
-		d.Set("minimum_tls_version", string(props.MinimumTLSVersion))
+		minimumTlsVersion := string(redis.TlsVersionOnePointZero)
+		if props.MinimumTlsVersion != nil {
+			minimumTlsVersion = string(*props.MinimumTlsVersion)
+		}
+		d.Set("minimum_tls_version", minimumTlsVersion)
 		d.Set("port", props.Port)
 		d.Set("enable_non_ssl_port", props.EnableNonSslPort)
 		if props.ShardCount != nil {
@@ -637,8 +698,8 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("private_static_ip_address", props.StaticIP)
 
 		subnetId := ""
-		if props.SubnetID != nil {
-			parsed, err := networkParse.SubnetID(*props.SubnetID)
+		if props.SubnetId != nil {
+			parsed, err := networkParse.SubnetID(*props.SubnetId)
 			if err != nil {
 				return err
 			}
@@ -647,31 +708,33 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 		d.Set("subnet_id", subnetId)
 
-		d.Set("public_network_access_enabled", props.PublicNetworkAccess == redis.PublicNetworkAccessEnabled)
+		d.Set("public_network_access_enabled", props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == redis.PublicNetworkAccessEnabled)
 		d.Set("replicas_per_master", props.ReplicasPerMaster)
 		d.Set("replicas_per_primary", props.ReplicasPerPrimary)
 		d.Set("redis_version", props.RedisVersion)
 		d.Set("tenant_settings", flattenTenantSettings(props.TenantSettings))
-	}
 
-	redisConfiguration, err := flattenRedisConfiguration(resp.RedisConfiguration)
-	if err != nil {
-		return fmt.Errorf("flattening `redis_configuration`: %+v", err)
-	}
-	if err := d.Set("redis_configuration", redisConfiguration); err != nil {
-		return fmt.Errorf("setting `redis_configuration`: %+v", err)
-	}
+		redisConfiguration, err := flattenRedisConfiguration(props.RedisConfiguration)
+		if err != nil {
+			return fmt.Errorf("flattening `redis_configuration`: %+v", err)
+		}
+		if err := d.Set("redis_configuration", redisConfiguration); err != nil {
+			return fmt.Errorf("setting `redis_configuration`: %+v", err)
+		}
 
-	d.Set("primary_access_key", keysResp.PrimaryKey)
-	d.Set("secondary_access_key", keysResp.SecondaryKey)
+		if keys := keysResp.Model; keys != nil {
+			d.Set("primary_access_key", keys.PrimaryKey)
+			d.Set("secondary_access_key", keys.SecondaryKey)
 
-	if props := resp.Properties; props != nil {
-		enableSslPort := !*props.EnableNonSslPort
-		d.Set("primary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keysResp.PrimaryKey, enableSslPort))
-		d.Set("secondary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keysResp.SecondaryKey, enableSslPort))
+			enableSslPort := !*props.EnableNonSslPort
+			d.Set("primary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keys.PrimaryKey, enableSslPort))
+			d.Set("secondary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keys.SecondaryKey, enableSslPort))
+		}
+
+		return tags.FlattenAndSet(d, flattenTags(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -679,20 +742,19 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.CacheID(d.Id())
+	id, err := redis.ParseRedisID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	read, err := client.Get(ctx, id.ResourceGroup, id.RediName)
+	read, err := client.Get(ctx, *id)
 	if err != nil {
-		return fmt.Errorf("retrieving Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
-	if read.Properties == nil {
-		return fmt.Errorf("retrieving Redis Cache %q (Resource Group %q): `properties` was nil", id.RediName, id.ResourceGroup)
+	if read.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
 	}
-	props := *read.Properties
-	if subnetID := props.SubnetID; subnetID != nil {
+	if subnetID := read.Model.Properties.SubnetId; subnetID != nil {
 		parsed, parseErr := networkParse.SubnetID(*subnetID)
 		if parseErr != nil {
 			return err
@@ -705,33 +767,31 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.RediName)
-	if err != nil {
-		return fmt.Errorf("deleting Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("waiting for deletion of Redis Cache %q (Resource Group %q): %+v", id.RediName, id.ResourceGroup, err)
-		}
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func redisStateRefreshFunc(ctx context.Context, client *redis.Client, resourceGroupName string, sgName string) pluginsdk.StateRefreshFunc {
+func redisStateRefreshFunc(ctx context.Context, client *redis.RedisClient, id redis.RedisId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, resourceGroupName, sgName)
+		res, err := client.Get(ctx, id)
 		if err != nil {
-			return nil, "", fmt.Errorf("polling for status of Redis Cache %q (Resource Group %q): %+v", sgName, resourceGroupName, err)
+			return nil, "", fmt.Errorf("polling for status of %s: %+v", id, err)
+		}
+
+		state := ""
+		if model := res.Model; model != nil && model.Properties.ProvisioningState != nil {
+			state = string(*model.Properties.ProvisioningState)
 		}
 
-		return res, string(res.ProvisioningState), nil
+		return res, state, nil
 	}
 }
 
-func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, error) {
-	output := make(map[string]*string)
+func expandRedisConfiguration(d *pluginsdk.ResourceData) (*redis.RedisCommonPropertiesRedisConfiguration, error) {
+	output := &redis.RedisCommonPropertiesRedisConfiguration{}
 
 	input := d.Get("redis_configuration").([]interface{})
 	if len(input) == 0 || input[0] == nil {
@@ -740,25 +800,25 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, er
 	raw := input[0].(map[string]interface{})
 
 	if v := raw["maxclients"].(int); v > 0 {
-		output["maxclients"] = utils.String(strconv.Itoa(v))
+		output.Maxclients = utils.String(strconv.Itoa(v))
 	}
 
 	if d.Get("sku_name").(string) != string(redis.SkuNameBasic) {
 		if v := raw["maxmemory_delta"].(int); v > 0 {
-			output["maxmemory-delta"] = utils.String(strconv.Itoa(v))
+			output.MaxmemoryDelta = utils.String(strconv.Itoa(v))
 		}
 
 		if v := raw["maxmemory_reserved"].(int); v > 0 {
-			output["maxmemory-reserved"] = utils.String(strconv.Itoa(v))
+			output.MaxmemoryReserved = utils.String(strconv.Itoa(v))
 		}
 
 		if v := raw["maxfragmentationmemory_reserved"].(int); v > 0 {
-			output["maxfragmentationmemory-reserved"] = utils.String(strconv.Itoa(v))
+			output.MaxfragmentationmemoryReserved = utils.String(strconv.Itoa(v))
 		}
 	}
 
 	if v := raw["maxmemory_policy"].(string); v != "" {
-		output["maxmemory-policy"] = utils.String(v)
+		output.MaxmemoryPolicy = utils.String(v)
 	}
 
 	// RDB Backup
@@ -766,36 +826,45 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, er
 		if connStr := raw["rdb_storage_connection_string"].(string); connStr == "" {
 			return nil, fmt.Errorf("The rdb_storage_connection_string property must be set when rdb_backup_enabled is true")
 		}
-		output["rdb-backup-enabled"] = utils.String(strconv.FormatBool(v))
+		output.RdbBackupEnabled = utils.String(strconv.FormatBool(v))
 	}
 
 	if v := raw["rdb_backup_frequency"].(int); v > 0 {
-		output["rdb-backup-frequency"] = utils.String(strconv.Itoa(v))
+		output.RdbBackupFrequency = utils.String(strconv.Itoa(v))
 	}
 
 	if v := raw["rdb_backup_max_snapshot_count"].(int); v > 0 {
-		output["rdb-backup-max-snapshot-count"] = utils.String(strconv.Itoa(v))
+		output.RdbBackupMaxSnapshotCount = utils.String(strconv.Itoa(v))
 	}
 
 	if v := raw["rdb_storage_connection_string"].(string); v != "" {
-		output["rdb-storage-connection-string"] = utils.String(v)
+		output.RdbStorageConnectionString = utils.String(v)
 	}
 
 	if v := raw["notify_keyspace_events"].(string); v != "" {
-		output["notify-keyspace-events"] = utils.String(v)
+		output.NotifyKeyspaceEvents = utils.String(v)
 	}
 
 	// AOF Backup
 	if v := raw["aof_backup_enabled"].(bool); v {
-		output["aof-backup-enabled"] = utils.String(strconv.FormatBool(v))
+		output.AofBackupEnabled = utils.String(strconv.FormatBool(v))
 	}
 
 	if v := raw["aof_storage_connection_string_0"].(string); v != "" {
-		output["aof-storage-connection-string-0"] = utils.String(v)
+		output.AofStorageConnectionString0 = utils.String(v)
 	}
 
 	if v := raw["aof_storage_connection_string_1"].(string); v != "" {
-		output["aof-storage-connection-string-1"] = utils.String(v)
+		output.AofStorageConnectionString1 = utils.String(v)
+	}
+
+	// Managed Identity authentication for the storage account used for data persistence
+	if v := raw["data_persistence_authentication_method"].(string); v != "" {
+		output.PreferredDataPersistenceAuthMethod = utils.String(v)
+	}
+
+	if v := raw["storage_account_subscription_id"].(string); v != "" {
+		output.StorageSubscriptionId = utils.String(v)
 	}
 
 	authEnabled := raw["enable_authentication"].(bool)
@@ -806,90 +875,114 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (map[string]*string, er
 		}
 	} else {
 		value := isAuthNotRequiredAsString(authEnabled)
-		output["authnotrequired"] = utils.String(value)
+		output.Authnotrequired = utils.String(value)
 	}
 	return output, nil
 }
 
-func expandRedisPatchSchedule(d *pluginsdk.ResourceData) *redis.PatchSchedule {
+func expandRedisCacheIdentity(input []interface{}) (*identity.SystemUserAssignedIdentityMap, error) {
+	expanded, err := redisCacheIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := identity.SystemUserAssignedIdentityMap{}
+	result.FromExpandedConfig(*expanded)
+	return &result, nil
+}
+
+func flattenRedisCacheIdentity(input *identity.SystemUserAssignedIdentityMap) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	config := input.ToExpandedConfig()
+	return redisCacheIdentity{}.Flatten(&config)
+}
+
+func expandRedisPatchSchedule(d *pluginsdk.ResourceData) *legacy.PatchSchedule {
 	v, ok := d.GetOk("patch_schedule")
 	if !ok {
 		return nil
 	}
 
 	scheduleValues := v.([]interface{})
-	entries := make([]redis.ScheduleEntry, 0)
+	entries := make([]legacy.ScheduleEntry, 0)
 	for _, scheduleValue := range scheduleValues {
 		vals := scheduleValue.(map[string]interface{})
 		dayOfWeek := vals["day_of_week"].(string)
 		maintenanceWindow := vals["maintenance_window"].(string)
 		startHourUtc := vals["start_hour_utc"].(int)
 
-		entry := redis.ScheduleEntry{
-			DayOfWeek:         redis.DayOfWeek(dayOfWeek),
+		entry := legacy.ScheduleEntry{
+			DayOfWeek:         legacy.DayOfWeek(dayOfWeek),
 			MaintenanceWindow: utils.String(maintenanceWindow),
 			StartHourUtc:      utils.Int32(int32(startHourUtc)),
 		}
 		entries = append(entries, entry)
 	}
 
-	schedule := redis.PatchSchedule{
-		ScheduleEntries: &redis.ScheduleEntries{
+	schedule := legacy.PatchSchedule{
+		ScheduleEntries: &legacy.ScheduleEntries{
 			ScheduleEntries: &entries,
 		},
 	}
 	return &schedule
 }
 
-func expandTenantSettings(input map[string]interface{}) map[string]*string {
-	output := make(map[string]*string, len(input))
+func expandTenantSettings(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string, len(input))
 
 	for i, v := range input {
-		output[i] = utils.String(v.(string))
+		output[i] = v.(string)
 	}
-	return output
+	return &output
 }
 
-func flattenTenantSettings(input map[string]*string) map[string]*string {
-	output := make(map[string]*string, len(input))
+func flattenTenantSettings(input *map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
 
-	for i, v := range input {
-		if v == nil {
-			continue
-		}
+	for i, v := range *input {
 		output[i] = v
 	}
 	return output
 }
-func flattenRedisConfiguration(input map[string]*string) ([]interface{}, error) {
-	outputs := make(map[string]interface{}, len(input))
 
-	if v := input["maxclients"]; v != nil {
+func flattenRedisConfiguration(input *redis.RedisCommonPropertiesRedisConfiguration) ([]interface{}, error) {
+	outputs := make(map[string]interface{})
+	if input == nil {
+		input = &redis.RedisCommonPropertiesRedisConfiguration{}
+	}
+
+	if v := input.Maxclients; v != nil {
 		i, err := strconv.Atoi(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `maxclients` %q: %+v", *v, err)
 		}
 		outputs["maxclients"] = i
 	}
-	if v := input["maxmemory-delta"]; v != nil {
+	if v := input.MaxmemoryDelta; v != nil {
 		i, err := strconv.Atoi(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `maxmemory-delta` %q: %+v", *v, err)
 		}
 		outputs["maxmemory_delta"] = i
 	}
-	if v := input["maxmemory-reserved"]; v != nil {
+	if v := input.MaxmemoryReserved; v != nil {
 		i, err := strconv.Atoi(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `maxmemory-reserved` %q: %+v", *v, err)
 		}
 		outputs["maxmemory_reserved"] = i
 	}
-	if v := input["maxmemory-policy"]; v != nil {
+	if v := input.MaxmemoryPolicy; v != nil {
 		outputs["maxmemory_policy"] = *v
 	}
 
-	if v := input["maxfragmentationmemory-reserved"]; v != nil {
+	if v := input.MaxfragmentationmemoryReserved; v != nil {
 		i, err := strconv.Atoi(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `maxfragmentationmemory-reserved` %q: %+v", *v, err)
@@ -898,51 +991,58 @@ func flattenRedisConfiguration(input map[string]*string) ([]interface{}, error)
 	}
 
 	// delta, reserved, enabled, frequency,, count,
-	if v := input["rdb-backup-enabled"]; v != nil {
+	if v := input.RdbBackupEnabled; v != nil {
 		b, err := strconv.ParseBool(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `rdb-backup-enabled` %q: %+v", *v, err)
 		}
 		outputs["rdb_backup_enabled"] = b
 	}
-	if v := input["rdb-backup-frequency"]; v != nil {
+	if v := input.RdbBackupFrequency; v != nil {
 		i, err := strconv.Atoi(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `rdb-backup-frequency` %q: %+v", *v, err)
 		}
 		outputs["rdb_backup_frequency"] = i
 	}
-	if v := input["rdb-backup-max-snapshot-count"]; v != nil {
+	if v := input.RdbBackupMaxSnapshotCount; v != nil {
 		i, err := strconv.Atoi(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `rdb-backup-max-snapshot-count` %q: %+v", *v, err)
 		}
 		outputs["rdb_backup_max_snapshot_count"] = i
 	}
-	if v := input["rdb-storage-connection-string"]; v != nil {
+	if v := input.RdbStorageConnectionString; v != nil {
 		outputs["rdb_storage_connection_string"] = *v
 	}
-	if v := input["notify-keyspace-events"]; v != nil {
+	if v := input.NotifyKeyspaceEvents; v != nil {
 		outputs["notify_keyspace_events"] = *v
 	}
 
-	if v := input["aof-backup-enabled"]; v != nil {
+	if v := input.AofBackupEnabled; v != nil {
 		b, err := strconv.ParseBool(*v)
 		if err != nil {
 			return nil, fmt.Errorf("parsing `aof-backup-enabled` %q: %+v", *v, err)
 		}
 		outputs["aof_backup_enabled"] = b
 	}
-	if v := input["aof-storage-connection-string-0"]; v != nil {
+	if v := input.AofStorageConnectionString0; v != nil {
 		outputs["aof_storage_connection_string_0"] = *v
 	}
-	if v := input["aof-storage-connection-string-1"]; v != nil {
+	if v := input.AofStorageConnectionString1; v != nil {
 		outputs["aof_storage_connection_string_1"] = *v
 	}
 
+	if v := input.PreferredDataPersistenceAuthMethod; v != nil {
+		outputs["data_persistence_authentication_method"] = *v
+	}
+	if v := input.StorageSubscriptionId; v != nil {
+		outputs["storage_account_subscription_id"] = *v
+	}
+
 	// `authnotrequired` is not set for instances launched outside a VNET
 	outputs["enable_authentication"] = true
-	if v := input["authnotrequired"]; v != nil {
+	if v := input.Authnotrequired; v != nil {
 		outputs["enable_authentication"] = isAuthRequiredAsBool(*v)
 	}
 
@@ -966,7 +1066,7 @@ func isAuthNotRequiredAsString(auth_required bool) string {
 	return output[auth_required]
 }
 
-func flattenRedisPatchSchedules(schedule redis.PatchSchedule) []interface{} {
+func flattenRedisPatchSchedules(schedule legacy.PatchSchedule) []interface{} {
 	outputs := make([]interface{}, 0)
 
 	for _, entry := range *schedule.ScheduleEntries.ScheduleEntries {
@@ -982,6 +1082,6 @@ func flattenRedisPatchSchedules(schedule redis.PatchSchedule) []interface{} {
 	return outputs
 }
 
-func getRedisConnectionString(redisHostName string, sslPort int32, accessKey string, enableSslPort bool) string {
+func getRedisConnectionString(redisHostName string, sslPort int64, accessKey string, enableSslPort bool) string {
 	return fmt.Sprintf("%s:%d,password=%s,ssl=%t,abortConnect=False", redisHostName, sslPort, accessKey, enableSslPort)
 }
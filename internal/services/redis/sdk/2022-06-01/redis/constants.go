@@ -0,0 +1,48 @@
+package redis
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCreating               ProvisioningState = "Creating"
+	ProvisioningStateDeleting               ProvisioningState = "Deleting"
+	ProvisioningStateDisabled               ProvisioningState = "Disabled"
+	ProvisioningStateFailed                 ProvisioningState = "Failed"
+	ProvisioningStateLinking                ProvisioningState = "Linking"
+	ProvisioningStateProvisioning           ProvisioningState = "Provisioning"
+	ProvisioningStateRecoveringScaleFailure ProvisioningState = "RecoveringScaleFailure"
+	ProvisioningStateScaling                ProvisioningState = "Scaling"
+	ProvisioningStateSucceeded              ProvisioningState = "Succeeded"
+	ProvisioningStateUnlinking              ProvisioningState = "Unlinking"
+	ProvisioningStateUnprovisioning         ProvisioningState = "Unprovisioning"
+	ProvisioningStateUpdating               ProvisioningState = "Updating"
+)
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+type SkuFamily string
+
+const (
+	SkuFamilyC SkuFamily = "C"
+	SkuFamilyP SkuFamily = "P"
+)
+
+type SkuName string
+
+const (
+	SkuNameBasic    SkuName = "Basic"
+	SkuNamePremium  SkuName = "Premium"
+	SkuNameStandard SkuName = "Standard"
+)
+
+type TlsVersion string
+
+const (
+	TlsVersionOnePointZero TlsVersion = "1.0"
+	TlsVersionOnePointOne  TlsVersion = "1.1"
+	TlsVersionOnePointTwo  TlsVersion = "1.2"
+)
@@ -0,0 +1,6 @@
+package redis
+
+type RedisAccessKeys struct {
+	PrimaryKey   *string `json:"primaryKey,omitempty"`
+	SecondaryKey *string `json:"secondaryKey,omitempty"`
+}
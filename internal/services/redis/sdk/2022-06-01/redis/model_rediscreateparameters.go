@@ -0,0 +1,13 @@
+package redis
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type RedisCreateParameters struct {
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Location   string                                  `json:"location"`
+	Properties RedisCreateProperties                   `json:"properties"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+	Zones      *[]string                               `json:"zones,omitempty"`
+}
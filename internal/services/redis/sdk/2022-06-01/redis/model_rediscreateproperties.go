@@ -0,0 +1,16 @@
+package redis
+
+type RedisCreateProperties struct {
+	EnableNonSslPort    *bool                                    `json:"enableNonSslPort,omitempty"`
+	MinimumTlsVersion   *TlsVersion                              `json:"minimumTlsVersion,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess                     `json:"publicNetworkAccess,omitempty"`
+	RedisConfiguration  *RedisCommonPropertiesRedisConfiguration `json:"redisConfiguration,omitempty"`
+	RedisVersion        *string                                  `json:"redisVersion,omitempty"`
+	ReplicasPerMaster   *int64                                   `json:"replicasPerMaster,omitempty"`
+	ReplicasPerPrimary  *int64                                   `json:"replicasPerPrimary,omitempty"`
+	ShardCount          *int64                                   `json:"shardCount,omitempty"`
+	Sku                 Sku                                      `json:"sku"`
+	StaticIP            *string                                  `json:"staticIP,omitempty"`
+	SubnetId            *string                                  `json:"subnetId,omitempty"`
+	TenantSettings      *map[string]string                       `json:"tenantSettings,omitempty"`
+}
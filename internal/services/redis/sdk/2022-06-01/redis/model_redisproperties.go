@@ -0,0 +1,21 @@
+package redis
+
+type RedisProperties struct {
+	AccessKeys          *RedisAccessKeys                         `json:"accessKeys,omitempty"`
+	EnableNonSslPort    *bool                                    `json:"enableNonSslPort,omitempty"`
+	HostName            *string                                  `json:"hostName,omitempty"`
+	MinimumTlsVersion   *TlsVersion                              `json:"minimumTlsVersion,omitempty"`
+	Port                *int64                                   `json:"port,omitempty"`
+	ProvisioningState   *ProvisioningState                       `json:"provisioningState,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess                     `json:"publicNetworkAccess,omitempty"`
+	RedisConfiguration  *RedisCommonPropertiesRedisConfiguration `json:"redisConfiguration,omitempty"`
+	RedisVersion        *string                                  `json:"redisVersion,omitempty"`
+	ReplicasPerMaster   *int64                                   `json:"replicasPerMaster,omitempty"`
+	ReplicasPerPrimary  *int64                                   `json:"replicasPerPrimary,omitempty"`
+	ShardCount          *int64                                   `json:"shardCount,omitempty"`
+	Sku                 Sku                                      `json:"sku"`
+	SslPort             *int64                                   `json:"sslPort,omitempty"`
+	StaticIP            *string                                  `json:"staticIP,omitempty"`
+	SubnetId            *string                                  `json:"subnetId,omitempty"`
+	TenantSettings      *map[string]string                       `json:"tenantSettings,omitempty"`
+}
@@ -0,0 +1,11 @@
+package redis
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+)
+
+type RedisUpdateParameters struct {
+	Identity   *identity.SystemUserAssignedIdentityMap `json:"identity,omitempty"`
+	Properties *RedisUpdateProperties                  `json:"properties,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
@@ -0,0 +1,7 @@
+package redis
+
+type Sku struct {
+	Capacity int64     `json:"capacity"`
+	Family   SkuFamily `json:"family"`
+	Name     SkuName   `json:"name"`
+}
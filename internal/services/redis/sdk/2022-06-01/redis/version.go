@@ -0,0 +1,9 @@
+package redis
+
+import "fmt"
+
+const defaultApiVersion = "2022-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/redis/%s", defaultApiVersion)
+}
@@ -5,29 +5,41 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2019-06-01-preview/templatespecs"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2021-07-01/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-05-01/privatelinkassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-05-01/resourcemanagementprivatelinks"
 )
 
 type Client struct {
-	DeploymentsClient           *resources.DeploymentsClient
-	GroupsClient                *resources.GroupsClient
-	LocksClient                 *locks.ManagementLocksClient
-	ProvidersClient             *providers.ProvidersClient
-	ResourceProvidersClient     *resources.ProvidersClient
-	ResourcesClient             *resources.Client
-	TemplateSpecsVersionsClient *templatespecs.VersionsClient
+	DeploymentsClient                   *resources.DeploymentsClient
+	FeaturesClient                      *features.Client
+	GroupsClient                        *resources.GroupsClient
+	LocksClient                         *locks.ManagementLocksClient
+	PrivateLinkAssociationClient        *privatelinkassociations.PrivateLinkAssociationsClient
+	ProvidersClient                     *providers.ProvidersClient
+	ResourceManagementPrivateLinkClient *resourcemanagementprivatelinks.ResourceManagementPrivateLinksClient
+	ResourceProvidersClient             *resources.ProvidersClient
+	ResourcesClient                     *resources.Client
+	TemplateSpecsVersionsClient         *templatespecs.VersionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
+	featuresClient := features.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&featuresClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
 	locksClient := locks.NewManagementLocksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&locksClient.Client, o.ResourceManagerAuthorizer)
 
+	privateLinkAssociationClient := privatelinkassociations.NewPrivateLinkAssociationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&privateLinkAssociationClient.Client, o.ResourceManagerAuthorizer)
+
 	// this has to come from the Profile since this is shared with Stack
 	providersClient := providers.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&providersClient.Client, o.ResourceManagerAuthorizer)
@@ -36,6 +48,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourceProvidersClient := resources.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourceProvidersClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceManagementPrivateLinkClient := resourcemanagementprivatelinks.NewResourceManagementPrivateLinksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourceManagementPrivateLinkClient.Client, o.ResourceManagerAuthorizer)
+
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -43,12 +58,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&templatespecsVersionsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		GroupsClient:                &groupsClient,
-		DeploymentsClient:           &deploymentsClient,
-		LocksClient:                 &locksClient,
-		ProvidersClient:             &providersClient,
-		ResourceProvidersClient:     &resourceProvidersClient,
-		ResourcesClient:             &resourcesClient,
-		TemplateSpecsVersionsClient: &templatespecsVersionsClient,
+		GroupsClient:                        &groupsClient,
+		DeploymentsClient:                   &deploymentsClient,
+		FeaturesClient:                      &featuresClient,
+		LocksClient:                         &locksClient,
+		PrivateLinkAssociationClient:        &privateLinkAssociationClient,
+		ProvidersClient:                     &providersClient,
+		ResourceManagementPrivateLinkClient: &resourceManagementPrivateLinkClient,
+		ResourceProvidersClient:             &resourceProvidersClient,
+		ResourcesClient:                     &resourcesClient,
+		TemplateSpecsVersionsClient:         &templatespecsVersionsClient,
 	}
 }
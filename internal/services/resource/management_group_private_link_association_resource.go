@@ -0,0 +1,197 @@
+package resource
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	mgParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	mgValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-05-01/privatelinkassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-05-01/resourcemanagementprivatelinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceManagementGroupPrivateLinkAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceManagementGroupPrivateLinkAssociationCreate,
+		Read:   resourceManagementGroupPrivateLinkAssociationRead,
+		Delete: resourceManagementGroupPrivateLinkAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := privatelinkassociations.ParsePrivateLinkAssociationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: mgValidate.ManagementGroupID,
+			},
+
+			"resource_management_private_link_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ResourceManagementPrivateLinkID,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"tenant_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceManagementGroupPrivateLinkAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.PrivateLinkAssociationClient
+	privateLinkClient := meta.(*clients.Client).Resource.ResourceManagementPrivateLinkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := mgParse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := privatelinkassociations.NewPrivateLinkAssociationID(managementGroupId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_management_group_private_link_association", id.ID())
+	}
+
+	privateLinkId, err := resourcemanagementprivatelinks.ParseResourceManagementPrivateLinkID(d.Get("resource_management_private_link_id").(string))
+	if err != nil {
+		return err
+	}
+
+	privateLink, err := privateLinkClient.Get(ctx, *privateLinkId)
+	if err != nil {
+		if response.WasNotFound(privateLink.HttpResponse) {
+			return fmt.Errorf("the %s referenced in `resource_management_private_link_id` was not found", *privateLinkId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *privateLinkId, err)
+	}
+
+	publicNetworkAccess := privatelinkassociations.PublicNetworkAccessOptionsDisabled
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = privatelinkassociations.PublicNetworkAccessOptionsEnabled
+	}
+
+	parameters := privatelinkassociations.PrivateLinkAssociationObject{
+		Properties: &privatelinkassociations.PrivateLinkAssociationProperties{
+			PrivateLink:         utils.String(privateLinkId.ID()),
+			PublicNetworkAccess: &publicNetworkAccess,
+		},
+	}
+
+	if _, err := client.Put(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceManagementGroupPrivateLinkAssociationRead(d, meta)
+}
+
+func resourceManagementGroupPrivateLinkAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.PrivateLinkAssociationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := privatelinkassociations.ParsePrivateLinkAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("management_group_id", mgParse.NewManagementGroupId(id.ManagementGroupName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			privateLinkId := ""
+			if props.PrivateLink != nil {
+				parsed, err := resourcemanagementprivatelinks.ParseResourceManagementPrivateLinkIDInsensitively(*props.PrivateLink)
+				if err != nil {
+					return err
+				}
+				privateLinkId = parsed.ID()
+			}
+			d.Set("resource_management_private_link_id", privateLinkId)
+
+			publicNetworkAccessEnabled := true
+			if props.PublicNetworkAccess != nil {
+				publicNetworkAccessEnabled = strings.EqualFold(string(*props.PublicNetworkAccess), string(privatelinkassociations.PublicNetworkAccessOptionsEnabled))
+			}
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+			d.Set("tenant_id", utils.NormalizeNilableString(props.TenantID))
+		}
+	}
+
+	return nil
+}
+
+func resourceManagementGroupPrivateLinkAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.PrivateLinkAssociationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := privatelinkassociations.ParsePrivateLinkAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
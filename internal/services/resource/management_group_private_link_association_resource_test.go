@@ -0,0 +1,144 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-05-01/privatelinkassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagementGroupPrivateLinkAssociationResource struct {
+}
+
+func TestAccManagementGroupPrivateLinkAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_private_link_association", "test")
+	r := ManagementGroupPrivateLinkAssociationResource{}
+	id := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tenant_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupPrivateLinkAssociation_publicNetworkAccessDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_private_link_association", "test")
+	r := ManagementGroupPrivateLinkAssociationResource{}
+	id := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publicNetworkAccessDisabled(data, id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_network_access_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupPrivateLinkAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_private_link_association", "test")
+	r := ManagementGroupPrivateLinkAssociationResource{}
+	id := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, id)
+		}),
+	})
+}
+
+func (ManagementGroupPrivateLinkAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatelinkassociations.ParsePrivateLinkAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.PrivateLinkAssociationClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ManagementGroupPrivateLinkAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_resource_management_private_link" "test" {
+  name                = "acctestrmpl-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ManagementGroupPrivateLinkAssociationResource) basic(data acceptance.TestData, id string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_private_link_association" "test" {
+  name                                = "%s"
+  management_group_id                 = azurerm_management_group.test.id
+  resource_management_private_link_id = azurerm_resource_management_private_link.test.id
+}
+`, r.template(data), id)
+}
+
+func (r ManagementGroupPrivateLinkAssociationResource) publicNetworkAccessDisabled(data acceptance.TestData, id string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_private_link_association" "test" {
+  name                                = "%s"
+  management_group_id                 = azurerm_management_group.test.id
+  resource_management_private_link_id = azurerm_resource_management_private_link.test.id
+  public_network_access_enabled       = false
+}
+`, r.template(data), id)
+}
+
+func (r ManagementGroupPrivateLinkAssociationResource) requiresImport(data acceptance.TestData, id string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_private_link_association" "import" {
+  name                                = azurerm_management_group_private_link_association.test.name
+  management_group_id                 = azurerm_management_group_private_link_association.test.management_group_id
+  resource_management_private_link_id = azurerm_management_group_private_link_association.test.resource_management_private_link_id
+}
+`, r.basic(data, id))
+}
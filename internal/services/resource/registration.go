@@ -36,13 +36,15 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_management_lock":                      resourceManagementLock(),
-		"azurerm_management_group_template_deployment": managementGroupTemplateDeploymentResource(),
-		"azurerm_resource_group":                       resourceResourceGroup(),
-		"azurerm_resource_group_template_deployment":   resourceGroupTemplateDeploymentResource(),
-		"azurerm_subscription_template_deployment":     subscriptionTemplateDeploymentResource(),
-		"azurerm_template_deployment":                  resourceTemplateDeployment(),
-		"azurerm_tenant_template_deployment":           tenantTemplateDeploymentResource(),
+		"azurerm_management_lock":                           resourceManagementLock(),
+		"azurerm_management_group_private_link_association": resourceManagementGroupPrivateLinkAssociation(),
+		"azurerm_management_group_template_deployment":      managementGroupTemplateDeploymentResource(),
+		"azurerm_resource_group":                            resourceResourceGroup(),
+		"azurerm_resource_management_private_link":          resourceResourceManagementPrivateLink(),
+		"azurerm_resource_group_template_deployment":        resourceGroupTemplateDeploymentResource(),
+		"azurerm_subscription_template_deployment":          subscriptionTemplateDeploymentResource(),
+		"azurerm_template_deployment":                       resourceTemplateDeployment(),
+		"azurerm_tenant_template_deployment":                tenantTemplateDeploymentResource(),
 	}
 }
 
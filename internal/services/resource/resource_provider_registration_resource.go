@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2021-07-01/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,11 +20,28 @@ import (
 
 var _ sdk.Resource = ResourceProviderRegistrationResource{}
 var _ sdk.ResourceWithCustomImporter = ResourceProviderRegistrationResource{}
+var _ sdk.ResourceWithUpdate = ResourceProviderRegistrationResource{}
+var _ sdk.ResourceWithCustomizeDiff = ResourceProviderRegistrationResource{}
+
+const (
+	featureStateNotRegistered = "NotRegistered"
+	featureStatePending       = "Pending"
+	featureStateRegistered    = "Registered"
+	featureStateRegistering   = "Registering"
+	featureStateUnregistered  = "Unregistered"
+	featureStateUnregistering = "Unregistering"
+)
 
 type ResourceProviderRegistrationResource struct{}
 
 type ResourceProviderRegistrationModel struct {
-	Name string `tfschema:"name"`
+	Name     string                         `tfschema:"name"`
+	Features []ResourceProviderFeatureModel `tfschema:"feature"`
+}
+
+type ResourceProviderFeatureModel struct {
+	Name       string `tfschema:"name"`
+	Registered bool   `tfschema:"registered"`
 }
 
 func (r ResourceProviderRegistrationResource) Arguments() map[string]*pluginsdk.Schema {
@@ -34,6 +52,25 @@ func (r ResourceProviderRegistrationResource) Arguments() map[string]*pluginsdk.
 			ForceNew:     true,
 			ValidateFunc: resourceproviders.EnhancedValidate,
 		},
+
+		"feature": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ResourceProviderFeatureName,
+					},
+
+					"registered": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -101,6 +138,13 @@ func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 			}
 			log.Printf("[DEBUG] Registered Resource Provider %q.", resourceId.ResourceProvider)
 
+			featuresClient := metadata.Client.Resource.FeaturesClient
+			for _, feature := range obj.Features {
+				if err := r.setFeatureRegistration(ctx, featuresClient, resourceId.ResourceProvider, feature); err != nil {
+					return err
+				}
+			}
+
 			metadata.SetID(resourceId)
 			return nil
 		},
@@ -108,6 +152,87 @@ func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 	}
 }
 
+func (r ResourceProviderRegistrationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.FeaturesClient
+			account := metadata.Client.Account
+
+			id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := r.checkIfManagedByTerraform(id.ResourceProvider, account); err != nil {
+				return err
+			}
+
+			var obj ResourceProviderRegistrationModel
+			if err := metadata.Decode(&obj); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("feature") {
+				oldRaw, _ := metadata.ResourceData.GetChange("feature")
+				configured := make(map[string]struct{})
+				for _, feature := range obj.Features {
+					configured[strings.ToLower(feature.Name)] = struct{}{}
+					if err := r.setFeatureRegistration(ctx, client, id.ResourceProvider, feature); err != nil {
+						return err
+					}
+				}
+
+				// features which are no longer managed by Terraform are unregistered if they were registered previously
+				for _, raw := range oldRaw.(*pluginsdk.Set).List() {
+					v := raw.(map[string]interface{})
+					name := v["name"].(string)
+					if _, ok := configured[strings.ToLower(name)]; ok || !v["registered"].(bool) {
+						continue
+					}
+
+					feature := ResourceProviderFeatureModel{
+						Name:       name,
+						Registered: false,
+					}
+					if err := r.setFeatureRegistration(ctx, client, id.ResourceProvider, feature); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ResourceProviderRegistrationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			name := rd.Get("name").(string)
+
+			features := make(map[string]struct{})
+			for _, raw := range rd.Get("feature").(*pluginsdk.Set).List() {
+				v := raw.(map[string]interface{})
+				featureName := v["name"].(string)
+
+				if namespace := strings.Split(featureName, "/")[0]; !strings.EqualFold(namespace, name) {
+					return fmt.Errorf("the feature %q must belong to the Resource Provider %q", featureName, name)
+				}
+
+				if _, ok := features[strings.ToLower(featureName)]; ok {
+					return fmt.Errorf("the feature %q is specified more than once", featureName)
+				}
+				features[strings.ToLower(featureName)] = struct{}{}
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
 func (r ResourceProviderRegistrationResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -137,8 +262,42 @@ func (r ResourceProviderRegistrationResource) Read() sdk.ResourceFunc {
 				return metadata.MarkAsGone(id)
 			}
 
+			var state ResourceProviderRegistrationModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// only the features defined in the configuration are tracked, since there can be hundreds per Resource Provider
+			featuresClient := metadata.Client.Resource.FeaturesClient
+			features := make([]ResourceProviderFeatureModel, 0)
+			for _, feature := range state.Features {
+				namespace, featureName := splitResourceProviderFeatureName(feature.Name)
+				resp, err := featuresClient.Get(ctx, namespace, featureName)
+				if err != nil {
+					if utils.ResponseWasNotFound(resp.Response) {
+						log.Printf("[WARN] Feature %q was not found within Resource Provider %q - removing from state", feature.Name, id.ResourceProvider)
+						continue
+					}
+
+					return fmt.Errorf("retrieving Feature %q for Resource Provider %q: %+v", feature.Name, id.ResourceProvider, err)
+				}
+
+				// features which require approval remain `Pending` until this has been granted, however since
+				// registration has been requested these are considered registered
+				registered := false
+				if props := resp.Properties; props != nil && props.State != nil {
+					registered = strings.EqualFold(*props.State, featureStateRegistered) || strings.EqualFold(*props.State, featureStatePending)
+				}
+
+				features = append(features, ResourceProviderFeatureModel{
+					Name:       feature.Name,
+					Registered: registered,
+				})
+			}
+
 			return metadata.Encode(&ResourceProviderRegistrationModel{
-				Name: id.ResourceProvider,
+				Name:     id.ResourceProvider,
+				Features: features,
 			})
 		},
 		Timeout: 5 * time.Minute,
@@ -160,6 +319,23 @@ func (r ResourceProviderRegistrationResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
+			var state ResourceProviderRegistrationModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			featuresClient := metadata.Client.Resource.FeaturesClient
+			for _, feature := range state.Features {
+				if !feature.Registered {
+					continue
+				}
+
+				feature.Registered = false
+				if err := r.setFeatureRegistration(ctx, featuresClient, id.ResourceProvider, feature); err != nil {
+					return err
+				}
+			}
+
 			if _, err := client.Unregister(ctx, id.ResourceProvider); err != nil {
 				return fmt.Errorf("unregistering Resource Provider %q: %+v", id.ResourceProvider, err)
 			}
@@ -218,6 +394,8 @@ func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFu
 			return fmt.Errorf("importing Resource Provider %q: %+v", id.ResourceProvider, err)
 		}
 
+		// Features aren't imported since only the Features defined in the configuration are tracked - these are
+		// populated once defined in the configuration
 		return nil
 	}
 }
@@ -270,3 +448,104 @@ func (r ResourceProviderRegistrationResource) unregisterRefreshFunc(ctx context.
 		return resp, "Processing", nil
 	}
 }
+
+// setFeatureRegistration registers/unregisters the specified feature as required and then waits for the state to be reflected
+func (r ResourceProviderRegistrationResource) setFeatureRegistration(ctx context.Context, client *features.Client, resourceProvider string, feature ResourceProviderFeatureModel) error {
+	namespace, featureName := splitResourceProviderFeatureName(feature.Name)
+
+	existing, err := client.Get(ctx, namespace, featureName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("the Feature %q was not found within the Resource Provider %q", feature.Name, resourceProvider)
+		}
+
+		return fmt.Errorf("retrieving Feature %q for Resource Provider %q: %+v", feature.Name, resourceProvider, err)
+	}
+
+	currentState := featureStateNotRegistered
+	if props := existing.Properties; props != nil && props.State != nil {
+		currentState = *props.State
+	}
+
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Refresh:      r.featureRefreshFunc(ctx, client, namespace, featureName),
+		MinTimeout:   15 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      time.Until(timeout),
+	}
+
+	if feature.Registered {
+		if strings.EqualFold(currentState, featureStateRegistered) || strings.EqualFold(currentState, featureStatePending) {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Registering Feature %q..", feature.Name)
+		if _, err := client.Register(ctx, namespace, featureName); err != nil {
+			return fmt.Errorf("registering Feature %q for Resource Provider %q: %+v", feature.Name, resourceProvider, err)
+		}
+
+		// some features require approval which means they remain `Pending` until this has been granted, which can take
+		// days - so rather than waiting for this the registration is considered complete once it's been requested
+		stateConf.Pending = []string{featureStateRegistering, featureStateNotRegistered, featureStateUnregistered}
+		stateConf.Target = []string{featureStateRegistered, featureStatePending}
+		raw, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for Feature %q for Resource Provider %q to be registered: %+v", feature.Name, resourceProvider, err)
+		}
+		if result, ok := raw.(features.Result); ok && result.Properties != nil && result.Properties.State != nil && strings.EqualFold(*result.Properties.State, featureStatePending) {
+			log.Printf("[WARN] Feature %q requires approval before it's registered - registration has been requested and is `Pending` approval", feature.Name)
+			return nil
+		}
+		log.Printf("[DEBUG] Registered Feature %q.", feature.Name)
+
+		return nil
+	}
+
+	if !strings.EqualFold(currentState, featureStateRegistered) && !strings.EqualFold(currentState, featureStatePending) {
+		return nil
+	}
+
+	if strings.EqualFold(currentState, featureStatePending) {
+		log.Printf("[WARN] Feature %q is `Pending` approval - unregistering it withdraws the registration request, so approval will need to be requested again if it's re-registered", feature.Name)
+	}
+
+	log.Printf("[DEBUG] Unregistering Feature %q..", feature.Name)
+	if _, err := client.Unregister(ctx, namespace, featureName); err != nil {
+		return fmt.Errorf("unregistering Feature %q for Resource Provider %q: %+v", feature.Name, resourceProvider, err)
+	}
+
+	stateConf.Pending = []string{featureStateRegistered, featureStatePending, featureStateUnregistering}
+	stateConf.Target = []string{featureStateUnregistered, featureStateNotRegistered}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Feature %q for Resource Provider %q to be unregistered: %+v", feature.Name, resourceProvider, err)
+	}
+	log.Printf("[DEBUG] Unregistered Feature %q.", feature.Name)
+
+	return nil
+}
+
+func (r ResourceProviderRegistrationResource) featureRefreshFunc(ctx context.Context, client *features.Client, resourceProviderNamespace, featureName string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceProviderNamespace, featureName)
+		if err != nil {
+			return resp, "Failed", err
+		}
+
+		if resp.Properties == nil || resp.Properties.State == nil {
+			return resp, "Failed", fmt.Errorf("`properties.state` was nil")
+		}
+
+		return resp, *resp.Properties.State, nil
+	}
+}
+
+// splitResourceProviderFeatureName splits a feature name in the format `Microsoft.Foo/Bar` into the namespace and feature name
+func splitResourceProviderFeatureName(input string) (string, string) {
+	namespace, featureName := input, ""
+	if idx := strings.Index(input, "/"); idx != -1 {
+		namespace = input[:idx]
+		featureName = input[idx+1:]
+	}
+	return namespace, featureName
+}
@@ -48,6 +48,28 @@ func TestAccResourceProviderRegistration_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccResourceProviderRegistration_feature(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_provider_registration", "test")
+	r := ResourceProviderRegistrationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.feature("Microsoft.ApiSecurity", "Microsoft.ApiSecurity/PP2CanaryAccessDEV", true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// Features aren't imported, since only the Features defined in the configuration are tracked
+		data.ImportStep("feature"),
+		{
+			Config: r.feature("Microsoft.ApiSecurity", "Microsoft.ApiSecurity/PP2CanaryAccessDEV", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("feature"),
+	})
+}
+
 func (ResourceProviderRegistrationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	name := state.Attributes["name"]
 
@@ -86,3 +108,21 @@ resource "azurerm_resource_provider_registration" "import" {
 }
 `, template)
 }
+
+func (ResourceProviderRegistrationResource) feature(name, featureName string, registered bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+  skip_provider_registration = true
+}
+
+resource "azurerm_resource_provider_registration" "test" {
+  name = %q
+
+  feature {
+    name       = %q
+    registered = %t
+  }
+}
+`, name, featureName, registered)
+}
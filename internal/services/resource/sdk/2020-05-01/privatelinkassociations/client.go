@@ -0,0 +1,15 @@
+package privatelinkassociations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateLinkAssociationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateLinkAssociationsClientWithBaseURI(endpoint string) PrivateLinkAssociationsClient {
+	return PrivateLinkAssociationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package privatelinkassociations
+
+type PublicNetworkAccessOptions string
+
+const (
+	PublicNetworkAccessOptionsDisabled PublicNetworkAccessOptions = "Disabled"
+	PublicNetworkAccessOptionsEnabled  PublicNetworkAccessOptions = "Enabled"
+)
@@ -0,0 +1,76 @@
+package privatelinkassociations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrivateLinkAssociationId is scoped to a Management Group rather than a Subscription, so is maintained by hand
+type PrivateLinkAssociationId struct {
+	ManagementGroupName string
+	Name                string
+}
+
+func NewPrivateLinkAssociationID(managementGroupName, name string) PrivateLinkAssociationId {
+	return PrivateLinkAssociationId{
+		ManagementGroupName: managementGroupName,
+		Name:                name,
+	}
+}
+
+func (id PrivateLinkAssociationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Management Group Name %q", id.ManagementGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Private Link Association", segmentsStr)
+}
+
+func (id PrivateLinkAssociationId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Authorization/privateLinkAssociations/%s"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.Name)
+}
+
+// ParsePrivateLinkAssociationID parses a PrivateLinkAssociation ID into an PrivateLinkAssociationId struct
+func ParsePrivateLinkAssociationID(input string) (*PrivateLinkAssociationId, error) {
+	return parsePrivateLinkAssociationID(input, false)
+}
+
+// ParsePrivateLinkAssociationIDInsensitively parses an PrivateLinkAssociation ID into an PrivateLinkAssociationId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParsePrivateLinkAssociationID method should be used instead for validation etc.
+func ParsePrivateLinkAssociationIDInsensitively(input string) (*PrivateLinkAssociationId, error) {
+	return parsePrivateLinkAssociationID(input, true)
+}
+
+func parsePrivateLinkAssociationID(input string, insensitively bool) (*PrivateLinkAssociationId, error) {
+	expected := []string{"providers", "Microsoft.Management", "managementGroups", "", "providers", "Microsoft.Authorization", "privateLinkAssociations", ""}
+
+	components := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if !strings.HasPrefix(input, "/") || len(components) != len(expected) {
+		return nil, fmt.Errorf("expected the Private Link Association ID %q to be in the format %q", input, NewPrivateLinkAssociationID("{managementGroupName}", "{privateLinkAssociationName}").ID())
+	}
+
+	for i, segment := range expected {
+		if segment == "" {
+			if components[i] == "" {
+				return nil, fmt.Errorf("ID was missing a value for the '%s' element", expected[i-1])
+			}
+			continue
+		}
+
+		matches := components[i] == segment
+		if insensitively {
+			matches = strings.EqualFold(components[i], segment)
+		}
+		if !matches {
+			return nil, fmt.Errorf("ID was missing the '%s' element", segment)
+		}
+	}
+
+	return &PrivateLinkAssociationId{
+		ManagementGroupName: components[3],
+		Name:                components[7],
+	}, nil
+}
@@ -0,0 +1,135 @@
+package privatelinkassociations
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = PrivateLinkAssociationId{}
+
+func TestPrivateLinkAssociationIDFormatter(t *testing.T) {
+	actual := NewPrivateLinkAssociationID("group1", "00000000-0000-0000-0000-000000000000").ID()
+	expected := "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/privateLinkAssociations/00000000-0000-0000-0000-000000000000"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParsePrivateLinkAssociationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateLinkAssociationId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing ManagementGroupName
+			Input: "/providers/Microsoft.Management/managementGroups/",
+			Error: true,
+		},
+		{
+			// missing Name
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/privateLinkAssociations/",
+			Error: true,
+		},
+		{
+			// subscription scoped
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/privateLinkAssociations/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/privateLinkAssociations/00000000-0000-0000-0000-000000000000",
+			Expected: &PrivateLinkAssociationId{
+				ManagementGroupName: "group1",
+				Name:                "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/PRIVATELINKASSOCIATIONS/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParsePrivateLinkAssociationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestParsePrivateLinkAssociationIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateLinkAssociationId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/privateLinkAssociations/00000000-0000-0000-0000-000000000000",
+			Expected: &PrivateLinkAssociationId{
+				ManagementGroupName: "group1",
+				Name:                "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/PRIVATELINKASSOCIATIONS/00000000-0000-0000-0000-000000000000",
+			Expected: &PrivateLinkAssociationId{
+				ManagementGroupName: "GROUP1",
+				Name:                "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParsePrivateLinkAssociationIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
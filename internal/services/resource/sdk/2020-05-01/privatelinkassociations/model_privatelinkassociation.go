@@ -0,0 +1,8 @@
+package privatelinkassociations
+
+type PrivateLinkAssociation struct {
+	Id         *string                                   `json:"id,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Properties *PrivateLinkAssociationPropertiesExpanded `json:"properties,omitempty"`
+	Type       *string                                   `json:"type,omitempty"`
+}
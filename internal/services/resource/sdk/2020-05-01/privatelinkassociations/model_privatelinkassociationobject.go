@@ -0,0 +1,5 @@
+package privatelinkassociations
+
+type PrivateLinkAssociationObject struct {
+	Properties *PrivateLinkAssociationProperties `json:"properties,omitempty"`
+}
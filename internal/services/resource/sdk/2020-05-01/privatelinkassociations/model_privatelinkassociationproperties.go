@@ -0,0 +1,6 @@
+package privatelinkassociations
+
+type PrivateLinkAssociationProperties struct {
+	PrivateLink         *string                     `json:"privateLink,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccessOptions `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,8 @@
+package privatelinkassociations
+
+type PrivateLinkAssociationPropertiesExpanded struct {
+	PrivateLink         *string                     `json:"privateLink,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccessOptions `json:"publicNetworkAccess,omitempty"`
+	Scope               *string                     `json:"scope,omitempty"`
+	TenantID            *string                     `json:"tenantID,omitempty"`
+}
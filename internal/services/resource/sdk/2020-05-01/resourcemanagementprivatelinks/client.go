@@ -0,0 +1,15 @@
+package resourcemanagementprivatelinks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ResourceManagementPrivateLinksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewResourceManagementPrivateLinksClientWithBaseURI(endpoint string) ResourceManagementPrivateLinksClient {
+	return ResourceManagementPrivateLinksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
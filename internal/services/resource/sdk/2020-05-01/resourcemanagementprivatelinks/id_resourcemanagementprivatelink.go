@@ -0,0 +1,108 @@
+package resourcemanagementprivatelinks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ResourceManagementPrivateLinkId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewResourceManagementPrivateLinkID(subscriptionId, resourceGroup, name string) ResourceManagementPrivateLinkId {
+	return ResourceManagementPrivateLinkId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ResourceManagementPrivateLinkId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Management Private Link", segmentsStr)
+}
+
+func (id ResourceManagementPrivateLinkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Authorization/resourceManagementPrivateLinks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseResourceManagementPrivateLinkID parses a ResourceManagementPrivateLink ID into an ResourceManagementPrivateLinkId struct
+func ParseResourceManagementPrivateLinkID(input string) (*ResourceManagementPrivateLinkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceManagementPrivateLinkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("resourceManagementPrivateLinks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseResourceManagementPrivateLinkIDInsensitively parses an ResourceManagementPrivateLink ID into an ResourceManagementPrivateLinkId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseResourceManagementPrivateLinkID method should be used instead for validation etc.
+func ParseResourceManagementPrivateLinkIDInsensitively(input string) (*ResourceManagementPrivateLinkId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceManagementPrivateLinkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'resourceManagementPrivateLinks' segment
+	resourceManagementPrivateLinksKey := "resourceManagementPrivateLinks"
+	for key := range id.Path {
+		if strings.EqualFold(key, resourceManagementPrivateLinksKey) {
+			resourceManagementPrivateLinksKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(resourceManagementPrivateLinksKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
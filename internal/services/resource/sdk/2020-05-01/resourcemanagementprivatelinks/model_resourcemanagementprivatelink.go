@@ -0,0 +1,9 @@
+package resourcemanagementprivatelinks
+
+type ResourceManagementPrivateLink struct {
+	Id         *string                                           `json:"id,omitempty"`
+	Location   *string                                           `json:"location,omitempty"`
+	Name       *string                                           `json:"name,omitempty"`
+	Properties *ResourceManagementPrivateLinkEndpointConnections `json:"properties,omitempty"`
+	Type       *string                                           `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package resourcemanagementprivatelinks
+
+type ResourceManagementPrivateLinkEndpointConnections struct {
+	PrivateEndpointConnections *[]string `json:"privateEndpointConnections,omitempty"`
+}
@@ -0,0 +1,5 @@
+package resourcemanagementprivatelinks
+
+type ResourceManagementPrivateLinkLocation struct {
+	Location *string `json:"location,omitempty"`
+}
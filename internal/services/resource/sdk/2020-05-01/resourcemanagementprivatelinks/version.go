@@ -0,0 +1,9 @@
+package resourcemanagementprivatelinks
+
+import "fmt"
+
+const defaultApiVersion = "2020-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/resourcemanagementprivatelinks/%s", defaultApiVersion)
+}
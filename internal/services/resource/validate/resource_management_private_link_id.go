@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-05-01/resourcemanagementprivatelinks"
+)
+
+func ResourceManagementPrivateLinkID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := resourcemanagementprivatelinks.ParseResourceManagementPrivateLinkID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
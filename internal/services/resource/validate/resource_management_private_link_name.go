@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ResourceManagementPrivateLinkName(v interface{}, k string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile(`^[-\w.()]{1,64}$`).MatchString(input) {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 64 characters and can only consist of alphanumeric characters, periods, dashes, underscores and parentheses", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceManagementPrivateLinkName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "hello",
+			Valid: true,
+		},
+		{
+			Input: "hello-world_(1).2",
+			Valid: true,
+		},
+		{
+			Input: "hello world",
+			Valid: false,
+		},
+		{
+			Input: "hello/world",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 64),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 65),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ResourceManagementPrivateLinkName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ResourceProviderFeatureName validates the fully qualified name of a Resource Provider Feature,
+// for example `Microsoft.ContainerService/EnableWorkloadIdentityPreview`
+func ResourceProviderFeatureName(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)+/[A-Za-z0-9][\w\.\-]*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must be in the format `{Resource Provider Namespace}/{Feature Name}`, for example `Microsoft.Compute/AHUB`", key))
+	}
+	return
+}
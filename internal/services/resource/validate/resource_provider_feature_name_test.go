@@ -0,0 +1,61 @@
+package validate
+
+import "testing"
+
+func TestResourceProviderFeatureName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// feature name only
+			Input: "AHUB",
+			Valid: false,
+		},
+		{
+			// namespace only
+			Input: "Microsoft.Compute",
+			Valid: false,
+		},
+		{
+			// missing feature name
+			Input: "Microsoft.Compute/",
+			Valid: false,
+		},
+		{
+			// namespace without a period
+			Input: "Microsoft/AHUB",
+			Valid: false,
+		},
+		{
+			// too many segments
+			Input: "Microsoft.Compute/AHUB/Extra",
+			Valid: false,
+		},
+		{
+			// valid
+			Input: "Microsoft.Compute/AHUB",
+			Valid: true,
+		},
+		{
+			// valid with dashes and periods
+			Input: "Microsoft.ContainerService/AKS-Dapr.Preview",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing value %s", tc.Input)
+		_, errors := ResourceProviderFeatureName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -29,8 +29,10 @@ func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 		Update: resourceSecurityCenterAutomationCreateUpdate,
 		Delete: resourceSecurityCenterAutomationDelete,
 
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SecurityCenterAutomationID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -129,8 +131,12 @@ func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(security.EventSourceAlerts),
 								string(security.EventSourceAssessments),
+								string(security.EventSourceRegulatoryComplianceAssessment),
+								string(security.EventSourceRegulatoryComplianceAssessmentSnapshot),
 								string(security.EventSourceSecureScoreControls),
+								string(security.EventSourceSecureScoreControlsSnapshot),
 								string(security.EventSourceSecureScores),
+								string(security.EventSourceSecureScoresSnapshot),
 								string(security.EventSourceSubAssessments),
 							}, false),
 						},
@@ -143,15 +149,18 @@ func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 									"rule": {
 										Type:     pluginsdk.TypeList,
 										Required: true,
+										MinItems: 1,
 										Elem: &pluginsdk.Resource{
 											Schema: map[string]*pluginsdk.Schema{
 												"property_path": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
 												},
 												"expected_value": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
 												},
 												"operator": {
 													Type:     pluginsdk.TypeString,
@@ -370,6 +379,10 @@ func expandSecurityCenterAutomationSources(sourcesRaw []interface{}) (*[]securit
 				ruleValue := ruleMap["expected_value"].(string)
 				ruleOperator := security.Operator(ruleMap["operator"].(string))
 
+				if err := validateSecurityCenterAutomationRuleOperator(ruleOperator, ruleType); err != nil {
+					return nil, fmt.Errorf("Security Center automation, rule with property_path %q: %+v", rulePath, err)
+				}
+
 				// Create AutomationTriggeringRule struct and push into array
 				rule := security.AutomationTriggeringRule{
 					PropertyJPath: &rulePath,
@@ -401,6 +414,34 @@ func expandSecurityCenterAutomationSources(sourcesRaw []interface{}) (*[]securit
 	return &output, nil
 }
 
+// validateSecurityCenterAutomationRuleOperator ensures the comparison operators are only used with numeric properties,
+// since otherwise the API accepts the rule but it never matches
+func validateSecurityCenterAutomationRuleOperator(operator security.Operator, propertyType security.PropertyType) error {
+	numericOperators := []security.Operator{
+		security.GreaterThan,
+		security.GreaterThanOrEqualTo,
+		security.LesserThan,
+		security.LesserThanOrEqualTo,
+	}
+	for _, v := range numericOperators {
+		if !strings.EqualFold(string(operator), string(v)) {
+			continue
+		}
+
+		if !strings.EqualFold(string(propertyType), string(security.Integer)) && !strings.EqualFold(string(propertyType), string(security.Number)) {
+			return fmt.Errorf("operator %q can only be used when property_type is %q or %q", operator, security.Integer, security.Number)
+		}
+	}
+
+	if strings.EqualFold(string(propertyType), string(security.Boolean)) {
+		if !strings.EqualFold(string(operator), string(security.Equals)) && !strings.EqualFold(string(operator), string(security.NotEquals)) {
+			return fmt.Errorf("operator %q can't be used when property_type is %q, only %q and %q are supported", operator, security.Boolean, security.Equals, security.NotEquals)
+		}
+	}
+
+	return nil
+}
+
 func expandSecurityCenterAutomationScopes(scopePathsRaw []interface{}) *[]security.AutomationScope {
 	scopes := make([]security.AutomationScope, 0)
 
@@ -3,6 +3,7 @@ package securitycenter_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -121,6 +122,18 @@ func TestAccSecurityCenterAutomation_ruleSingle(t *testing.T) {
 	})
 }
 
+func TestAccSecurityCenterAutomation_ruleInvalidOperator(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_security_center_automation", "test")
+	r := SecurityCenterAutomationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.ruleInvalidOperator(data),
+			ExpectError: regexp.MustCompile("can only be used when property_type is"),
+		},
+	})
+}
+
 func TestAccSecurityCenterAutomation_ruleMulti(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_security_center_automation", "test")
 	r := SecurityCenterAutomationResource{}
@@ -449,6 +462,56 @@ resource "azurerm_security_center_automation" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
 }
 
+func (SecurityCenterAutomationResource) ruleInvalidOperator(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlogicapp-%d"
+  location            = "%s"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_security_center_automation" "test" {
+  name                = "acctestautomation-%d"
+  location            = "%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  scopes = [
+    "/subscriptions/${data.azurerm_client_config.current.subscription_id}"
+  ]
+
+  action {
+    type        = "LogicApp"
+    resource_id = azurerm_logic_app_workflow.test.id
+    trigger_url = "https://example.net/this_is_never_validated_by_azure"
+  }
+
+  source {
+    event_source = "Alerts"
+    rule_set {
+      rule {
+        property_path  = "properties.metadata.severity"
+        operator       = "GreaterThan"
+        expected_value = "High"
+        property_type  = "String"
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
+}
+
 func (SecurityCenterAutomationResource) scopeMulti(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,140 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `premiumMessagingPartitions` field is only available from API Version `2022-10-01-preview`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const premiumMessagingPartitionsApiVersion = "2022-10-01-preview"
+
+type NamespacesWorkaroundClient struct {
+	sdkClient *servicebus.NamespacesClient
+}
+
+func NewNamespacesWorkaroundClient(client *servicebus.NamespacesClient) NamespacesWorkaroundClient {
+	return NamespacesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// CreateOrUpdate creates or updates a service namespace, including the number of `premiumMessagingPartitions` of the
+// namespace.
+// Parameters:
+// resourceGroupName - name of the Resource group within the Azure subscription.
+// namespaceName - the namespace name.
+// parameters - parameters supplied to create a namespace resource.
+// premiumMessagingPartitions - the number of partitions of a Service Bus namespace, only applicable to Premium namespaces.
+func (client NamespacesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, namespaceName string, parameters servicebus.SBNamespace, premiumMessagingPartitions int32) (result servicebus.NamespacesCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, namespaceName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "servicebus.NamespacesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withPremiumMessagingPartitions(premiumMessagingPartitions))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "servicebus.NamespacesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "servicebus.NamespacesClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetPremiumMessagingPartitions returns the number of `premiumMessagingPartitions` of the specified namespace.
+// Parameters:
+// resourceGroupName - name of the Resource group within the Azure subscription.
+// namespaceName - the namespace name.
+func (client NamespacesWorkaroundClient) GetPremiumMessagingPartitions(ctx context.Context, resourceGroupName string, namespaceName string) (result NamespacePremiumMessagingPartitionsResult, err error) {
+	req, err := client.sdkClient.GetPreparer(ctx, resourceGroupName, namespaceName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "servicebus.NamespacesClient", "GetPremiumMessagingPartitions", nil, "Failure preparing request")
+		return
+	}
+
+	query := req.URL.Query()
+	query.Set("api-version", premiumMessagingPartitionsApiVersion)
+	req.URL.RawQuery = query.Encode()
+
+	resp, err := client.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "servicebus.NamespacesClient", "GetPremiumMessagingPartitions", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "servicebus.NamespacesClient", "GetPremiumMessagingPartitions", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type NamespacePremiumMessagingPartitionsResult struct {
+	autorest.Response `json:"-"`
+	Properties        *NamespacePremiumMessagingPartitionsProperties `json:"properties,omitempty"`
+}
+
+type NamespacePremiumMessagingPartitionsProperties struct {
+	PremiumMessagingPartitions *int32 `json:"premiumMessagingPartitions,omitempty"`
+}
+
+// withPremiumMessagingPartitions sets the `premiumMessagingPartitions` within the properties of the request body and
+// updates the API Version used for the request to one which supports this field.
+func withPremiumMessagingPartitions(input int32) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			properties["premiumMessagingPartitions"] = input
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", premiumMessagingPartitionsApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
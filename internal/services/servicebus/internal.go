@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func expandAuthorizationRuleRights(d *pluginsdk.ResourceData) *[]servicebus.AccessRights {
@@ -107,11 +108,37 @@ func authorizationRuleSchemaFrom(s map[string]*pluginsdk.Schema) map[string]*plu
 			Computed:  true,
 			Sensitive: true,
 		},
+
+		"regenerate": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"primary_key_trigger": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secondary_key_trigger": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
 	}
 	return azure.MergeSchema(s, authSchema)
 }
 
 func authorizationRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the rights may be interpolated from other resources, in which case they can only be validated at apply time
+	if !d.NewValueKnown("listen") || !d.NewValueKnown("send") || !d.NewValueKnown("manage") {
+		return nil
+	}
+
 	listen, hasListen := d.GetOk("listen")
 	send, hasSend := d.GetOk("send")
 	manage, hasManage := d.GetOk("manage")
@@ -127,12 +154,103 @@ func authorizationRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDi
 	return nil
 }
 
+type authorizationRuleListKeysFunc func(ctx context.Context) (servicebus.AccessKeys, error)
+
+type authorizationRuleRegenerateKeysFunc func(ctx context.Context, parameters servicebus.RegenerateAccessKeyParameters) (servicebus.AccessKeys, error)
+
+// regenerateAuthorizationRuleKeys regenerates the Primary and/or Secondary Key of an Authorization Rule when the
+// associated trigger within the `regenerate` block changes. Since the keys aren't regenerated when the trigger is
+// unchanged, re-applying the same configuration doesn't regenerate the keys again.
+func regenerateAuthorizationRuleKeys(ctx context.Context, d *pluginsdk.ResourceData, regenerate authorizationRuleRegenerateKeysFunc, listKeys authorizationRuleListKeysFunc) error {
+	// the keys of a new Authorization Rule have only just been generated, so there's nothing to do
+	if d.IsNewResource() {
+		return nil
+	}
+
+	triggers := map[servicebus.KeyType]string{
+		servicebus.KeyTypePrimaryKey:   "regenerate.0.primary_key_trigger",
+		servicebus.KeyTypeSecondaryKey: "regenerate.0.secondary_key_trigger",
+	}
+	for _, keyType := range servicebus.PossibleKeyTypeValues() {
+		trigger := triggers[keyType]
+		if !d.HasChange(trigger) || d.Get(trigger).(string) == "" {
+			continue
+		}
+
+		log.Printf("[DEBUG] Regenerating the %s..", keyType)
+		keys, err := regenerate(ctx, servicebus.RegenerateAccessKeyParameters{
+			KeyType: keyType,
+		})
+		if err != nil {
+			return fmt.Errorf("regenerating the %s: %+v", keyType, err)
+		}
+
+		expected := authorizationRuleKeyForType(keys, keyType)
+		if expected == "" {
+			return fmt.Errorf("regenerating the %s: the regenerated key was empty", keyType)
+		}
+
+		log.Printf("[DEBUG] Waiting for the regenerated %s to become available..", keyType)
+		if err := waitForAuthorizationRuleKey(ctx, listKeys, keyType, expected, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("waiting for the regenerated %s to become available: %+v", keyType, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForAuthorizationRuleKey waits for the regenerated key to be returned consistently, since on Namespaces with
+// multiple (Premium Messaging) Partitions the key is propagated to each partition asynchronously - meaning that the
+// previous key can be returned for a period of time after the key has been regenerated.
+func waitForAuthorizationRuleKey(ctx context.Context, listKeys authorizationRuleListKeysFunc, keyType servicebus.KeyType, expected string, timeout time.Duration) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Available"},
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   timeout,
+		Refresh: func() (interface{}, string, error) {
+			keys, err := listKeys(ctx)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing keys: %+v", err)
+			}
+
+			if authorizationRuleKeyForType(keys, keyType) != expected {
+				return keys, "Pending", nil
+			}
+
+			return keys, "Available", nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func authorizationRuleKeyForType(keys servicebus.AccessKeys, keyType servicebus.KeyType) string {
+	var key *string
+	switch keyType {
+	case servicebus.KeyTypePrimaryKey:
+		key = keys.PrimaryKey
+	case servicebus.KeyTypeSecondaryKey:
+		key = keys.SecondaryKey
+	}
+
+	if key == nil {
+		return ""
+	}
+	return *key
+}
+
 func waitForPairedNamespaceReplication(ctx context.Context, meta interface{}, resourceGroup, namespaceName string, timeout time.Duration) error {
 	namespaceClient := meta.(*clients.Client).ServiceBus.NamespacesClient
 	namespace, err := namespaceClient.Get(ctx, resourceGroup, namespaceName)
+	if err != nil {
+		return fmt.Errorf("retrieving Service Bus Namespace %q (Resource Group %q): %+v", namespaceName, resourceGroup, err)
+	}
 
-	if !strings.EqualFold(string(namespace.Sku.Name), "Premium") {
-		return err
+	if namespace.Sku == nil || !strings.EqualFold(string(namespace.Sku.Name), "Premium") {
+		return nil
 	}
 
 	disasterRecoveryClient := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
@@ -103,6 +103,43 @@ func TestAccServiceBusNamespaceAuthorizationRule_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusNamespaceAuthorizationRule_regenerateKeysPremiumPartitioned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_authorization_rule", "test")
+	r := ServiceBusNamespaceAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the keys aren't regenerated when the Authorization Rule is created
+			Config: r.premiumPartitioned(data, "1", "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("regenerate"),
+		{
+			// re-applying the same triggers mustn't regenerate the keys again
+			Config:   r.premiumPartitioned(data, "1", "1"),
+			PlanOnly: true,
+		},
+		{
+			Config: r.premiumPartitioned(data, "2", "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+			),
+		},
+		data.ImportStep("regenerate"),
+		{
+			Config: r.premiumPartitioned(data, "2", "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_connection_string").Exists(),
+			),
+		},
+		data.ImportStep("regenerate"),
+	})
+}
+
 func (t ServiceBusNamespaceAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NamespaceAuthorizationRuleID(state.ID)
 	if err != nil {
@@ -216,3 +253,40 @@ resource "azurerm_servicebus_namespace_authorization_rule" "test" {
 
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
+
+func (ServiceBusNamespaceAuthorizationRuleResource) premiumPartitioned(data acceptance.TestData, primaryKeyTrigger, secondaryKeyTrigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                         = "acctest-%[1]d"
+  location                     = azurerm_resource_group.test.location
+  resource_group_name          = azurerm_resource_group.test.name
+  sku                          = "Premium"
+  capacity                     = 2
+  premium_messaging_partitions = 2
+}
+
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = true
+
+  regenerate {
+    primary_key_trigger   = "%[3]s"
+    secondary_key_trigger = "%[4]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, primaryKeyTrigger, secondaryKeyTrigger)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
@@ -85,6 +86,14 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				ValidateFunc: validation.IntInSlice([]int{0, 1, 2, 4, 8, 16}),
 			},
 
+			"premium_messaging_partitions": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 4}),
+			},
+
 			"default_primary_connection_string": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -168,7 +177,21 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		parameters.Sku.Capacity = utils.Int32(int32(capacity.(int)))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.Name, parameters)
+	var future servicebus.NamespacesCreateOrUpdateFuture
+	var err error
+	if v, ok := d.GetOk("premium_messaging_partitions"); ok {
+		partitions := v.(int)
+		if !strings.EqualFold(sku, string(servicebus.SkuNamePremium)) {
+			return fmt.Errorf("`premium_messaging_partitions` can only be specified when `sku` is `Premium`")
+		}
+		if capacity := d.Get("capacity").(int); capacity%partitions != 0 {
+			return fmt.Errorf("`capacity` must be a multiple of `premium_messaging_partitions` (%d), got %d", partitions, capacity)
+		}
+
+		future, err = azuresdkhacks.NewNamespacesWorkaroundClient(client).CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.Name, parameters, int32(partitions))
+	} else {
+		future, err = client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.Name, parameters)
+	}
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
@@ -214,6 +237,24 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("zone_redundant", properties.ZoneRedundant)
 	}
 
+	// partitioning is only available for Premium namespaces
+	premiumMessagingPartitions := 0
+	if resp.Sku != nil && resp.Sku.Name == servicebus.SkuNamePremium {
+		partitionsResp, err := azuresdkhacks.NewNamespacesWorkaroundClient(client).GetPremiumMessagingPartitions(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			// the newer API Version used to retrieve this may not be available (e.g. in some national clouds), in which
+			// case the existing value is retained rather than failing to read the namespace
+			if !utils.ResponseWasBadRequest(partitionsResp.Response) && !utils.ResponseWasNotFound(partitionsResp.Response) {
+				return fmt.Errorf("retrieving `premium_messaging_partitions` for %s: %+v", id, err)
+			}
+			log.Printf("[DEBUG] unable to retrieve `premium_messaging_partitions` for %s - retaining the existing value: %+v", id, err)
+			premiumMessagingPartitions = d.Get("premium_messaging_partitions").(int)
+		} else if props := partitionsResp.Properties; props != nil && props.PremiumMessagingPartitions != nil {
+			premiumMessagingPartitions = int(*props.PremiumMessagingPartitions)
+		}
+	}
+	d.Set("premium_messaging_partitions", premiumMessagingPartitions)
+
 	keys, err := clientStable.ListKeys(ctx, id.ResourceGroup, id.Name, serviceBusNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] listing default keys for %s: %+v", id, err)
@@ -138,6 +138,43 @@ func TestAccAzureRMServiceBusNamespace_zoneRedundant(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_premiumMessagingPartitions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premiumMessagingPartitions(data, 2, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("premium_messaging_partitions").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMServiceBusNamespace_premiumMessagingPartitionsInvalidCapacity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.premiumMessagingPartitions(data, 2, 4),
+			ExpectError: regexp.MustCompile("`capacity` must be a multiple of `premium_messaging_partitions`"),
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespace_premiumMessagingPartitionsNonPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.premiumMessagingPartitionsNonPremium(data),
+			ExpectError: regexp.MustCompile("`premium_messaging_partitions` can only be specified when `sku` is `Premium`"),
+		},
+	})
+}
+
 func (t ServiceBusNamespaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NamespaceID(state.ID)
 	if err != nil {
@@ -289,3 +326,46 @@ resource "azurerm_servicebus_namespace" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (ServiceBusNamespaceResource) premiumMessagingPartitions(data acceptance.TestData, capacity, partitions int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                         = "acctestservicebusnamespace-%d"
+  location                     = azurerm_resource_group.test.location
+  resource_group_name          = azurerm_resource_group.test.name
+  sku                          = "Premium"
+  capacity                     = %d
+  premium_messaging_partitions = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, capacity, partitions)
+}
+
+func (ServiceBusNamespaceResource) premiumMessagingPartitionsNonPremium(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                         = "acctestservicebusnamespace-%d"
+  location                     = azurerm_resource_group.test.location
+  resource_group_name          = azurerm_resource_group.test.name
+  sku                          = "Standard"
+  premium_messaging_partitions = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -1,6 +1,7 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -103,6 +104,16 @@ func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 		return fmt.Errorf("waiting for replication to complete for Service Bus Namespace Disaster Recovery Configs (Namespace %q / Resource Group %q): %s", resourceId.NamespaceName, resourceId.ResourceGroup, err)
 	}
 
+	regenerate := func(ctx context.Context, parameters servicebus.RegenerateAccessKeyParameters) (servicebus.AccessKeys, error) {
+		return client.RegenerateKeys(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.QueueName, resourceId.AuthorizationRuleName, parameters)
+	}
+	listKeys := func(ctx context.Context) (servicebus.AccessKeys, error) {
+		return client.ListKeys(ctx, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.QueueName, resourceId.AuthorizationRuleName)
+	}
+	if err := regenerateAuthorizationRuleKeys(ctx, d, regenerate, listKeys); err != nil {
+		return fmt.Errorf("regenerating the keys for %s: %+v", resourceId, err)
+	}
+
 	return resourceServiceBusQueueAuthorizationRuleRead(d, meta)
 }
 
@@ -136,6 +136,37 @@ func TestAccServiceBusQueueAuthorizationRule_withAliasConnectionString(t *testin
 	})
 }
 
+func TestAccServiceBusQueueAuthorizationRule_regenerateKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_queue_authorization_rule", "test")
+	r := ServiceBusQueueAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.base(data, true, true, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.regenerateKeys(data, "1", "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("secondary_connection_string").Exists(),
+			),
+		},
+		data.ImportStep("regenerate"),
+		{
+			Config: r.regenerateKeys(data, "2", "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("regenerate"),
+	})
+}
+
 func (t ServiceBusQueueAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.QueueAuthorizationRuleID(state.ID)
 	if err != nil {
@@ -266,3 +297,47 @@ resource "azurerm_servicebus_queue_authorization_rule" "test" {
 
 `, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
 }
+
+func (ServiceBusQueueAuthorizationRuleResource) regenerateKeys(data acceptance.TestData, primaryKeyTrigger, secondaryKeyTrigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+  name                = "acctest-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_servicebus_namespace.test.name
+
+  enable_partitioning = true
+}
+
+resource "azurerm_servicebus_queue_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  queue_name          = azurerm_servicebus_queue.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = true
+
+  regenerate {
+    primary_key_trigger   = "%[3]s"
+    secondary_key_trigger = "%[4]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, primaryKeyTrigger, secondaryKeyTrigger)
+}
@@ -0,0 +1,183 @@
+package servicenetworking
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/frontendsinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/trafficcontrollerinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceApplicationLoadBalancerFrontend() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationLoadBalancerFrontendCreate,
+		Read:   resourceApplicationLoadBalancerFrontendRead,
+		Update: resourceApplicationLoadBalancerFrontendUpdate,
+		Delete: resourceApplicationLoadBalancerFrontendDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := frontendsinterface.ParseFrontendID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationLoadBalancerName,
+			},
+
+			"application_load_balancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationLoadBalancerID,
+			},
+
+			"fully_qualified_domain_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceApplicationLoadBalancerFrontendCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.FrontendsClient
+	trafficControllerClient := meta.(*clients.Client).ServiceNetworking.TrafficControllerClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := trafficcontrollerinterface.ParseTrafficControllerID(d.Get("application_load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := frontendsinterface.NewFrontendID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_application_load_balancer_frontend", id.ID())
+	}
+
+	// the Frontend must be created in the same location as the Application Load Balancer
+	loadBalancer, err := trafficControllerClient.Get(ctx, *loadBalancerId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+	}
+	if loadBalancer.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *loadBalancerId)
+	}
+
+	parameters := frontendsinterface.Frontend{
+		Location: loadBalancer.Model.Location,
+		Tags:     expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationLoadBalancerFrontendRead(d, meta)
+}
+
+func resourceApplicationLoadBalancerFrontendRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.FrontendsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := frontendsinterface.ParseFrontendID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_load_balancer_id", trafficcontrollerinterface.NewTrafficControllerID(id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName).ID())
+
+	if model := resp.Model; model != nil {
+		fqdn := ""
+		if props := model.Properties; props != nil && props.Fqdn != nil {
+			fqdn = *props.Fqdn
+		}
+		d.Set("fully_qualified_domain_name", fqdn)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceApplicationLoadBalancerFrontendUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.FrontendsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := frontendsinterface.ParseFrontendID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := frontendsinterface.FrontendUpdate{
+			Tags: expandTags(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.Update(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceApplicationLoadBalancerFrontendRead(d, meta)
+}
+
+func resourceApplicationLoadBalancerFrontendDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.FrontendsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := frontendsinterface.ParseFrontendID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
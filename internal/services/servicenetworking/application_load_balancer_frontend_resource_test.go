@@ -0,0 +1,147 @@
+package servicenetworking_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/frontendsinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationLoadBalancerFrontendResource struct{}
+
+func TestAccApplicationLoadBalancerFrontend_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_frontend", "test")
+	r := ApplicationLoadBalancerFrontendResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fully_qualified_domain_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationLoadBalancerFrontend_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_frontend", "test")
+	r := ApplicationLoadBalancerFrontendResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationLoadBalancerFrontend_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_frontend", "test")
+	r := ApplicationLoadBalancerFrontendResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := frontendsinterface.ParseFrontendID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceNetworking.FrontendsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApplicationLoadBalancerFrontendResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-alb-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_application_load_balancer" "test" {
+  name                = "acctestalb-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ApplicationLoadBalancerFrontendResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_frontend" "test" {
+  name                         = "acctestfrontend-%d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationLoadBalancerFrontendResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_frontend" "import" {
+  name                         = azurerm_application_load_balancer_frontend.test.name
+  application_load_balancer_id = azurerm_application_load_balancer_frontend.test.application_load_balancer_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationLoadBalancerFrontendResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_frontend" "test" {
+  name                         = "acctestfrontend-%d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
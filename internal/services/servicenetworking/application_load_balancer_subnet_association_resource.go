@@ -0,0 +1,275 @@
+package servicenetworking
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/associationsinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/trafficcontrollerinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+const (
+	// applicationLoadBalancerSubnetDelegation is the Service which the Subnet must be delegated to
+	applicationLoadBalancerSubnetDelegation = "Microsoft.ServiceNetworking/trafficControllers"
+
+	// applicationLoadBalancerSubnetMinimumSize is the smallest prefix length (e.g. a `/24`) supported for the Subnet
+	applicationLoadBalancerSubnetMinimumSize = 24
+)
+
+func resourceApplicationLoadBalancerSubnetAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationLoadBalancerSubnetAssociationCreate,
+		Read:   resourceApplicationLoadBalancerSubnetAssociationRead,
+		Update: resourceApplicationLoadBalancerSubnetAssociationUpdate,
+		Delete: resourceApplicationLoadBalancerSubnetAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := associationsinterface.ParseAssociationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationLoadBalancerName,
+			},
+
+			"application_load_balancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationLoadBalancerID,
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceApplicationLoadBalancerSubnetAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.AssociationsClient
+	trafficControllerClient := meta.(*clients.Client).ServiceNetworking.TrafficControllerClient
+	subnetsClient := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := trafficcontrollerinterface.ParseTrafficControllerID(d.Get("application_load_balancer_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := associationsinterface.NewAssociationID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_application_load_balancer_subnet_association", id.ID())
+	}
+
+	subnetId, err := networkParse.SubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := validateApplicationLoadBalancerSubnet(ctx, subnetsClient, *subnetId); err != nil {
+		return fmt.Errorf("validating %s for %s: %+v", *subnetId, id, err)
+	}
+
+	// the Association must be created in the same location as the Application Load Balancer
+	loadBalancer, err := trafficControllerClient.Get(ctx, *loadBalancerId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *loadBalancerId, err)
+	}
+	if loadBalancer.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *loadBalancerId)
+	}
+
+	parameters := associationsinterface.Association{
+		Location: loadBalancer.Model.Location,
+		Properties: &associationsinterface.AssociationProperties{
+			AssociationType: associationsinterface.AssociationTypeSubnets,
+			Subnet: &associationsinterface.AssociationSubnet{
+				Id: subnetId.ID(),
+			},
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationLoadBalancerSubnetAssociationRead(d, meta)
+}
+
+func resourceApplicationLoadBalancerSubnetAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.AssociationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := associationsinterface.ParseAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_load_balancer_id", trafficcontrollerinterface.NewTrafficControllerID(id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName).ID())
+
+	if model := resp.Model; model != nil {
+		subnetId := ""
+		if props := model.Properties; props != nil && props.Subnet != nil {
+			parsed, err := networkParse.SubnetIDInsensitively(props.Subnet.Id)
+			if err != nil {
+				return err
+			}
+			subnetId = parsed.ID()
+		}
+		d.Set("subnet_id", subnetId)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceApplicationLoadBalancerSubnetAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.AssociationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := associationsinterface.ParseAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := associationsinterface.AssociationUpdate{
+			Tags: expandTags(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.Update(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceApplicationLoadBalancerSubnetAssociationRead(d, meta)
+}
+
+func resourceApplicationLoadBalancerSubnetAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceNetworking.AssociationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := associationsinterface.ParseAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// validateApplicationLoadBalancerSubnet checks that the Subnet is delegated to the Application Load Balancer and is
+// large enough - since otherwise the Association is accepted by the API but fails to provision a long time later
+func validateApplicationLoadBalancerSubnet(ctx context.Context, client *network.SubnetsClient, id networkParse.SubnetId) error {
+	subnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Subnet: %+v", err)
+	}
+	if subnet.SubnetPropertiesFormat == nil {
+		return fmt.Errorf("retrieving Subnet: `properties` was nil")
+	}
+	props := *subnet.SubnetPropertiesFormat
+
+	delegated := false
+	if props.Delegations != nil {
+		for _, delegation := range *props.Delegations {
+			if delegation.ServiceDelegationPropertiesFormat == nil || delegation.ServiceDelegationPropertiesFormat.ServiceName == nil {
+				continue
+			}
+
+			if strings.EqualFold(*delegation.ServiceDelegationPropertiesFormat.ServiceName, applicationLoadBalancerSubnetDelegation) {
+				delegated = true
+				break
+			}
+		}
+	}
+	if !delegated {
+		return fmt.Errorf("the Subnet must be delegated to `%s`", applicationLoadBalancerSubnetDelegation)
+	}
+
+	addressPrefixes := make([]string, 0)
+	if props.AddressPrefix != nil {
+		addressPrefixes = append(addressPrefixes, *props.AddressPrefix)
+	}
+	if props.AddressPrefixes != nil {
+		addressPrefixes = append(addressPrefixes, *props.AddressPrefixes...)
+	}
+
+	for _, addressPrefix := range addressPrefixes {
+		_, ipNet, err := net.ParseCIDR(addressPrefix)
+		if err != nil {
+			return fmt.Errorf("parsing the address prefix %q: %+v", addressPrefix, err)
+		}
+
+		// the minimum size only applies to the IPv4 address space of the Subnet
+		if ipNet.IP.To4() == nil {
+			continue
+		}
+
+		if size, _ := ipNet.Mask.Size(); size > applicationLoadBalancerSubnetMinimumSize {
+			return fmt.Errorf("the address prefix %q of the Subnet must be a `/%d` or larger", addressPrefix, applicationLoadBalancerSubnetMinimumSize)
+		}
+	}
+
+	return nil
+}
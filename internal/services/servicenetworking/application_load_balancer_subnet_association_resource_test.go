@@ -0,0 +1,203 @@
+package servicenetworking_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/associationsinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationLoadBalancerSubnetAssociationResource struct{}
+
+func TestAccApplicationLoadBalancerSubnetAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_subnet_association", "test")
+	r := ApplicationLoadBalancerSubnetAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationLoadBalancerSubnetAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_subnet_association", "test")
+	r := ApplicationLoadBalancerSubnetAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationLoadBalancerSubnetAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_subnet_association", "test")
+	r := ApplicationLoadBalancerSubnetAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationLoadBalancerSubnetAssociation_subnetNotDelegated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_subnet_association", "test")
+	r := ApplicationLoadBalancerSubnetAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.subnetNotDelegated(data),
+			ExpectError: regexp.MustCompile("the Subnet must be delegated to"),
+		},
+	})
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := associationsinterface.ParseAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceNetworking.AssociationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ApplicationLoadBalancerSubnetAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-alb-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      name    = "Microsoft.ServiceNetworking/trafficControllers"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+    }
+  }
+}
+
+resource "azurerm_application_load_balancer" "test" {
+  name                = "acctestalb-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_subnet_association" "test" {
+  name                         = "acctestassoc-%d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+  subnet_id                    = azurerm_subnet.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_subnet_association" "import" {
+  name                         = azurerm_application_load_balancer_subnet_association.test.name
+  application_load_balancer_id = azurerm_application_load_balancer_subnet_association.test.application_load_balancer_id
+  subnet_id                    = azurerm_application_load_balancer_subnet_association.test.subnet_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_subnet_association" "test" {
+  name                         = "acctestassoc-%d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+  subnet_id                    = azurerm_subnet.test.id
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) subnetNotDelegated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_subnet" "other" {
+  name                 = "acctestsubnet-other-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_application_load_balancer_subnet_association" "test" {
+  name                         = "acctestassoc-%[2]d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+  subnet_id                    = azurerm_subnet.other.id
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/associationsinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/frontendsinterface"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/sdk/2023-11-01/trafficcontrollerinterface"
+)
+
+type Client struct {
+	AssociationsClient      *associationsinterface.AssociationsInterfaceClient
+	FrontendsClient         *frontendsinterface.FrontendsInterfaceClient
+	TrafficControllerClient *trafficcontrollerinterface.TrafficControllerInterfaceClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	associationsClient := associationsinterface.NewAssociationsInterfaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&associationsClient.Client, o.ResourceManagerAuthorizer)
+
+	frontendsClient := frontendsinterface.NewFrontendsInterfaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&frontendsClient.Client, o.ResourceManagerAuthorizer)
+
+	trafficControllerClient := trafficcontrollerinterface.NewTrafficControllerInterfaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&trafficControllerClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AssociationsClient:      &associationsClient,
+		FrontendsClient:         &frontendsClient,
+		TrafficControllerClient: &trafficControllerClient,
+	}
+}
@@ -0,0 +1,33 @@
+package servicenetworking
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Service Networking"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Service Networking",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_application_load_balancer":                    resourceApplicationLoadBalancer(),
+		"azurerm_application_load_balancer_frontend":           resourceApplicationLoadBalancerFrontend(),
+		"azurerm_application_load_balancer_subnet_association": resourceApplicationLoadBalancerSubnetAssociation(),
+	}
+}
@@ -0,0 +1,15 @@
+package associationsinterface
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AssociationsInterfaceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAssociationsInterfaceClientWithBaseURI(endpoint string) AssociationsInterfaceClient {
+	return AssociationsInterfaceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
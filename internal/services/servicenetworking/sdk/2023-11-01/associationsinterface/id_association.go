@@ -0,0 +1,126 @@
+package associationsinterface
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AssociationId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	TrafficControllerName string
+	Name                  string
+}
+
+func NewAssociationID(subscriptionId, resourceGroup, trafficControllerName, name string) AssociationId {
+	return AssociationId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		TrafficControllerName: trafficControllerName,
+		Name:                  name,
+	}
+}
+
+func (id AssociationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Traffic Controller Name %q", id.TrafficControllerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Association", segmentsStr)
+}
+
+func (id AssociationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s/associations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName, id.Name)
+}
+
+// ParseAssociationID parses a Association ID into an AssociationId struct
+func ParseAssociationID(input string) (*AssociationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AssociationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficControllerName, err = id.PopSegment("trafficControllers"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("associations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseAssociationIDInsensitively parses an Association ID into an AssociationId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseAssociationID method should be used instead for validation etc.
+func ParseAssociationIDInsensitively(input string) (*AssociationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AssociationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'trafficControllers' segment
+	trafficControllersKey := "trafficControllers"
+	for key := range id.Path {
+		if strings.EqualFold(key, trafficControllersKey) {
+			trafficControllersKey = key
+			break
+		}
+	}
+	if resourceId.TrafficControllerName, err = id.PopSegment(trafficControllersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'associations' segment
+	associationsKey := "associations"
+	for key := range id.Path {
+		if strings.EqualFold(key, associationsKey) {
+			associationsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(associationsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
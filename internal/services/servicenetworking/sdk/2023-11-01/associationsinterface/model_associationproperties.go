@@ -0,0 +1,7 @@
+package associationsinterface
+
+type AssociationProperties struct {
+	AssociationType   AssociationType    `json:"associationType"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Subnet            *AssociationSubnet `json:"subnet,omitempty"`
+}
@@ -0,0 +1,5 @@
+package associationsinterface
+
+type AssociationSubnet struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,5 @@
+package associationsinterface
+
+type AssociationSubnetUpdate struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,6 @@
+package associationsinterface
+
+type AssociationUpdate struct {
+	Properties *AssociationUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string           `json:"tags,omitempty"`
+}
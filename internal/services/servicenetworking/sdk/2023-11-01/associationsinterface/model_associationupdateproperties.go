@@ -0,0 +1,6 @@
+package associationsinterface
+
+type AssociationUpdateProperties struct {
+	AssociationType *AssociationType         `json:"associationType,omitempty"`
+	Subnet          *AssociationSubnetUpdate `json:"subnet,omitempty"`
+}
@@ -0,0 +1,15 @@
+package frontendsinterface
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FrontendsInterfaceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFrontendsInterfaceClientWithBaseURI(endpoint string) FrontendsInterfaceClient {
+	return FrontendsInterfaceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
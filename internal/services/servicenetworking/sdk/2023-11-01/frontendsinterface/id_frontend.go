@@ -0,0 +1,126 @@
+package frontendsinterface
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FrontendId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	TrafficControllerName string
+	Name                  string
+}
+
+func NewFrontendID(subscriptionId, resourceGroup, trafficControllerName, name string) FrontendId {
+	return FrontendId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		TrafficControllerName: trafficControllerName,
+		Name:                  name,
+	}
+}
+
+func (id FrontendId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Traffic Controller Name %q", id.TrafficControllerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Frontend", segmentsStr)
+}
+
+func (id FrontendId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s/frontends/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName, id.Name)
+}
+
+// ParseFrontendID parses a Frontend ID into an FrontendId struct
+func ParseFrontendID(input string) (*FrontendId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontendId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficControllerName, err = id.PopSegment("trafficControllers"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("frontends"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseFrontendIDInsensitively parses an Frontend ID into an FrontendId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseFrontendID method should be used instead for validation etc.
+func ParseFrontendIDInsensitively(input string) (*FrontendId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontendId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'trafficControllers' segment
+	trafficControllersKey := "trafficControllers"
+	for key := range id.Path {
+		if strings.EqualFold(key, trafficControllersKey) {
+			trafficControllersKey = key
+			break
+		}
+	}
+	if resourceId.TrafficControllerName, err = id.PopSegment(trafficControllersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'frontends' segment
+	frontendsKey := "frontends"
+	for key := range id.Path {
+		if strings.EqualFold(key, frontendsKey) {
+			frontendsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(frontendsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package frontendsinterface
+
+type FrontendProperties struct {
+	Fqdn              *string            `json:"fqdn,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
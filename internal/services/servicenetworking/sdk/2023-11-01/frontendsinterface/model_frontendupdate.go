@@ -0,0 +1,5 @@
+package frontendsinterface
+
+type FrontendUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
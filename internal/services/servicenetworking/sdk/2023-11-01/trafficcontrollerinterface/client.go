@@ -0,0 +1,15 @@
+package trafficcontrollerinterface
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TrafficControllerInterfaceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTrafficControllerInterfaceClientWithBaseURI(endpoint string) TrafficControllerInterfaceClient {
+	return TrafficControllerInterfaceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
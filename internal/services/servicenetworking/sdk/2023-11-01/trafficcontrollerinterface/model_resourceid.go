@@ -0,0 +1,5 @@
+package trafficcontrollerinterface
+
+type ResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,8 @@
+package trafficcontrollerinterface
+
+type TrafficControllerProperties struct {
+	Associations           *[]ResourceId      `json:"associations,omitempty"`
+	ConfigurationEndpoints *[]string          `json:"configurationEndpoints,omitempty"`
+	Frontends              *[]ResourceId      `json:"frontends,omitempty"`
+	ProvisioningState      *ProvisioningState `json:"provisioningState,omitempty"`
+}
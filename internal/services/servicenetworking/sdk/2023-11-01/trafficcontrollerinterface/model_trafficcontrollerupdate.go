@@ -0,0 +1,5 @@
+package trafficcontrollerinterface
+
+type TrafficControllerUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
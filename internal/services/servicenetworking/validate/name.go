@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ApplicationLoadBalancerName validates the name of an Application Load Balancer, or of one of its Frontends or Subnet Associations
+func ApplicationLoadBalancerName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters, start with a letter or number, end with a letter, number or underscore and may only contain letters, numbers, underscores, periods and hyphens", k))
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplicationLoadBalancerName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "example-alb_1",
+			Expected: true,
+		},
+		{
+			Input:    "example.alb",
+			Expected: true,
+		},
+		{
+			Input:    "example_",
+			Expected: true,
+		},
+		{
+			Input:    "-example",
+			Expected: false,
+		},
+		{
+			Input:    "example-",
+			Expected: false,
+		},
+		{
+			Input:    "example alb",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 64),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 65),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := ApplicationLoadBalancerName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
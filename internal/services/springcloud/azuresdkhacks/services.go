@@ -0,0 +1,95 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/appplatform/mgmt/2021-06-01-preview/appplatform"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// the Enterprise tier (the `E0` sku) is only available from API Version `2023-05-01-preview`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const servicesEnterpriseTierApiVersion = "2023-05-01-preview"
+
+type ServicesWorkaroundClient struct {
+	sdkClient *appplatform.ServicesClient
+}
+
+func NewServicesWorkaroundClient(client *appplatform.ServicesClient) ServicesWorkaroundClient {
+	return ServicesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// CreateOrUpdate creates or updates a Spring Cloud Service using the Enterprise tier.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// serviceName - the name of the Service resource.
+// resource - parameters for the create or update operation
+func (client ServicesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, serviceName string, resource appplatform.ServiceResource) (result appplatform.ServicesCreateOrUpdateFuture, err error) {
+	req, err := client.sdkClient.CreateOrUpdatePreparer(ctx, resourceGroupName, serviceName, resource)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appplatform.ServicesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withEnterpriseTierApiVersion())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appplatform.ServicesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appplatform.ServicesClient", "CreateOrUpdate", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// Update updates a Spring Cloud Service using the Enterprise tier.
+// Parameters:
+// resourceGroupName - the name of the resource group.
+// serviceName - the name of the Service resource.
+// resource - parameters for the update operation
+func (client ServicesWorkaroundClient) Update(ctx context.Context, resourceGroupName string, serviceName string, resource appplatform.ServiceResource) (result appplatform.ServicesUpdateFuture, err error) {
+	req, err := client.sdkClient.UpdatePreparer(ctx, resourceGroupName, serviceName, resource)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appplatform.ServicesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withEnterpriseTierApiVersion())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appplatform.ServicesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.UpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appplatform.ServicesClient", "Update", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// withEnterpriseTierApiVersion updates the API Version used for the request to one which supports the Enterprise tier.
+func withEnterpriseTierApiVersion() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", servicesEnterpriseTierApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/appplatform/mgmt/2021-06-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gatewaycustomdomains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gatewayrouteconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gateways"
 )
 
 type Client struct {
-	AppsClient               *appplatform.AppsClient
-	BindingsClient           *appplatform.BindingsClient
-	CertificatesClient       *appplatform.CertificatesClient
-	ConfigServersClient      *appplatform.ConfigServersClient
-	CustomDomainsClient      *appplatform.CustomDomainsClient
-	MonitoringSettingsClient *appplatform.MonitoringSettingsClient
-	DeploymentsClient        *appplatform.DeploymentsClient
-	ServicesClient           *appplatform.ServicesClient
+	AppsClient                 *appplatform.AppsClient
+	BindingsClient             *appplatform.BindingsClient
+	CertificatesClient         *appplatform.CertificatesClient
+	ConfigServersClient        *appplatform.ConfigServersClient
+	CustomDomainsClient        *appplatform.CustomDomainsClient
+	GatewayCustomDomainsClient *gatewaycustomdomains.GatewayCustomDomainsClient
+	GatewayRouteConfigsClient  *gatewayrouteconfigs.GatewayRouteConfigsClient
+	GatewaysClient             *gateways.GatewaysClient
+	MonitoringSettingsClient   *appplatform.MonitoringSettingsClient
+	DeploymentsClient          *appplatform.DeploymentsClient
+	ServicesClient             *appplatform.ServicesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -32,6 +38,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	customDomainsClient := appplatform.NewCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&customDomainsClient.Client, o.ResourceManagerAuthorizer)
 
+	gatewayCustomDomainsClient := gatewaycustomdomains.NewGatewayCustomDomainsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&gatewayCustomDomainsClient.Client, o.ResourceManagerAuthorizer)
+
+	gatewayRouteConfigsClient := gatewayrouteconfigs.NewGatewayRouteConfigsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&gatewayRouteConfigsClient.Client, o.ResourceManagerAuthorizer)
+
+	gatewaysClient := gateways.NewGatewaysClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&gatewaysClient.Client, o.ResourceManagerAuthorizer)
+
 	deploymentsClient := appplatform.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -42,13 +57,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AppsClient:               &appsClient,
-		BindingsClient:           &bindingsClient,
-		CertificatesClient:       &certificatesClient,
-		ConfigServersClient:      &configServersClient,
-		CustomDomainsClient:      &customDomainsClient,
-		DeploymentsClient:        &deploymentsClient,
-		MonitoringSettingsClient: &monitoringSettingsClient,
-		ServicesClient:           &servicesClient,
+		AppsClient:                 &appsClient,
+		BindingsClient:             &bindingsClient,
+		CertificatesClient:         &certificatesClient,
+		ConfigServersClient:        &configServersClient,
+		CustomDomainsClient:        &customDomainsClient,
+		DeploymentsClient:          &deploymentsClient,
+		GatewayCustomDomainsClient: &gatewayCustomDomainsClient,
+		GatewayRouteConfigsClient:  &gatewayRouteConfigsClient,
+		GatewaysClient:             &gatewaysClient,
+		MonitoringSettingsClient:   &monitoringSettingsClient,
+		ServicesClient:             &servicesClient,
 	}
 }
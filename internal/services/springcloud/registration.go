@@ -36,6 +36,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_spring_cloud_app_redis_association":    resourceSpringCloudAppRedisAssociation(),
 		"azurerm_spring_cloud_certificate":              resourceSpringCloudCertificate(),
 		"azurerm_spring_cloud_custom_domain":            resourceSpringCloudCustomDomain(),
+		"azurerm_spring_cloud_gateway":                  resourceSpringCloudGateway(),
+		"azurerm_spring_cloud_gateway_custom_domain":    resourceSpringCloudGatewayCustomDomain(),
+		"azurerm_spring_cloud_gateway_route_config":     resourceSpringCloudGatewayRouteConfig(),
 		"azurerm_spring_cloud_java_deployment":          resourceSpringCloudJavaDeployment(),
 		"azurerm_spring_cloud_service":                  resourceSpringCloudService(),
 	}
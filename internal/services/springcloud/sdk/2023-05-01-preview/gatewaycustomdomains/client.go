@@ -0,0 +1,15 @@
+package gatewaycustomdomains
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GatewayCustomDomainsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGatewayCustomDomainsClientWithBaseURI(endpoint string) GatewayCustomDomainsClient {
+	return GatewayCustomDomainsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
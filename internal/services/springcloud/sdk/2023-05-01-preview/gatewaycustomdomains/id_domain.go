@@ -0,0 +1,144 @@
+package gatewaycustomdomains
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DomainId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SpringName     string
+	GatewayName    string
+	Name           string
+}
+
+func NewDomainID(subscriptionId, resourceGroup, springName, gatewayName, name string) DomainId {
+	return DomainId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SpringName:     springName,
+		GatewayName:    gatewayName,
+		Name:           name,
+	}
+}
+
+func (id DomainId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Gateway Name %q", id.GatewayName),
+		fmt.Sprintf("Spring Name %q", id.SpringName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Domain", segmentsStr)
+}
+
+func (id DomainId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s/gateways/%s/domains/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.GatewayName, id.Name)
+}
+
+// ParseDomainID parses a Domain ID into an DomainId struct
+func ParseDomainID(input string) (*DomainId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DomainId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+		return nil, err
+	}
+	if resourceId.GatewayName, err = id.PopSegment("gateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("domains"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDomainIDInsensitively parses an Domain ID into an DomainId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDomainID method should be used instead for validation etc.
+func ParseDomainIDInsensitively(input string) (*DomainId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DomainId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'Spring' segment
+	springKey := "Spring"
+	for key := range id.Path {
+		if strings.EqualFold(key, springKey) {
+			springKey = key
+			break
+		}
+	}
+	if resourceId.SpringName, err = id.PopSegment(springKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'gateways' segment
+	gatewaysKey := "gateways"
+	for key := range id.Path {
+		if strings.EqualFold(key, gatewaysKey) {
+			gatewaysKey = key
+			break
+		}
+	}
+	if resourceId.GatewayName, err = id.PopSegment(gatewaysKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'domains' segment
+	domainsKey := "domains"
+	for key := range id.Path {
+		if strings.EqualFold(key, domainsKey) {
+			domainsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(domainsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
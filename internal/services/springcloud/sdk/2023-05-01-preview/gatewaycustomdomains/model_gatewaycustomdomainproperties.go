@@ -0,0 +1,5 @@
+package gatewaycustomdomains
+
+type GatewayCustomDomainProperties struct {
+	Thumbprint *string `json:"thumbprint,omitempty"`
+}
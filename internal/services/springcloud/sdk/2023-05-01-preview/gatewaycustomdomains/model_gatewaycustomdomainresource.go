@@ -0,0 +1,8 @@
+package gatewaycustomdomains
+
+type GatewayCustomDomainResource struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *GatewayCustomDomainProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
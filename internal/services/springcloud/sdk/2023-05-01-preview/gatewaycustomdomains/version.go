@@ -0,0 +1,9 @@
+package gatewaycustomdomains
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/gatewaycustomdomains/%s", defaultApiVersion)
+}
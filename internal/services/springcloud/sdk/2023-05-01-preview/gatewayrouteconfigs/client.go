@@ -0,0 +1,15 @@
+package gatewayrouteconfigs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GatewayRouteConfigsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGatewayRouteConfigsClientWithBaseURI(endpoint string) GatewayRouteConfigsClient {
+	return GatewayRouteConfigsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,18 @@
+package gatewayrouteconfigs
+
+type GatewayProvisioningState string
+
+const (
+	GatewayProvisioningStateCreating  GatewayProvisioningState = "Creating"
+	GatewayProvisioningStateDeleting  GatewayProvisioningState = "Deleting"
+	GatewayProvisioningStateFailed    GatewayProvisioningState = "Failed"
+	GatewayProvisioningStateSucceeded GatewayProvisioningState = "Succeeded"
+	GatewayProvisioningStateUpdating  GatewayProvisioningState = "Updating"
+)
+
+type GatewayRouteConfigProtocol string
+
+const (
+	GatewayRouteConfigProtocolHTTP  GatewayRouteConfigProtocol = "HTTP"
+	GatewayRouteConfigProtocolHTTPS GatewayRouteConfigProtocol = "HTTPS"
+)
@@ -0,0 +1,13 @@
+package gatewayrouteconfigs
+
+type GatewayApiRoute struct {
+	Description *string   `json:"description,omitempty"`
+	Filters     *[]string `json:"filters,omitempty"`
+	Order       *int64    `json:"order,omitempty"`
+	Predicates  *[]string `json:"predicates,omitempty"`
+	SsoEnabled  *bool     `json:"ssoEnabled,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Title       *string   `json:"title,omitempty"`
+	TokenRelay  *bool     `json:"tokenRelay,omitempty"`
+	Uri         *string   `json:"uri,omitempty"`
+}
@@ -0,0 +1,5 @@
+package gatewayrouteconfigs
+
+type GatewayRouteConfigOpenApiProperties struct {
+	Uri *string `json:"uri,omitempty"`
+}
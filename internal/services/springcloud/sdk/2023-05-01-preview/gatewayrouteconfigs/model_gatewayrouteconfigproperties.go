@@ -0,0 +1,12 @@
+package gatewayrouteconfigs
+
+type GatewayRouteConfigProperties struct {
+	AppResourceId     *string                              `json:"appResourceId,omitempty"`
+	Filters           *[]string                            `json:"filters,omitempty"`
+	OpenApi           *GatewayRouteConfigOpenApiProperties `json:"openApi,omitempty"`
+	Predicates        *[]string                            `json:"predicates,omitempty"`
+	Protocol          *GatewayRouteConfigProtocol          `json:"protocol,omitempty"`
+	ProvisioningState *GatewayProvisioningState            `json:"provisioningState,omitempty"`
+	Routes            *[]GatewayApiRoute                   `json:"routes,omitempty"`
+	SsoEnabled        *bool                                `json:"ssoEnabled,omitempty"`
+}
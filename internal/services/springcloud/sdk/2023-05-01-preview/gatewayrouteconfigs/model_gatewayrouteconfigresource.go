@@ -0,0 +1,8 @@
+package gatewayrouteconfigs
+
+type GatewayRouteConfigResource struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *GatewayRouteConfigProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package gateways
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GatewaysClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGatewaysClientWithBaseURI(endpoint string) GatewaysClient {
+	return GatewaysClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,18 @@
+package gateways
+
+type GatewayCertificateVerification string
+
+const (
+	GatewayCertificateVerificationDisabled GatewayCertificateVerification = "Disabled"
+	GatewayCertificateVerificationEnabled  GatewayCertificateVerification = "Enabled"
+)
+
+type GatewayProvisioningState string
+
+const (
+	GatewayProvisioningStateCreating  GatewayProvisioningState = "Creating"
+	GatewayProvisioningStateDeleting  GatewayProvisioningState = "Deleting"
+	GatewayProvisioningStateFailed    GatewayProvisioningState = "Failed"
+	GatewayProvisioningStateSucceeded GatewayProvisioningState = "Succeeded"
+	GatewayProvisioningStateUpdating  GatewayProvisioningState = "Updating"
+)
@@ -0,0 +1,126 @@
+package gateways
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type GatewayId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SpringName     string
+	Name           string
+}
+
+func NewGatewayID(subscriptionId, resourceGroup, springName, name string) GatewayId {
+	return GatewayId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SpringName:     springName,
+		Name:           name,
+	}
+}
+
+func (id GatewayId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Spring Name %q", id.SpringName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Gateway", segmentsStr)
+}
+
+func (id GatewayId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s/gateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.Name)
+}
+
+// ParseGatewayID parses a Gateway ID into an GatewayId struct
+func ParseGatewayID(input string) (*GatewayId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := GatewayId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("gateways"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseGatewayIDInsensitively parses an Gateway ID into an GatewayId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseGatewayID method should be used instead for validation etc.
+func ParseGatewayIDInsensitively(input string) (*GatewayId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := GatewayId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'Spring' segment
+	springKey := "Spring"
+	for key := range id.Path {
+		if strings.EqualFold(key, springKey) {
+			springKey = key
+			break
+		}
+	}
+	if resourceId.SpringName, err = id.PopSegment(springKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'gateways' segment
+	gatewaysKey := "gateways"
+	for key := range id.Path {
+		if strings.EqualFold(key, gatewaysKey) {
+			gatewaysKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(gatewaysKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
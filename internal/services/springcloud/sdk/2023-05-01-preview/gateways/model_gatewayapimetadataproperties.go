@@ -0,0 +1,9 @@
+package gateways
+
+type GatewayApiMetadataProperties struct {
+	Description   *string `json:"description,omitempty"`
+	Documentation *string `json:"documentation,omitempty"`
+	ServerUrl     *string `json:"serverUrl,omitempty"`
+	Title         *string `json:"title,omitempty"`
+	Version       *string `json:"version,omitempty"`
+}
@@ -0,0 +1,11 @@
+package gateways
+
+type GatewayCorsProperties struct {
+	AllowCredentials      *bool     `json:"allowCredentials,omitempty"`
+	AllowedHeaders        *[]string `json:"allowedHeaders,omitempty"`
+	AllowedMethods        *[]string `json:"allowedMethods,omitempty"`
+	AllowedOriginPatterns *[]string `json:"allowedOriginPatterns,omitempty"`
+	AllowedOrigins        *[]string `json:"allowedOrigins,omitempty"`
+	ExposedHeaders        *[]string `json:"exposedHeaders,omitempty"`
+	MaxAge                *int64    `json:"maxAge,omitempty"`
+}
@@ -0,0 +1,35 @@
+package gateways
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type GatewayLocalResponseCachePerInstanceProperties struct {
+	Size       *string `json:"size,omitempty"`
+	TimeToLive *string `json:"timeToLive,omitempty"`
+}
+
+var _ json.Marshaler = GatewayLocalResponseCachePerInstanceProperties{}
+
+func (s GatewayLocalResponseCachePerInstanceProperties) MarshalJSON() ([]byte, error) {
+	type wrapper GatewayLocalResponseCachePerInstanceProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling GatewayLocalResponseCachePerInstanceProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling GatewayLocalResponseCachePerInstanceProperties: %+v", err)
+	}
+	decoded["responseCacheType"] = "LocalCachedPerInstance"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling GatewayLocalResponseCachePerInstanceProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,35 @@
+package gateways
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type GatewayLocalResponseCachePerRouteProperties struct {
+	Size       *string `json:"size,omitempty"`
+	TimeToLive *string `json:"timeToLive,omitempty"`
+}
+
+var _ json.Marshaler = GatewayLocalResponseCachePerRouteProperties{}
+
+func (s GatewayLocalResponseCachePerRouteProperties) MarshalJSON() ([]byte, error) {
+	type wrapper GatewayLocalResponseCachePerRouteProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling GatewayLocalResponseCachePerRouteProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling GatewayLocalResponseCachePerRouteProperties: %+v", err)
+	}
+	decoded["responseCacheType"] = "LocalCachedPerRoute"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling GatewayLocalResponseCachePerRouteProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
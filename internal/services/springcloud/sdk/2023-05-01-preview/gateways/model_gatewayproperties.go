@@ -0,0 +1,55 @@
+package gateways
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type GatewayProperties struct {
+	ApiMetadataProperties   *GatewayApiMetadataProperties          `json:"apiMetadataProperties,omitempty"`
+	ClientAuth              *GatewayPropertiesClientAuth           `json:"clientAuth,omitempty"`
+	CorsProperties          *GatewayCorsProperties                 `json:"corsProperties,omitempty"`
+	EnvironmentVariables    *GatewayPropertiesEnvironmentVariables `json:"environmentVariables,omitempty"`
+	HTTPSOnly               *bool                                  `json:"httpsOnly,omitempty"`
+	ProvisioningState       *GatewayProvisioningState              `json:"provisioningState,omitempty"`
+	Public                  *bool                                  `json:"public,omitempty"`
+	ResourceRequests        *GatewayResourceRequests               `json:"resourceRequests,omitempty"`
+	ResponseCacheProperties GatewayResponseCacheProperties         `json:"responseCacheProperties"`
+	SsoProperties           *SsoProperties                         `json:"ssoProperties,omitempty"`
+	Url                     *string                                `json:"url,omitempty"`
+}
+
+var _ json.Unmarshaler = &GatewayProperties{}
+
+func (s *GatewayProperties) UnmarshalJSON(bytes []byte) error {
+	type alias GatewayProperties
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into GatewayProperties: %+v", err)
+	}
+
+	s.ApiMetadataProperties = decoded.ApiMetadataProperties
+	s.ClientAuth = decoded.ClientAuth
+	s.CorsProperties = decoded.CorsProperties
+	s.EnvironmentVariables = decoded.EnvironmentVariables
+	s.HTTPSOnly = decoded.HTTPSOnly
+	s.ProvisioningState = decoded.ProvisioningState
+	s.Public = decoded.Public
+	s.ResourceRequests = decoded.ResourceRequests
+	s.SsoProperties = decoded.SsoProperties
+	s.Url = decoded.Url
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling GatewayProperties into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["responseCacheProperties"]; ok {
+		impl, err := unmarshalGatewayResponseCachePropertiesImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'ResponseCacheProperties' for 'GatewayProperties': %+v", err)
+		}
+		s.ResponseCacheProperties = impl
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+package gateways
+
+type GatewayPropertiesClientAuth struct {
+	CertificateVerification *GatewayCertificateVerification `json:"certificateVerification,omitempty"`
+	Certificates            *[]string                       `json:"certificates,omitempty"`
+}
@@ -0,0 +1,6 @@
+package gateways
+
+type GatewayPropertiesEnvironmentVariables struct {
+	Properties *map[string]string `json:"properties,omitempty"`
+	Secrets    *map[string]string `json:"secrets,omitempty"`
+}
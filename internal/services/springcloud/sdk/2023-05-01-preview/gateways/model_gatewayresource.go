@@ -0,0 +1,9 @@
+package gateways
+
+type GatewayResource struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *GatewayProperties `json:"properties,omitempty"`
+	Sku        *Sku               `json:"sku,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
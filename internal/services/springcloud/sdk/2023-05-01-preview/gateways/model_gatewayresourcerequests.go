@@ -0,0 +1,6 @@
+package gateways
+
+type GatewayResourceRequests struct {
+	Cpu    *string `json:"cpu,omitempty"`
+	Memory *string `json:"memory,omitempty"`
+}
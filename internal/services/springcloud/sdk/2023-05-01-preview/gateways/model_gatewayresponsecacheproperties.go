@@ -0,0 +1,53 @@
+package gateways
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type GatewayResponseCacheProperties interface {
+}
+
+func unmarshalGatewayResponseCachePropertiesImplementation(input []byte) (GatewayResponseCacheProperties, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling GatewayResponseCacheProperties into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["responseCacheType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "LocalCachedPerInstance") {
+		var out GatewayLocalResponseCachePerInstanceProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into GatewayLocalResponseCachePerInstanceProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "LocalCachedPerRoute") {
+		var out GatewayLocalResponseCachePerRouteProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into GatewayLocalResponseCachePerRouteProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawGatewayResponseCachePropertiesImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawGatewayResponseCachePropertiesImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
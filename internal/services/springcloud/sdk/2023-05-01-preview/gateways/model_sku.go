@@ -0,0 +1,7 @@
+package gateways
+
+type Sku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Tier     *string `json:"tier,omitempty"`
+}
@@ -0,0 +1,8 @@
+package gateways
+
+type SsoProperties struct {
+	ClientId     *string   `json:"clientId,omitempty"`
+	ClientSecret *string   `json:"clientSecret,omitempty"`
+	IssuerUri    *string   `json:"issuerUri,omitempty"`
+	Scope        *[]string `json:"scope,omitempty"`
+}
@@ -0,0 +1,96 @@
+package springcloud_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gatewaycustomdomains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SpringCloudGatewayCustomDomainResource struct {
+}
+
+func TestAccSpringCloudGatewayCustomDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_gateway_custom_domain", "test")
+	r := SpringCloudGatewayCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSpringCloudGatewayCustomDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_gateway_custom_domain", "test")
+	r := SpringCloudGatewayCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SpringCloudGatewayCustomDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := gatewaycustomdomains.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppPlatform.GatewayCustomDomainsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SpringCloudGatewayCustomDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctest-dns-%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "gateway"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  record              = azurerm_spring_cloud_gateway.test.url
+}
+
+resource "azurerm_spring_cloud_gateway_custom_domain" "test" {
+  name                    = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  spring_cloud_gateway_id = azurerm_spring_cloud_gateway.test.id
+}
+`, SpringCloudGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SpringCloudGatewayCustomDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_gateway_custom_domain" "import" {
+  name                    = azurerm_spring_cloud_gateway_custom_domain.test.name
+  spring_cloud_gateway_id = azurerm_spring_cloud_gateway_custom_domain.test.spring_cloud_gateway_id
+}
+`, r.basic(data))
+}
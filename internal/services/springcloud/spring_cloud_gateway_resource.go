@@ -0,0 +1,784 @@
+package springcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSpringCloudGateway() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSpringCloudGatewayCreateUpdate,
+		Read:   resourceSpringCloudGatewayRead,
+		Update: resourceSpringCloudGatewayCreateUpdate,
+		Delete: resourceSpringCloudGatewayDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := gateways.ParseGatewayID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				// a Spring Cloud Service can only contain a single Gateway, which must be named `default`
+				ValidateFunc: validation.StringInSlice([]string{
+					"default",
+				}, false),
+			},
+
+			"spring_cloud_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SpringCloudServiceID,
+			},
+
+			"instance_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+
+			"https_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"api_metadata": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"documentation_url": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"server_url": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"title": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"client_authorization": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"certificate_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.SpringCloudCertificateID,
+							},
+						},
+
+						"verification_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"cors": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_headers": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_methods": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"DELETE",
+									"GET",
+									"HEAD",
+									"MERGE",
+									"POST",
+									"OPTIONS",
+									"PUT",
+									"PATCH",
+								}, false),
+							},
+						},
+
+						"allowed_origins": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_origin_patterns": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"credentials_allowed": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
+						"exposed_headers": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"max_age_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"sensitive_environment_variables": {
+				Type:      pluginsdk.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"local_response_cache_per_instance": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_response_cache_per_route"},
+				Elem: &pluginsdk.Resource{
+					Schema: schemaSpringCloudGatewayLocalResponseCache(),
+				},
+			},
+
+			"local_response_cache_per_route": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_response_cache_per_instance"},
+				Elem: &pluginsdk.Resource{
+					Schema: schemaSpringCloudGatewayLocalResponseCache(),
+				},
+			},
+
+			"quota": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cpu": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "1",
+							ValidateFunc: validation.StringInSlice([]string{
+								"500m",
+								"1",
+								"2",
+							}, false),
+						},
+
+						"memory": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "2Gi",
+							ValidateFunc: validation.StringInSlice([]string{
+								"1Gi",
+								"2Gi",
+								"4Gi",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"sso": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"client_secret": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"issuer_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"scope": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaSpringCloudGatewayLocalResponseCache() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"size": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.SpringCloudGatewayResponseCacheSize,
+		},
+
+		"time_to_live": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.SpringCloudGatewayResponseCacheTimeToLive,
+		},
+	}
+}
+
+func resourceSpringCloudGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.GatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serviceId, err := parse.SpringCloudServiceID(d.Get("spring_cloud_service_id").(string))
+	if err != nil {
+		return err
+	}
+	id := gateways.NewGatewayID(serviceId.SubscriptionId, serviceId.ResourceGroup, serviceId.SpringName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_spring_cloud_gateway", id.ID())
+		}
+	}
+
+	parameters := gateways.GatewayResource{
+		Properties: &gateways.GatewayProperties{
+			ApiMetadataProperties:   expandSpringCloudGatewayApiMetadata(d.Get("api_metadata").([]interface{})),
+			ClientAuth:              expandSpringCloudGatewayClientAuthorization(d.Get("client_authorization").([]interface{})),
+			CorsProperties:          expandSpringCloudGatewayCors(d.Get("cors").([]interface{})),
+			EnvironmentVariables:    expandSpringCloudGatewayEnvironmentVariables(d.Get("environment_variables").(map[string]interface{}), d.Get("sensitive_environment_variables").(map[string]interface{})),
+			HTTPSOnly:               utils.Bool(d.Get("https_only").(bool)),
+			Public:                  utils.Bool(d.Get("public_network_access_enabled").(bool)),
+			ResourceRequests:        expandSpringCloudGatewayQuota(d.Get("quota").([]interface{})),
+			ResponseCacheProperties: expandSpringCloudGatewayResponseCache(d),
+			SsoProperties:           expandSpringCloudGatewaySso(d.Get("sso").([]interface{})),
+		},
+		Sku: &gateways.Sku{
+			Name:     utils.String(springCloudServiceEnterpriseSkuName),
+			Tier:     utils.String("Enterprise"),
+			Capacity: utils.Int64(int64(d.Get("instance_count").(int))),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSpringCloudGatewayRead(d, meta)
+}
+
+func resourceSpringCloudGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.GatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := gateways.ParseGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("spring_cloud_service_id", parse.NewSpringCloudServiceID(id.SubscriptionId, id.ResourceGroup, id.SpringName).ID())
+
+	if model := resp.Model; model != nil {
+		instanceCount := 1
+		if sku := model.Sku; sku != nil && sku.Capacity != nil {
+			instanceCount = int(*sku.Capacity)
+		}
+		d.Set("instance_count", instanceCount)
+
+		if props := model.Properties; props != nil {
+			d.Set("https_only", props.HTTPSOnly)
+			d.Set("public_network_access_enabled", props.Public)
+			d.Set("url", props.Url)
+
+			if err := d.Set("api_metadata", flattenSpringCloudGatewayApiMetadata(props.ApiMetadataProperties)); err != nil {
+				return fmt.Errorf("setting `api_metadata`: %+v", err)
+			}
+			if err := d.Set("client_authorization", flattenSpringCloudGatewayClientAuthorization(props.ClientAuth)); err != nil {
+				return fmt.Errorf("setting `client_authorization`: %+v", err)
+			}
+			if err := d.Set("cors", flattenSpringCloudGatewayCors(props.CorsProperties)); err != nil {
+				return fmt.Errorf("setting `cors`: %+v", err)
+			}
+
+			var environmentVariables map[string]string
+			if props.EnvironmentVariables != nil && props.EnvironmentVariables.Properties != nil {
+				environmentVariables = *props.EnvironmentVariables.Properties
+			}
+			if err := d.Set("environment_variables", environmentVariables); err != nil {
+				return fmt.Errorf("setting `environment_variables`: %+v", err)
+			}
+			// the sensitive environment variables aren't returned by the API, so these are left as-is
+
+			perInstance, perRoute := flattenSpringCloudGatewayResponseCache(props.ResponseCacheProperties)
+			if err := d.Set("local_response_cache_per_instance", perInstance); err != nil {
+				return fmt.Errorf("setting `local_response_cache_per_instance`: %+v", err)
+			}
+			if err := d.Set("local_response_cache_per_route", perRoute); err != nil {
+				return fmt.Errorf("setting `local_response_cache_per_route`: %+v", err)
+			}
+
+			if err := d.Set("quota", flattenSpringCloudGatewayQuota(props.ResourceRequests)); err != nil {
+				return fmt.Errorf("setting `quota`: %+v", err)
+			}
+			if err := d.Set("sso", flattenSpringCloudGatewaySso(props.SsoProperties, d.Get("sso").([]interface{}))); err != nil {
+				return fmt.Errorf("setting `sso`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceSpringCloudGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.GatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := gateways.ParseGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandSpringCloudGatewayApiMetadata(input []interface{}) *gateways.GatewayApiMetadataProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := gateways.GatewayApiMetadataProperties{}
+	if description := v["description"].(string); description != "" {
+		result.Description = utils.String(description)
+	}
+	if documentationUrl := v["documentation_url"].(string); documentationUrl != "" {
+		result.Documentation = utils.String(documentationUrl)
+	}
+	if serverUrl := v["server_url"].(string); serverUrl != "" {
+		result.ServerUrl = utils.String(serverUrl)
+	}
+	if title := v["title"].(string); title != "" {
+		result.Title = utils.String(title)
+	}
+	if version := v["version"].(string); version != "" {
+		result.Version = utils.String(version)
+	}
+	return &result
+}
+
+func expandSpringCloudGatewayClientAuthorization(input []interface{}) *gateways.GatewayPropertiesClientAuth {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	verification := gateways.GatewayCertificateVerificationDisabled
+	if v["verification_enabled"].(bool) {
+		verification = gateways.GatewayCertificateVerificationEnabled
+	}
+	return &gateways.GatewayPropertiesClientAuth{
+		CertificateVerification: &verification,
+		Certificates:            utils.ExpandStringSlice(v["certificate_ids"].([]interface{})),
+	}
+}
+
+func expandSpringCloudGatewayCors(input []interface{}) *gateways.GatewayCorsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := gateways.GatewayCorsProperties{
+		AllowCredentials:      utils.Bool(v["credentials_allowed"].(bool)),
+		AllowedHeaders:        utils.ExpandStringSlice(v["allowed_headers"].(*pluginsdk.Set).List()),
+		AllowedMethods:        utils.ExpandStringSlice(v["allowed_methods"].(*pluginsdk.Set).List()),
+		AllowedOriginPatterns: utils.ExpandStringSlice(v["allowed_origin_patterns"].(*pluginsdk.Set).List()),
+		AllowedOrigins:        utils.ExpandStringSlice(v["allowed_origins"].(*pluginsdk.Set).List()),
+		ExposedHeaders:        utils.ExpandStringSlice(v["exposed_headers"].(*pluginsdk.Set).List()),
+	}
+	if maxAge := v["max_age_seconds"].(int); maxAge != 0 {
+		result.MaxAge = utils.Int64(int64(maxAge))
+	}
+	return &result
+}
+
+func expandSpringCloudGatewayEnvironmentVariables(properties map[string]interface{}, secrets map[string]interface{}) *gateways.GatewayPropertiesEnvironmentVariables {
+	if len(properties) == 0 && len(secrets) == 0 {
+		return nil
+	}
+
+	return &gateways.GatewayPropertiesEnvironmentVariables{
+		Properties: expandSpringCloudGatewayStringMap(properties),
+		Secrets:    expandSpringCloudGatewayStringMap(secrets),
+	}
+}
+
+func expandSpringCloudGatewayStringMap(input map[string]interface{}) *map[string]string {
+	result := make(map[string]string)
+	for k, v := range input {
+		result[k] = v.(string)
+	}
+	return &result
+}
+
+func expandSpringCloudGatewayResponseCache(d *pluginsdk.ResourceData) gateways.GatewayResponseCacheProperties {
+	if raw := d.Get("local_response_cache_per_instance").([]interface{}); len(raw) > 0 {
+		result := gateways.GatewayLocalResponseCachePerInstanceProperties{}
+		if raw[0] != nil {
+			v := raw[0].(map[string]interface{})
+			if size := v["size"].(string); size != "" {
+				result.Size = utils.String(size)
+			}
+			if timeToLive := v["time_to_live"].(string); timeToLive != "" {
+				result.TimeToLive = utils.String(timeToLive)
+			}
+		}
+		return result
+	}
+
+	if raw := d.Get("local_response_cache_per_route").([]interface{}); len(raw) > 0 {
+		result := gateways.GatewayLocalResponseCachePerRouteProperties{}
+		if raw[0] != nil {
+			v := raw[0].(map[string]interface{})
+			if size := v["size"].(string); size != "" {
+				result.Size = utils.String(size)
+			}
+			if timeToLive := v["time_to_live"].(string); timeToLive != "" {
+				result.TimeToLive = utils.String(timeToLive)
+			}
+		}
+		return result
+	}
+
+	return nil
+}
+
+func expandSpringCloudGatewayQuota(input []interface{}) *gateways.GatewayResourceRequests {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	return &gateways.GatewayResourceRequests{
+		Cpu:    utils.String(v["cpu"].(string)),
+		Memory: utils.String(v["memory"].(string)),
+	}
+}
+
+func expandSpringCloudGatewaySso(input []interface{}) *gateways.SsoProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := gateways.SsoProperties{
+		Scope: utils.ExpandStringSlice(v["scope"].(*pluginsdk.Set).List()),
+	}
+	if clientId := v["client_id"].(string); clientId != "" {
+		result.ClientId = utils.String(clientId)
+	}
+	if clientSecret := v["client_secret"].(string); clientSecret != "" {
+		result.ClientSecret = utils.String(clientSecret)
+	}
+	if issuerUri := v["issuer_uri"].(string); issuerUri != "" {
+		result.IssuerUri = utils.String(issuerUri)
+	}
+	return &result
+}
+
+func flattenSpringCloudGatewayApiMetadata(input *gateways.GatewayApiMetadataProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var description, documentationUrl, serverUrl, title, version string
+	if input.Description != nil {
+		description = *input.Description
+	}
+	if input.Documentation != nil {
+		documentationUrl = *input.Documentation
+	}
+	if input.ServerUrl != nil {
+		serverUrl = *input.ServerUrl
+	}
+	if input.Title != nil {
+		title = *input.Title
+	}
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"description":       description,
+			"documentation_url": documentationUrl,
+			"server_url":        serverUrl,
+			"title":             title,
+			"version":           version,
+		},
+	}
+}
+
+func flattenSpringCloudGatewayClientAuthorization(input *gateways.GatewayPropertiesClientAuth) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	// the API returns an empty block when Client Authorization isn't configured
+	verificationEnabled := input.CertificateVerification != nil && *input.CertificateVerification == gateways.GatewayCertificateVerificationEnabled
+	certificateIds := utils.FlattenStringSlice(input.Certificates)
+	if !verificationEnabled && len(certificateIds) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_ids":      certificateIds,
+			"verification_enabled": verificationEnabled,
+		},
+	}
+}
+
+func flattenSpringCloudGatewayCors(input *gateways.GatewayCorsProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	credentialsAllowed := false
+	if input.AllowCredentials != nil {
+		credentialsAllowed = *input.AllowCredentials
+	}
+	maxAge := 0
+	if input.MaxAge != nil {
+		maxAge = int(*input.MaxAge)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_headers":         utils.FlattenStringSlice(input.AllowedHeaders),
+			"allowed_methods":         utils.FlattenStringSlice(input.AllowedMethods),
+			"allowed_origin_patterns": utils.FlattenStringSlice(input.AllowedOriginPatterns),
+			"allowed_origins":         utils.FlattenStringSlice(input.AllowedOrigins),
+			"credentials_allowed":     credentialsAllowed,
+			"exposed_headers":         utils.FlattenStringSlice(input.ExposedHeaders),
+			"max_age_seconds":         maxAge,
+		},
+	}
+}
+
+func flattenSpringCloudGatewayResponseCache(input gateways.GatewayResponseCacheProperties) (perInstance []interface{}, perRoute []interface{}) {
+	perInstance = make([]interface{}, 0)
+	perRoute = make([]interface{}, 0)
+
+	switch v := input.(type) {
+	case gateways.GatewayLocalResponseCachePerInstanceProperties:
+		perInstance = flattenSpringCloudGatewayLocalResponseCache(v.Size, v.TimeToLive)
+	case gateways.GatewayLocalResponseCachePerRouteProperties:
+		perRoute = flattenSpringCloudGatewayLocalResponseCache(v.Size, v.TimeToLive)
+	}
+
+	return perInstance, perRoute
+}
+
+func flattenSpringCloudGatewayLocalResponseCache(size *string, timeToLive *string) []interface{} {
+	var sizeValue, timeToLiveValue string
+	if size != nil {
+		sizeValue = *size
+	}
+	if timeToLive != nil {
+		timeToLiveValue = *timeToLive
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"size":         sizeValue,
+			"time_to_live": timeToLiveValue,
+		},
+	}
+}
+
+func flattenSpringCloudGatewayQuota(input *gateways.GatewayResourceRequests) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var cpu, memory string
+	if input.Cpu != nil {
+		cpu = *input.Cpu
+	}
+	if input.Memory != nil {
+		memory = *input.Memory
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cpu":    cpu,
+			"memory": memory,
+		},
+	}
+}
+
+func flattenSpringCloudGatewaySso(input *gateways.SsoProperties, existing []interface{}) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var clientId, clientSecret, issuerUri string
+	if input.ClientId != nil {
+		clientId = *input.ClientId
+	}
+	if input.IssuerUri != nil {
+		issuerUri = *input.IssuerUri
+	}
+
+	// the Client Secret isn't returned by the API, so we look it up from the config
+	if len(existing) > 0 && existing[0] != nil {
+		clientSecret = existing[0].(map[string]interface{})["client_secret"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"client_id":     clientId,
+			"client_secret": clientSecret,
+			"issuer_uri":    issuerUri,
+			"scope":         utils.FlattenStringSlice(input.Scope),
+		},
+	}
+}
@@ -0,0 +1,396 @@
+package springcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gatewayrouteconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSpringCloudGatewayRouteConfig() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSpringCloudGatewayRouteConfigCreateUpdate,
+		Read:   resourceSpringCloudGatewayRouteConfigRead,
+		Update: resourceSpringCloudGatewayRouteConfigCreateUpdate,
+		Delete: resourceSpringCloudGatewayRouteConfigDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := gatewayrouteconfigs.ParseRouteConfigID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"spring_cloud_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SpringCloudGatewayID,
+			},
+
+			"spring_cloud_app_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.SpringCloudAppID,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(gatewayrouteconfigs.GatewayRouteConfigProtocolHTTP),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(gatewayrouteconfigs.GatewayRouteConfigProtocolHTTP),
+					string(gatewayrouteconfigs.GatewayRouteConfigProtocolHTTPS),
+				}, false),
+			},
+
+			"sso_validation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"filters": schemaSpringCloudGatewayRouteFilters(),
+
+			"predicates": schemaSpringCloudGatewayRoutePredicates(),
+
+			"open_api": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+
+			"route": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"order": {
+							Type:     pluginsdk.TypeInt,
+							Required: true,
+						},
+
+						"classification_tags": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"filters": schemaSpringCloudGatewayRouteFilters(),
+
+						"predicates": schemaSpringCloudGatewayRoutePredicates(),
+
+						"sso_validation_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
+						"title": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"token_relay": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
+						"uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaSpringCloudGatewayRouteFilters() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validate.SpringCloudGatewayRouteFilter,
+		},
+	}
+}
+
+func schemaSpringCloudGatewayRoutePredicates() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validate.SpringCloudGatewayRoutePredicate,
+		},
+	}
+}
+
+func resourceSpringCloudGatewayRouteConfigCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.GatewayRouteConfigsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := gateways.ParseGatewayID(d.Get("spring_cloud_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+	id := gatewayrouteconfigs.NewRouteConfigID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.SpringName, gatewayId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_spring_cloud_gateway_route_config", id.ID())
+		}
+	}
+
+	protocol := gatewayrouteconfigs.GatewayRouteConfigProtocol(d.Get("protocol").(string))
+	parameters := gatewayrouteconfigs.GatewayRouteConfigResource{
+		Properties: &gatewayrouteconfigs.GatewayRouteConfigProperties{
+			Filters:    utils.ExpandStringSlice(d.Get("filters").([]interface{})),
+			OpenApi:    expandSpringCloudGatewayRouteConfigOpenApi(d.Get("open_api").([]interface{})),
+			Predicates: utils.ExpandStringSlice(d.Get("predicates").([]interface{})),
+			Protocol:   &protocol,
+			Routes:     expandSpringCloudGatewayRouteConfigRoutes(d.Get("route").(*pluginsdk.Set).List()),
+			SsoEnabled: utils.Bool(d.Get("sso_validation_enabled").(bool)),
+		},
+	}
+	if appId := d.Get("spring_cloud_app_id").(string); appId != "" {
+		parameters.Properties.AppResourceId = utils.String(appId)
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSpringCloudGatewayRouteConfigRead(d, meta)
+}
+
+func resourceSpringCloudGatewayRouteConfigRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.GatewayRouteConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := gatewayrouteconfigs.ParseRouteConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("spring_cloud_gateway_id", gateways.NewGatewayID(id.SubscriptionId, id.ResourceGroup, id.SpringName, id.GatewayName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			appId := ""
+			if props.AppResourceId != nil {
+				parsed, err := parse.SpringCloudAppID(*props.AppResourceId)
+				if err != nil {
+					return fmt.Errorf("parsing `appResourceId`: %+v", err)
+				}
+				appId = parsed.ID()
+			}
+			d.Set("spring_cloud_app_id", appId)
+
+			protocol := string(gatewayrouteconfigs.GatewayRouteConfigProtocolHTTP)
+			if props.Protocol != nil {
+				protocol = string(*props.Protocol)
+			}
+			d.Set("protocol", protocol)
+			d.Set("sso_validation_enabled", props.SsoEnabled)
+
+			if err := d.Set("filters", utils.FlattenStringSlice(props.Filters)); err != nil {
+				return fmt.Errorf("setting `filters`: %+v", err)
+			}
+			if err := d.Set("predicates", utils.FlattenStringSlice(props.Predicates)); err != nil {
+				return fmt.Errorf("setting `predicates`: %+v", err)
+			}
+			if err := d.Set("open_api", flattenSpringCloudGatewayRouteConfigOpenApi(props.OpenApi)); err != nil {
+				return fmt.Errorf("setting `open_api`: %+v", err)
+			}
+			if err := d.Set("route", flattenSpringCloudGatewayRouteConfigRoutes(props.Routes)); err != nil {
+				return fmt.Errorf("setting `route`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceSpringCloudGatewayRouteConfigDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).AppPlatform.GatewayRouteConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := gatewayrouteconfigs.ParseRouteConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandSpringCloudGatewayRouteConfigOpenApi(input []interface{}) *gatewayrouteconfigs.GatewayRouteConfigOpenApiProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := gatewayrouteconfigs.GatewayRouteConfigOpenApiProperties{}
+	if uri := v["uri"].(string); uri != "" {
+		result.Uri = utils.String(uri)
+	}
+	return &result
+}
+
+func expandSpringCloudGatewayRouteConfigRoutes(input []interface{}) *[]gatewayrouteconfigs.GatewayApiRoute {
+	results := make([]gatewayrouteconfigs.GatewayApiRoute, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		route := gatewayrouteconfigs.GatewayApiRoute{
+			Filters:    utils.ExpandStringSlice(v["filters"].([]interface{})),
+			Order:      utils.Int64(int64(v["order"].(int))),
+			Predicates: utils.ExpandStringSlice(v["predicates"].([]interface{})),
+			SsoEnabled: utils.Bool(v["sso_validation_enabled"].(bool)),
+			Tags:       utils.ExpandStringSlice(v["classification_tags"].(*pluginsdk.Set).List()),
+			TokenRelay: utils.Bool(v["token_relay"].(bool)),
+		}
+		if description := v["description"].(string); description != "" {
+			route.Description = utils.String(description)
+		}
+		if title := v["title"].(string); title != "" {
+			route.Title = utils.String(title)
+		}
+		if uri := v["uri"].(string); uri != "" {
+			route.Uri = utils.String(uri)
+		}
+
+		results = append(results, route)
+	}
+	return &results
+}
+
+func flattenSpringCloudGatewayRouteConfigOpenApi(input *gatewayrouteconfigs.GatewayRouteConfigOpenApiProperties) []interface{} {
+	if input == nil || input.Uri == nil {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"uri": *input.Uri,
+		},
+	}
+}
+
+func flattenSpringCloudGatewayRouteConfigRoutes(input *[]gatewayrouteconfigs.GatewayApiRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var description, title, uri string
+		if item.Description != nil {
+			description = *item.Description
+		}
+		if item.Title != nil {
+			title = *item.Title
+		}
+		if item.Uri != nil {
+			uri = *item.Uri
+		}
+
+		order := 0
+		if item.Order != nil {
+			order = int(*item.Order)
+		}
+
+		ssoValidationEnabled := false
+		if item.SsoEnabled != nil {
+			ssoValidationEnabled = *item.SsoEnabled
+		}
+
+		tokenRelay := false
+		if item.TokenRelay != nil {
+			tokenRelay = *item.TokenRelay
+		}
+
+		results = append(results, map[string]interface{}{
+			"classification_tags":    utils.FlattenStringSlice(item.Tags),
+			"description":            description,
+			"filters":                utils.FlattenStringSlice(item.Filters),
+			"order":                  order,
+			"predicates":             utils.FlattenStringSlice(item.Predicates),
+			"sso_validation_enabled": ssoValidationEnabled,
+			"title":                  title,
+			"token_relay":            tokenRelay,
+			"uri":                    uri,
+		})
+	}
+	return results
+}
@@ -0,0 +1,171 @@
+package springcloud_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/sdk/2023-05-01-preview/gatewayrouteconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SpringCloudGatewayRouteConfigResource struct {
+}
+
+func TestAccSpringCloudGatewayRouteConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_gateway_route_config", "test")
+	r := SpringCloudGatewayRouteConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSpringCloudGatewayRouteConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_gateway_route_config", "test")
+	r := SpringCloudGatewayRouteConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSpringCloudGatewayRouteConfig_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_gateway_route_config", "test")
+	r := SpringCloudGatewayRouteConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSpringCloudGatewayRouteConfig_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_gateway_route_config", "test")
+	r := SpringCloudGatewayRouteConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SpringCloudGatewayRouteConfigResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := gatewayrouteconfigs.ParseRouteConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppPlatform.GatewayRouteConfigsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SpringCloudGatewayRouteConfigResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_app" "test" {
+  name                = "acctest-sca-%d"
+  resource_group_name = azurerm_spring_cloud_service.test.resource_group_name
+  service_name        = azurerm_spring_cloud_service.test.name
+}
+`, SpringCloudGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SpringCloudGatewayRouteConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_gateway_route_config" "test" {
+  name                    = "acctest-agrc-%d"
+  spring_cloud_gateway_id = azurerm_spring_cloud_gateway.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SpringCloudGatewayRouteConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_gateway_route_config" "import" {
+  name                    = azurerm_spring_cloud_gateway_route_config.test.name
+  spring_cloud_gateway_id = azurerm_spring_cloud_gateway_route_config.test.spring_cloud_gateway_id
+}
+`, r.basic(data))
+}
+
+func (r SpringCloudGatewayRouteConfigResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_spring_cloud_gateway_route_config" "test" {
+  name                    = "acctest-agrc-%d"
+  spring_cloud_gateway_id = azurerm_spring_cloud_gateway.test.id
+  spring_cloud_app_id     = azurerm_spring_cloud_app.test.id
+  protocol                = "HTTPS"
+  sso_validation_enabled  = true
+  filters                 = ["StripPrefix=2", "RateLimit=1,1s"]
+  predicates              = ["Path=/api5/customer/**"]
+
+  open_api {
+    uri = "https://www.example.com/openapi.json"
+  }
+
+  route {
+    description            = "test description"
+    filters                = ["StripPrefix=2", "RateLimit=1,1s"]
+    order                  = 1
+    predicates             = ["Path=/api5/customer/**"]
+    sso_validation_enabled = true
+    title                  = "myApp route config"
+    token_relay            = true
+    uri                    = "https://www.test.com"
+    classification_tags    = ["tag1", "tag2"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/appplatform/mgmt/2021-06-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
@@ -172,9 +173,13 @@ func dataSourceSpringCloudServiceRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	configServer, err := configServersClient.Get(ctx, id.ResourceGroup, id.SpringName)
-	if err != nil {
-		return fmt.Errorf("retrieving config server configuration for %s: %+v", id, err)
+	// the Config Server isn't available for the Enterprise tier
+	var configServer appplatform.ConfigServerResource
+	if resp.Sku == nil || resp.Sku.Name == nil || *resp.Sku.Name != springCloudServiceEnterpriseSkuName {
+		configServer, err = configServersClient.Get(ctx, id.ResourceGroup, id.SpringName)
+		if err != nil {
+			return fmt.Errorf("retrieving config server configuration for %s: %+v", id, err)
+		}
 	}
 
 	d.SetId(id.ID())
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const springCloudServiceEnterpriseSkuName = "E0"
+
 func resourceSpringCloudService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSpringCloudServiceCreate,
@@ -64,6 +67,7 @@ func resourceSpringCloudService() *pluginsdk.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"B0",
+					"E0",
 					"S0",
 				}, false),
 			},
@@ -276,9 +280,20 @@ func resourceSpringCloudService() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(springCloudServiceCustomizeDiff),
 	}
 }
 
+func springCloudServiceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	// the Enterprise tier uses the Application Configuration Service rather than the Config Server
+	if diff.Get("sku_name").(string) == springCloudServiceEnterpriseSkuName && len(diff.Get("config_server_git_setting").([]interface{})) > 0 {
+		return fmt.Errorf("`config_server_git_setting` cannot be specified when `sku_name` is `%s`", springCloudServiceEnterpriseSkuName)
+	}
+
+	return nil
+}
+
 func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.ServicesClient
 	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
@@ -302,14 +317,13 @@ func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
+	skuName := d.Get("sku_name").(string)
 	resource := appplatform.ServiceResource{
 		Location: utils.String(location),
 		Properties: &appplatform.ClusterResourceProperties{
 			NetworkProfile: expandSpringCloudNetwork(d.Get("network").([]interface{})),
 		},
-		Sku: &appplatform.Sku{
-			Name: utils.String(d.Get("sku_name").(string)),
-		},
+		Sku:  expandSpringCloudSku(skuName),
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
@@ -320,7 +334,12 @@ func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{
 
 	// current create api doesn't take care parameters of config server.
 	// so we need to invoke create api first and then update api
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SpringName, resource)
+	var future appplatform.ServicesCreateOrUpdateFuture
+	if skuName == springCloudServiceEnterpriseSkuName {
+		future, err = azuresdkhacks.NewServicesWorkaroundClient(client).CreateOrUpdate(ctx, id.ResourceGroup, id.SpringName, resource)
+	} else {
+		future, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.SpringName, resource)
+	}
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -329,11 +348,13 @@ func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 	d.SetId(id.ID())
 
-	log.Printf("[DEBUG] Updating Config Server Settings for %s..", id)
-	if err := updateConfigServerSettings(ctx, configServersClient, id, gitProperty); err != nil {
-		return err
+	if skuName != springCloudServiceEnterpriseSkuName {
+		log.Printf("[DEBUG] Updating Config Server Settings for %s..", id)
+		if err := updateConfigServerSettings(ctx, configServersClient, id, gitProperty); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Updated Config Server Settings for %s.", id)
 	}
-	log.Printf("[DEBUG] Updated Config Server Settings for %s.", id)
 
 	log.Printf("[DEBUG] Updating Monitor Settings for %s..", id)
 	monitorSettings := appplatform.MonitoringSettingResource{
@@ -364,14 +385,18 @@ func resourceSpringCloudServiceUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("tags") {
+		skuName := d.Get("sku_name").(string)
 		model := appplatform.ServiceResource{
-			Sku: &appplatform.Sku{
-				Name: utils.String(d.Get("sku_name").(string)),
-			},
+			Sku:  expandSpringCloudSku(skuName),
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 		}
 
-		future, err := client.Update(ctx, id.ResourceGroup, id.SpringName, model)
+		var future appplatform.ServicesUpdateFuture
+		if skuName == springCloudServiceEnterpriseSkuName {
+			future, err = azuresdkhacks.NewServicesWorkaroundClient(client).Update(ctx, id.ResourceGroup, id.SpringName, model)
+		} else {
+			future, err = client.Update(ctx, id.ResourceGroup, id.SpringName, model)
+		}
 		if err != nil {
 			return fmt.Errorf("updating %s: %+v", id, err)
 		}
@@ -434,9 +459,13 @@ func resourceSpringCloudServiceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("unable to read Spring Cloud Service %q (Resource Group %q): %+v", id.SpringName, id.ResourceGroup, err)
 	}
 
-	configServer, err := configServersClient.Get(ctx, id.ResourceGroup, id.SpringName)
-	if err != nil {
-		return fmt.Errorf("retrieving config server settings for %s: %+v", id, err)
+	// the Config Server isn't available for the Enterprise tier
+	var configServer appplatform.ConfigServerResource
+	if resp.Sku == nil || resp.Sku.Name == nil || *resp.Sku.Name != springCloudServiceEnterpriseSkuName {
+		configServer, err = configServersClient.Get(ctx, id.ResourceGroup, id.SpringName)
+		if err != nil {
+			return fmt.Errorf("retrieving config server settings for %s: %+v", id, err)
+		}
 	}
 
 	monitoringSettings, err := monitoringSettingsClient.Get(ctx, id.ResourceGroup, id.SpringName)
@@ -532,6 +561,19 @@ func updateConfigServerSettings(ctx context.Context, client *appplatform.ConfigS
 	return nil
 }
 
+func expandSpringCloudSku(name string) *appplatform.Sku {
+	sku := &appplatform.Sku{
+		Name: utils.String(name),
+	}
+
+	// unlike the Basic and Standard tiers, the tier isn't inferred from the name for the Enterprise tier
+	if name == springCloudServiceEnterpriseSkuName {
+		sku.Tier = utils.String("Enterprise")
+	}
+
+	return sku
+}
+
 func expandSpringCloudNetwork(input []interface{}) *appplatform.NetworkProfile {
 	if len(input) == 0 || input[0] == nil {
 		return nil
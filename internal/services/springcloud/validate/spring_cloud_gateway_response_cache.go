@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func SpringCloudGatewayResponseCacheSize(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^[1-9][0-9]*(KB|MB|GB)$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a size in KB, MB or GB (e.g. `10MB`), got %q", key, v))
+	}
+
+	return
+}
+
+func SpringCloudGatewayResponseCacheTimeToLive(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^[1-9][0-9]*[smh]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a duration in seconds, minutes or hours (e.g. `30s`, `5m` or `1h`), got %q", key, v))
+	}
+
+	return
+}
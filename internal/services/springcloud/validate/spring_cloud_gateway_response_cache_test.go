@@ -0,0 +1,107 @@
+package validate
+
+import "testing"
+
+func TestSpringCloudGatewayResponseCacheSize(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "900KB",
+			expected: true,
+		},
+		{
+			input:    "10MB",
+			expected: true,
+		},
+		{
+			input:    "1GB",
+			expected: true,
+		},
+		{
+			input:    "0MB",
+			expected: false,
+		},
+		{
+			input:    "10",
+			expected: false,
+		},
+		{
+			input:    "10mb",
+			expected: false,
+		},
+		{
+			input:    "10 MB",
+			expected: false,
+		},
+		{
+			input:    "1TB",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := SpringCloudGatewayResponseCacheSize(v.input, "size")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestSpringCloudGatewayResponseCacheTimeToLive(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "300s",
+			expected: true,
+		},
+		{
+			input:    "5m",
+			expected: true,
+		},
+		{
+			input:    "1h",
+			expected: true,
+		},
+		{
+			input:    "0s",
+			expected: false,
+		},
+		{
+			input:    "300",
+			expected: false,
+		},
+		{
+			input:    "1d",
+			expected: false,
+		},
+		{
+			input:    "PT5M",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := SpringCloudGatewayResponseCacheTimeToLive(v.input, "time_to_live")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Predicates and Filters use the "shortcut" syntax supported by Spring Cloud Gateway, that is the name of the
+// Predicate/Filter, followed by an equals sign and a comma separated list of arguments (e.g. `Path=/api/**`)
+// https://docs.spring.io/spring-cloud-gateway/docs/current/reference/html/#shortcut-configuration
+var springCloudGatewayRouteShortcutNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+func SpringCloudGatewayRoutePredicate(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	// all Predicates take at least one argument
+	segments := strings.SplitN(v, "=", 2)
+	if !springCloudGatewayRouteShortcutNameRegex.MatchString(segments[0]) || len(segments) != 2 || strings.TrimSpace(segments[1]) == "" {
+		errors = append(errors, fmt.Errorf("%q must be a Predicate in the format `Name=arguments` (e.g. `Path=/api/**`), got %q", key, v))
+	}
+
+	return
+}
+
+func SpringCloudGatewayRouteFilter(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	// some Filters (e.g. `PreserveHostHeader`) don't take any arguments
+	segments := strings.SplitN(v, "=", 2)
+	if !springCloudGatewayRouteShortcutNameRegex.MatchString(segments[0]) || (len(segments) == 2 && strings.TrimSpace(segments[1]) == "") {
+		errors = append(errors, fmt.Errorf("%q must be a Filter in the format `Name` or `Name=arguments` (e.g. `StripPrefix=1`), got %q", key, v))
+	}
+
+	return
+}
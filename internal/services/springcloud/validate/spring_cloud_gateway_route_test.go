@@ -0,0 +1,129 @@
+package validate
+
+import "testing"
+
+func TestSpringCloudGatewayRoutePredicate(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// path
+			input:    "Path=/api/**",
+			expected: true,
+		},
+		{
+			// multiple arguments
+			input:    "Header=X-Request-Id, \\d+",
+			expected: true,
+		},
+		{
+			// method
+			input:    "Method=GET,POST",
+			expected: true,
+		},
+		{
+			// no arguments
+			input:    "Path",
+			expected: false,
+		},
+		{
+			// empty arguments
+			input:    "Path=",
+			expected: false,
+		},
+		{
+			// whitespace arguments
+			input:    "Path= ",
+			expected: false,
+		},
+		{
+			// no name
+			input:    "=/api/**",
+			expected: false,
+		},
+		{
+			// whitespace around the equals sign
+			input:    "Path = /api/**",
+			expected: false,
+		},
+		{
+			// fully expanded arguments aren't supported
+			input:    "name: Path",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := SpringCloudGatewayRoutePredicate(v.input, "predicates")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestSpringCloudGatewayRouteFilter(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// strip prefix
+			input:    "StripPrefix=1",
+			expected: true,
+		},
+		{
+			// multiple arguments
+			input:    "AddRequestHeader=X-Request-Red, Blue",
+			expected: true,
+		},
+		{
+			// no arguments
+			input:    "PreserveHostHeader",
+			expected: true,
+		},
+		{
+			// empty arguments
+			input:    "StripPrefix=",
+			expected: false,
+		},
+		{
+			// no name
+			input:    "=1",
+			expected: false,
+		},
+		{
+			// name starting with a number
+			input:    "1StripPrefix=1",
+			expected: false,
+		},
+		{
+			// whitespace around the equals sign
+			input:    "StripPrefix = 1",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := SpringCloudGatewayRouteFilter(v.input, "filters")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
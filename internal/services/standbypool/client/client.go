@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2025-03-01/standbycontainergrouppools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2025-03-01/standbyvirtualmachinepools"
+)
+
+type Client struct {
+	StandbyContainerGroupPoolsClient *standbycontainergrouppools.StandbyContainerGroupPoolsClient
+	StandbyVirtualMachinePoolsClient *standbyvirtualmachinepools.StandbyVirtualMachinePoolsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	standbyContainerGroupPoolsClient := standbycontainergrouppools.NewStandbyContainerGroupPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&standbyContainerGroupPoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	standbyVirtualMachinePoolsClient := standbyvirtualmachinepools.NewStandbyVirtualMachinePoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&standbyVirtualMachinePoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		StandbyContainerGroupPoolsClient: &standbyContainerGroupPoolsClient,
+		StandbyVirtualMachinePoolsClient: &standbyVirtualMachinePoolsClient,
+	}
+}
@@ -0,0 +1,15 @@
+package standbycontainergrouppools
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StandbyContainerGroupPoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStandbyContainerGroupPoolsClientWithBaseURI(endpoint string) StandbyContainerGroupPoolsClient {
+	return StandbyContainerGroupPoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
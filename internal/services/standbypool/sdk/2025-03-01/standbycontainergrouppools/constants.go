@@ -0,0 +1,16 @@
+package standbycontainergrouppools
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+)
+
+type RefillPolicy string
+
+const (
+	RefillPolicyAlways RefillPolicy = "always"
+)
@@ -0,0 +1,108 @@
+package standbycontainergrouppools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StandbyContainerGroupPoolId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewStandbyContainerGroupPoolID(subscriptionId, resourceGroup, name string) StandbyContainerGroupPoolId {
+	return StandbyContainerGroupPoolId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id StandbyContainerGroupPoolId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Standby Container Group Pool", segmentsStr)
+}
+
+func (id StandbyContainerGroupPoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StandbyPool/standbyContainerGroupPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseStandbyContainerGroupPoolID parses a StandbyContainerGroupPool ID into an StandbyContainerGroupPoolId struct
+func ParseStandbyContainerGroupPoolID(input string) (*StandbyContainerGroupPoolId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StandbyContainerGroupPoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("standbyContainerGroupPools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseStandbyContainerGroupPoolIDInsensitively parses an StandbyContainerGroupPool ID into an StandbyContainerGroupPoolId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseStandbyContainerGroupPoolID method should be used instead for validation etc.
+func ParseStandbyContainerGroupPoolIDInsensitively(input string) (*StandbyContainerGroupPoolId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StandbyContainerGroupPoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'standbyContainerGroupPools' segment
+	standbyContainerGroupPoolsKey := "standbyContainerGroupPools"
+	for key := range id.Path {
+		if strings.EqualFold(key, standbyContainerGroupPoolsKey) {
+			standbyContainerGroupPoolsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(standbyContainerGroupPoolsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
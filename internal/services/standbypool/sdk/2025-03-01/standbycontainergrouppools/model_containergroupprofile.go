@@ -0,0 +1,6 @@
+package standbycontainergrouppools
+
+type ContainerGroupProfile struct {
+	Id       string `json:"id"`
+	Revision *int64 `json:"revision,omitempty"`
+}
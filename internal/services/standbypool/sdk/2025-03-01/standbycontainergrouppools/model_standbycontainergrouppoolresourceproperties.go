@@ -0,0 +1,8 @@
+package standbycontainergrouppools
+
+type StandbyContainerGroupPoolResourceProperties struct {
+	ContainerGroupProperties ContainerGroupProperties                   `json:"containerGroupProperties"`
+	ElasticityProfile        StandbyContainerGroupPoolElasticityProfile `json:"elasticityProfile"`
+	ProvisioningState        *ProvisioningState                         `json:"provisioningState,omitempty"`
+	Zones                    *[]string                                  `json:"zones,omitempty"`
+}
@@ -0,0 +1,5 @@
+package standbycontainergrouppools
+
+type Subnet struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,17 @@
+package standbyvirtualmachinepools
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+)
+
+type VirtualMachineState string
+
+const (
+	VirtualMachineStateDeallocated VirtualMachineState = "Deallocated"
+	VirtualMachineStateRunning     VirtualMachineState = "Running"
+)
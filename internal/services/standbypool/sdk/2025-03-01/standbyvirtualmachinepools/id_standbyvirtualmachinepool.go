@@ -0,0 +1,108 @@
+package standbyvirtualmachinepools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StandbyVirtualMachinePoolId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewStandbyVirtualMachinePoolID(subscriptionId, resourceGroup, name string) StandbyVirtualMachinePoolId {
+	return StandbyVirtualMachinePoolId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id StandbyVirtualMachinePoolId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Standby Virtual Machine Pool", segmentsStr)
+}
+
+func (id StandbyVirtualMachinePoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StandbyPool/standbyVirtualMachinePools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseStandbyVirtualMachinePoolID parses a StandbyVirtualMachinePool ID into an StandbyVirtualMachinePoolId struct
+func ParseStandbyVirtualMachinePoolID(input string) (*StandbyVirtualMachinePoolId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StandbyVirtualMachinePoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("standbyVirtualMachinePools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseStandbyVirtualMachinePoolIDInsensitively parses an StandbyVirtualMachinePool ID into an StandbyVirtualMachinePoolId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseStandbyVirtualMachinePoolID method should be used instead for validation etc.
+func ParseStandbyVirtualMachinePoolIDInsensitively(input string) (*StandbyVirtualMachinePoolId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StandbyVirtualMachinePoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'standbyVirtualMachinePools' segment
+	standbyVirtualMachinePoolsKey := "standbyVirtualMachinePools"
+	for key := range id.Path {
+		if strings.EqualFold(key, standbyVirtualMachinePoolsKey) {
+			standbyVirtualMachinePoolsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(standbyVirtualMachinePoolsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
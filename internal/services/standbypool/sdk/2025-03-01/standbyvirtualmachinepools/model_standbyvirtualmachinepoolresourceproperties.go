@@ -0,0 +1,8 @@
+package standbyvirtualmachinepools
+
+type StandbyVirtualMachinePoolResourceProperties struct {
+	AttachedVirtualMachineScaleSetId *string                                     `json:"attachedVirtualMachineScaleSetId,omitempty"`
+	ElasticityProfile                *StandbyVirtualMachinePoolElasticityProfile `json:"elasticityProfile,omitempty"`
+	ProvisioningState                *ProvisioningState                          `json:"provisioningState,omitempty"`
+	VirtualMachineState              VirtualMachineState                         `json:"virtualMachineState"`
+}
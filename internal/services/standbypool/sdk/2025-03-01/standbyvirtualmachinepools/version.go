@@ -0,0 +1,9 @@
+package standbyvirtualmachinepools
+
+import "fmt"
+
+const defaultApiVersion = "2025-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/standbyvirtualmachinepools/%s", defaultApiVersion)
+}
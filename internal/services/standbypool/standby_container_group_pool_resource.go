@@ -0,0 +1,254 @@
+package standbypool
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2025-03-01/standbycontainergrouppools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStandbyContainerGroupPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStandbyContainerGroupPoolCreate,
+		Read:   resourceStandbyContainerGroupPoolRead,
+		Update: resourceStandbyContainerGroupPoolUpdate,
+		Delete: resourceStandbyContainerGroupPoolDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StandbyPoolName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"container_group_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"max_ready_capacity": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"container_group_profile_revision": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"refill_policy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(standbycontainergrouppools.RefillPolicyAlways),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(standbycontainergrouppools.RefillPolicyAlways),
+				}, false),
+			},
+
+			"subnet_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: networkValidate.SubnetID,
+				},
+			},
+
+			"zones": azure.SchemaZones(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceStandbyContainerGroupPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyContainerGroupPoolsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := standbycontainergrouppools.NewStandbyContainerGroupPoolID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_standby_container_group_pool", id.ID())
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, expandStandbyContainerGroupPool(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStandbyContainerGroupPoolRead(d, meta)
+}
+
+func resourceStandbyContainerGroupPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyContainerGroupPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			profile := props.ContainerGroupProperties.ContainerGroupProfile
+			d.Set("container_group_profile_id", profile.Id)
+
+			revision := 0
+			if profile.Revision != nil {
+				revision = int(*profile.Revision)
+			}
+			d.Set("container_group_profile_revision", revision)
+
+			subnetIds := make([]interface{}, 0)
+			if props.ContainerGroupProperties.SubnetIds != nil {
+				for _, v := range *props.ContainerGroupProperties.SubnetIds {
+					subnetIds = append(subnetIds, v.Id)
+				}
+			}
+			d.Set("subnet_ids", subnetIds)
+
+			d.Set("max_ready_capacity", int(props.ElasticityProfile.MaxReadyCapacity))
+
+			refillPolicy := string(standbycontainergrouppools.RefillPolicyAlways)
+			if props.ElasticityProfile.RefillPolicy != nil {
+				refillPolicy = string(*props.ElasticityProfile.RefillPolicy)
+			}
+			d.Set("refill_policy", refillPolicy)
+
+			d.Set("zones", azure.FlattenZones(props.Zones))
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceStandbyContainerGroupPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyContainerGroupPoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, expandStandbyContainerGroupPool(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStandbyContainerGroupPoolRead(d, meta)
+}
+
+func resourceStandbyContainerGroupPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyContainerGroupPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStandbyContainerGroupPool(d *pluginsdk.ResourceData) standbycontainergrouppools.StandbyContainerGroupPoolResource {
+	profile := standbycontainergrouppools.ContainerGroupProfile{
+		Id: d.Get("container_group_profile_id").(string),
+	}
+	// the latest revision of the Container Group Profile is used when a revision isn't specified
+	if v, ok := d.GetOk("container_group_profile_revision"); ok {
+		profile.Revision = utils.Int64(int64(v.(int)))
+	}
+
+	subnetIds := make([]standbycontainergrouppools.Subnet, 0)
+	for _, v := range d.Get("subnet_ids").(*pluginsdk.Set).List() {
+		subnetIds = append(subnetIds, standbycontainergrouppools.Subnet{
+			Id: v.(string),
+		})
+	}
+
+	refillPolicy := standbycontainergrouppools.RefillPolicy(d.Get("refill_policy").(string))
+
+	return standbycontainergrouppools.StandbyContainerGroupPoolResource{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &standbycontainergrouppools.StandbyContainerGroupPoolResourceProperties{
+			ContainerGroupProperties: standbycontainergrouppools.ContainerGroupProperties{
+				ContainerGroupProfile: profile,
+				SubnetIds:             &subnetIds,
+			},
+			ElasticityProfile: standbycontainergrouppools.StandbyContainerGroupPoolElasticityProfile{
+				MaxReadyCapacity: int64(d.Get("max_ready_capacity").(int)),
+				RefillPolicy:     &refillPolicy,
+			},
+			Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+}
@@ -0,0 +1,200 @@
+package standbypool_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2025-03-01/standbycontainergrouppools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StandbyContainerGroupPoolResource struct{}
+
+func TestAccStandbyContainerGroupPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_container_group_pool", "test")
+	r := StandbyContainerGroupPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStandbyContainerGroupPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_container_group_pool", "test")
+	r := StandbyContainerGroupPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStandbyContainerGroupPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_container_group_pool", "test")
+	r := StandbyContainerGroupPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StandbyContainerGroupPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StandbyPool.StandbyContainerGroupPoolsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StandbyContainerGroupPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-standbypool-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-cgprofile-deployment-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  parameters_content = jsonencode({
+    name = {
+      value = "acctest-cgprofile-%[1]d"
+    }
+  })
+
+  template_content = <<EOF
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "name": {
+      "type": "String"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.ContainerInstance/containerGroupProfiles",
+      "apiVersion": "2024-05-01-preview",
+      "name": "[parameters('name')]",
+      "location": "${azurerm_resource_group.test.location}",
+      "properties": {
+        "sku": "Standard",
+        "osType": "Linux",
+        "containers": [
+          {
+            "name": "hello-world",
+            "properties": {
+              "image": "mcr.microsoft.com/azuredocs/aci-helloworld:latest",
+              "resources": {
+                "requests": {
+                  "cpu": 1,
+                  "memoryInGB": 1.5
+                }
+              }
+            }
+          }
+        ]
+      }
+    }
+  ],
+
+  "outputs": {
+    "id": {
+      "type": "String",
+      "value": "[resourceId('Microsoft.ContainerInstance/containerGroupProfiles', parameters('name'))]"
+    }
+  }
+}
+EOF
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StandbyContainerGroupPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_container_group_pool" "test" {
+  name                       = "acctest-scgp-%s"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  container_group_profile_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  max_ready_capacity         = 1
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StandbyContainerGroupPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_container_group_pool" "import" {
+  name                       = azurerm_standby_container_group_pool.test.name
+  resource_group_name        = azurerm_standby_container_group_pool.test.resource_group_name
+  location                   = azurerm_standby_container_group_pool.test.location
+  container_group_profile_id = azurerm_standby_container_group_pool.test.container_group_profile_id
+  max_ready_capacity         = azurerm_standby_container_group_pool.test.max_ready_capacity
+}
+`, r.basic(data))
+}
+
+func (r StandbyContainerGroupPoolResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_container_group_pool" "test" {
+  name                             = "acctest-scgp-%s"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = azurerm_resource_group.test.location
+  container_group_profile_id       = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  container_group_profile_revision = 1
+  max_ready_capacity               = 2
+  refill_policy                    = "always"
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomString)
+}
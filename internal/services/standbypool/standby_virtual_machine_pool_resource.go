@@ -0,0 +1,261 @@
+package standbypool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2025-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStandbyVirtualMachinePool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStandbyVirtualMachinePoolCreate,
+		Read:   resourceStandbyVirtualMachinePoolRead,
+		Update: resourceStandbyVirtualMachinePoolUpdate,
+		Delete: resourceStandbyVirtualMachinePoolDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(standbyVirtualMachinePoolCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StandbyPoolName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"attached_virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineScaleSetID,
+			},
+
+			"max_ready_capacity": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"virtual_machine_state": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(standbyvirtualmachinepools.VirtualMachineStateDeallocated),
+					string(standbyvirtualmachinepools.VirtualMachineStateRunning),
+				}, false),
+			},
+
+			"min_ready_capacity": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func standbyVirtualMachinePoolCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	maxReadyCapacity := diff.Get("max_ready_capacity").(int)
+	if minReadyCapacity := diff.Get("min_ready_capacity").(int); minReadyCapacity > maxReadyCapacity {
+		return fmt.Errorf("`min_ready_capacity` (%d) must be less than or equal to `max_ready_capacity` (%d)", minReadyCapacity, maxReadyCapacity)
+	}
+
+	return nil
+}
+
+func resourceStandbyVirtualMachinePoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyVirtualMachinePoolsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := standbyvirtualmachinepools.NewStandbyVirtualMachinePoolID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_standby_virtual_machine_pool", id.ID())
+	}
+
+	if err := validateStandbyVirtualMachinePoolScaleSet(ctx, meta, d.Get("attached_virtual_machine_scale_set_id").(string)); err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, expandStandbyVirtualMachinePool(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStandbyVirtualMachinePoolRead(d, meta)
+}
+
+func resourceStandbyVirtualMachinePoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyVirtualMachinePoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			d.Set("attached_virtual_machine_scale_set_id", utils.NormalizeNilableString(props.AttachedVirtualMachineScaleSetId))
+
+			maxReadyCapacity := 0
+			minReadyCapacity := 0
+			if profile := props.ElasticityProfile; profile != nil {
+				maxReadyCapacity = int(profile.MaxReadyCapacity)
+				if profile.MinReadyCapacity != nil {
+					minReadyCapacity = int(*profile.MinReadyCapacity)
+				}
+			}
+			d.Set("max_ready_capacity", maxReadyCapacity)
+			d.Set("min_ready_capacity", minReadyCapacity)
+
+			d.Set("virtual_machine_state", string(props.VirtualMachineState))
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceStandbyVirtualMachinePoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyVirtualMachinePoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("attached_virtual_machine_scale_set_id") {
+		if err := validateStandbyVirtualMachinePoolScaleSet(ctx, meta, d.Get("attached_virtual_machine_scale_set_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, expandStandbyVirtualMachinePool(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStandbyVirtualMachinePoolRead(d, meta)
+}
+
+func resourceStandbyVirtualMachinePoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StandbyPool.StandbyVirtualMachinePoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// validateStandbyVirtualMachinePoolScaleSet ensures that the Virtual Machine Scale Set the Standby Pool is attached to
+// exists and uses Flexible Orchestration, since Standby Pools aren't supported for Uniform Orchestration
+func validateStandbyVirtualMachinePoolScaleSet(ctx context.Context, meta interface{}, input string) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetClient
+
+	id, err := computeParse.VirtualMachineScaleSetID(input)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("the %s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.VirtualMachineScaleSetProperties; props == nil || props.OrchestrationMode != compute.OrchestrationModeFlexible {
+		return fmt.Errorf("Standby Virtual Machine Pools can only be attached to a Virtual Machine Scale Set using `Flexible` Orchestration Mode - %s does not", *id)
+	}
+
+	return nil
+}
+
+func expandStandbyVirtualMachinePool(d *pluginsdk.ResourceData) standbyvirtualmachinepools.StandbyVirtualMachinePoolResource {
+	elasticityProfile := standbyvirtualmachinepools.StandbyVirtualMachinePoolElasticityProfile{
+		MaxReadyCapacity: int64(d.Get("max_ready_capacity").(int)),
+		MinReadyCapacity: utils.Int64(int64(d.Get("min_ready_capacity").(int))),
+	}
+
+	return standbyvirtualmachinepools.StandbyVirtualMachinePoolResource{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &standbyvirtualmachinepools.StandbyVirtualMachinePoolResourceProperties{
+			AttachedVirtualMachineScaleSetId: utils.String(d.Get("attached_virtual_machine_scale_set_id").(string)),
+			ElasticityProfile:                &elasticityProfile,
+			VirtualMachineState:              standbyvirtualmachinepools.VirtualMachineState(d.Get("virtual_machine_state").(string)),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+}
@@ -0,0 +1,204 @@
+package standbypool_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/standbypool/sdk/2025-03-01/standbyvirtualmachinepools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StandbyVirtualMachinePoolResource struct{}
+
+func TestAccStandbyVirtualMachinePool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStandbyVirtualMachinePool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStandbyVirtualMachinePool_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStandbyVirtualMachinePool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStandbyVirtualMachinePool_minReadyCapacityExceedsMax(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_standby_virtual_machine_pool", "test")
+	r := StandbyVirtualMachinePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.minReadyCapacityExceedsMax(data),
+			ExpectError: regexp.MustCompile("`min_ready_capacity` \\(3\\) must be less than or equal to `max_ready_capacity` \\(2\\)"),
+		},
+	})
+}
+
+func (r StandbyVirtualMachinePoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := standbyvirtualmachinepools.ParseStandbyVirtualMachinePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StandbyPool.StandbyVirtualMachinePoolsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StandbyVirtualMachinePoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-standbypool-%d"
+  location = "%s"
+}
+
+resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
+  name                = "acctestVMO-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  platform_fault_domain_count = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r StandbyVirtualMachinePoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "test" {
+  name                                  = "acctest-svmp-%s"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = azurerm_resource_group.test.location
+  attached_virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+  max_ready_capacity                    = 1
+  virtual_machine_state                 = "Deallocated"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StandbyVirtualMachinePoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "import" {
+  name                                  = azurerm_standby_virtual_machine_pool.test.name
+  resource_group_name                   = azurerm_standby_virtual_machine_pool.test.resource_group_name
+  location                              = azurerm_standby_virtual_machine_pool.test.location
+  attached_virtual_machine_scale_set_id = azurerm_standby_virtual_machine_pool.test.attached_virtual_machine_scale_set_id
+  max_ready_capacity                    = azurerm_standby_virtual_machine_pool.test.max_ready_capacity
+  virtual_machine_state                 = azurerm_standby_virtual_machine_pool.test.virtual_machine_state
+}
+`, r.basic(data))
+}
+
+func (r StandbyVirtualMachinePoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "test" {
+  name                                  = "acctest-svmp-%s"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = azurerm_resource_group.test.location
+  attached_virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+  max_ready_capacity                    = 3
+  min_ready_capacity                    = 1
+  virtual_machine_state                 = "Running"
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StandbyVirtualMachinePoolResource) minReadyCapacityExceedsMax(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_standby_virtual_machine_pool" "test" {
+  name                                  = "acctest-svmp-%s"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = azurerm_resource_group.test.location
+  attached_virtual_machine_scale_set_id = azurerm_orchestrated_virtual_machine_scale_set.test.id
+  max_ready_capacity                    = 2
+  min_ready_capacity                    = 3
+  virtual_machine_state                 = "Running"
+}
+`, r.template(data), data.RandomString)
+}
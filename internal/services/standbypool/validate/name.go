@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// StandbyPoolName validates the name of a Standby Virtual Machine Pool or Standby Container Group Pool
+func StandbyPoolName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters and may only contain letters, numbers and hyphens", k))
+	}
+
+	return
+}
@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStandbyPoolName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "ab",
+			Expected: false,
+		},
+		{
+			Input:    "abc",
+			Expected: true,
+		},
+		{
+			Input:    "example-pool-1",
+			Expected: true,
+		},
+		{
+			Input:    "example_pool",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 24),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 25),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := StandbyPoolName(v.Input, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
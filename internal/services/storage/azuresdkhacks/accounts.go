@@ -0,0 +1,209 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the `dnsEndpointType` and `immutableStorageWithVersioning` fields are only available from API Version `2022-09-01`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const accountsDnsEndpointTypeApiVersion = "2022-09-01"
+
+type AccountsWorkaroundClient struct {
+	sdkClient *storage.AccountsClient
+}
+
+func NewAccountsWorkaroundClient(client *storage.AccountsClient) AccountsWorkaroundClient {
+	return AccountsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+// Create asynchronously creates a new storage account with the specified parameters, including the `dnsEndpointType`.
+// Parameters:
+// resourceGroupName - the name of the resource group within the user's subscription.
+// accountName - the name of the storage account within the specified resource group.
+// parameters - the parameters to provide for the created account.
+// dnsEndpointType - the type of endpoint which should be used for the account, either `Standard` or `AzureDnsZone`.
+func (client AccountsWorkaroundClient) Create(ctx context.Context, resourceGroupName string, accountName string, parameters storage.AccountCreateParameters, dnsEndpointType string) (result storage.AccountsCreateFuture, err error) {
+	req, err := client.sdkClient.CreatePreparer(ctx, resourceGroupName, accountName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	req, err = autorest.Prepare(req, withDnsEndpointType(dnsEndpointType))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.sdkClient.CreateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "Create", nil, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetDnsEndpointType returns the `dnsEndpointType` of the specified storage account.
+// Parameters:
+// resourceGroupName - the name of the resource group within the user's subscription.
+// accountName - the name of the storage account within the specified resource group.
+func (client AccountsWorkaroundClient) GetDnsEndpointType(ctx context.Context, resourceGroupName string, accountName string) (result AccountDnsEndpointType, err error) {
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": accountsDnsEndpointTypeApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetDnsEndpointType", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.Send(req, azure.DoRetryWithRegistration(client.sdkClient.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetDnsEndpointType", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetDnsEndpointType", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type AccountDnsEndpointType struct {
+	autorest.Response `json:"-"`
+	Properties        *AccountDnsEndpointTypeProperties `json:"properties,omitempty"`
+}
+
+type AccountDnsEndpointTypeProperties struct {
+	DnsEndpointType *string `json:"dnsEndpointType,omitempty"`
+}
+
+// GetImmutableStorageWithVersioning returns the `immutableStorageWithVersioning` settings of the specified storage account.
+// Parameters:
+// resourceGroupName - the name of the resource group within the user's subscription.
+// accountName - the name of the storage account within the specified resource group.
+func (client AccountsWorkaroundClient) GetImmutableStorageWithVersioning(ctx context.Context, resourceGroupName string, accountName string) (result AccountImmutableStorageWithVersioning, err error) {
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": accountsDnsEndpointTypeApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetImmutableStorageWithVersioning", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.Send(req, azure.DoRetryWithRegistration(client.sdkClient.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetImmutableStorageWithVersioning", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetImmutableStorageWithVersioning", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+type AccountImmutableStorageWithVersioning struct {
+	autorest.Response `json:"-"`
+	Properties        *AccountImmutableStorageWithVersioningProperties `json:"properties,omitempty"`
+}
+
+type AccountImmutableStorageWithVersioningProperties struct {
+	ImmutableStorageWithVersioning *ImmutableStorageWithVersioning `json:"immutableStorageWithVersioning,omitempty"`
+}
+
+type ImmutableStorageWithVersioning struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// withDnsEndpointType sets the `dnsEndpointType` within the properties of the request body and updates the API Version
+// used for the request to one which supports this field.
+func withDnsEndpointType(dnsEndpointType string) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if r.Body == nil {
+				return r, fmt.Errorf("the request body was nil")
+			}
+
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				return r, fmt.Errorf("decoding the request body: %+v", err)
+			}
+			r.Body.Close()
+
+			properties, ok := body["properties"].(map[string]interface{})
+			if !ok {
+				properties = make(map[string]interface{})
+			}
+			properties["dnsEndpointType"] = dnsEndpointType
+			body["properties"] = properties
+
+			r, err = autorest.Prepare(r, autorest.WithJSON(body))
+			if err != nil {
+				return r, err
+			}
+
+			query := r.URL.Query()
+			query.Set("api-version", accountsDnsEndpointTypeApiVersion)
+			r.URL.RawQuery = query.Encode()
+
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+// the `Cold` Access Tier and Blob-level Immutability Policies are only available from API Version `2021-12-02`
+// (see `internal/azuresdkhacks` for when this workaround client is used)
+const blobsApiVersion = "2021-12-02"
+
+const (
+	// BlobAccessTierCold is the `Cold` Access Tier, which isn't defined in the SDK
+	BlobAccessTierCold blobs.AccessTier = "Cold"
+)
+
+type BlobImmutabilityPolicyMode string
+
+const (
+	BlobImmutabilityPolicyModeLocked   BlobImmutabilityPolicyMode = "Locked"
+	BlobImmutabilityPolicyModeUnlocked BlobImmutabilityPolicyMode = "Unlocked"
+)
+
+type BlobsWorkaroundClient struct {
+	sdkClient *blobs.Client
+}
+
+func NewBlobsWorkaroundClient(client *blobs.Client) BlobsWorkaroundClient {
+	return BlobsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type BlobProperties struct {
+	autorest.Response
+
+	AccessTier blobs.AccessTier
+
+	// ImmutabilityPolicyExpiry is the date/time (in RFC1123 format) until which the blob is immutable
+	ImmutabilityPolicyExpiry string
+	ImmutabilityPolicyMode   BlobImmutabilityPolicyMode
+}
+
+// SetTier sets the tier on a blob, using an API Version which supports the `Cold` Access Tier.
+func (client BlobsWorkaroundClient) SetTier(ctx context.Context, accountName, containerName, blobName string, tier blobs.AccessTier) (result autorest.Response, err error) {
+	req, err := client.sdkClient.SetTierPreparer(ctx, accountName, containerName, blobName, tier)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "SetTier", nil, "Failure preparing request")
+		return
+	}
+
+	req.Header.Set("x-ms-version", blobsApiVersion)
+
+	resp, err := client.sdkClient.SetTierSender(req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "blobs.Client", "SetTier", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.sdkClient.SetTierResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "SetTier", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// GetProperties returns the Access Tier and Immutability Policy of the specified blob.
+func (client BlobsWorkaroundClient) GetProperties(ctx context.Context, accountName, containerName, blobName string) (result BlobProperties, err error) {
+	req, err := client.sdkClient.GetPropertiesPreparer(ctx, accountName, containerName, blobName, blobs.GetPropertiesInput{})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "GetProperties", nil, "Failure preparing request")
+		return
+	}
+
+	req.Header.Set("x-ms-version", blobsApiVersion)
+
+	resp, err := client.sdkClient.GetPropertiesSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "blobs.Client", "GetProperties", resp, "Failure sending request")
+		return
+	}
+
+	if resp != nil && resp.Header != nil {
+		result.AccessTier = blobs.AccessTier(resp.Header.Get("x-ms-access-tier"))
+		result.ImmutabilityPolicyExpiry = resp.Header.Get("x-ms-immutability-policy-until-date")
+		result.ImmutabilityPolicyMode = BlobImmutabilityPolicyMode(resp.Header.Get("x-ms-immutability-policy-mode"))
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.sdkClient.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "GetProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// SetImmutabilityPolicy sets the Immutability Policy on a blob.
+// Parameters:
+// expiry - the date/time (in RFC1123 format) until which the blob should be immutable.
+// mode - the mode of the Immutability Policy, either `Locked` or `Unlocked`.
+func (client BlobsWorkaroundClient) SetImmutabilityPolicy(ctx context.Context, accountName, containerName, blobName string, expiry string, mode BlobImmutabilityPolicyMode) (result autorest.Response, err error) {
+	headers := map[string]interface{}{
+		"x-ms-version":                        blobsApiVersion,
+		"x-ms-immutability-policy-until-date": expiry,
+		"x-ms-immutability-policy-mode":       string(mode),
+	}
+
+	req, err := client.immutabilityPolicyPreparer(ctx, autorest.AsPut(), accountName, containerName, blobName, headers)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "SetImmutabilityPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.Send(req, azure.DoRetryWithRegistration(client.sdkClient.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "blobs.Client", "SetImmutabilityPolicy", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.sdkClient.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "SetImmutabilityPolicy", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// DeleteImmutabilityPolicy removes the Immutability Policy from a blob - this is only possible when the
+// Immutability Policy is `Unlocked`.
+func (client BlobsWorkaroundClient) DeleteImmutabilityPolicy(ctx context.Context, accountName, containerName, blobName string) (result autorest.Response, err error) {
+	headers := map[string]interface{}{
+		"x-ms-version": blobsApiVersion,
+	}
+
+	req, err := client.immutabilityPolicyPreparer(ctx, autorest.AsDelete(), accountName, containerName, blobName, headers)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "DeleteImmutabilityPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.sdkClient.Send(req, azure.DoRetryWithRegistration(client.sdkClient.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "blobs.Client", "DeleteImmutabilityPolicy", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.sdkClient.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "blobs.Client", "DeleteImmutabilityPolicy", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (client BlobsWorkaroundClient) immutabilityPolicyPreparer(ctx context.Context, method autorest.PrepareDecorator, accountName, containerName, blobName string, headers map[string]interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"containerName": autorest.Encode("path", containerName),
+		"blobName":      autorest.Encode("path", blobName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp": autorest.Encode("query", "immutabilityPolicies"),
+	}
+
+	preparer := autorest.CreatePreparer(
+		method,
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.blob.%s", accountName, client.sdkClient.BaseURI)),
+		autorest.WithPathParameters("/{containerName}/{blobName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
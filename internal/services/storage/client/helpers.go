@@ -20,6 +20,7 @@ var (
 type accountDetails struct {
 	ID            string
 	ResourceGroup string
+	Kind          storage.Kind
 	Properties    *storage.AccountProperties
 
 	accountKey *string
@@ -130,6 +131,7 @@ func populateAccountDetails(accountName string, props storage.Account) (*account
 		name:          accountName,
 		ID:            accountId,
 		ResourceGroup: id.ResourceGroup,
+		Kind:          props.Kind,
 		Properties:    props.AccountProperties,
 	}, nil
 }
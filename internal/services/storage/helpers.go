@@ -1,6 +1,10 @@
 package storage
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -72,3 +76,197 @@ func schemaStorageAccountCorsRule(patchEnabled bool) *pluginsdk.Schema {
 		},
 	}
 }
+
+func schemaStorageAccountQueueLogging() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"delete": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+				"read": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+				"write": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+				"retention_policy_days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func schemaStorageAccountQueueMetrics() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"enabled": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func schemaStorageAccountShareRetentionPolicy() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"days": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      7,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func schemaStorageAccountShareSMB() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"versions": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"SMB2.1",
+							"SMB3.0",
+							"SMB3.1.1",
+						}, false),
+					},
+				},
+
+				"authentication_types": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"NTLMv2",
+							"Kerberos",
+						}, false),
+					},
+				},
+
+				"kerberos_ticket_encryption_type": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"RC4-HMAC",
+							"AES-256",
+						}, false),
+					},
+				},
+
+				"channel_encryption_type": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"AES-128-CCM",
+							"AES-128-GCM",
+							"AES-256-GCM",
+						}, false),
+					},
+				},
+
+				// SMB Multichannel is only supported for Premium FileStorage accounts
+				"multichannel_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+// validateStorageAccountCorsRules validates the limits which Azure applies to the CORS rules for a Storage Service,
+// which can't be expressed in the schema - see https://learn.microsoft.com/rest/api/storageservices/cross-origin-resource-sharing--cors--support-for-the-azure-storage-services
+func validateStorageAccountCorsRules(input []interface{}) error {
+	// the size of all of the CORS rules (excluding the XML tags) mustn't exceed 2KiB
+	const maxCorsRulesSize = 2 * 1024
+	// up to two prefixed headers (e.g. `x-ms-meta-*`) can be specified for each of the allowed and exposed headers
+	const maxPrefixedHeaders = 2
+
+	size := 0
+	for i, raw := range input {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"allowed_headers", "exposed_headers"} {
+			prefixedHeaders := 0
+			for _, header := range rule[key].([]interface{}) {
+				v, _ := header.(string)
+				if len(v) > 1 && strings.HasSuffix(v, "*") {
+					prefixedHeaders++
+				}
+			}
+			if prefixedHeaders > maxPrefixedHeaders {
+				return fmt.Errorf("`cors_rule.%d.%s` can contain at most %d prefixed headers but got %d", i, key, maxPrefixedHeaders, prefixedHeaders)
+			}
+		}
+
+		for _, key := range []string{"allowed_origins", "allowed_methods", "allowed_headers", "exposed_headers"} {
+			for _, value := range rule[key].([]interface{}) {
+				v, _ := value.(string)
+				size += len(v)
+			}
+		}
+		size += len(strconv.Itoa(rule["max_age_in_seconds"].(int)))
+	}
+
+	if size > maxCorsRulesSize {
+		return fmt.Errorf("the combined size of the `cors_rule` blocks can be at most %d bytes but got %d bytes", maxCorsRulesSize, size)
+	}
+
+	return nil
+}
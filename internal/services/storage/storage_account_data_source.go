@@ -10,6 +10,7 @@ import (
 	azautorest "github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -69,6 +70,11 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"dns_endpoint_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"enable_https_traffic_only": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -304,11 +310,22 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	// the `dnsEndpointType` isn't available in the version of the SDK being used, so we retrieve it separately
+	dnsEndpointTypeResp, err := azuresdkhacks.NewAccountsWorkaroundClient(client).GetDnsEndpointType(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("retrieving `dns_endpoint_type` for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	dnsEndpointType := storageAccountDnsEndpointTypeStandard
+	if props := dnsEndpointTypeResp.Properties; props != nil && props.DnsEndpointType != nil && *props.DnsEndpointType != "" {
+		dnsEndpointType = *props.DnsEndpointType
+	}
+
 	accountKeys := keys.Keys
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 	d.Set("account_kind", resp.Kind)
+	d.Set("dns_endpoint_type", dnsEndpointType)
 
 	if sku := resp.Sku; sku != nil {
 		d.Set("account_tier", sku.Tier)
@@ -336,11 +353,17 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 			storageAccessKeys := *accessKeys
 			if len(storageAccessKeys) > 0 {
 				pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccessKeys[0].Value, endpointSuffix)
+				if dnsEndpointType == storageAccountDnsEndpointTypeAzureDnsZone {
+					pcs = getZonalConnectionString(props.PrimaryEndpoints, *resp.Name, *storageAccessKeys[0].Value)
+				}
 				d.Set("primary_connection_string", pcs)
 			}
 
 			if len(storageAccessKeys) > 1 {
 				scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccessKeys[1].Value, endpointSuffix)
+				if dnsEndpointType == storageAccountDnsEndpointTypeAzureDnsZone {
+					scs = getZonalConnectionString(props.SecondaryEndpoints, *resp.Name, *storageAccessKeys[1].Value)
+				}
 				d.Set("secondary_connection_string", scs)
 			}
 		}
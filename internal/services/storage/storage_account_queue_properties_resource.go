@@ -0,0 +1,278 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/queue/queues"
+)
+
+func resourceStorageAccountQueueProperties() *pluginsdk.Resource {
+	// the Queue Service defaults these (e.g. enabling the Hour Metrics) when they're not specified
+	logging := schemaStorageAccountQueueLogging()
+	logging.Computed = true
+	hourMetrics := schemaStorageAccountQueueMetrics()
+	hourMetrics.Computed = true
+	minuteMetrics := schemaStorageAccountQueueMetrics()
+	minuteMetrics.Computed = true
+
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountQueuePropertiesCreate,
+		Read:   resourceStorageAccountQueuePropertiesRead,
+		Update: resourceStorageAccountQueuePropertiesUpdate,
+		Delete: resourceStorageAccountQueuePropertiesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"cors_rule": schemaStorageAccountCorsRule(false),
+
+			"logging": logging,
+
+			"hour_metrics": hourMetrics,
+
+			"minute_metrics": minuteMetrics,
+		},
+	}
+}
+
+func resourceStorageAccountQueuePropertiesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	queueClient, err := storageAccountQueuePropertiesClient(ctx, storageClient, *id)
+	if err != nil {
+		return err
+	}
+
+	existing, err := queueClient.GetServiceProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Queue Properties for %s: %+v", *id, err)
+	}
+	if checkForNonDefaultStorageAccountQueueProperties(existing) {
+		return tf.ImportAsExistsError("azurerm_storage_account_queue_properties", id.ID())
+	}
+
+	if err := storageAccountQueuePropertiesSet(ctx, queueClient, d, *id); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageAccountQueuePropertiesRead(d, meta)
+}
+
+func resourceStorageAccountQueuePropertiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] %s was not found - removing Queue Properties from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	queueClient, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %+v", err)
+	}
+
+	props, err := queueClient.GetServiceProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Queue Properties for %s: %+v", *id, err)
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	queueProperties := make(map[string]interface{})
+	if flattened := flattenQueueProperties(props); len(flattened) > 0 {
+		queueProperties = flattened[0].(map[string]interface{})
+	}
+	for _, key := range []string{"cors_rule", "logging", "hour_metrics", "minute_metrics"} {
+		value, ok := queueProperties[key]
+		if !ok {
+			value = []interface{}{}
+		}
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountQueuePropertiesUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	queueClient, err := storageAccountQueuePropertiesClient(ctx, storageClient, *id)
+	if err != nil {
+		return err
+	}
+
+	if err := storageAccountQueuePropertiesSet(ctx, queueClient, d, *id); err != nil {
+		return err
+	}
+
+	return resourceStorageAccountQueuePropertiesRead(d, meta)
+}
+
+func resourceStorageAccountQueuePropertiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if account == nil {
+		return nil
+	}
+
+	queueClient, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %+v", err)
+	}
+
+	// the Queue Properties can't be deleted, so we'll reset them back to the defaults instead
+	defaults, err := expandQueueProperties([]interface{}{})
+	if err != nil {
+		return fmt.Errorf("expanding the default Queue Properties: %+v", err)
+	}
+
+	if err := queueClient.UpdateServiceProperties(ctx, id.ResourceGroup, id.Name, defaults); err != nil {
+		return fmt.Errorf("resetting Queue Properties for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func storageAccountQueuePropertiesClient(ctx context.Context, storageClient *client.Client, id parse.StorageAccountId) (shim.StorageQueuesWrapper, error) {
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("%s was not found", id)
+	}
+
+	// the Queue Service is only available for Standard Storage and StorageV2 accounts
+	if account.Kind != storage.Storage && account.Kind != storage.StorageV2 {
+		return nil, fmt.Errorf("Queue Properties aren't supported for %s since it has the kind %q", id, string(account.Kind))
+	}
+
+	queueClient, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Queues Client: %+v", err)
+	}
+
+	return queueClient, nil
+}
+
+func storageAccountQueuePropertiesSet(ctx context.Context, queueClient shim.StorageQueuesWrapper, d *pluginsdk.ResourceData, id parse.StorageAccountId) error {
+	corsRules := d.Get("cors_rule").([]interface{})
+	if err := validateStorageAccountCorsRules(corsRules); err != nil {
+		return err
+	}
+
+	props, err := expandQueueProperties([]interface{}{
+		map[string]interface{}{
+			"cors_rule":      corsRules,
+			"logging":        d.Get("logging").([]interface{}),
+			"hour_metrics":   d.Get("hour_metrics").([]interface{}),
+			"minute_metrics": d.Get("minute_metrics").([]interface{}),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("expanding Queue Properties: %+v", err)
+	}
+
+	if err := queueClient.UpdateServiceProperties(ctx, id.ResourceGroup, id.Name, props); err != nil {
+		return fmt.Errorf("updating Queue Properties for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// To make sure that someone isn't overriding their existing Queue Properties, we'll check for any CORS Rules or Logging
+func checkForNonDefaultStorageAccountQueueProperties(input *queues.StorageServiceProperties) bool {
+	if input == nil {
+		return false
+	}
+
+	if input.Cors != nil && len(input.Cors.CorsRule) > 0 && input.Cors.CorsRule[0].AllowedOrigins != "" {
+		return true
+	}
+
+	if logging := input.Logging; logging != nil && (logging.Delete || logging.Read || logging.Write) {
+		return true
+	}
+
+	return false
+}
@@ -21,6 +21,7 @@ import (
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -37,6 +38,11 @@ import (
 var storageAccountResourceName = "azurerm_storage_account"
 var allowPublicNestedItemsName = getDefaultAllowBlobPublicAccessName()
 
+const (
+	storageAccountDnsEndpointTypeStandard     = "Standard"
+	storageAccountDnsEndpointTypeAzureDnsZone = "AzureDnsZone"
+)
+
 func resourceStorageAccount() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStorageAccountCreate,
@@ -207,6 +213,17 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"dns_endpoint_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  storageAccountDnsEndpointTypeStandard,
+				ValidateFunc: validation.StringInSlice([]string{
+					storageAccountDnsEndpointTypeStandard,
+					storageAccountDnsEndpointTypeAzureDnsZone,
+				}, false),
+			},
+
 			"enable_https_traffic_only": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -439,92 +456,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
-						"cors_rule": schemaStorageAccountCorsRule(false),
-						"logging": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"version": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"delete": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-									"read": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-									"write": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-									"retention_policy_days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-						},
-						"hour_metrics": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"version": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-									"include_apis": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"retention_policy_days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-						},
-						"minute_metrics": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"version": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-									"include_apis": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"retention_policy_days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-						},
+						"cors_rule":      schemaStorageAccountCorsRule(false),
+						"logging":        schemaStorageAccountQueueLogging(),
+						"hour_metrics":   schemaStorageAccountQueueMetrics(),
+						"minute_metrics": schemaStorageAccountQueueMetrics(),
 					},
 				},
 			},
@@ -570,80 +505,9 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					Schema: map[string]*pluginsdk.Schema{
 						"cors_rule": schemaStorageAccountCorsRule(true),
 
-						"retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										Default:      7,
-										ValidateFunc: validation.IntBetween(1, 365),
-									},
-								},
-							},
-						},
-
-						"smb": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"versions": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"SMB2.1",
-												"SMB3.0",
-												"SMB3.1.1",
-											}, false),
-										},
-									},
-
-									"authentication_types": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"NTLMv2",
-												"Kerberos",
-											}, false),
-										},
-									},
-
-									"kerberos_ticket_encryption_type": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"RC4-HMAC",
-												"AES-256",
-											}, false),
-										},
-									},
+						"retention_policy": schemaStorageAccountShareRetentionPolicy(),
 
-									"channel_encryption_type": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"AES-128-CCM",
-												"AES-128-GCM",
-												"AES-256-GCM",
-											}, false),
-										},
-									},
-								},
-							},
-						},
+						"smb": schemaStorageAccountShareSMB(),
 					},
 				},
 			},
@@ -1030,7 +894,13 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
+	var future storage.AccountsCreateFuture
+	if dnsEndpointType := d.Get("dns_endpoint_type").(string); dnsEndpointType != storageAccountDnsEndpointTypeStandard {
+		// the `dnsEndpointType` can only be set when the Storage Account is created
+		future, err = azuresdkhacks.NewAccountsWorkaroundClient(client).Create(ctx, resourceGroupName, storageAccountName, parameters, dnsEndpointType)
+	} else {
+		future, err = client.Create(ctx, resourceGroupName, storageAccountName, parameters)
+	}
 	if err != nil {
 		return fmt.Errorf("creating Azure Storage Account %q: %+v", storageAccountName, err)
 	}
@@ -1100,7 +970,12 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		if accountKind == string(storage.FileStorage) || accountKind != string(storage.BlobStorage) && accountKind != string(storage.BlockBlobStorage) && accountTier != string(storage.Premium) {
 			fileServiceClient := meta.(*clients.Client).Storage.FileServicesClient
 
-			if _, err = fileServiceClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, expandShareProperties(val.([]interface{}))); err != nil {
+			shareProperties, err := expandShareProperties(val.([]interface{}), storageAccountSupportsSMBMultichannel(accountKind, accountTier))
+			if err != nil {
+				return fmt.Errorf("expanding `share_properties` for Azure Storage Account %q: %+v", storageAccountName, err)
+			}
+
+			if _, err = fileServiceClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, shareProperties); err != nil {
 				return fmt.Errorf("updating Azure Storage Account `share_properties` %q: %+v", storageAccountName, err)
 			}
 		} else {
@@ -1474,7 +1349,12 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		if accountKind == string(storage.FileStorage) || accountKind != string(storage.BlobStorage) && accountKind != string(storage.BlockBlobStorage) && accountTier != string(storage.Premium) {
 			fileServiceClient := meta.(*clients.Client).Storage.FileServicesClient
 
-			if _, err = fileServiceClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, expandShareProperties(d.Get("share_properties").([]interface{}))); err != nil {
+			shareProperties, err := expandShareProperties(d.Get("share_properties").([]interface{}), storageAccountSupportsSMBMultichannel(accountKind, accountTier))
+			if err != nil {
+				return fmt.Errorf("expanding `share_properties` for Azure Storage Account %q: %+v", storageAccountName, err)
+			}
+
+			if _, err = fileServiceClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, shareProperties); err != nil {
 				return fmt.Errorf("updating Azure Storage Account `file share_properties` %q: %+v", storageAccountName, err)
 			}
 		} else {
@@ -1559,12 +1439,26 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	// the `dnsEndpointType` isn't available in the version of the SDK being used, so we retrieve it separately - since
+	// this can't be changed once set, this is only retrieved when it's unknown (e.g. on import) or isn't the default
+	dnsEndpointType := storageAccountDnsEndpointTypeStandard
+	if d.Get("dns_endpoint_type").(string) != storageAccountDnsEndpointTypeStandard {
+		dnsEndpointTypeResp, err := azuresdkhacks.NewAccountsWorkaroundClient(client).GetDnsEndpointType(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving `dns_endpoint_type` for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+		if props := dnsEndpointTypeResp.Properties; props != nil && props.DnsEndpointType != nil && *props.DnsEndpointType != "" {
+			dnsEndpointType = *props.DnsEndpointType
+		}
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroupName)
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 	d.Set("account_kind", resp.Kind)
+	d.Set("dns_endpoint_type", dnsEndpointType)
 
 	if sku := resp.Sku; sku != nil {
 		d.Set("account_tier", sku.Tier)
@@ -1630,11 +1524,17 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 			storageAccountKeys := *accessKeys
 			if len(storageAccountKeys) > 0 {
 				pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccountKeys[0].Value, endpointSuffix)
+				if dnsEndpointType == storageAccountDnsEndpointTypeAzureDnsZone {
+					pcs = getZonalConnectionString(props.PrimaryEndpoints, *resp.Name, *storageAccountKeys[0].Value)
+				}
 				d.Set("primary_connection_string", pcs)
 			}
 
 			if len(storageAccountKeys) > 1 {
 				scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccountKeys[1].Value, endpointSuffix)
+				if dnsEndpointType == storageAccountDnsEndpointTypeAzureDnsZone {
+					scs = getZonalConnectionString(props.SecondaryEndpoints, *resp.Name, *storageAccountKeys[1].Value)
+				}
 				d.Set("secondary_connection_string", scs)
 			}
 		}
@@ -2111,7 +2011,7 @@ func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
 	return &blobCorsRules
 }
 
-func expandShareProperties(input []interface{}) storage.FileServiceProperties {
+func expandShareProperties(input []interface{}, multichannelSupported bool) (storage.FileServiceProperties, error) {
 	props := storage.FileServiceProperties{
 		FileServicePropertiesProperties: &storage.FileServicePropertiesProperties{
 			Cors: &storage.CorsRules{
@@ -2124,7 +2024,7 @@ func expandShareProperties(input []interface{}) storage.FileServiceProperties {
 	}
 
 	if len(input) == 0 || input[0] == nil {
-		return props
+		return props, nil
 	}
 
 	v := input[0].(map[string]interface{})
@@ -2133,31 +2033,53 @@ func expandShareProperties(input []interface{}) storage.FileServiceProperties {
 
 	props.FileServicePropertiesProperties.Cors = expandBlobPropertiesCors(v["cors_rule"].([]interface{}))
 
+	smb, err := expandSharePropertiesSMB(v["smb"].([]interface{}), multichannelSupported)
+	if err != nil {
+		return props, fmt.Errorf("expanding `smb`: %+v", err)
+	}
 	props.ProtocolSettings = &storage.ProtocolSettings{
-		Smb: expandSharePropertiesSMB(v["smb"].([]interface{})),
+		Smb: smb,
 	}
 
-	return props
+	return props, nil
 }
 
-func expandSharePropertiesSMB(input []interface{}) *storage.SmbSetting {
+func expandSharePropertiesSMB(input []interface{}, multichannelSupported bool) (*storage.SmbSetting, error) {
 	if len(input) == 0 || input[0] == nil {
 		return &storage.SmbSetting{
 			Versions:                 utils.String(""),
 			AuthenticationMethods:    utils.String(""),
 			KerberosTicketEncryption: utils.String(""),
 			ChannelEncryption:        utils.String(""),
-		}
+		}, nil
 	}
 
 	v := input[0].(map[string]interface{})
 
-	return &storage.SmbSetting{
+	smb := &storage.SmbSetting{
 		Versions:                 utils.ExpandStringSliceWithDelimiter(v["versions"].(*pluginsdk.Set).List(), ";"),
 		AuthenticationMethods:    utils.ExpandStringSliceWithDelimiter(v["authentication_types"].(*pluginsdk.Set).List(), ";"),
 		KerberosTicketEncryption: utils.ExpandStringSliceWithDelimiter(v["kerberos_ticket_encryption_type"].(*pluginsdk.Set).List(), ";"),
 		ChannelEncryption:        utils.ExpandStringSliceWithDelimiter(v["channel_encryption_type"].(*pluginsdk.Set).List(), ";"),
 	}
+
+	// the Multichannel setting can only be sent for accounts which support it, otherwise the API returns an error
+	multichannelEnabled := v["multichannel_enabled"].(bool)
+	if multichannelSupported {
+		smb.Multichannel = &storage.Multichannel{
+			Enabled: utils.Bool(multichannelEnabled),
+		}
+	} else if multichannelEnabled {
+		return nil, fmt.Errorf("`multichannel_enabled` can only be set for Premium FileStorage accounts")
+	}
+
+	return smb, nil
+}
+
+// storageAccountSupportsSMBMultichannel returns whether SMB Multichannel can be configured for a Storage Account of
+// the specified Kind and Tier, which is only the case for Premium FileStorage accounts
+func storageAccountSupportsSMBMultichannel(accountKind, accountTier string) bool {
+	return accountKind == string(storage.FileStorage) && accountTier == string(storage.Premium)
 }
 
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
@@ -2734,7 +2656,12 @@ func flattenedSharePropertiesSMB(input *storage.SmbSetting) []interface{} {
 		channelEncryption = utils.FlattenStringSliceWithDelimiter(input.ChannelEncryption, ";")
 	}
 
-	if len(versions) == 0 && len(authenticationMethods) == 0 && len(kerberosTicketEncryption) == 0 && len(channelEncryption) == 0 {
+	multichannelEnabled := false
+	if input.Multichannel != nil && input.Multichannel.Enabled != nil {
+		multichannelEnabled = *input.Multichannel.Enabled
+	}
+
+	if len(versions) == 0 && len(authenticationMethods) == 0 && len(kerberosTicketEncryption) == 0 && len(channelEncryption) == 0 && !multichannelEnabled {
 		return []interface{}{}
 	}
 
@@ -2744,6 +2671,7 @@ func flattenedSharePropertiesSMB(input *storage.SmbSetting) []interface{} {
 			"authentication_types":            authenticationMethods,
 			"kerberos_ticket_encryption_type": kerberosTicketEncryption,
 			"channel_encryption_type":         channelEncryption,
+			"multichannel_enabled":            multichannelEnabled,
 		},
 	}
 }
@@ -2849,6 +2777,28 @@ func flattenAzureRmStorageAccountIdentity(identity *storage.Identity) ([]interfa
 	}, nil
 }
 
+// getZonalConnectionString returns a Connection String for a Storage Account using Azure DNS Zone endpoints, where the
+// hostnames contain a DNS Zone identifier and as such can't be built from the Endpoint Suffix
+func getZonalConnectionString(endpoints *storage.Endpoints, accountName string, accountKey string) string {
+	connectionString := "DefaultEndpointsProtocol=https"
+	if endpoints != nil {
+		if endpoints.Blob != nil {
+			connectionString += fmt.Sprintf(";BlobEndpoint=%s", *endpoints.Blob)
+		}
+		if endpoints.Queue != nil {
+			connectionString += fmt.Sprintf(";QueueEndpoint=%s", *endpoints.Queue)
+		}
+		if endpoints.Table != nil {
+			connectionString += fmt.Sprintf(";TableEndpoint=%s", *endpoints.Table)
+		}
+		if endpoints.File != nil {
+			connectionString += fmt.Sprintf(";FileEndpoint=%s", *endpoints.File)
+		}
+	}
+
+	return fmt.Sprintf("%s;AccountName=%s;AccountKey=%s", connectionString, accountName, accountKey)
+}
+
 func getBlobConnectionString(blobEndpoint *string, acctName *string, acctKey *string) string {
 	var endpoint string
 	if blobEndpoint != nil {
@@ -0,0 +1,274 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountShareProperties() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountSharePropertiesCreate,
+		Read:   resourceStorageAccountSharePropertiesRead,
+		Update: resourceStorageAccountSharePropertiesUpdate,
+		Delete: resourceStorageAccountSharePropertiesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"cors_rule": schemaStorageAccountCorsRule(true),
+
+			"retention_policy": schemaStorageAccountShareRetentionPolicy(),
+
+			"smb": schemaStorageAccountShareSMB(),
+		},
+	}
+}
+
+func resourceStorageAccountSharePropertiesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	account, err := accountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if err := checkStorageAccountSupportsShareProperties(*id, account); err != nil {
+		return err
+	}
+
+	existing, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Share Properties for %s: %+v", *id, err)
+	}
+	if checkForNonDefaultStorageAccountShareProperties(existing) {
+		return tf.ImportAsExistsError("azurerm_storage_account_share_properties", id.ID())
+	}
+
+	if err := storageAccountSharePropertiesSet(ctx, client, d, *id, account); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageAccountSharePropertiesRead(d, meta)
+}
+
+func resourceStorageAccountSharePropertiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing Share Properties from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Share Properties for %s: %+v", *id, err)
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	shareProperties := make(map[string]interface{})
+	if flattened := flattenShareProperties(resp); len(flattened) > 0 {
+		shareProperties = flattened[0].(map[string]interface{})
+	}
+	for _, key := range []string{"cors_rule", "retention_policy", "smb"} {
+		value, ok := shareProperties[key]
+		if !ok {
+			value = []interface{}{}
+		}
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountSharePropertiesUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	account, err := accountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if err := storageAccountSharePropertiesSet(ctx, client, d, *id, account); err != nil {
+		return err
+	}
+
+	return resourceStorageAccountSharePropertiesRead(d, meta)
+}
+
+func resourceStorageAccountSharePropertiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage.FileServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	account, err := accountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the Share Properties can't be deleted, so we'll reset them back to the defaults instead
+	defaults, err := expandShareProperties([]interface{}{}, false)
+	if err != nil {
+		return fmt.Errorf("expanding the default Share Properties: %+v", err)
+	}
+	smb, err := expandSharePropertiesSMB([]interface{}{}, false)
+	if err != nil {
+		return fmt.Errorf("expanding the default SMB settings: %+v", err)
+	}
+	defaults.ProtocolSettings = &storage.ProtocolSettings{
+		Smb: smb,
+	}
+	if storageAccountSupportsSMBMultichannel(string(account.Kind), storageAccountTier(account)) {
+		defaults.ProtocolSettings.Smb.Multichannel = &storage.Multichannel{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.Name, defaults); err != nil {
+		return fmt.Errorf("resetting Share Properties for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func storageAccountSharePropertiesSet(ctx context.Context, client *storage.FileServicesClient, d *pluginsdk.ResourceData, id parse.StorageAccountId, account storage.Account) error {
+	if err := checkStorageAccountSupportsShareProperties(id, account); err != nil {
+		return err
+	}
+
+	corsRules := d.Get("cors_rule").([]interface{})
+	if err := validateStorageAccountCorsRules(corsRules); err != nil {
+		return err
+	}
+
+	props, err := expandShareProperties([]interface{}{
+		map[string]interface{}{
+			"cors_rule":        corsRules,
+			"retention_policy": d.Get("retention_policy").([]interface{}),
+			"smb":              d.Get("smb").([]interface{}),
+		},
+	}, storageAccountSupportsSMBMultichannel(string(account.Kind), storageAccountTier(account)))
+	if err != nil {
+		return fmt.Errorf("expanding Share Properties: %+v", err)
+	}
+
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.Name, props); err != nil {
+		return fmt.Errorf("updating Share Properties for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// BlobStorage, BlockBlobStorage and StorageV2 Premium accounts don't support file share settings, however FileStorage
+// Premium accounts do
+func checkStorageAccountSupportsShareProperties(id parse.StorageAccountId, account storage.Account) error {
+	accountTier := storageAccountTier(account)
+	if account.Kind != storage.FileStorage && (account.Kind == storage.BlobStorage || account.Kind == storage.BlockBlobStorage || accountTier == string(storage.Premium)) {
+		return fmt.Errorf("Share Properties aren't supported for %s since it has the kind %q and tier %q", id, string(account.Kind), accountTier)
+	}
+
+	return nil
+}
+
+func storageAccountTier(account storage.Account) string {
+	if account.Sku == nil {
+		return ""
+	}
+
+	return string(account.Sku.Tier)
+}
+
+// To make sure that someone isn't overriding their existing Share Properties, we'll check for any CORS Rules or SMB settings
+func checkForNonDefaultStorageAccountShareProperties(input storage.FileServiceProperties) bool {
+	props := input.FileServicePropertiesProperties
+	if props == nil {
+		return false
+	}
+
+	if props.Cors != nil && props.Cors.CorsRules != nil && len(*props.Cors.CorsRules) > 0 {
+		return true
+	}
+
+	if props.ProtocolSettings != nil && len(flattenedSharePropertiesSMB(props.ProtocolSettings.Smb)) > 0 {
+		return true
+	}
+
+	return false
+}
@@ -1,13 +1,17 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -88,9 +92,34 @@ func resourceStorageBlob() *pluginsdk.Resource {
 					string(blobs.Archive),
 					string(blobs.Cool),
 					string(blobs.Hot),
+					string(azuresdkhacks.BlobAccessTierCold),
 				}, false),
 			},
 
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiry_time": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.BlobImmutabilityPolicyModeLocked),
+								string(azuresdkhacks.BlobImmutabilityPolicyModeUnlocked),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"content_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -146,6 +175,36 @@ func resourceStorageBlob() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.Get("access_tier").(string) == string(azuresdkhacks.BlobAccessTierCold) && d.Get("type").(string) != "Block" {
+					return fmt.Errorf("the `Cold` `access_tier` can only be used with a `type` of `Block`")
+				}
+
+				if d.HasChange("immutability_policy") {
+					oldRaw, newRaw := d.GetChange("immutability_policy")
+					oldPolicy := expandStorageBlobImmutabilityPolicy(oldRaw.([]interface{}))
+					if oldPolicy == nil || oldPolicy.mode != azuresdkhacks.BlobImmutabilityPolicyModeLocked {
+						return nil
+					}
+
+					// a Locked Immutability Policy can't be unlocked or removed and the expiry can only be extended
+					newPolicy := expandStorageBlobImmutabilityPolicy(newRaw.([]interface{}))
+					if newPolicy == nil || newPolicy.mode != azuresdkhacks.BlobImmutabilityPolicyModeLocked {
+						return fmt.Errorf("a `Locked` `immutability_policy` cannot be unlocked or removed")
+					}
+
+					oldExpiry, _ := time.Parse(time.RFC3339, oldPolicy.expiryTime)
+					newExpiry, _ := time.Parse(time.RFC3339, newPolicy.expiryTime)
+					if newExpiry.Before(oldExpiry) {
+						return fmt.Errorf("the `expiry_time` of a `Locked` `immutability_policy` can only be extended")
+					}
+				}
+
+				return nil
+			}),
+		),
 	}
 }
 
@@ -185,6 +244,10 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if err := validateStorageBlobAccessTierAndImmutabilityPolicy(ctx, d, storageClient.AccountsClient, account.ResourceGroup, account.Kind); err != nil {
+		return err
+	}
+
 	contentMD5Raw := d.Get("content_md5").(string)
 	contentMD5 := ""
 	if contentMD5Raw != "" {
@@ -247,12 +310,20 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
+	if !d.IsNewResource() && d.HasChanges("access_tier", "immutability_policy") {
+		if err := validateStorageBlobAccessTierAndImmutabilityPolicy(ctx, d, storageClient.AccountsClient, account.ResourceGroup, account.Kind); err != nil {
+			return err
+		}
+	}
+
+	workaroundClient := azuresdkhacks.NewBlobsWorkaroundClient(blobsClient)
+
 	if d.HasChange("access_tier") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
 
-		if _, err := blobsClient.SetTier(ctx, id.AccountName, id.ContainerName, id.BlobName, accessTier); err != nil {
+		if _, err := workaroundClient.SetTier(ctx, id.AccountName, id.ContainerName, id.BlobName, accessTier); err != nil {
 			return fmt.Errorf("updating Access Tier for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
 		}
 
@@ -305,6 +376,25 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updated Cache Control for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
+	if d.HasChange("immutability_policy") {
+		log.Printf("[DEBUG] Updating Immutability Policy for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		if policy := expandStorageBlobImmutabilityPolicy(d.Get("immutability_policy").([]interface{})); policy != nil {
+			expiry, err := time.Parse(time.RFC3339, policy.expiryTime)
+			if err != nil {
+				return fmt.Errorf("parsing `expiry_time` %q: %+v", policy.expiryTime, err)
+			}
+
+			if _, err := workaroundClient.SetImmutabilityPolicy(ctx, id.AccountName, id.ContainerName, id.BlobName, expiry.UTC().Format(http.TimeFormat), policy.mode); err != nil {
+				return fmt.Errorf("updating Immutability Policy for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+		} else {
+			if _, err := workaroundClient.DeleteImmutabilityPolicy(ctx, id.AccountName, id.ContainerName, id.BlobName); err != nil {
+				return fmt.Errorf("removing Immutability Policy for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+		}
+		log.Printf("[DEBUG] Updated Immutability Policy for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
 	return resourceStorageBlobRead(d, meta)
 }
 
@@ -350,7 +440,14 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("storage_container_name", id.ContainerName)
 	d.Set("storage_account_name", id.AccountName)
 
-	d.Set("access_tier", string(props.AccessTier))
+	// the Access Tier and Immutability Policy are retrieved using a newer API Version, since the `Cold` Access Tier
+	// and Blob-level Immutability Policies aren't supported by the API Version used by the SDK
+	workaroundProps, err := azuresdkhacks.NewBlobsWorkaroundClient(blobsClient).GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName)
+	if err != nil {
+		return fmt.Errorf("retrieving Access Tier and Immutability Policy for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+
+	d.Set("access_tier", string(workaroundProps.AccessTier))
 	d.Set("content_type", props.ContentType)
 	d.Set("cache_control", props.CacheControl)
 
@@ -370,6 +467,14 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %+v", err)
 	}
+	immutabilityPolicy, err := flattenStorageBlobImmutabilityPolicy(workaroundProps)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("immutability_policy", immutabilityPolicy); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
+
 	// The CopySource is only returned if the blob hasn't been modified (e.g. metadata configured etc)
 	// as such, we need to conditionally set this to ensure it's trackable if possible
 	if props.CopySource != "" {
@@ -402,6 +507,14 @@ func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
+	// an Unlocked Immutability Policy has to be removed before the Blob can be deleted
+	if policy := expandStorageBlobImmutabilityPolicy(d.Get("immutability_policy").([]interface{})); policy != nil && policy.mode == azuresdkhacks.BlobImmutabilityPolicyModeUnlocked {
+		log.Printf("[INFO] Removing Immutability Policy from Blob %q (Container %q / Storage Account %q)", id.BlobName, id.ContainerName, id.AccountName)
+		if _, err := azuresdkhacks.NewBlobsWorkaroundClient(blobsClient).DeleteImmutabilityPolicy(ctx, id.AccountName, id.ContainerName, id.BlobName); err != nil {
+			return fmt.Errorf("removing Immutability Policy from Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting Blob %q from Container %q / Storage Account %q", id.BlobName, id.ContainerName, id.AccountName)
 	input := blobs.DeleteInput{
 		DeleteSnapshots: true,
@@ -412,3 +525,67 @@ func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+type storageBlobImmutabilityPolicy struct {
+	expiryTime string
+	mode       azuresdkhacks.BlobImmutabilityPolicyMode
+}
+
+func expandStorageBlobImmutabilityPolicy(input []interface{}) *storageBlobImmutabilityPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &storageBlobImmutabilityPolicy{
+		expiryTime: raw["expiry_time"].(string),
+		mode:       azuresdkhacks.BlobImmutabilityPolicyMode(raw["mode"].(string)),
+	}
+}
+
+func flattenStorageBlobImmutabilityPolicy(input azuresdkhacks.BlobProperties) ([]interface{}, error) {
+	if input.ImmutabilityPolicyExpiry == "" {
+		return []interface{}{}, nil
+	}
+
+	expiry, err := time.Parse(http.TimeFormat, input.ImmutabilityPolicyExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Immutability Policy expiry %q: %+v", input.ImmutabilityPolicyExpiry, err)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expiry_time": expiry.Format(time.RFC3339),
+			"mode":        string(input.ImmutabilityPolicyMode),
+		},
+	}, nil
+}
+
+// validateStorageBlobAccessTierAndImmutabilityPolicy validates that the Storage Account supports the `Cold` Access Tier
+// and Blob-level Immutability Policies when these are specified.
+func validateStorageBlobAccessTierAndImmutabilityPolicy(ctx context.Context, d *pluginsdk.ResourceData, accountsClient *storage.AccountsClient, resourceGroup string, accountKind storage.Kind) error {
+	accountName := d.Get("storage_account_name").(string)
+
+	if d.Get("access_tier").(string) == string(azuresdkhacks.BlobAccessTierCold) {
+		if accountKind != storage.StorageV2 && accountKind != storage.BlobStorage {
+			return fmt.Errorf("the `Cold` `access_tier` is only supported for Storage Accounts of kind `%s` or `%s` but Storage Account %q is of kind %q", string(storage.StorageV2), string(storage.BlobStorage), accountName, string(accountKind))
+		}
+	}
+
+	if policy := expandStorageBlobImmutabilityPolicy(d.Get("immutability_policy").([]interface{})); policy != nil {
+		resp, err := azuresdkhacks.NewAccountsWorkaroundClient(accountsClient).GetImmutableStorageWithVersioning(ctx, resourceGroup, accountName)
+		if err != nil {
+			return fmt.Errorf("retrieving the Immutable Storage with Versioning settings for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+		}
+
+		enabled := false
+		if props := resp.Properties; props != nil && props.ImmutableStorageWithVersioning != nil && props.ImmutableStorageWithVersioning.Enabled != nil {
+			enabled = *props.ImmutableStorageWithVersioning.Enabled
+		}
+		if !enabled {
+			return fmt.Errorf("an `immutability_policy` can only be specified when version-level immutability (Immutable Storage with Versioning) is enabled on Storage Account %q", accountName)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -124,6 +125,14 @@ func TestAccStorageBlob_blockEmptyAccessTier(t *testing.T) {
 				check.That(data.ResourceName).Key("access_tier").HasValue("Hot"),
 			),
 		},
+		{
+			Config: r.blockEmptyAccessTier(data, "Cold"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_tier").HasValue("Cold"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
 		{
 			Config: r.blockEmptyAccessTier(data, blobs.Cool),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -134,6 +143,53 @@ func TestAccStorageBlob_blockEmptyAccessTier(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_immutabilityPolicy(t *testing.T) {
+	if os.Getenv("ARM_TEST_IMMUTABLE_STORAGE_ACCOUNT_NAME") == "" {
+		t.Skip("Skipping as `ARM_TEST_IMMUTABLE_STORAGE_ACCOUNT_NAME` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.immutabilityPolicy(data, "2040-01-01T00:00:00Z"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutability_policy.0.mode").HasValue("Unlocked"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.immutabilityPolicy(data, "2041-01-01T00:00:00Z"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.immutabilityPolicyRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutability_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+	})
+}
+
+func TestAccStorageBlob_immutabilityPolicyNotSupported(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.immutabilityPolicyNotSupported(data),
+			ExpectError: regexp.MustCompile("an `immutability_policy` can only be specified when version-level immutability"),
+		},
+	})
+}
+
 func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -636,6 +692,78 @@ resource "azurerm_storage_blob" "test" {
 `, template, string(accessTier))
 }
 
+func (r StorageBlobResource) immutabilityPolicy(data acceptance.TestData, expiryTime string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestsc%s"
+  storage_account_name  = "%s"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_container.test.storage_account_name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Hello, World!"
+
+  immutability_policy {
+    expiry_time = "%s"
+    mode        = "Unlocked"
+  }
+}
+`, data.RandomString, os.Getenv("ARM_TEST_IMMUTABLE_STORAGE_ACCOUNT_NAME"), expiryTime)
+}
+
+func (r StorageBlobResource) immutabilityPolicyRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestsc%s"
+  storage_account_name  = "%s"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_container.test.storage_account_name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Hello, World!"
+}
+`, data.RandomString, os.Getenv("ARM_TEST_IMMUTABLE_STORAGE_ACCOUNT_NAME"))
+}
+
+func (r StorageBlobResource) immutabilityPolicyNotSupported(data acceptance.TestData) string {
+	template := r.templateBlockBlobStorage(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+
+  immutability_policy {
+    expiry_time = "2040-01-01T00:00:00Z"
+    mode        = "Unlocked"
+  }
+}
+`, template)
+}
+
 func (r StorageBlobResource) blockFromInlineContent(data acceptance.TestData) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
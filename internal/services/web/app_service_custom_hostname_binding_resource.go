@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -60,14 +61,28 @@ func resourceAppServiceCustomHostnameBinding() *pluginsdk.Resource {
 					string(web.SslStateIPBasedEnabled),
 					string(web.SslStateSniEnabled),
 				}, false),
+				ConflictsWith: []string{"managed_certificate_enabled"},
 			},
 
 			"thumbprint": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"managed_certificate_enabled"},
+			},
+
+			"managed_certificate_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"managed_certificate_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
 			},
 
 			"virtual_ip": {
@@ -141,11 +156,92 @@ func resourceAppServiceCustomHostnameBindingCreate(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("Cannot read Hostname Binding %q (App Service %q / Resource Group %q) ID", hostname, appServiceName, resourceGroup)
 	}
 
+	if d.Get("managed_certificate_enabled").(bool) {
+		bindingId, err := parse.AppServiceCustomHostnameBindingID(*read.ID)
+		if err != nil {
+			return err
+		}
+
+		timeout, _ := ctx.Deadline()
+		managedCertificateId, err := createAndBindAppServiceManagedCertificate(ctx, meta, *bindingId, time.Until(timeout))
+		if err != nil {
+			// roll back the Custom Hostname Binding so that a subsequent apply starts from a clean slate
+			log.Printf("[DEBUG] Rolling back Custom Hostname Binding %q (App Service %q / Resource Group %q)..", hostname, appServiceName, resourceGroup)
+			if _, rollbackErr := client.DeleteHostNameBinding(ctx, resourceGroup, appServiceName, hostname); rollbackErr != nil {
+				return fmt.Errorf("%+v\n\nadditionally, rolling back Custom Hostname Binding %q (App Service %q / Resource Group %q): %+v", err, hostname, appServiceName, resourceGroup, rollbackErr)
+			}
+			return err
+		}
+
+		d.Set("managed_certificate_id", managedCertificateId.ID())
+	}
+
 	d.SetId(*read.ID)
 
 	return resourceAppServiceCustomHostnameBindingRead(d, meta)
 }
 
+// createAndBindAppServiceManagedCertificate provisions a Managed Certificate for the specified Custom Hostname Binding and
+// then SNI-binds this certificate to the hostname - the Managed Certificate is removed if the binding can't be completed
+func createAndBindAppServiceManagedCertificate(ctx context.Context, meta interface{}, bindingId parse.AppServiceCustomHostnameBindingId, timeout time.Duration) (*parse.ManagedCertificateId, error) {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certificatesClient := meta.(*clients.Client).Web.CertificatesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+
+	appServicePlanId, certificate, err := expandAppServiceManagedCertificate(ctx, client, bindingId, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	id := parse.NewManagedCertificateID(subscriptionId, appServicePlanId.ResourceGroup, bindingId.Name)
+
+	existing, err := certificatesClient.Get(ctx, id.ResourceGroup, id.CertificateName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return nil, fmt.Errorf("checking for presence of existing App Service Managed Certificate %q (Resource Group %q): %s", id.CertificateName, id.ResourceGroup, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return nil, fmt.Errorf("an App Service Certificate %q already exists in Resource Group %q - this must be removed or bound using the `thumbprint` and `ssl_state` fields", id.CertificateName, id.ResourceGroup)
+	}
+
+	if err := createUpdateAppServiceManagedCertificate(ctx, certificatesClient, id, *certificate, timeout); err != nil {
+		return nil, err
+	}
+
+	bindErr := func() error {
+		read, err := certificatesClient.Get(ctx, id.ResourceGroup, id.CertificateName)
+		if err != nil {
+			return fmt.Errorf("retrieving App Service Managed Certificate %q (Resource Group %q): %+v", id.CertificateName, id.ResourceGroup, err)
+		}
+		if read.CertificateProperties == nil || read.CertificateProperties.Thumbprint == nil {
+			return fmt.Errorf("retrieving App Service Managed Certificate %q (Resource Group %q): `thumbprint` was nil", id.CertificateName, id.ResourceGroup)
+		}
+
+		properties := web.HostNameBinding{
+			HostNameBindingProperties: &web.HostNameBindingProperties{
+				SiteName:   utils.String(bindingId.AppServiceName),
+				SslState:   web.SslStateSniEnabled,
+				Thumbprint: read.CertificateProperties.Thumbprint,
+			},
+		}
+		if _, err := client.CreateOrUpdateHostNameBinding(ctx, bindingId.ResourceGroup, bindingId.AppServiceName, bindingId.Name, properties); err != nil {
+			return fmt.Errorf("binding App Service Managed Certificate %q to Custom Hostname Binding %q (App Service %q / Resource Group %q): %+v", id.CertificateName, bindingId.Name, bindingId.AppServiceName, bindingId.ResourceGroup, err)
+		}
+
+		return nil
+	}()
+	if bindErr != nil {
+		log.Printf("[DEBUG] Rolling back App Service Managed Certificate %q (Resource Group %q)..", id.CertificateName, id.ResourceGroup)
+		if resp, err := certificatesClient.Delete(ctx, id.ResourceGroup, id.CertificateName); err != nil && !utils.ResponseWasNotFound(resp) {
+			return nil, fmt.Errorf("%+v\n\nadditionally, rolling back App Service Managed Certificate %q (Resource Group %q): %+v", bindErr, id.CertificateName, id.ResourceGroup, err)
+		}
+		return nil, bindErr
+	}
+
+	return &id, nil
+}
+
 func resourceAppServiceCustomHostnameBindingRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -201,5 +297,21 @@ func resourceAppServiceCustomHostnameBindingDelete(d *pluginsdk.ResourceData, me
 		}
 	}
 
+	// the Managed Certificate can only be removed once it's no longer bound to the hostname
+	if v := d.Get("managed_certificate_id").(string); d.Get("managed_certificate_enabled").(bool) && v != "" {
+		certificateId, err := parse.ManagedCertificateID(v)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Deleting App Service Managed Certificate %q (Resource Group %q)", certificateId.CertificateName, certificateId.ResourceGroup)
+		resp, err := meta.(*clients.Client).Web.CertificatesClient.Delete(ctx, certificateId.ResourceGroup, certificateId.CertificateName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting App Service Managed Certificate %q (Resource Group %q): %+v", certificateId.CertificateName, certificateId.ResourceGroup, err)
+			}
+		}
+	}
+
 	return nil
 }
@@ -38,6 +38,7 @@ func TestAccAppServiceCustomHostnameBinding(t *testing.T) {
 			"multiple":       testAccAppServiceCustomHostnameBinding_multiple,
 			"requiresImport": testAccAppServiceCustomHostnameBinding_requiresImport,
 			"ssl":            testAccAppServiceCustomHostnameBinding_ssl,
+			"managedCert":    testAccAppServiceCustomHostnameBinding_managedCertificate,
 		},
 	}
 
@@ -121,6 +122,24 @@ func testAccAppServiceCustomHostnameBinding_ssl(t *testing.T, appServiceEnv, dom
 	})
 }
 
+func testAccAppServiceCustomHostnameBinding_managedCertificate(t *testing.T, appServiceEnv, domainEnv string) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_custom_hostname_binding", "test")
+	r := ServiceCustomHostnameBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedCertificateConfig(data, appServiceEnv, domainEnv),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ssl_state").HasValue("SniEnabled"),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That(data.ResourceName).Key("managed_certificate_id").Exists(),
+			),
+		},
+		data.ImportStep("managed_certificate_enabled", "managed_certificate_id"),
+	})
+}
+
 func (r ServiceCustomHostnameBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.AppServiceCustomHostnameBindingID(state.ID)
 	if err != nil {
@@ -301,3 +320,41 @@ resource "azurerm_app_service_custom_hostname_binding" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, appServiceName, data.RandomInteger, data.RandomInteger, domain, data.RandomInteger, domain)
 }
+
+func (ServiceCustomHostnameBindingResource) managedCertificateConfig(data acceptance.TestData, appServiceName string, domain string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    tier = "Basic"
+    size = "B1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname                    = "%s"
+  app_service_name            = azurerm_app_service.test.name
+  resource_group_name         = azurerm_resource_group.test.name
+  managed_certificate_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, appServiceName, domain)
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -103,31 +104,14 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	appService, err := appServiceClient.Get(ctx, customHostnameBindingId.ResourceGroup, customHostnameBindingId.AppServiceName)
-	if err != nil {
-		return fmt.Errorf("could not retrieve App Service Custom Hostname details for %q", customHostnameBindingId.Name)
-	}
-
-	name := customHostnameBindingId.Name
-	appServicePlanIDRaw := ""
-	if appService.SiteProperties == nil || appService.SiteProperties.ServerFarmID == nil {
-		return fmt.Errorf("could not get App Service Plan ID for Custom Hostname Binding %q (resource group %q)", customHostnameBindingId.Name, customHostnameBindingId.ResourceGroup)
-	}
-	appServicePlanIDRaw = *appService.SiteProperties.ServerFarmID
+	t := d.Get("tags").(map[string]interface{})
 
-	appServicePlanID, err := parse.AppServicePlanID(appServicePlanIDRaw)
+	appServicePlanID, certificate, err := expandAppServiceManagedCertificate(ctx, appServiceClient, *customHostnameBindingId, t)
 	if err != nil {
 		return err
 	}
 
-	appServiceLocation := ""
-	if appService.Location != nil {
-		appServiceLocation = location.Normalize(*appService.Location)
-	}
-
-	t := d.Get("tags").(map[string]interface{})
-
-	id := parse.NewManagedCertificateID(subscriptionId, appServicePlanID.ResourceGroup, name)
+	id := parse.NewManagedCertificateID(subscriptionId, appServicePlanID.ResourceGroup, customHostnameBindingId.Name)
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.CertificateName)
@@ -142,49 +126,13 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	certificate := web.Certificate{
-		CertificateProperties: &web.CertificateProperties{
-			CanonicalName: utils.String(customHostnameBindingId.Name),
-			ServerFarmID:  utils.String(appServicePlanIDRaw),
-			Password:      new(string),
-		},
-		Location: utils.String(appServiceLocation),
-		Tags:     tags.Expand(t),
-	}
-
-	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
-		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
-		if !utils.ResponseWasStatusCode(resp.Response, 202) {
-			return fmt.Errorf("creating/updating App Service Managed Certificate %q (Resource Group %q): %s", id.CertificateName, id.ResourceGroup, err)
-		}
-	}
-
-	certificateWait := &pluginsdk.StateChangeConf{
-		Pending:    []string{"NotFound", "Unknown"},
-		Target:     []string{"Success"},
-		MinTimeout: 1 * time.Minute,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
-		Refresh: func() (interface{}, string, error) {
-			resp, err := client.Get(ctx, id.ResourceGroup, id.CertificateName)
-			if err != nil {
-				if utils.ResponseWasNotFound(resp.Response) {
-					return "NotFound", "NotFound", nil
-				}
-				return "Unknown", "Unknown", err
-			}
-			if utils.ResponseWasStatusCode(resp.Response, 200) {
-				return "Success", "Success", nil
-			}
-			return "Unknown", "Unknown", err
-		},
-	}
-
+	timeout := d.Timeout(pluginsdk.TimeoutCreate)
 	if !d.IsNewResource() {
-		certificateWait.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		timeout = d.Timeout(pluginsdk.TimeoutUpdate)
 	}
 
-	if _, err := certificateWait.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for App Service Managed Certificate %q: %+v", id.CertificateName, err)
+	if err := createUpdateAppServiceManagedCertificate(ctx, client, id, *certificate, timeout); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID())
@@ -255,3 +203,75 @@ func resourceAppServiceManagedCertificateDelete(d *pluginsdk.ResourceData, meta
 
 	return nil
 }
+
+// expandAppServiceManagedCertificate builds the payload for a Managed Certificate for the specified Custom Hostname
+// Binding, returning the ID of the App Service Plan which the Managed Certificate will be created within
+func expandAppServiceManagedCertificate(ctx context.Context, appServiceClient *web.AppsClient, customHostnameBindingId parse.AppServiceCustomHostnameBindingId, t map[string]interface{}) (*parse.AppServicePlanId, *web.Certificate, error) {
+	appService, err := appServiceClient.Get(ctx, customHostnameBindingId.ResourceGroup, customHostnameBindingId.AppServiceName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not retrieve App Service Custom Hostname details for %q", customHostnameBindingId.Name)
+	}
+
+	if appService.SiteProperties == nil || appService.SiteProperties.ServerFarmID == nil {
+		return nil, nil, fmt.Errorf("could not get App Service Plan ID for Custom Hostname Binding %q (resource group %q)", customHostnameBindingId.Name, customHostnameBindingId.ResourceGroup)
+	}
+	appServicePlanIDRaw := *appService.SiteProperties.ServerFarmID
+
+	appServicePlanID, err := parse.AppServicePlanID(appServicePlanIDRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	appServiceLocation := ""
+	if appService.Location != nil {
+		appServiceLocation = location.Normalize(*appService.Location)
+	}
+
+	certificate := web.Certificate{
+		CertificateProperties: &web.CertificateProperties{
+			CanonicalName: utils.String(customHostnameBindingId.Name),
+			ServerFarmID:  utils.String(appServicePlanIDRaw),
+			Password:      new(string),
+		},
+		Location: utils.String(appServiceLocation),
+		Tags:     tags.Expand(t),
+	}
+
+	return appServicePlanID, &certificate, nil
+}
+
+// createUpdateAppServiceManagedCertificate creates/updates the specified Managed Certificate and waits for it to be provisioned
+func createUpdateAppServiceManagedCertificate(ctx context.Context, client *web.CertificatesClient, id parse.ManagedCertificateId, certificate web.Certificate, timeout time.Duration) error {
+	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
+		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
+		if !utils.ResponseWasStatusCode(resp.Response, 202) {
+			return fmt.Errorf("creating/updating App Service Managed Certificate %q (Resource Group %q): %s", id.CertificateName, id.ResourceGroup, err)
+		}
+	}
+
+	certificateWait := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound", "Unknown"},
+		Target:     []string{"Success"},
+		MinTimeout: 1 * time.Minute,
+		Timeout:    timeout,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.CertificateName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return "NotFound", "NotFound", nil
+				}
+				return "Unknown", "Unknown", err
+			}
+			if utils.ResponseWasStatusCode(resp.Response, 200) {
+				return "Success", "Success", nil
+			}
+			return "Unknown", "Unknown", err
+		},
+	}
+
+	if _, err := certificateWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for App Service Managed Certificate %q: %+v", id.CertificateName, err)
+	}
+
+	return nil
+}
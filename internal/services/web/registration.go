@@ -52,6 +52,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_function_app":                                      resourceFunctionApp(),
 		"azurerm_function_app_slot":                                 resourceFunctionAppSlot(),
 		"azurerm_static_site":                                       resourceStaticSite(),
+		"azurerm_static_web_app_custom_domain":                      resourceStaticWebAppCustomDomain(),
+		"azurerm_static_web_app_function_app_registration":          resourceStaticWebAppFunctionAppRegistration(),
 	}
 }
 
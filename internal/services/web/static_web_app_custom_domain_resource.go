@@ -0,0 +1,229 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	staticWebAppCustomDomainValidationTypeCNAMEDelegation = "cname-delegation"
+	staticWebAppCustomDomainValidationTypeDNSTXTToken     = "dns-txt-token"
+)
+
+func resourceStaticWebAppCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticWebAppCustomDomainCreate,
+		Read:   resourceStaticWebAppCustomDomainRead,
+		Delete: resourceStaticWebAppCustomDomainDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteCustomDomainID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"domain_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"static_web_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"validation_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					staticWebAppCustomDomainValidationTypeCNAMEDelegation,
+					staticWebAppCustomDomainValidationTypeDNSTXTToken,
+				}, false),
+			},
+
+			"validation_token": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceStaticWebAppCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_web_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteCustomDomainID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, d.Get("domain_name").(string))
+
+	existing, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_static_web_app_custom_domain", id.ID())
+	}
+
+	validationType := d.Get("validation_type").(string)
+	envelope := web.StaticSiteCustomDomainRequestPropertiesARMResource{
+		StaticSiteCustomDomainRequestPropertiesARMResourceProperties: &web.StaticSiteCustomDomainRequestPropertiesARMResourceProperties{
+			ValidationMethod: utils.String(validationType),
+		},
+	}
+
+	future, err := client.CreateOrUpdateStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName, envelope)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	switch validationType {
+	case staticWebAppCustomDomainValidationTypeCNAMEDelegation:
+		// the CNAME record must already exist, so the domain can be validated and added straight away
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+
+	case staticWebAppCustomDomainValidationTypeDNSTXTToken:
+		// the TXT record can only be created once the validation token is known, as such we only wait for the token
+		// to be issued here - the domain is then validated by the service once the TXT record has been created
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending: []string{
+				string(web.CustomDomainStatusRetrievingValidationToken),
+			},
+			Target: []string{
+				string(web.CustomDomainStatusValidating),
+				string(web.CustomDomainStatusAdding),
+				string(web.CustomDomainStatusReady),
+			},
+			Refresh:    staticWebAppCustomDomainStateRefreshFunc(ctx, client, id),
+			MinTimeout: 15 * time.Second,
+			Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for the validation token for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticWebAppCustomDomainRead(d, meta)
+}
+
+func resourceStaticWebAppCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("domain_name", id.CustomDomainName)
+	d.Set("static_web_app_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	// the validation token is only returned whilst the domain is being validated, so we keep the existing value once it's ready
+	if props := resp.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil {
+		if props.ValidationToken != nil && *props.ValidationToken != "" {
+			d.Set("validation_token", props.ValidationToken)
+		}
+	}
+
+	return nil
+}
+
+func resourceStaticWebAppCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func staticWebAppCustomDomainStateRefreshFunc(ctx context.Context, client *web.StaticSitesClient, id parse.StaticSiteCustomDomainId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		props := resp.StaticSiteCustomDomainOverviewARMResourceProperties
+		if props == nil {
+			return resp, string(web.CustomDomainStatusRetrievingValidationToken), nil
+		}
+
+		if props.Status == web.CustomDomainStatusFailed {
+			message := ""
+			if props.ErrorMessage != nil {
+				message = *props.ErrorMessage
+			}
+			return resp, string(props.Status), fmt.Errorf("validation of %s failed: %s", id, message)
+		}
+
+		// the status can move to `Validating` before the token has been populated
+		if props.Status == web.CustomDomainStatusValidating && (props.ValidationToken == nil || *props.ValidationToken == "") {
+			return resp, string(web.CustomDomainStatusRetrievingValidationToken), nil
+		}
+
+		return resp, string(props.Status), nil
+	}
+}
@@ -0,0 +1,175 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStaticWebAppFunctionAppRegistration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticWebAppFunctionAppRegistrationCreate,
+		Read:   resourceStaticWebAppFunctionAppRegistrationRead,
+		Delete: resourceStaticWebAppFunctionAppRegistrationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteUserProvidedFunctionAppID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_web_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"function_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FunctionAppID,
+			},
+		},
+	}
+}
+
+func resourceStaticWebAppFunctionAppRegistrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	appServicesClient := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_web_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	functionAppId, err := parse.FunctionAppID(d.Get("function_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteUserProvidedFunctionAppID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, functionAppId.SiteName)
+
+	existing, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_static_web_app_function_app_registration", id.ID())
+	}
+
+	// linking a backend is only supported on the Standard tier
+	staticSite, err := client.GetStaticSite(ctx, staticSiteId.ResourceGroup, staticSiteId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", staticSiteId, err)
+	}
+	if staticSite.Sku == nil || staticSite.Sku.Tier == nil || !strings.EqualFold(*staticSite.Sku.Tier, "Standard") {
+		return fmt.Errorf("a Function App can only be registered with a Static Web App using the `Standard` SKU tier")
+	}
+
+	functionApp, err := appServicesClient.Get(ctx, functionAppId.ResourceGroup, functionAppId.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", functionAppId, err)
+	}
+	if functionApp.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", functionAppId)
+	}
+
+	envelope := web.StaticSiteUserProvidedFunctionAppARMResource{
+		StaticSiteUserProvidedFunctionAppARMResourceProperties: &web.StaticSiteUserProvidedFunctionAppARMResourceProperties{
+			FunctionAppResourceID: utils.String(functionAppId.ID()),
+			FunctionAppRegion:     utils.String(location.Normalize(*functionApp.Location)),
+		},
+	}
+
+	future, err := client.RegisterUserProvidedFunctionAppWithStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName, envelope, utils.Bool(false))
+	if err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for registration of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticWebAppFunctionAppRegistrationRead(d, meta)
+}
+
+func resourceStaticWebAppFunctionAppRegistrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteUserProvidedFunctionAppID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_web_app_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	functionAppId := ""
+	if props := resp.StaticSiteUserProvidedFunctionAppARMResourceProperties; props != nil && props.FunctionAppResourceID != nil {
+		parsed, err := parse.FunctionAppID(*props.FunctionAppResourceID)
+		if err != nil {
+			return fmt.Errorf("parsing `function_app_id` %q: %+v", *props.FunctionAppResourceID, err)
+		}
+		functionAppId = parsed.ID()
+	}
+	d.Set("function_app_id", functionAppId)
+
+	return nil
+}
+
+func resourceStaticWebAppFunctionAppRegistrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteUserProvidedFunctionAppID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DetachUserProvidedFunctionAppFromStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("detaching %s: %+v", *id, err)
+	}
+
+	return nil
+}
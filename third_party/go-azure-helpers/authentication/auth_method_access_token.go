@@ -0,0 +1,121 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/hashicorp/go-multierror"
+)
+
+// bearerTokenAuth uses an access token which has already been obtained (e.g. by an outer process) verbatim,
+// rather than obtaining a token from Azure Active Directory. Since the token is issued for a single resource
+// it's used regardless of the endpoint requested, and it can't be refreshed once it expires.
+type bearerTokenAuth struct {
+	accessToken    string
+	subscriptionId string
+	tenantOnly     bool
+}
+
+func (a bearerTokenAuth) build(b Builder) (authMethod, error) {
+	method := bearerTokenAuth{
+		accessToken:    b.AccessToken,
+		subscriptionId: b.SubscriptionID,
+		tenantOnly:     b.TenantOnly,
+	}
+	return method, nil
+}
+
+func (a bearerTokenAuth) isApplicable(b Builder) bool {
+	return b.AccessToken != ""
+}
+
+func (a bearerTokenAuth) name() string {
+	return "Access Token"
+}
+
+func (a bearerTokenAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	return autorest.NewBearerAuthorizer(&staticTokenProvider{
+		accessToken: a.accessToken,
+	}), nil
+}
+
+func (a bearerTokenAuth) populateConfig(c *Config) error {
+	c.GetAuthenticatedObjectID = func(ctx context.Context) (string, error) {
+		claims, ok := parseAccessTokenClaims(a.accessToken)
+		if !ok {
+			return "", fmt.Errorf("the Object ID can't be determined since the Access Token isn't a JWT")
+		}
+
+		objectId, ok := claims["oid"].(string)
+		if !ok || objectId == "" {
+			return "", fmt.Errorf("the Object ID can't be determined since the Access Token doesn't contain an `oid` claim")
+		}
+
+		return objectId, nil
+	}
+	return nil
+}
+
+func (a bearerTokenAuth) validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating using an Access Token."
+
+	if !a.tenantOnly && a.subscriptionId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+	if a.accessToken == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Access Token"))
+	}
+
+	// opaque (non-JWT) access tokens are used as-is, since the expiry can't be determined
+	if expiry, ok := accessTokenExpiry(a.accessToken); ok {
+		if !time.Now().Before(expiry) {
+			err = multierror.Append(err, fmt.Errorf("The Access Token expired at %s - a new Access Token must be obtained.", expiry.Format(time.RFC3339)))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// staticTokenProvider implements adal.OAuthTokenProvider, returning the same access token each time
+type staticTokenProvider struct {
+	accessToken string
+}
+
+var _ adal.OAuthTokenProvider = &staticTokenProvider{}
+
+func (p *staticTokenProvider) OAuthToken() string {
+	return p.accessToken
+}
+
+// parseAccessTokenClaims returns the claims within the access token, when the access token is a JWT.
+// The signature isn't verified, since this is only used to inspect the token before it's sent to Azure.
+func parseAccessTokenClaims(accessToken string) (jwt.MapClaims, bool) {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(accessToken, claims); err != nil {
+		return nil, false
+	}
+
+	return claims, true
+}
+
+// accessTokenExpiry returns the time at which the access token expires, when the access token is a JWT
+// containing an `exp` claim
+func accessTokenExpiry(accessToken string) (time.Time, bool) {
+	claims, ok := parseAccessTokenClaims(accessToken)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(exp), 0), true
+}
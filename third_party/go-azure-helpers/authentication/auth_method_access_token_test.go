@@ -0,0 +1,136 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/form3tech-oss/jwt-go"
+)
+
+func TestBearerTokenAuth(t *testing.T) {
+	validToken := testAccessToken(t, jwt.MapClaims{
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"oid": "11111111-1111-1111-1111-111111111111",
+	})
+	expiredToken := testAccessToken(t, jwt.MapClaims{
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	testData := []struct {
+		name          string
+		accessToken   string
+		expectedError string
+	}{
+		{
+			name:        "valid token",
+			accessToken: validToken,
+		},
+		{
+			name:          "expired token",
+			accessToken:   expiredToken,
+			expectedError: "The Access Token expired at",
+		},
+		{
+			name:        "opaque token",
+			accessToken: "an-opaque-access-token",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		builder := Builder{
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			AccessToken:              v.accessToken,
+			SupportsClientSecretAuth: true,
+			ClientSecret:             "secret",
+		}
+		config, err := builder.Build()
+		if v.expectedError != "" {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !strings.Contains(err.Error(), v.expectedError) {
+				t.Fatalf("expected the error to contain %q but got: %+v", v.expectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("building config: %+v", err)
+		}
+
+		if _, ok := config.authMethod.(bearerTokenAuth); !ok {
+			t.Fatalf("expected the Access Token to take precedence but got %s", config.authMethod.name())
+		}
+
+		// the Sender must not be used, since no token needs to be obtained
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			t.Fatalf("expected no requests to be made but got a request to %q", r.URL.String())
+			return nil, nil
+		})
+		for _, endpoint := range []string{"https://management.azure.com/", "https://graph.windows.net/"} {
+			authorizer, err := config.GetAuthorizationToken(sender, &OAuthConfig{}, endpoint)
+			if err != nil {
+				t.Fatalf("obtaining authorizer for %q: %+v", endpoint, err)
+			}
+
+			req, err := autorest.Prepare(&http.Request{}, authorizer.WithAuthorization())
+			if err != nil {
+				t.Fatalf("authorizing request: %+v", err)
+			}
+			if expected := "Bearer " + v.accessToken; req.Header.Get("Authorization") != expected {
+				t.Fatalf("expected the Authorization header %q but got %q", expected, req.Header.Get("Authorization"))
+			}
+		}
+	}
+}
+
+func TestBearerTokenAuth_authenticatedObjectID(t *testing.T) {
+	builder := Builder{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		AccessToken: testAccessToken(t, jwt.MapClaims{
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"oid": "11111111-1111-1111-1111-111111111111",
+		}),
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building config: %+v", err)
+	}
+
+	objectId, err := config.GetAuthenticatedObjectID(context.TODO())
+	if err != nil {
+		t.Fatalf("retrieving the authenticated object ID: %+v", err)
+	}
+	if objectId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the Object ID %q but got %q", "11111111-1111-1111-1111-111111111111", objectId)
+	}
+}
+
+func TestBearerTokenAuth_validateSubscriptionID(t *testing.T) {
+	builder := Builder{
+		AccessToken: "an-opaque-access-token",
+	}
+	if _, err := builder.Build(); err == nil || !strings.Contains(err.Error(), "A Subscription ID must be configured") {
+		t.Fatalf("expected an error about the Subscription ID but got: %+v", err)
+	}
+
+	builder.TenantOnly = true
+	if _, err := builder.Build(); err != nil {
+		t.Fatalf("expected no error when authenticating at the tenant level but got: %+v", err)
+	}
+}
+
+// testAccessToken returns a JWT containing the specified claims, signed using HMAC since the signature isn't checked
+func testAccessToken(t *testing.T, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("signing access token: %+v", err)
+	}
+
+	return token
+}
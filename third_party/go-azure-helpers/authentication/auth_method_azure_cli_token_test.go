@@ -0,0 +1,317 @@
+package authentication
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestAzureCLITokenAuth_isApplicable(t *testing.T) {
+	cases := []struct {
+		Description string
+		Builder     Builder
+		Valid       bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Builder:     Builder{},
+			Valid:       false,
+		},
+		{
+			Description: "Feature Toggled off",
+			Builder: Builder{
+				SupportsAzureCliToken: false,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Feature Toggled on",
+			Builder: Builder{
+				SupportsAzureCliToken: true,
+			},
+			Valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		applicable := azureCliTokenAuth{}.isApplicable(v.Builder)
+		if v.Valid != applicable {
+			t.Fatalf("Expected %q to be %t but got %t", v.Description, v.Valid, applicable)
+		}
+	}
+}
+
+func TestAzureCLITokenAuth_populateConfig(t *testing.T) {
+	config := &Config{}
+	auth := azureCliTokenAuth{
+		profile: &azureCLIProfile{
+			clientId:       "some-subscription-id",
+			environment:    "dimension-c137",
+			subscriptionId: "some-subscription-id",
+			tenantId:       "some-tenant-id",
+		},
+	}
+
+	err := auth.populateConfig(config)
+	if err != nil {
+		t.Fatalf("Error populating config: %s", err)
+	}
+
+	if auth.profile.clientId != config.ClientID {
+		t.Fatalf("Expected Client ID to be %q but got %q", auth.profile.tenantId, config.TenantID)
+	}
+
+	if auth.profile.environment != config.Environment {
+		t.Fatalf("Expected Environment to be %q but got %q", auth.profile.tenantId, config.TenantID)
+	}
+
+	if auth.profile.subscriptionId != config.SubscriptionID {
+		t.Fatalf("Expected Subscription ID to be %q but got %q", auth.profile.tenantId, config.TenantID)
+	}
+
+	if auth.profile.tenantId != config.TenantID {
+		t.Fatalf("Expected Tenant ID to be %q but got %q", auth.profile.tenantId, config.TenantID)
+	}
+}
+
+func TestAzureCLITokenAuth_validate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      azureCliTokenAuth
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      azureCliTokenAuth{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: azureCliTokenAuth{
+				profile: &azureCLIProfile{
+					subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+					tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: azureCliTokenAuth{
+				profile: &azureCLIProfile{
+					clientId: "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+					tenantId: "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: azureCliTokenAuth{
+				profile: &azureCLIProfile{
+					clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+					subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: azureCliTokenAuth{
+				profile: &azureCLIProfile{
+					clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+					subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+					tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				},
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Valid TenantOnly Configuration",
+			Config: azureCliTokenAuth{
+				profile: &azureCLIProfile{
+					clientId:   "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+					tenantId:   "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+					tenantOnly: true,
+				},
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Invalid TenantOnly Configuration",
+			Config: azureCliTokenAuth{
+				profile: &azureCLIProfile{
+					clientId:   "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+					tenantOnly: true,
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.validate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+func TestAzureCliTokenAuth_defaultSubscription(t *testing.T) {
+	testData := []struct {
+		name                   string
+		subscriptionId         string
+		account                string
+		expectedSubscriptionId string
+		expectedTenantId       string
+		expectedError          string
+	}{
+		{
+			name:                   "default subscription",
+			account:                `{"id": "00000000-0000-0000-0000-000000000000", "tenantId": "11111111-1111-1111-1111-111111111111", "isDefault": true, "environmentName": "AzureCloud", "user": {"name": "user@example.com", "type": "user"}}`,
+			expectedSubscriptionId: "00000000-0000-0000-0000-000000000000",
+			expectedTenantId:       "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name:                   "explicit subscription",
+			subscriptionId:         "22222222-2222-2222-2222-222222222222",
+			account:                `{"id": "22222222-2222-2222-2222-222222222222", "tenantId": "11111111-1111-1111-1111-111111111111", "isDefault": false, "environmentName": "AzureCloud", "user": {"name": "user@example.com", "type": "user"}}`,
+			expectedSubscriptionId: "22222222-2222-2222-2222-222222222222",
+			expectedTenantId:       "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name:          "no default subscription set",
+			account:       `{"id": "", "tenantId": "11111111-1111-1111-1111-111111111111", "isDefault": false, "environmentName": "AzureCloud", "user": {"name": "user@example.com", "type": "user"}}`,
+			expectedError: "no default Subscription is set in the Azure CLI",
+		},
+	}
+
+	defer func(original func(arg ...string) ([]byte, error)) {
+		runAzCmd = original
+	}(runAzCmd)
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		account := v.account
+		runAzCmd = func(arg ...string) ([]byte, error) {
+			switch {
+			case len(arg) > 0 && arg[0] == "version":
+				return []byte(`{"azure-cli": "2.30.0"}`), nil
+			case len(arg) > 1 && arg[0] == "account" && arg[1] == "show":
+				return []byte(account), nil
+			}
+			return nil, fmt.Errorf("unexpected Azure CLI invocation: az %s", strings.Join(arg, " "))
+		}
+
+		builder := Builder{
+			SubscriptionID:        v.subscriptionId,
+			SupportsAzureCliToken: true,
+		}
+
+		method, err := azureCliTokenAuth{}.build(builder)
+		if v.expectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+				t.Fatalf("expected an error containing %q but got %+v", v.expectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("building: %+v", err)
+		}
+
+		if err := method.validate(); err != nil {
+			t.Fatalf("validating: %+v", err)
+		}
+
+		config := &Config{}
+		if err := method.populateConfig(config); err != nil {
+			t.Fatalf("populating config: %+v", err)
+		}
+
+		if config.SubscriptionID != v.expectedSubscriptionId {
+			t.Fatalf("expected the Subscription ID %q but got %q", v.expectedSubscriptionId, config.SubscriptionID)
+		}
+		if config.TenantID != v.expectedTenantId {
+			t.Fatalf("expected the Tenant ID %q but got %q", v.expectedTenantId, config.TenantID)
+		}
+	}
+}
+
+func TestAzureCliTokenAuth_getAuthorizationTokenResource(t *testing.T) {
+	testData := []struct {
+		name             string
+		endpoint         string
+		expectedResource string
+	}{
+		{
+			name:             "Resource Manager",
+			endpoint:         "https://management.azure.com/",
+			expectedResource: "https://management.azure.com",
+		},
+		{
+			name:             "Microsoft Graph",
+			endpoint:         "https://graph.microsoft.com/",
+			expectedResource: "https://graph.microsoft.com",
+		},
+		{
+			name:             "Key Vault without a trailing slash",
+			endpoint:         "https://vault.azure.net",
+			expectedResource: "https://vault.azure.net",
+		},
+	}
+
+	defer func(original func(arg ...string) ([]byte, error)) {
+		runAzCmd = original
+	}(runAzCmd)
+
+	oauthConfig, err := adal.NewOAuthConfig("https://login.microsoftonline.com/", "11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	auth := azureCliTokenAuth{
+		profile: &azureCLIProfile{
+			clientId:       "04b07795-8ddb-461a-bbee-02f9e1bf7b46",
+			subscriptionId: "00000000-0000-0000-0000-000000000000",
+			tenantId:       "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	resources := make(map[string]string)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var resource string
+		runAzCmd = func(arg ...string) ([]byte, error) {
+			if len(arg) > 1 && arg[0] == "account" && arg[1] == "get-access-token" {
+				for i := 2; i < len(arg)-1; i++ {
+					if arg[i] == "--resource" {
+						resource = arg[i+1]
+					}
+				}
+				return []byte(`{"accessToken": "abc123", "expiresOn": "2099-01-01 00:00:00.000000", "tokenType": "Bearer"}`), nil
+			}
+			return nil, fmt.Errorf("unexpected Azure CLI invocation: az %s", strings.Join(arg, " "))
+		}
+
+		if _, err := auth.getAuthorizationToken(nil, &OAuthConfig{OAuth: oauthConfig}, v.endpoint); err != nil {
+			t.Fatalf("obtaining authorization token: %+v", err)
+		}
+
+		if resource != v.expectedResource {
+			t.Fatalf("expected the resource %q but got %q", v.expectedResource, resource)
+		}
+		if existing, ok := resources[resource]; ok {
+			t.Fatalf("expected a distinct resource for %q but it was the same as for %q", v.name, existing)
+		}
+		resources[resource] = v.name
+	}
+}
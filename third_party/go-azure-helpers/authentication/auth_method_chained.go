@@ -0,0 +1,128 @@
+package authentication
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-multierror"
+)
+
+// authMethodsByName are the authentication methods which can be specified in the `AuthMethodOrder`,
+// where each name maps to one or more methods - the first of which that's applicable is used
+var authMethodsByName = map[string][]authMethod{
+	"azure_cli":          {azureCliTokenMultiTenantAuth{}, azureCliTokenAuth{}},
+	"client_certificate": {servicePrincipalClientCertificateAuth{}},
+	"client_secret":      {servicePrincipalClientSecretMultiTenantAuth{}, servicePrincipalClientSecretAuth{}},
+	"msi":                {managedServiceIdentityAuth{}},
+	"oidc":               {servicePrincipalOIDCAuth{}},
+}
+
+// chainedAuth tries each of the authentication methods in the order specified in the `AuthMethodOrder`,
+// using the first which is able to obtain a token
+type chainedAuth struct {
+	methods []authMethod
+}
+
+func (a chainedAuth) build(b Builder) (authMethod, error) {
+	auth := chainedAuth{
+		methods: make([]authMethod, 0),
+	}
+
+	for _, name := range b.AuthMethodOrder {
+		candidates, ok := authMethodsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("%q is not a supported authentication method - supported values are %s", name, supportedAuthMethodNames())
+		}
+
+		for _, candidate := range candidates {
+			if !candidate.isApplicable(b) {
+				continue
+			}
+
+			method, err := candidate.build(b)
+			if err != nil {
+				log.Printf("[DEBUG] Skipping %s since it couldn't be built: %+v", candidate.name(), err)
+				break
+			}
+
+			if err := method.validate(); err != nil {
+				log.Printf("[DEBUG] Skipping %s since it isn't valid: %+v", method.name(), err)
+				break
+			}
+
+			auth.methods = append(auth.methods, method)
+			break
+		}
+	}
+
+	return auth, nil
+}
+
+func (a chainedAuth) isApplicable(b Builder) bool {
+	return len(b.AuthMethodOrder) > 0
+}
+
+func (a chainedAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	var errs *multierror.Error
+
+	for _, method := range a.methods {
+		authorizer, err := method.getAuthorizationToken(sender, oauth, endpoint)
+		if err == nil {
+			log.Printf("[DEBUG] Obtained an Authorization Token for %q using %s", endpoint, method.name())
+			return authorizer, nil
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %+v", method.name(), err))
+	}
+
+	if errs == nil {
+		return nil, fmt.Errorf("obtaining an Authorization Token for %q: no authentication methods were available", endpoint)
+	}
+
+	return nil, fmt.Errorf("obtaining an Authorization Token for %q using each of the authentication methods: %+v", endpoint, errs)
+}
+
+func (a chainedAuth) name() string {
+	names := make([]string, 0)
+	for _, method := range a.methods {
+		names = append(names, method.name())
+	}
+	return fmt.Sprintf("Chained Authentication (%s)", strings.Join(names, ", "))
+}
+
+func (a chainedAuth) tokenCacheIdentity() string {
+	identities := make([]string, 0)
+	for _, method := range a.methods {
+		identity := ""
+		if v, ok := method.(tokenCacheIdentifier); ok {
+			identity = v.tokenCacheIdentity()
+		}
+		identities = append(identities, identity)
+	}
+	return strings.Join(identities, "|")
+}
+
+func (a chainedAuth) populateConfig(c *Config) error {
+	// populate in reverse order so that the values from the preferred method take precedence
+	for i := len(a.methods) - 1; i >= 0; i-- {
+		if err := a.methods[i].populateConfig(c); err != nil {
+			return fmt.Errorf("populating the configuration from %s: %+v", a.methods[i].name(), err)
+		}
+	}
+
+	return nil
+}
+
+func (a chainedAuth) validate() error {
+	if len(a.methods) == 0 {
+		return fmt.Errorf("none of the authentication methods specified in the order were applicable - supported values are %s", supportedAuthMethodNames())
+	}
+
+	return nil
+}
+
+func supportedAuthMethodNames() string {
+	return "`azure_cli`, `client_certificate`, `client_secret`, `msi` and `oidc`"
+}
@@ -0,0 +1,139 @@
+package authentication
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testAuthMethod struct {
+	methodName string
+	err        error
+	attempts   *int
+}
+
+func (a testAuthMethod) build(b Builder) (authMethod, error) {
+	return a, nil
+}
+
+func (a testAuthMethod) isApplicable(b Builder) bool {
+	return true
+}
+
+func (a testAuthMethod) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	*a.attempts++
+	if a.err != nil {
+		return nil, a.err
+	}
+	return autorest.NullAuthorizer{}, nil
+}
+
+func (a testAuthMethod) name() string {
+	return a.methodName
+}
+
+func (a testAuthMethod) populateConfig(c *Config) error {
+	c.ClientID = a.methodName
+	return nil
+}
+
+func (a testAuthMethod) validate() error {
+	return nil
+}
+
+func TestChainedAuth_firstSuccessIsUsed(t *testing.T) {
+	first, second, third := 0, 0, 0
+	auth := chainedAuth{
+		methods: []authMethod{
+			testAuthMethod{methodName: "First", err: fmt.Errorf("not logged in"), attempts: &first},
+			testAuthMethod{methodName: "Second", attempts: &second},
+			testAuthMethod{methodName: "Third", attempts: &third},
+		},
+	}
+
+	if _, err := auth.getAuthorizationToken(nil, &OAuthConfig{}, "https://management.azure.com/"); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if first != 1 || second != 1 || third != 0 {
+		t.Fatalf("expected the first two methods to be attempted once, but got %d, %d and %d attempts", first, second, third)
+	}
+}
+
+func TestChainedAuth_allFailures(t *testing.T) {
+	first, second := 0, 0
+	auth := chainedAuth{
+		methods: []authMethod{
+			testAuthMethod{methodName: "First", err: fmt.Errorf("not logged in"), attempts: &first},
+			testAuthMethod{methodName: "Second", err: fmt.Errorf("endpoint unavailable"), attempts: &second},
+		},
+	}
+
+	_, err := auth.getAuthorizationToken(nil, &OAuthConfig{}, "https://management.azure.com/")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	for _, expected := range []string{"First: not logged in", "Second: endpoint unavailable"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected the error to contain %q but got %q", expected, err.Error())
+		}
+	}
+}
+
+func TestChainedAuth_populateConfigPrefersFirstMethod(t *testing.T) {
+	attempts := 0
+	auth := chainedAuth{
+		methods: []authMethod{
+			testAuthMethod{methodName: "First", attempts: &attempts},
+			testAuthMethod{methodName: "Second", attempts: &attempts},
+		},
+	}
+
+	config := Config{}
+	if err := auth.populateConfig(&config); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if config.ClientID != "First" {
+		t.Fatalf("expected the configuration to be populated from the first method but got %q", config.ClientID)
+	}
+}
+
+func TestChainedAuth_build(t *testing.T) {
+	builder := Builder{
+		AuthMethodOrder:          []string{"msi", "client_secret"},
+		ClientID:                 "00000000-0000-0000-0000-000000000000",
+		ClientSecret:             "secret",
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		TenantID:                 "00000000-0000-0000-0000-000000000000",
+		SupportsClientSecretAuth: true,
+	}
+
+	method, err := chainedAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("building auth method: %+v", err)
+	}
+
+	// MSI isn't supported by the Builder, so only the Client Secret should be used
+	methods := method.(chainedAuth).methods
+	if len(methods) != 1 || methods[0].name() != (servicePrincipalClientSecretAuth{}).name() {
+		t.Fatalf("expected only the Client Secret auth method to be used but got %q", method.name())
+	}
+}
+
+func TestChainedAuth_buildUnsupportedMethod(t *testing.T) {
+	builder := Builder{
+		AuthMethodOrder: []string{"azure_cli", "carrier_pigeon"},
+	}
+
+	_, err := chainedAuth{}.build(builder)
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "carrier_pigeon") {
+		t.Fatalf("expected the error to contain the unsupported method but got %q", err.Error())
+	}
+}
@@ -0,0 +1,219 @@
+package authentication
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/crypto/pkcs12"
+)
+
+type servicePrincipalClientCertificateAuth struct {
+	clientId           string
+	clientCertPath     string
+	clientCertPassword string
+	clientCertVaultId  string
+	subscriptionId     string
+	tenantId           string
+	tenantOnly         bool
+
+	// sendCertificateChain specifies whether the full certificate chain should be included in the `x5c` header
+	// of the client assertion, which is required for Subject Name + Issuer authentication
+	sendCertificateChain bool
+
+	// bootstrap is the authentication method used to retrieve the Client Certificate from
+	// Key Vault, which is only set when `clientCertVaultId` is specified
+	bootstrap authMethod
+}
+
+func (a servicePrincipalClientCertificateAuth) build(b Builder) (authMethod, error) {
+	method := servicePrincipalClientCertificateAuth{
+		clientId:           b.ClientID,
+		clientCertPath:     b.ClientCertPath,
+		clientCertPassword: b.ClientCertPassword,
+		clientCertVaultId:  b.ClientCertificateVaultID,
+		subscriptionId:     b.SubscriptionID,
+		tenantId:           b.TenantID,
+		tenantOnly:         b.TenantOnly,
+
+		sendCertificateChain: b.ClientCertificateSendChain,
+	}
+
+	if b.ClientCertificateVaultID != "" {
+		bootstrap, err := buildClientCertificateVaultBootstrap(b)
+		if err != nil {
+			return nil, err
+		}
+		method.bootstrap = bootstrap
+	}
+
+	return method, nil
+}
+
+func (a servicePrincipalClientCertificateAuth) isApplicable(b Builder) bool {
+	return b.SupportsClientCertAuth && (b.ClientCertPath != "" || b.ClientCertificateVaultID != "")
+}
+
+func (a servicePrincipalClientCertificateAuth) name() string {
+	return "Service Principal / Client Certificate"
+}
+
+func (a servicePrincipalClientCertificateAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauth.OAuth == nil {
+		return nil, fmt.Errorf("Error getting Authorization Token for client cert: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	// Get the certificate and private key from either Key Vault or the pfx file
+	var certificateData []byte
+	if a.clientCertVaultId != "" {
+		var err error
+		certificateData, err = a.getCertificateFromKeyVault(sender, oauth)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		certificateData, err = ioutil.ReadFile(a.clientCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading Client Certificate %q: %v", a.clientCertPath, err)
+		}
+	}
+
+	spt, err := a.buildServicePrincipalToken(*oauth.OAuth, certificateData, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	spt.SetSender(sender)
+
+	err = spt.Refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	auth := autorest.NewBearerAuthorizer(spt)
+	return auth, nil
+}
+
+func (a servicePrincipalClientCertificateAuth) buildServicePrincipalToken(oauthConfig adal.OAuthConfig, certificateData []byte, endpoint string) (*adal.ServicePrincipalToken, error) {
+	source := "pkcs12 certificate"
+	if a.clientCertVaultId != "" {
+		source = "pkcs12 certificate retrieved from Key Vault"
+	}
+
+	if a.sendCertificateChain {
+		chain, rsaPrivateKey, err := decodePkcs12Chain(certificateData, a.clientCertPassword)
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding %s: %v", source, err)
+		}
+
+		secret := &clientCertificateChainSecret{
+			clientId:      a.clientId,
+			tokenEndpoint: oauthConfig.TokenEndpoint.String(),
+			chain:         chain,
+			privateKey:    rsaPrivateKey,
+		}
+		return adal.NewServicePrincipalTokenWithSecret(oauthConfig, a.clientId, endpoint, secret)
+	}
+
+	certificate, rsaPrivateKey, err := decodePkcs12(certificateData, a.clientCertPassword)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding %s: %v", source, err)
+	}
+
+	return adal.NewServicePrincipalTokenFromCertificate(oauthConfig, a.clientId, certificate, rsaPrivateKey, endpoint)
+}
+
+func (a servicePrincipalClientCertificateAuth) tokenCacheIdentity() string {
+	// the contents of a Client Certificate in Key Vault aren't known without retrieving it, so the ID is used
+	if a.clientCertVaultId != "" {
+		return a.clientCertVaultId
+	}
+
+	certificateData, err := ioutil.ReadFile(a.clientCertPath)
+	if err != nil {
+		return a.clientCertPath
+	}
+
+	return credentialFingerprint(certificateData)
+}
+
+func (a servicePrincipalClientCertificateAuth) populateConfig(c *Config) error {
+	c.AuthenticatedAsAServicePrincipal = true
+	c.GetAuthenticatedObjectID = buildServicePrincipalObjectIDFunc(c)
+	return nil
+}
+
+func (a servicePrincipalClientCertificateAuth) validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using a Client Certificate."
+
+	if !a.tenantOnly && a.subscriptionId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+
+	if a.clientId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+
+	if a.clientCertPath != "" && a.clientCertVaultId != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of the Client Certificate Path or the Client Certificate Key Vault ID can be configured when authenticating as a Service Principal using a Client Certificate."))
+	} else if a.clientCertVaultId != "" {
+		if _, verr := parseClientCertificateVaultID(a.clientCertVaultId); verr != nil {
+			err = multierror.Append(err, fmt.Errorf("The Client Certificate Key Vault ID is not valid: %v", verr))
+		}
+		if a.bootstrap == nil {
+			err = multierror.Append(err, fmt.Errorf("A Client Secret or Managed Service Identity must be configured to retrieve the Client Certificate from Key Vault."))
+		} else if berr := a.bootstrap.validate(); berr != nil {
+			err = multierror.Append(err, fmt.Errorf("validating the credentials used to retrieve the Client Certificate from Key Vault: %v", berr))
+		}
+	} else if a.clientCertPath == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client Certificate Path"))
+	} else {
+
+		// validate the certificate path is a valid pfx file
+		var derr error
+		if a.sendCertificateChain {
+			_, _, derr = decodePkcs12ChainFile(a.clientCertPath, a.clientCertPassword)
+		} else {
+			_, _, derr = decodePkcs12File(a.clientCertPath, a.clientCertPassword)
+		}
+		if derr != nil {
+			err = multierror.Append(err, fmt.Errorf("The Client Certificate Path is not a valid pfx file: %v", derr))
+		}
+	}
+
+	if a.tenantId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+func decodePkcs12File(f string, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certificateData, err := ioutil.ReadFile(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %v", f, err)
+	}
+
+	return decodePkcs12(certificateData, password)
+}
+
+func decodePkcs12(certificateData []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	privateKey, certificate, err := pkcs12.Decode(certificateData, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rsaPrivateKey, isRsaKey := privateKey.(*rsa.PrivateKey)
+	if !isRsaKey {
+		return nil, nil, fmt.Errorf("PKCS#12 certificate must contain an RSA private key")
+	}
+
+	return certificate, rsaPrivateKey, nil
+}
@@ -0,0 +1,582 @@
+package authentication
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/form3tech-oss/jwt-go"
+)
+
+func TestServicePrincipalClientCertAuth_builder(t *testing.T) {
+	builder := Builder{
+		ClientID:           "some-client-id",
+		ClientCertPath:     "some-client-cert-path",
+		ClientCertPassword: "some-password",
+		Environment:        "some-environment",
+		SubscriptionID:     "some-subscription-id",
+		TenantID:           "some-tenant-id",
+	}
+	config, err := servicePrincipalClientCertificateAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("Error building client cert auth: %s", err)
+	}
+
+	servicePrincipal := config.(servicePrincipalClientCertificateAuth)
+
+	if builder.ClientID != servicePrincipal.clientId {
+		t.Fatalf("Expected Client ID to be %q but got %q", builder.ClientID, servicePrincipal.clientId)
+	}
+
+	if builder.ClientCertPath != servicePrincipal.clientCertPath {
+		t.Fatalf("Expected Client Certificate Path to be %q but got %q", builder.ClientCertPath, servicePrincipal.clientCertPath)
+	}
+
+	if builder.ClientCertPassword != servicePrincipal.clientCertPassword {
+		t.Fatalf("Expected Client Certificate Password to be %q but got %q", builder.ClientCertPassword, servicePrincipal.clientCertPassword)
+	}
+
+	if builder.SubscriptionID != servicePrincipal.subscriptionId {
+		t.Fatalf("Expected Subscription ID to be %q but got %q", builder.SubscriptionID, servicePrincipal.subscriptionId)
+	}
+
+	if builder.TenantID != servicePrincipal.tenantId {
+		t.Fatalf("Expected Tenant ID to be %q but got %q", builder.TenantID, servicePrincipal.tenantId)
+	}
+}
+
+func TestServicePrincipalClientCertAuth_isApplicable(t *testing.T) {
+	cases := []struct {
+		Description string
+		Builder     Builder
+		Valid       bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Builder:     Builder{},
+			Valid:       false,
+		},
+		{
+			Description: "Feature Toggled off",
+			Builder: Builder{
+				SupportsClientCertAuth: false,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Feature Toggled on but no cert specified",
+			Builder: Builder{
+				SupportsClientCertAuth: true,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Cert specified but feature toggled off",
+			Builder: Builder{
+				ClientCertPath: "./path/to/file",
+			},
+			Valid: false,
+		},
+		{
+			Description: "Valid configuration",
+			Builder: Builder{
+				SupportsClientCertAuth: true,
+				ClientCertPath:         "./path/to/file",
+			},
+			Valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		applicable := servicePrincipalClientCertificateAuth{}.isApplicable(v.Builder)
+		if v.Valid != applicable {
+			t.Fatalf("Expected %q to be %t but got %t", v.Description, v.Valid, applicable)
+		}
+	}
+}
+
+func TestServicePrincipalClientCertAuth_populateConfig(t *testing.T) {
+	config := &Config{}
+	err := servicePrincipalClientCertificateAuth{}.populateConfig(config)
+	if err != nil {
+		t.Fatalf("Error populating config: %s", err)
+	}
+
+	if !config.AuthenticatedAsAServicePrincipal {
+		t.Fatalf("Expected `AuthenticatedAsAServicePrincipal` to be true but it wasn't")
+	}
+}
+
+func TestServicePrincipalClientCertAuth_validate(t *testing.T) {
+	filePath := "./testdata/sp.pfx"
+	emptyPath := "./testdata/empty"
+	aliasFilePath := "./testdata/sp.pfx.alias"
+
+	cases := []struct {
+		Description string
+		Config      servicePrincipalClientCertificateAuth
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      servicePrincipalClientCertificateAuth{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: servicePrincipalClientCertificateAuth{
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath: filePath,
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientCertPath: filePath,
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client Certificate Path",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath: filePath,
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "File isn't an valid pfx",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath: emptyPath,
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "File does not exist",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath: "does-not-exist.pfx",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration but incorrect password",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath: filePath,
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId:     "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath:     filePath,
+				clientCertPassword: "123",
+				tenantId:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Valid Configuration with file not end with .pfx",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId:     "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientCertPath:     aliasFilePath,
+				clientCertPassword: "123",
+				tenantId:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Invalid TenantOnly Configuration",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientCertPath:     filePath,
+				clientCertPassword: "123",
+				tenantOnly:         true,
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid TenantOnly Configuration",
+			Config: servicePrincipalClientCertificateAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientCertPath:     filePath,
+				clientCertPassword: "123",
+				tenantId:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				tenantOnly:         true,
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.validate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+// testClientCertificatePfx is a self-signed certificate (with an RSA private key) protected by the
+// password `testClientCertificatePassword`, encoded in the same way Key Vault returns a PFX secret
+const testClientCertificatePfx = "MIIGGQIBAzCCBd8GCSqGSIb3DQEHAaCCBdAEggXMMIIFyDCCAscGCSqGSIb3DQEHBqCCArgwggK0AgEAMIICrQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQMwDgQIwvaYzgfKdlYCAggAgIICgJRJ2idKFMFTpi6VYg6WW1qHtGsNqVl6yHvGHXJ0KkCGivy8BAhruoRWxPCVvk96q5S16kfDTYzLBdDFW+x4kHZRJy5vkdsNkgpt9rUxyLtqzlPLnNEyhWZ2xwG3qrS6IM01IHW/dqPC6y8Gl84b+u5C0aUHfHZektAJiJvdkBIuUrnubyM/eCJqtfrIXQYni8UzofgyjYI5zu3oIP0+qEHbRlmf9mUHs5SjommjC5zdZvvhllZNv0o3aM7OD45q1+lZydySoLeZjkuvTiz0BbrGolA63PswHOq4/cBpJuQ25W0OlMOBkSIhobwrdqmssifVawt54mV+jFQIOWRpzWVBK7ugTZK9rvm3x3nfasMTx9es5zq9l/D/cOHCpzLs5mNy1Y9bWcPTbba/m4BSK2FxUWx1H4B5+CRQHH9IVaJ+EY0khJvYqj/1apj+6ADfV2F5uzqMSaI3/Vd7Qu/SZn/yceXO6THc6Suv9KNzjCAgOLeTGfjaLyFWNXlODQBYCPcuBciRHL3cWBfwRgMbRre1BPeNtJS8iTPH1XVt6/wJUQnnRPH2wzf04+AMXE518zM88+QLH4VvqNEXKEixbafyYYiDz5U6igPGw/HW9NZqEc9/Z9dvzbwSU4W7konAxom/ZZu+PaG2U9wpQ6HG/HrQoxqzcUHTIhwvP6J2apgsP3S0a88T7lHFnm91kAJx32oxwNkv2C52EzBWf/DzLklvJbewb2EQNpiktYmMrjluBkM3/AGPWvs3eGjuthifA0qxnXq2roKpyKnAQCyj/maaY2K5vD+4lL7v8Xy4zF23Njgbcy8V0izeeQPDUELbb7ldzgp9cUBLu5OgwSd7kewwggL5BgkqhkiG9w0BBwGgggLqBIIC5jCCAuIwggLeBgsqhkiG9w0BDAoBAqCCAqYwggKiMBwGCiqGSIb3DQEMAQMwDgQIKuy7+7OcUfoCAggABIICgBYcpJLIf6MAg7IS9SQX5PkG+vVcMdXt2nqzymHqwsnI1l2OPPtRtLOFQgKloq0YpfXC+FUatmK5DmhfBPlsjuT+F+cbFtp54YZ1+zGZVQWLdhu/1q0DDGVejZ8e1awYbjbjfK8Shh7lFJouIV/iC1Q+bU3dfMhgnSeSE1izVu5atxEm+qjlGJcZ3MA+B3iYAP/D4Jvyy45k/takvEa0INRXM97VFiZ4YFOD1eqy4u5suqTdJ1D4gNNa8i5rl6JlvWHFoPOVE8xmceS+gkdRLyaJBJsgSkankxNac+ed/aKaHPyQ2XQdCtiajU8T9qm/B9z9SSAEtgchgII9URcL85eZkMjboRJGGTuLa0Mp/wim5lGg/XOd2BWf6IdgxMBdEv9VOJ0RgcgRi+11fLF2Mu/cfQJ0OqFXTLYuLZyt9/jGT7DJp5GchlHcgc3aPe1HgvUNhrnosDfE9REuiEtKfl2/JQejoILh1xKfgFZFf2Z8E3HOe135QKPp4zxIhLneMR/PhRVXGQUJ8Qfu66Zi9/RK6Y77gMMwhTEXg21zT6SapdA5JfEcb91+s0wM4QCOe5tbQII/62iLpYZ/OPAF1b6GQA+SJFwCzUr5vaBdF9GDJFMh9g5f+f3y7bDNldisQp2aRGuYI9sMknVG6my1NrOPcZvY64H7vVyyrMsMj3LYB1qsG9mcbbklqF0j/P/aYDtGFQENG5CN5jwVHp0wpw2grJUBjO+Hw6nMxE71kMs+O/1mHRY/mbKo7jOHt1ZSC0HBIB9M2YSZG2eihDEGEDHPSUq+vYqciduJ3Y2SniFnoLYK3+HYrJC9ht47Uybr/4b9vExOOWUPQhR6Mk7iXz0xJTAjBgkqhkiG9w0BCRUxFgQUVWOKzaxF1qePNVOK9//R7Qlg7u8wMTAhMAkGBSsOAwIaBQAEFEjK4MaQ9PZdkyDGgNBqTemKFHzJBAieoQhI2HmBkQICCAA="
+
+const testClientCertificatePassword = "Password1234!"
+
+// testClientCertificateChainPfx is a certificate with the Common Name `Test Client Certificate` issued by an intermediate
+// certificate with the Common Name `Test Intermediate CA`, containing both certificates and the RSA private key of the
+// former - protected by the password `testClientCertificatePassword`
+const testClientCertificateChainPfx = "MIIMYQIBAzCCDCcGCSqGSIb3DQEHAaCCDBgEggwUMIIMEDCCBscGCSqGSIb3DQEHBqCCBrgwgga0AgEAMIIGrQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQMwDgQIcGoGo3CIVrUCAggAgIIGgEM96b3iy11t+0T6VcSZsdChhod/TXYtU8mI4or8bGjTFVKno+0Hfg/6EXLRjR2TuChFxdnZ6Vq6D7ak35a7vSE5oCW/IGeazbdm6gEjpoJZ9xaio00cFTWQ0UCPy0eNHnsdDjUl2M+MaR4H/dOar5gagL/MkG3CPLeZzNndFdQ4YCWr1g3ThCjouHg00idP+XJXLhps4il4odjbClurG4JWTWNwRGU9HYCRO8r5OG9CGrwrYWzVGQkkDShzyKi+UO6x0O51w8f/XwADplMVUPJ6psca/E/F7l1a14lhhNHMAIdN9/2yWKuPEqF2YbjDWMkdfw4TVbso50gylX6sDVGWY+y5l4v2JMT/qbSorH2LqVvuzEgp0Ojyv8RT+L+u9wzQeGSABruJ1dvv3cJAWzyE7ZxcbfxwQRaRFQgdGgjBlQR9j/f6P/4W9UlfNPxD4fv8stx3RL6KhwmPc0bj5ywVRC3FjzfedagTt8/MBAVX+OUNrSSxfRkZSBlk3oXa9SY0vtPA1MnLsv2jAisGftnDh25bprqOLem88XjfDiMCovhEayF3zwY3F8QibmWZ9B2lD3J8CKtOU6CN39hsdFQcCJPwgWoJC75RzowpqxAOycgTUC/Mg8hbvXtKZI/zT0AdmTnnZYpudG9Vh9n08SQBKJi2SpZUzApA+u7x4lB35gcskEXgFuE1C5OE6QGTUTjCPfI7ktuE+UXBgxe78fmTh2uuTPAr84qDxBZqikT8ExjwkxH8A0dyE7lBhM9kuncIKm/Y2m4HjnyJtYIlo0aii+2VGG6bIW3VAfLR4BoM2Q8m5/KBpUJBGNI/qxTvhk7oQ3u+pEo1q4+OrkETm2tdH7wo1GGjec2s1AnwrDkurZt7yecFxHWIRo0PTE1MzLbIkZVw4MFkI30zqioFl2aNw3YWhO4VBVKWjphI5x2vcFerLHogK0euZv9/meEcqIegYJZUvV3rVJfHig7xu+7vYOjcaaWd6nO84bhgm60LZMP93IbXSHjdjH2vj5JY1QtxFjBwpHwO3gIcpxlr6qXmU6b454x5LtATm4LmXm7dpcS9frqfR36Y79dYbpgf2/iHSE3rKHtTAAEMdAaJH8UdpuXf98J/i+w1Nm4J6FgQTe5RlQvgCuuqzNBcE5q/qqg+98mauOq9limHOMYysfKk0vURLid45uNDymRV3U/m7WCa0+SISYXlIEe9jeoYt3oKsrWmn8mXSwDB5Kzy4Kxa/DJe9KcV4KE+wDBg+LAPYhbGOUcVxV8KnchJhNHl3tbojkHxJn1RQKuJpb6J+VPP3laKUCxf3m4QDSosN7kobHHln6iTnugws9GhnCsDEq2urc8XsG1ASYDaAt1S3RrDH0kmR2iSi7ZPQU8OPoXh3M9k9kaLkaLgPMdOfEc9E3uk1TQVGbnyIfrnpw9p3kPMvDAbvVpwYAgKhiXmxmMcf7vraadme7Ptmpc9Qn7q5j2zHzJDeNcBAHtZPjdHh6bw7morB1ajQQsxsEPSWMnP3xYc+hcHJAS3+t3BS62IPxj53NTEn3rFwYgK6zf2fvNWCSCqLwMLUI3ZLV6cS8LyO+1zbauqXwsK4trNcZyQNePQI4iDqm2z3JnD+qd14Xp7vNPXS68vSoiiy7JRvw2guKTWrXLyBa0xkv/qbOApLkRG9jqiIhn0L2Hwl+f0oEPmbO/NWgcsw24+7rvByWe7UUdEu8bPvd0aMYWKGlF0qKZhJZQm14nP28gmNab2aAh9DgQk7tVsTyIwBThrUQVXXGqkAJxnScuHNq9vPF0gjJT7RR9We5NvO2xdWD/ZQXdZzkaoIZKbB2paPRLyL8S8vrk/d/CnVGvdeqwGvMjKmD7QuHYcE6N2xFma/GQU3ojIJTEKaapefpqLyco/gCCxeyRi0gISKruXd+kh5sCfmZ0x+iwnNr2MfXCUOKi1MvWsSUugkP0Vj54s96CaMDvMHUtd6XmCnohc1qh6ztkqT1OwI97Keg+1uuevQlG8/4Bliiag6tc2K1LGfiClNV28S54KZsFYZPmosQeqJ5ERRtjne2L2OiM7TcWKxWwpn+Rs619VDRijlBAsKZyHeYFm7fmkQkfi9rvcVDYLJ3uDw0c4WRnVFHtrExyVON0BQS/A343PCEa9qCyMfpWuGWorhWKHiiD4tKPKxmoj6ztNSQApheMSP6J33DpdQO42PTk0cK8wWkW1A9Bk6QmfCALhMIIFQQYJKoZIhvcNAQcBoIIFMgSCBS4wggUqMIIFJgYLKoZIhvcNAQwKAQKgggTuMIIE6jAcBgoqhkiG9w0BDAEDMA4ECFbSLSRnxuQqAgIIAASCBMg4luS2kmm+qu8TlVkBHg7sU1RzR5xwb8cFfHa1rZHMvAfw++arVNH+MGWuXsGsPpxH2FEEvVKtVZO3VSG9Tnk2DY92Lsu+PU3hukLEQfvTGKpEMvVN33UMukDQoRBe7DUv1IVRyYzSDwn9EeLL6B83MHEPCqdkHT92JbRIp9l9EsmZUlh0QQ5M865J8FvvDklBmz7DMQ5H8SJDE1UUBzaQr1EV8zmfjV9nOOAYLz3nr/b8wTyvvA1NDeKdR6SC3P+xDbOvCQlXQVmiGaUJCWfIZxitnlrkk2I03+0bCr/3hyGufHCUgiE1uh5xMUf4Uls7bauoXWvWtT2/8a3PQllp/U57CVCErD5vz+Papehj9WLKF/k8840knE2ovxP0z5sc1cz8KbBF3Sr4RFgVjqijXRDxfGY/V43XTceeyShecMLIq3ArQpYqjLUqWxXpHdwJBhaJ0iNJBkW/W/9FkK3QIl5IkyufLClU0Ct/dnP88K7AclMeGBHEGyCSyZa8Q+eI/49+kFPDdj3bH/K5J7ThBD03CafDZE1j9+kP+lku39xkVoIG+yJvmv85RTEJ8adcw8k6bfD9t/j3gx1AJrGSN8H68CxZ0ZHql7oSqsR3OYfKfVdwAIVi3QBqnSk7ep9SF4Hwymlkjt2P0F/uWzdg6q0IROtbL7dXVe6Lj8i4eDYlZnsbMo6UEJbsziS64uN/aD7dsrqzqj5tpxMUHs2vv1BmN11fS8POSuqFk/rLCgtqWV+9rkOZrql578OCbqjY5BxXBHGQrOiW80OJyYQ/xwwK6IwCiYFDR/7REHW8GAsxh14wWcKgpgETJqUsv6QghfmDOgv7zZR22MMvOnQhAJu+Rp425IPKv2Lz0MUlo74OEuIlNTxqFBeuqWekwtrVBSCG1E7v7CC4qUgPa5iTVqW6eNXzzbGvb8K0tJ6Noo+47CllJt8pkis9OOVYoBZTS+JBF2yzko4PpSum7CKsTiUmtICXCzL5BYWwu7olwVE+Xl6c2hlSmH/tWxnyrV3pUcoSeqrpIbTMCDHsmhBMAhD2spjgoHYIYWh1z9HQuJghHsQDcFaLZfIf3N2oxVDdGR9wbbYei1nav7y32dIeJy13QmMO2IvQ7mIR3Y1bGebF7HSgToWfxNNZs81flvg4PhpytJqC9xDJxhzhJswCZxBqdc4ZQKcxP6B9JgCgVghAPuXVYcaf9uT10SaNFxcu6ZY/iuOyPAcf46V1eEg7T6fsVHgvVECAXvkIG0Mkdety79iV4/tfwT81daVbp7rguRLEIwORCV6uD4zs216pLARFBAmZ40F5ZMFztF651uCfrhuRBNc2fIRS8JzaMBanbwZe8D9UGNdx55gv5ucgPQCXWUbFrw5jXGlPIPWqRvisDHQu8vS/Kwoq8b39D5Sj9/QyK2Ae+GnNDQSP6gpqu0DV1yEcXX14lVKYmLkTwF9IEV06xMt1WYkhyW6MiYSMx49LH75NH0h1C0LoRLZsqil7lAgwh2sa/qrIb4YQ0UMJ97PLAHoHBuYzNRoZFjlMuSDTnP9hFBsiRNYR1SCw8V70REBCIEJ+U8wb/WDVRob4B924KeKUVQgDSRmy/GetZkThsM4idMKdq8ZRG2DUxGHM9lnSt5kxJTAjBgkqhkiG9w0BCRUxFgQU3HISBq6S+V/NRDJWM9CSlVbG/IEwMTAhMAkGBSsOAwIaBQAEFI5CUCeZ62ZJcNTX2v5SqWkC0CFmBAjkKtJEY1r2JwICCAA="
+
+func TestServicePrincipalClientCertificateAuth_keyVault(t *testing.T) {
+	tokenRequests := make([]string, 0)
+	secretRequests := make([]string, 0)
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Host {
+		case "login.example.com":
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("parsing token request: %+v", err)
+			}
+			if r.PostForm.Get("client_secret") != "" {
+				tokenRequests = append(tokenRequests, fmt.Sprintf("secret:%s", r.PostForm.Get("resource")))
+				return testResponse(r, http.StatusOK, `{"access_token":"bootstrap-token","expires_in":"3600","expires_on":"0","not_before":"0","resource":"https://vault.azure.net","token_type":"Bearer"}`), nil
+			}
+			if r.PostForm.Get("client_assertion") != "" {
+				tokenRequests = append(tokenRequests, fmt.Sprintf("certificate:%s", r.PostForm.Get("resource")))
+				return testResponse(r, http.StatusOK, `{"access_token":"access-token","expires_in":"3600","expires_on":"0","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`), nil
+			}
+			t.Errorf("unexpected token request: %+v", r.PostForm)
+			return testResponse(r, http.StatusBadRequest, `{}`), nil
+
+		case "example.vault.azure.net":
+			secretRequests = append(secretRequests, r.URL.Path)
+			if r.Header.Get("Authorization") != "Bearer bootstrap-token" {
+				t.Errorf("expected the bootstrap token to be used for Key Vault but got %q", r.Header.Get("Authorization"))
+			}
+			if r.URL.Query().Get("api-version") != keyVaultApiVersion {
+				t.Errorf("expected the api-version to be %q but got %q", keyVaultApiVersion, r.URL.Query().Get("api-version"))
+			}
+			return testResponse(r, http.StatusOK, fmt.Sprintf(`{"value":%q,"contentType":"application/x-pkcs12"}`, testClientCertificatePfx)), nil
+		}
+
+		t.Errorf("unexpected request to %q", r.URL.String())
+		return testResponse(r, http.StatusNotFound, `{}`), nil
+	})
+
+	builder := Builder{
+		ClientID:                 "00000000-0000-0000-0000-000000000000",
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		TenantID:                 "00000000-0000-0000-0000-000000000000",
+		SupportsClientCertAuth:   true,
+		SupportsClientSecretAuth: true,
+		ClientSecret:             "bootstrap-secret",
+		ClientCertPassword:       testClientCertificatePassword,
+		ClientCertificateVaultID: "https://example.vault.azure.net/secrets/client-certificate/abc123",
+	}
+	method, err := servicePrincipalClientCertificateAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("building auth method: %+v", err)
+	}
+	if err := method.validate(); err != nil {
+		t.Fatalf("validating auth method: %+v", err)
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig("https://login.example.com/", builder.TenantID)
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	if _, err := method.getAuthorizationToken(sender, &OAuthConfig{OAuth: oauthConfig}, "https://management.azure.com/"); err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+
+	expectedTokenRequests := []string{"secret:https://vault.azure.net", "certificate:https://management.azure.com/"}
+	if strings.Join(tokenRequests, ",") != strings.Join(expectedTokenRequests, ",") {
+		t.Fatalf("expected the token requests to be %q but got %q", expectedTokenRequests, tokenRequests)
+	}
+
+	expectedSecretRequests := []string{"/secrets/client-certificate/abc123"}
+	if strings.Join(secretRequests, ",") != strings.Join(expectedSecretRequests, ",") {
+		t.Fatalf("expected the Key Vault requests to be %q but got %q", expectedSecretRequests, secretRequests)
+	}
+}
+
+func TestServicePrincipalClientCertificateAuth_validateKeyVault(t *testing.T) {
+	testData := []struct {
+		name          string
+		builder       Builder
+		expectedError string
+	}{
+		{
+			name: "path and key vault",
+			builder: Builder{
+				ClientCertPath:           "/path/to/certificate.pfx",
+				ClientCertificateVaultID: "https://example.vault.azure.net/secrets/client-certificate",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "bootstrap-secret",
+			},
+			expectedError: "Only one of the Client Certificate Path or the Client Certificate Key Vault ID can be configured",
+		},
+		{
+			name: "invalid key vault id",
+			builder: Builder{
+				ClientCertificateVaultID: "https://example.vault.azure.net/keys/client-certificate",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "bootstrap-secret",
+			},
+			expectedError: "The Client Certificate Key Vault ID is not valid",
+		},
+		{
+			name: "no bootstrap credentials",
+			builder: Builder{
+				ClientCertificateVaultID: "https://example.vault.azure.net/secrets/client-certificate",
+			},
+			expectedError: "A Client Secret or Managed Service Identity must be configured",
+		},
+		{
+			name: "valid",
+			builder: Builder{
+				ClientCertificateVaultID: "https://example.vault.azure.net/secrets/client-certificate",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "bootstrap-secret",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		v.builder.ClientID = "00000000-0000-0000-0000-000000000000"
+		v.builder.SubscriptionID = "00000000-0000-0000-0000-000000000000"
+		v.builder.TenantID = "00000000-0000-0000-0000-000000000000"
+		v.builder.SupportsClientCertAuth = true
+
+		method, err := servicePrincipalClientCertificateAuth{}.build(v.builder)
+		if err != nil {
+			t.Fatalf("building auth method: %+v", err)
+		}
+
+		err = method.validate()
+		if v.expectedError == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error containing %q but didn't get one", v.expectedError)
+		}
+		if !strings.Contains(err.Error(), v.expectedError) {
+			t.Fatalf("expected an error containing %q but got %q", v.expectedError, err.Error())
+		}
+	}
+}
+
+func TestServicePrincipalClientCertificateAuth_sendCertificateChain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clientcertificate")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	certificateData, err := base64.StdEncoding.DecodeString(testClientCertificateChainPfx)
+	if err != nil {
+		t.Fatalf("decoding certificate: %+v", err)
+	}
+	certificatePath := filepath.Join(dir, "certificate.pfx")
+	if err := ioutil.WriteFile(certificatePath, certificateData, 0600); err != nil {
+		t.Fatalf("writing certificate file: %+v", err)
+	}
+
+	assertions := make([]string, 0)
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing token request: %+v", err)
+		}
+		assertions = append(assertions, r.PostForm.Get("client_assertion"))
+		return testResponse(r, http.StatusOK, `{"access_token":"access-token","expires_in":"3600","expires_on":"0","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`), nil
+	})
+
+	builder := Builder{
+		ClientID:                   "00000000-0000-0000-0000-000000000000",
+		SubscriptionID:             "00000000-0000-0000-0000-000000000000",
+		TenantID:                   "00000000-0000-0000-0000-000000000000",
+		SupportsClientCertAuth:     true,
+		ClientCertPath:             certificatePath,
+		ClientCertPassword:         testClientCertificatePassword,
+		ClientCertificateSendChain: true,
+	}
+	method, err := servicePrincipalClientCertificateAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("building auth method: %+v", err)
+	}
+	if err := method.validate(); err != nil {
+		t.Fatalf("validating auth method: %+v", err)
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig("https://login.example.com/", builder.TenantID)
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	if _, err := method.getAuthorizationToken(sender, &OAuthConfig{OAuth: oauthConfig}, "https://management.azure.com/"); err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+
+	if len(assertions) != 1 {
+		t.Fatalf("expected 1 token request but got %d", len(assertions))
+	}
+	segments := strings.Split(assertions[0], ".")
+	if len(segments) != 3 {
+		t.Fatalf("expected the client assertion to be a JWT but got %q", assertions[0])
+	}
+	rawHeader, err := base64.RawURLEncoding.DecodeString(segments[0])
+	if err != nil {
+		t.Fatalf("decoding the client assertion header: %+v", err)
+	}
+	var header struct {
+		X5c []string `json:"x5c"`
+	}
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		t.Fatalf("unmarshalling the client assertion header: %+v", err)
+	}
+
+	expectedCommonNames := []string{"Test Client Certificate", "Test Intermediate CA"}
+	if len(header.X5c) != len(expectedCommonNames) {
+		t.Fatalf("expected the `x5c` header to contain %d certificates but got %d", len(expectedCommonNames), len(header.X5c))
+	}
+	chain := make([]*x509.Certificate, 0)
+	for i, v := range header.X5c {
+		raw, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			t.Fatalf("decoding certificate %d within the `x5c` header: %+v", i, err)
+		}
+		certificate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			t.Fatalf("parsing certificate %d within the `x5c` header: %+v", i, err)
+		}
+		if certificate.Subject.CommonName != expectedCommonNames[i] {
+			t.Fatalf("expected certificate %d within the `x5c` header to be %q but got %q", i, expectedCommonNames[i], certificate.Subject.CommonName)
+		}
+		chain = append(chain, certificate)
+	}
+
+	// the assertion must be signed by the private key of the Client Certificate
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(assertions[0], claims, func(token *jwt.Token) (interface{}, error) {
+		return chain[0].PublicKey, nil
+	}); err != nil {
+		t.Fatalf("verifying the client assertion: %+v", err)
+	}
+	if claims["aud"] != oauthConfig.TokenEndpoint.String() {
+		t.Fatalf("expected the `aud` claim to be %q but got %q", oauthConfig.TokenEndpoint.String(), claims["aud"])
+	}
+	if claims["iss"] != builder.ClientID || claims["sub"] != builder.ClientID {
+		t.Fatalf("expected the `iss` and `sub` claims to be %q but got %q and %q", builder.ClientID, claims["iss"], claims["sub"])
+	}
+}
+
+func TestBuilder_validateClientCertificateSendChain(t *testing.T) {
+	testData := []struct {
+		name          string
+		builder       Builder
+		expectedError bool
+	}{
+		{
+			name: "no certificate",
+			builder: Builder{
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "secret",
+			},
+			expectedError: true,
+		},
+		{
+			name: "certificate auth not supported",
+			builder: Builder{
+				ClientCertPath:           "/path/to/certificate.pfx",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "secret",
+			},
+			expectedError: true,
+		},
+		{
+			name: "key vault",
+			builder: Builder{
+				SupportsClientCertAuth:   true,
+				ClientCertificateVaultID: "https://example.vault.azure.net/secrets/client-certificate",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "bootstrap-secret",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		v.builder.ClientID = "00000000-0000-0000-0000-000000000000"
+		v.builder.SubscriptionID = "00000000-0000-0000-0000-000000000000"
+		v.builder.TenantID = "00000000-0000-0000-0000-000000000000"
+		v.builder.ClientCertificateSendChain = true
+
+		_, err := v.builder.Build()
+		if v.expectedError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectedError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "the Client Certificate chain can only be sent when authenticating using a Client Certificate") {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+}
+
+func testResponse(r *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		Request:    r,
+	}
+}
@@ -0,0 +1,138 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
+)
+
+type servicePrincipalClientSecretAuth struct {
+	clientId       string
+	clientSecret   string
+	subscriptionId string
+	tenantId       string
+	tenantOnly     bool
+
+	// clientSecretFileErr is the error returned when reading the Client Secret from the
+	// ClientSecretFilePath, which is surfaced during validation
+	clientSecretFileErr error
+}
+
+func (a servicePrincipalClientSecretAuth) build(b Builder) (authMethod, error) {
+	clientSecret, err := clientSecretFromBuilder(b)
+	method := servicePrincipalClientSecretAuth{
+		clientId:            b.ClientID,
+		clientSecret:        clientSecret,
+		subscriptionId:      b.SubscriptionID,
+		tenantId:            b.TenantID,
+		tenantOnly:          b.TenantOnly,
+		clientSecretFileErr: err,
+	}
+	return method, nil
+}
+
+func (a servicePrincipalClientSecretAuth) isApplicable(b Builder) bool {
+	return b.SupportsClientSecretAuth && (b.ClientSecret != "" || b.ClientSecretFilePath != "")
+}
+
+func (a servicePrincipalClientSecretAuth) name() string {
+	return "Service Principal / Client Secret"
+}
+
+func (a servicePrincipalClientSecretAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauth.OAuth == nil {
+		return nil, fmt.Errorf("Error getting Authorization Token for client secret auth: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	spt, err := adal.NewServicePrincipalToken(*oauth.OAuth, a.clientId, a.clientSecret, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	spt.SetSender(sender)
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+func (a servicePrincipalClientSecretAuth) tokenCacheIdentity() string {
+	return credentialFingerprint([]byte(a.clientSecret))
+}
+
+func (a servicePrincipalClientSecretAuth) populateConfig(c *Config) error {
+	c.AuthenticatedAsAServicePrincipal = true
+	c.GetAuthenticatedObjectID = buildServicePrincipalObjectIDFunc(c)
+	return nil
+}
+
+func (a servicePrincipalClientSecretAuth) validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using a Client Secret."
+
+	if !a.tenantOnly && a.subscriptionId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+	if a.clientId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+	if a.clientSecretFileErr != nil {
+		err = multierror.Append(err, a.clientSecretFileErr)
+	} else if a.clientSecret == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client Secret"))
+	}
+	if a.tenantId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+	err = multierror.Append(err, validateClientSecretIdentifiers(a.tenantId, a.subscriptionId, nil)...)
+
+	return err.ErrorOrNil()
+}
+
+// clientSecretFromBuilder returns the Client Secret specified in the Builder, falling back to reading
+// the Client Secret from the ClientSecretFilePath when no Client Secret is specified inline.
+func clientSecretFromBuilder(b Builder) (string, error) {
+	if b.ClientSecret != "" || b.ClientSecretFilePath == "" {
+		return b.ClientSecret, nil
+	}
+
+	contents, err := ioutil.ReadFile(b.ClientSecretFilePath)
+	if err != nil {
+		return "", fmt.Errorf("reading the Client Secret from the file %q: %+v", b.ClientSecretFilePath, err)
+	}
+
+	// the file is commonly written by a tool/process substitution which appends a trailing newline
+	return strings.TrimRight(string(contents), " \t\r\n"), nil
+}
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validateClientSecretIdentifiers ensures that the Tenant ID, Subscription ID and any Auxiliary Tenant IDs
+// are well-formed UUIDs, so that a typo is surfaced before making a request to Azure Active Directory.
+// Empty values are skipped, since these are reported separately.
+func validateClientSecretIdentifiers(tenantId, subscriptionId string, auxiliaryTenantIDs []string) []error {
+	errs := make([]error, 0)
+
+	if tenantId != "" && !uuidRegex.MatchString(tenantId) && !isTenantAlias(tenantId) {
+		errs = append(errs, fmt.Errorf("The Tenant ID %q is not a valid UUID - expected a value in the format `00000000-0000-0000-0000-000000000000`, or one of `common` or `organizations`.", tenantId))
+	}
+	if subscriptionId != "" && !uuidRegex.MatchString(subscriptionId) {
+		errs = append(errs, fmt.Errorf("The Subscription ID %q is not a valid UUID - expected a value in the format `00000000-0000-0000-0000-000000000000`.", subscriptionId))
+	}
+	for i, auxiliaryTenantId := range auxiliaryTenantIDs {
+		if !uuidRegex.MatchString(auxiliaryTenantId) {
+			errs = append(errs, fmt.Errorf("The Auxiliary Tenant ID %q (at index %d) is not a valid UUID - expected a value in the format `00000000-0000-0000-0000-000000000000`.", auxiliaryTenantId, i))
+		}
+	}
+
+	return errs
+}
+
+// isTenantAlias returns whether the Tenant ID is one of the aliases supported by Azure Active Directory
+// in place of a Tenant ID
+func isTenantAlias(tenantId string) bool {
+	return strings.EqualFold(tenantId, "common") || strings.EqualFold(tenantId, "organizations")
+}
@@ -0,0 +1,206 @@
+package authentication
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
+)
+
+type servicePrincipalClientSecretMultiTenantAuth struct {
+	clientId           string
+	clientSecret       string
+	subscriptionId     string
+	tenantId           string
+	tenantOnly         bool
+	auxiliaryTenantIDs []string
+
+	// auxiliaryTenantSecrets are the Client Secrets used for specific Auxiliary Tenants, keyed by the Tenant ID
+	auxiliaryTenantSecrets map[string]string
+
+	// clientSecretFileErr is the error returned when reading the Client Secret from the
+	// ClientSecretFilePath, which is surfaced during validation
+	clientSecretFileErr error
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) build(b Builder) (authMethod, error) {
+	clientSecret, err := clientSecretFromBuilder(b)
+	method := servicePrincipalClientSecretMultiTenantAuth{
+		clientId:               b.ClientID,
+		clientSecret:           clientSecret,
+		subscriptionId:         b.SubscriptionID,
+		tenantId:               b.TenantID,
+		tenantOnly:             b.TenantOnly,
+		auxiliaryTenantIDs:     deduplicateAuxiliaryTenantIDs(b.AuxiliaryTenantIDs),
+		auxiliaryTenantSecrets: b.AuxiliaryTenantSecrets,
+		clientSecretFileErr:    err,
+	}
+	return method, nil
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) isApplicable(b Builder) bool {
+	return b.SupportsClientSecretAuth && (b.ClientSecret != "" || b.ClientSecretFilePath != "") && b.SupportsAuxiliaryTenants && (len(b.AuxiliaryTenantIDs) > 0)
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) name() string {
+	return "Multi Tenant Service Principal / Client Secret"
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauth.MultiTenantOauth == nil {
+		return nil, fmt.Errorf("Error getting Authorization Token for client cert: an MultiTenantOauth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	var spt *adal.MultiTenantServicePrincipalToken
+	var err error
+	if len(a.auxiliaryTenantSecrets) > 0 {
+		spt, err = a.multiTenantServicePrincipalTokenWithSecrets(*oauth.MultiTenantOauth, endpoint)
+	} else {
+		spt, err = adal.NewMultiTenantServicePrincipalToken(*oauth.MultiTenantOauth, a.clientId, a.clientSecret, endpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spt.PrimaryToken.SetSender(sender)
+	for _, t := range spt.AuxiliaryTokens {
+		t.SetSender(sender)
+	}
+
+	auth := autorest.NewMultiTenantServicePrincipalTokenAuthorizer(spt)
+	return auth, nil
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) tokenCacheIdentity() string {
+	return credentialFingerprint([]byte(a.clientSecret))
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) populateConfig(c *Config) error {
+	c.AuthenticatedAsAServicePrincipal = true
+	c.GetAuthenticatedObjectID = buildServicePrincipalObjectIDFunc(c)
+	return nil
+}
+
+func (a servicePrincipalClientSecretMultiTenantAuth) validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using a Multi Tenant Client Secret."
+
+	if !a.tenantOnly && a.subscriptionId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+	if a.clientId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+	if a.clientSecretFileErr != nil {
+		err = multierror.Append(err, a.clientSecretFileErr)
+	} else if a.clientSecret == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client Secret"))
+	}
+	if a.tenantId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+	if len(a.auxiliaryTenantIDs) == 0 {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Auxiliary Tenant IDs"))
+	}
+	if len(a.auxiliaryTenantIDs) > maxAuxiliaryTenantIDs {
+		err = multierror.Append(err, fmt.Errorf("A maximum of %d Auxiliary Tenant IDs can be configured when authenticating as a Service Principal using a Multi Tenant Client Secret, since Azure doesn't support issuing tokens for more than %d Auxiliary Tenants - got %d.", maxAuxiliaryTenantIDs, maxAuxiliaryTenantIDs, len(a.auxiliaryTenantIDs)))
+	}
+	for _, auxiliaryTenantId := range a.auxiliaryTenantIDs {
+		if a.tenantId != "" && strings.EqualFold(auxiliaryTenantId, a.tenantId) {
+			err = multierror.Append(err, fmt.Errorf("The Auxiliary Tenant ID %q is the same as the Tenant ID - the primary Tenant must not be specified as an Auxiliary Tenant.", auxiliaryTenantId))
+		}
+	}
+	for auxiliaryTenantId, clientSecret := range a.auxiliaryTenantSecrets {
+		if !containsTenantID(a.auxiliaryTenantIDs, auxiliaryTenantId) {
+			err = multierror.Append(err, fmt.Errorf("A Client Secret was specified for the Tenant %q which isn't an Auxiliary Tenant - the Auxiliary Tenant IDs are %v.", auxiliaryTenantId, a.auxiliaryTenantIDs))
+		}
+		if clientSecret == "" {
+			err = multierror.Append(err, fmt.Errorf("The Client Secret for the Auxiliary Tenant %q must not be empty.", auxiliaryTenantId))
+		}
+	}
+	err = multierror.Append(err, validateClientSecretIdentifiers(a.tenantId, a.subscriptionId, a.auxiliaryTenantIDs)...)
+
+	return err.ErrorOrNil()
+}
+
+// maxAuxiliaryTenantIDs is the maximum number of Auxiliary Tenants which Azure supports issuing tokens for
+const maxAuxiliaryTenantIDs = 3
+
+// deduplicateAuxiliaryTenantIDs removes any duplicate Auxiliary Tenant IDs (compared case-insensitively),
+// retaining the order in which they were specified
+func deduplicateAuxiliaryTenantIDs(input []string) []string {
+	if input == nil {
+		return nil
+	}
+
+	output := make([]string, 0, len(input))
+	seen := make(map[string]struct{}, len(input))
+	for _, v := range input {
+		key := strings.ToLower(v)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		output = append(output, v)
+	}
+
+	return output
+}
+
+// multiTenantServicePrincipalTokenWithSecrets builds a MultiTenantServicePrincipalToken using the Client Secret specified
+// for each Auxiliary Tenant, falling back to the Client Secret when one isn't specified for an Auxiliary Tenant
+func (a servicePrincipalClientSecretMultiTenantAuth) multiTenantServicePrincipalTokenWithSecrets(multiTenantOauth adal.MultiTenantOAuthConfig, endpoint string) (*adal.MultiTenantServicePrincipalToken, error) {
+	primary, err := adal.NewServicePrincipalToken(*multiTenantOauth.PrimaryTenant(), a.clientId, a.clientSecret, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SPT for primary tenant: %v", err)
+	}
+
+	auxiliaryTenants := multiTenantOauth.AuxiliaryTenants()
+	spt := adal.MultiTenantServicePrincipalToken{
+		PrimaryToken:    primary,
+		AuxiliaryTokens: make([]*adal.ServicePrincipalToken, len(auxiliaryTenants)),
+	}
+	for i, auxiliaryTenant := range auxiliaryTenants {
+		tenantId := tenantIDFromOAuthConfig(*auxiliaryTenant)
+		aux, err := adal.NewServicePrincipalToken(*auxiliaryTenant, a.clientId, a.clientSecretForTenant(tenantId), endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SPT for auxiliary tenant %q: %v", tenantId, err)
+		}
+		spt.AuxiliaryTokens[i] = aux
+	}
+
+	return &spt, nil
+}
+
+// clientSecretForTenant returns the Client Secret specified for the Auxiliary Tenant (compared case-insensitively),
+// falling back to the Client Secret when there isn't one
+func (a servicePrincipalClientSecretMultiTenantAuth) clientSecretForTenant(tenantId string) string {
+	for auxiliaryTenantId, clientSecret := range a.auxiliaryTenantSecrets {
+		if strings.EqualFold(auxiliaryTenantId, tenantId) {
+			return clientSecret
+		}
+	}
+
+	return a.clientSecret
+}
+
+// tenantIDFromOAuthConfig returns the Tenant ID which the OAuthConfig was built for, which is the final segment of
+// the Authority Endpoint (e.g. `https://login.microsoftonline.com/{tenantId}`)
+func tenantIDFromOAuthConfig(config adal.OAuthConfig) string {
+	return path.Base(strings.TrimSuffix(config.AuthorityEndpoint.Path, "/"))
+}
+
+// containsTenantID returns whether the Tenant ID is present in the list of Tenant IDs, compared case-insensitively
+func containsTenantID(tenantIds []string, tenantId string) bool {
+	for _, v := range tenantIds {
+		if strings.EqualFold(v, tenantId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,484 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestServicePrincipalClientSecretMultiTenantAuth_builder(t *testing.T) {
+	builder := Builder{
+		ClientID:           "some-client-id",
+		ClientSecret:       "some-client-secret",
+		SubscriptionID:     "some-subscription-id",
+		TenantID:           "some-tenant-id",
+		AuxiliaryTenantIDs: []string{"aux-tenant-id1", "aux-tenant-id2"},
+	}
+	config, err := servicePrincipalClientSecretMultiTenantAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("Error building client secret auth: %s", err)
+	}
+	servicePrincipal := config.(servicePrincipalClientSecretMultiTenantAuth)
+
+	if builder.ClientID != servicePrincipal.clientId {
+		t.Fatalf("Expected Client ID to be %q but got %q", builder.ClientID, servicePrincipal.clientId)
+	}
+
+	if builder.ClientSecret != servicePrincipal.clientSecret {
+		t.Fatalf("Expected Client Secret to be %q but got %q", builder.ClientSecret, servicePrincipal.clientSecret)
+	}
+
+	if builder.SubscriptionID != servicePrincipal.subscriptionId {
+		t.Fatalf("Expected Subscription ID to be %q but got %q", builder.SubscriptionID, servicePrincipal.subscriptionId)
+	}
+
+	if builder.TenantID != servicePrincipal.tenantId {
+		t.Fatalf("Expected Tenant ID to be %q but got %q", builder.TenantID, servicePrincipal.tenantId)
+	}
+
+	if builder.AuxiliaryTenantIDs[0] != servicePrincipal.auxiliaryTenantIDs[0] {
+		t.Fatalf("Expected Auxiliary Tenant ID 1 to be %q but got %q", builder.TenantID[0], servicePrincipal.tenantId[0])
+	}
+
+	if builder.AuxiliaryTenantIDs[1] != servicePrincipal.auxiliaryTenantIDs[1] {
+		t.Fatalf("Expected Auxiliary Tenant ID 2 to be %q but got %q", builder.TenantID[1], servicePrincipal.tenantId[1])
+	}
+
+	if len(builder.AuxiliaryTenantIDs) != len(servicePrincipal.auxiliaryTenantIDs) {
+		t.Fatalf("Expected len(Auxiliary Tenant ID) to be %q but got %q", len(builder.TenantID), len(servicePrincipal.tenantId))
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_isApplicable(t *testing.T) {
+	cases := []struct {
+		Description string
+		Builder     Builder
+		Valid       bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Builder:     Builder{},
+			Valid:       false,
+		},
+		{
+			Description: "Feature Toggled off",
+			Builder: Builder{
+				SupportsClientSecretAuth: false,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Feature Toggled on but no secret specified",
+			Builder: Builder{
+				SupportsClientSecretAuth: true,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Secret specified but feature toggled off",
+			Builder: Builder{
+				ClientSecret: "I turned myself into a pickle morty!",
+			},
+			Valid: false,
+		},
+		{
+			Description: "Multi Tenant not enabled",
+			Builder: Builder{
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "I turned myself into a pickle morty!",
+			},
+			Valid: false,
+		},
+		{
+			Description: "Missing Auxiliary Tenants",
+			Builder: Builder{
+				SupportsClientSecretAuth: true,
+				SupportsAuxiliaryTenants: true,
+				ClientSecret:             "I turned myself into a pickle morty!",
+			},
+			Valid: false,
+		},
+		{
+			Description: "Valid configuration",
+			Builder: Builder{
+				SupportsClientSecretAuth: true,
+				SupportsAuxiliaryTenants: true,
+				AuxiliaryTenantIDs:       []string{"aux-tenant-id1", "aux-tenant-id2"},
+				ClientSecret:             "I turned myself into a pickle morty!",
+			},
+			Valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		applicable := servicePrincipalClientSecretMultiTenantAuth{}.isApplicable(v.Builder)
+		if v.Valid != applicable {
+			t.Fatalf("Expected %q to be %t but got %t", v.Description, v.Valid, applicable)
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_populateConfig(t *testing.T) {
+	config := &Config{}
+	err := servicePrincipalClientSecretMultiTenantAuth{}.populateConfig(config)
+	if err != nil {
+		t.Fatalf("Error populating config: %s", err)
+	}
+
+	if !config.AuthenticatedAsAServicePrincipal {
+		t.Fatalf("Expected `AuthenticatedAsAServicePrincipal` to be true but it wasn't")
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_validate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      servicePrincipalClientSecretMultiTenantAuth
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      servicePrincipalClientSecretMultiTenantAuth{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:   "Does Hammer Time have Daylight Savings Time?",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:     "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientSecret: "Does Hammer Time have Daylight Savings Time?",
+				tenantId:     "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client Secret",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:   "Does Hammer Time have Daylight Savings Time?",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Auxiliary Tenants ID",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:   "Does Hammer Time have Daylight Savings Time?",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId:     "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				tenantId:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				auxiliaryTenantIDs: []string{"9834f8d0-0707-1984-bd35-c611c461a129", "9834f8d0-1984-0707-bd35-c611c461a129"},
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Invalid TenantOnly Configuration",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				tenantOnly:         true,
+				auxiliaryTenantIDs: []string{"9834f8d0-0707-1984-bd35-c611c461a129", "9834f8d0-1984-0707-bd35-c611c461a129"},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid TenantOnly Configuration",
+			Config: servicePrincipalClientSecretMultiTenantAuth{
+				clientId:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				tenantId:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				tenantOnly:         true,
+				auxiliaryTenantIDs: []string{"9834f8d0-0707-1984-bd35-c611c461a129", "9834f8d0-1984-0707-bd35-c611c461a129"},
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.validate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_auxiliaryTenantIDs(t *testing.T) {
+	tenantId := "00000000-0000-0000-0000-000000000000"
+
+	testData := []struct {
+		name               string
+		auxiliaryTenantIds []string
+		expected           []string
+		expectedError      string
+	}{
+		{
+			name:               "within the limit",
+			auxiliaryTenantIds: []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222", "33333333-3333-3333-3333-333333333333"},
+			expected:           []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222", "33333333-3333-3333-3333-333333333333"},
+		},
+		{
+			name:               "over the limit",
+			auxiliaryTenantIds: []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222", "33333333-3333-3333-3333-333333333333", "44444444-4444-4444-4444-444444444444"},
+			expectedError:      "A maximum of 3 Auxiliary Tenant IDs can be configured",
+		},
+		{
+			name:               "duplicates are removed before checking the limit",
+			auxiliaryTenantIds: []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222", "11111111-1111-1111-1111-111111111111", "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", "AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA"},
+			expected:           []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222", "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"},
+		},
+		{
+			name:               "the primary tenant",
+			auxiliaryTenantIds: []string{"11111111-1111-1111-1111-111111111111", tenantId},
+			expectedError:      "is the same as the Tenant ID",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		builder := Builder{
+			ClientID:                 "00000000-0000-0000-0000-000000000000",
+			ClientSecret:             "secret",
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			TenantID:                 tenantId,
+			AuxiliaryTenantIDs:       v.auxiliaryTenantIds,
+			SupportsAuxiliaryTenants: true,
+			SupportsClientSecretAuth: true,
+		}
+
+		method, err := servicePrincipalClientSecretMultiTenantAuth{}.build(builder)
+		if err != nil {
+			t.Fatalf("building: %+v", err)
+		}
+
+		err = method.validate()
+		if v.expectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+				t.Fatalf("expected validation to fail with %q but got %+v", v.expectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("validating: %+v", err)
+		}
+
+		actual := method.(servicePrincipalClientSecretMultiTenantAuth).auxiliaryTenantIDs
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected the Auxiliary Tenant IDs %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_httpClient(t *testing.T) {
+	proxy := newTestTokenProxy(t)
+	defer proxy.Close()
+
+	builder := Builder{
+		ClientID:                 "00000000-0000-0000-0000-000000000000",
+		ClientSecret:             "secret",
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		TenantID:                 "11111111-1111-1111-1111-111111111111",
+		AuxiliaryTenantIDs:       []string{"22222222-2222-2222-2222-222222222222"},
+		SupportsAuxiliaryTenants: true,
+		SupportsClientSecretAuth: true,
+		HTTPClient:               proxy.client(t),
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building: %+v", err)
+	}
+
+	oauth, err := config.BuildOAuthConfig(testTokenProxyActiveDirectoryEndpoint)
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	authorizer, err := config.GetAuthorizationToken(testUnusedSender(t), oauth, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+	testAuthorizeRequest(t, authorizer)
+
+	// a token is obtained for both the primary and auxiliary tenant
+	actual := proxy.requestedPaths()
+	sort.Strings(actual)
+	expected := []string{
+		"/11111111-1111-1111-1111-111111111111/oauth2/token",
+		"/22222222-2222-2222-2222-222222222222/oauth2/token",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the token requests %+v to pass through the proxy but got %+v", expected, actual)
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_auxiliaryTenantSecrets(t *testing.T) {
+	builder := Builder{
+		ClientID:       "00000000-0000-0000-0000-000000000000",
+		ClientSecret:   "shared-secret",
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		TenantID:       "11111111-1111-1111-1111-111111111111",
+		AuxiliaryTenantIDs: []string{
+			"22222222-2222-2222-2222-222222222222",
+			"33333333-3333-3333-3333-33333333333a",
+		},
+		AuxiliaryTenantSecrets: map[string]string{
+			// the Tenant ID is compared case-insensitively
+			"33333333-3333-3333-3333-33333333333A": "tenant-3-secret",
+		},
+		SupportsAuxiliaryTenants: true,
+		SupportsClientSecretAuth: true,
+	}
+
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building: %+v", err)
+	}
+
+	oauth, err := config.BuildOAuthConfig("https://login.example.com/")
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	var lock sync.Mutex
+	actual := make(map[string]string)
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parsing token request: %+v", err)
+		}
+
+		lock.Lock()
+		actual[r.URL.Path] = r.PostForm.Get("client_secret")
+		lock.Unlock()
+
+		expiresOn := time.Now().Add(time.Hour).Unix()
+		body := fmt.Sprintf(`{"access_token":"abc123","expires_in":"3600","expires_on":"%d","not_before":"%d","resource":"https://management.azure.com/","token_type":"Bearer"}`, expiresOn, time.Now().Unix())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+
+	authorizer, err := config.GetAuthorizationToken(sender, oauth, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+	testAuthorizeRequest(t, authorizer)
+
+	// the Auxiliary Tenant without a Client Secret of its own uses the shared Client Secret
+	expected := map[string]string{
+		"/11111111-1111-1111-1111-111111111111/oauth2/token": "shared-secret",
+		"/22222222-2222-2222-2222-222222222222/oauth2/token": "shared-secret",
+		"/33333333-3333-3333-3333-33333333333a/oauth2/token": "tenant-3-secret",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the Client Secrets %+v but got %+v", expected, actual)
+	}
+}
+
+func TestServicePrincipalClientSecretMultiTenantAuth_validateAuxiliaryTenantSecrets(t *testing.T) {
+	testData := []struct {
+		name                   string
+		auxiliaryTenantSecrets map[string]string
+		expectedError          string
+	}{
+		{
+			name: "secret for an auxiliary tenant",
+			auxiliaryTenantSecrets: map[string]string{
+				"22222222-2222-2222-2222-222222222222": "secret",
+			},
+		},
+		{
+			name: "secret for the primary tenant",
+			auxiliaryTenantSecrets: map[string]string{
+				"11111111-1111-1111-1111-111111111111": "secret",
+			},
+			expectedError: "isn't an Auxiliary Tenant",
+		},
+		{
+			name: "secret for an unknown tenant",
+			auxiliaryTenantSecrets: map[string]string{
+				"44444444-4444-4444-4444-444444444444": "secret",
+			},
+			expectedError: "isn't an Auxiliary Tenant",
+		},
+		{
+			name: "empty secret",
+			auxiliaryTenantSecrets: map[string]string{
+				"22222222-2222-2222-2222-222222222222": "",
+			},
+			expectedError: "must not be empty",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		builder := Builder{
+			ClientID:                 "00000000-0000-0000-0000-000000000000",
+			ClientSecret:             "secret",
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			TenantID:                 "11111111-1111-1111-1111-111111111111",
+			AuxiliaryTenantIDs:       []string{"22222222-2222-2222-2222-222222222222"},
+			AuxiliaryTenantSecrets:   v.auxiliaryTenantSecrets,
+			SupportsAuxiliaryTenants: true,
+			SupportsClientSecretAuth: true,
+		}
+
+		method, err := servicePrincipalClientSecretMultiTenantAuth{}.build(builder)
+		if err != nil {
+			t.Fatalf("building: %+v", err)
+		}
+
+		err = method.validate()
+		if v.expectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+				t.Fatalf("expected validation to fail with %q but got %+v", v.expectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("validating: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,518 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestServicePrincipalClientSecretAuth_builder(t *testing.T) {
+	builder := Builder{
+		ClientID:       "some-client-id",
+		ClientSecret:   "some-client-secret",
+		SubscriptionID: "some-subscription-id",
+		TenantID:       "some-tenant-id",
+	}
+	config, err := servicePrincipalClientSecretAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("Error building client secret auth: %s", err)
+	}
+	servicePrincipal := config.(servicePrincipalClientSecretAuth)
+
+	if builder.ClientID != servicePrincipal.clientId {
+		t.Fatalf("Expected Client ID to be %q but got %q", builder.ClientID, servicePrincipal.clientId)
+	}
+
+	if builder.ClientSecret != servicePrincipal.clientSecret {
+		t.Fatalf("Expected Client Secret to be %q but got %q", builder.ClientSecret, servicePrincipal.clientSecret)
+	}
+
+	if builder.SubscriptionID != servicePrincipal.subscriptionId {
+		t.Fatalf("Expected Subscription ID to be %q but got %q", builder.SubscriptionID, servicePrincipal.subscriptionId)
+	}
+
+	if builder.TenantID != servicePrincipal.tenantId {
+		t.Fatalf("Expected Tenant ID to be %q but got %q", builder.TenantID, servicePrincipal.tenantId)
+	}
+}
+
+func TestServicePrincipalClientSecretAuth_isApplicable(t *testing.T) {
+	cases := []struct {
+		Description string
+		Builder     Builder
+		Valid       bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Builder:     Builder{},
+			Valid:       false,
+		},
+		{
+			Description: "Feature Toggled off",
+			Builder: Builder{
+				SupportsClientSecretAuth: false,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Feature Toggled on but no secret specified",
+			Builder: Builder{
+				SupportsClientSecretAuth: true,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Secret specified but feature toggled off",
+			Builder: Builder{
+				ClientSecret: "I turned myself into a pickle morty!",
+			},
+			Valid: false,
+		},
+		{
+			Description: "Valid configuration",
+			Builder: Builder{
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "I turned myself into a pickle morty!",
+			},
+			Valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		applicable := servicePrincipalClientSecretAuth{}.isApplicable(v.Builder)
+		if v.Valid != applicable {
+			t.Fatalf("Expected %q to be %t but got %t", v.Description, v.Valid, applicable)
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretAuth_populateConfig(t *testing.T) {
+	config := &Config{}
+	err := servicePrincipalClientSecretAuth{}.populateConfig(config)
+	if err != nil {
+		t.Fatalf("Error populating config: %s", err)
+	}
+
+	if !config.AuthenticatedAsAServicePrincipal {
+		t.Fatalf("Expected `AuthenticatedAsAServicePrincipal` to be true but it wasn't")
+	}
+}
+
+func TestServicePrincipalClientSecretAuth_validate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      servicePrincipalClientSecretAuth
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      servicePrincipalClientSecretAuth{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: servicePrincipalClientSecretAuth{
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:   "Does Hammer Time have Daylight Savings Time?",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: servicePrincipalClientSecretAuth{
+				clientId:     "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientSecret: "Does Hammer Time have Daylight Savings Time?",
+				tenantId:     "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client Secret",
+			Config: servicePrincipalClientSecretAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: servicePrincipalClientSecretAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:   "Does Hammer Time have Daylight Savings Time?",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: servicePrincipalClientSecretAuth{
+				clientId:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				subscriptionId: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				clientSecret:   "Does Hammer Time have Daylight Savings Time?",
+				tenantId:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Invalid TenantOnly Configuration",
+			Config: servicePrincipalClientSecretAuth{
+				clientId:     "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientSecret: "Does Hammer Time have Daylight Savings Time?",
+				tenantOnly:   true,
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid TenantOnly Configuration",
+			Config: servicePrincipalClientSecretAuth{
+				clientId:     "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				clientSecret: "Does Hammer Time have Daylight Savings Time?",
+				tenantId:     "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				tenantOnly:   true,
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.validate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretAuth_secretFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clientsecret")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	secretFilePath := filepath.Join(dir, "secret")
+	if err := ioutil.WriteFile(secretFilePath, []byte("file-secret \r\n\n"), 0600); err != nil {
+		t.Fatalf("writing secret file: %+v", err)
+	}
+
+	testData := []struct {
+		name           string
+		clientSecret   string
+		secretFilePath string
+		expected       string
+		expectedError  string
+	}{
+		{
+			name:           "file",
+			secretFilePath: secretFilePath,
+			expected:       "file-secret",
+		},
+		{
+			name:           "inline takes precedence over the file",
+			clientSecret:   "inline-secret",
+			secretFilePath: secretFilePath,
+			expected:       "inline-secret",
+		},
+		{
+			name:           "unreadable file",
+			secretFilePath: filepath.Join(dir, "does-not-exist"),
+			expectedError:  "reading the Client Secret from the file",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		builder := Builder{
+			ClientID:                 "00000000-0000-0000-0000-000000000000",
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			TenantID:                 "00000000-0000-0000-0000-000000000000",
+			AuxiliaryTenantIDs:       []string{"11111111-1111-1111-1111-111111111111"},
+			SupportsAuxiliaryTenants: true,
+			SupportsClientSecretAuth: true,
+			ClientSecret:             v.clientSecret,
+			ClientSecretFilePath:     v.secretFilePath,
+		}
+
+		for _, candidate := range []authMethod{servicePrincipalClientSecretAuth{}, servicePrincipalClientSecretMultiTenantAuth{}} {
+			if !candidate.isApplicable(builder) {
+				t.Fatalf("expected %s to be applicable", candidate.name())
+			}
+
+			method, err := candidate.build(builder)
+			if err != nil {
+				t.Fatalf("building %s: %+v", candidate.name(), err)
+			}
+
+			err = method.validate()
+			if v.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+					t.Fatalf("expected %s to fail validation with %q but got %+v", candidate.name(), v.expectedError, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("validating %s: %+v", candidate.name(), err)
+			}
+
+			actual := ""
+			switch m := method.(type) {
+			case servicePrincipalClientSecretAuth:
+				actual = m.clientSecret
+			case servicePrincipalClientSecretMultiTenantAuth:
+				actual = m.clientSecret
+			}
+			if actual != v.expected {
+				t.Fatalf("expected %s to use the Client Secret %q but got %q", candidate.name(), v.expected, actual)
+			}
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretAuth_validateIdentifiers(t *testing.T) {
+	validId := "00000000-0000-0000-0000-000000000000"
+
+	testData := []struct {
+		name               string
+		tenantId           string
+		subscriptionId     string
+		auxiliaryTenantIds []string
+		expectedErrors     []string
+	}{
+		{
+			name:               "valid",
+			tenantId:           "72F988BF-86F1-41AF-91AB-2D7CD011DB47",
+			subscriptionId:     validId,
+			auxiliaryTenantIds: []string{"11111111-1111-1111-1111-111111111111"},
+		},
+		{
+			name:               "common alias",
+			tenantId:           "common",
+			subscriptionId:     validId,
+			auxiliaryTenantIds: []string{validId},
+		},
+		{
+			name:               "organizations alias",
+			tenantId:           "Organizations",
+			subscriptionId:     validId,
+			auxiliaryTenantIds: []string{validId},
+		},
+		{
+			name:               "tenant id missing a character",
+			tenantId:           "00000000-0000-0000-0000-00000000000",
+			subscriptionId:     validId,
+			auxiliaryTenantIds: []string{validId},
+			expectedErrors:     []string{"The Tenant ID"},
+		},
+		{
+			name:               "tenant id with braces",
+			tenantId:           "{00000000-0000-0000-0000-000000000000}",
+			subscriptionId:     validId,
+			auxiliaryTenantIds: []string{validId},
+			expectedErrors:     []string{"The Tenant ID"},
+		},
+		{
+			name:               "subscription id with non-hex characters",
+			tenantId:           validId,
+			subscriptionId:     "0000000g-0000-0000-0000-000000000000",
+			auxiliaryTenantIds: []string{validId},
+			expectedErrors:     []string{"The Subscription ID"},
+		},
+		{
+			name:               "subscription id without hyphens",
+			tenantId:           validId,
+			subscriptionId:     "00000000000000000000000000000000",
+			auxiliaryTenantIds: []string{validId},
+			expectedErrors:     []string{"The Subscription ID"},
+		},
+		{
+			name:               "malformed auxiliary tenant ids",
+			tenantId:           validId,
+			subscriptionId:     validId,
+			auxiliaryTenantIds: []string{validId, "common", "not-a-uuid"},
+			expectedErrors:     []string{"(at index 1)", "(at index 2)"},
+		},
+		{
+			name:               "everything malformed",
+			tenantId:           "contoso.onmicrosoft.com",
+			subscriptionId:     "my-subscription",
+			auxiliaryTenantIds: []string{" " + validId},
+			expectedErrors:     []string{"The Tenant ID", "The Subscription ID", "The Auxiliary Tenant ID"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		builder := Builder{
+			ClientID:                 validId,
+			ClientSecret:             "secret",
+			SubscriptionID:           v.subscriptionId,
+			TenantID:                 v.tenantId,
+			AuxiliaryTenantIDs:       v.auxiliaryTenantIds,
+			SupportsAuxiliaryTenants: true,
+			SupportsClientSecretAuth: true,
+		}
+
+		for _, candidate := range []authMethod{servicePrincipalClientSecretAuth{}, servicePrincipalClientSecretMultiTenantAuth{}} {
+			method, err := candidate.build(builder)
+			if err != nil {
+				t.Fatalf("building %s: %+v", candidate.name(), err)
+			}
+
+			expectedErrors := v.expectedErrors
+			if _, ok := candidate.(servicePrincipalClientSecretAuth); ok {
+				// the single tenant method doesn't make use of the Auxiliary Tenant IDs
+				expectedErrors = make([]string, 0)
+				for _, e := range v.expectedErrors {
+					if !strings.Contains(e, "at index") && !strings.Contains(e, "Auxiliary") {
+						expectedErrors = append(expectedErrors, e)
+					}
+				}
+			}
+
+			err = method.validate()
+			if len(expectedErrors) == 0 {
+				if err != nil {
+					t.Fatalf("expected %s to pass validation but got %+v", candidate.name(), err)
+				}
+				continue
+			}
+
+			if err == nil {
+				t.Fatalf("expected %s to fail validation but it didn't", candidate.name())
+			}
+			for _, expected := range expectedErrors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Fatalf("expected %s to fail validation with %q but got %+v", candidate.name(), expected, err)
+				}
+			}
+		}
+	}
+}
+
+func TestServicePrincipalClientSecretAuth_httpClient(t *testing.T) {
+	proxy := newTestTokenProxy(t)
+	defer proxy.Close()
+
+	builder := Builder{
+		ClientID:                 "00000000-0000-0000-0000-000000000000",
+		ClientSecret:             "secret",
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		TenantID:                 "11111111-1111-1111-1111-111111111111",
+		SupportsClientSecretAuth: true,
+		HTTPClient:               proxy.client(t),
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building: %+v", err)
+	}
+
+	oauth, err := config.BuildOAuthConfig(testTokenProxyActiveDirectoryEndpoint)
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	authorizer, err := config.GetAuthorizationToken(testUnusedSender(t), oauth, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+	testAuthorizeRequest(t, authorizer)
+
+	expected := []string{"/11111111-1111-1111-1111-111111111111/oauth2/token"}
+	if actual := proxy.requestedPaths(); strings.Join(actual, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the token requests %+v to pass through the proxy but got %+v", expected, actual)
+	}
+}
+
+const testTokenProxyActiveDirectoryEndpoint = "http://login.example.com/"
+
+// testTokenProxy is a forward proxy which records the token requests made through it and responds with a token
+type testTokenProxy struct {
+	*httptest.Server
+
+	lock  sync.Mutex
+	paths []string
+}
+
+func newTestTokenProxy(t *testing.T) *testTokenProxy {
+	proxy := &testTokenProxy{}
+	proxy.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// requests sent through a forward proxy use the absolute URI of the destination
+		if r.URL.Host != "login.example.com" {
+			t.Errorf("expected a proxied request for %q but got %q", "login.example.com", r.URL.String())
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		proxy.lock.Lock()
+		proxy.paths = append(proxy.paths, r.URL.Path)
+		proxy.lock.Unlock()
+
+		expiresOn := time.Now().Add(time.Hour).Unix()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"abc123","expires_in":"3600","expires_on":"%d","not_before":"%d","resource":"https://management.azure.com/","token_type":"Bearer"}`, expiresOn, time.Now().Unix())
+	}))
+	return proxy
+}
+
+func (p *testTokenProxy) client(t *testing.T) *http.Client {
+	proxyUrl, err := url.Parse(p.URL)
+	if err != nil {
+		t.Fatalf("parsing proxy URL: %+v", err)
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyURL(proxyUrl),
+		},
+	}
+}
+
+func (p *testTokenProxy) requestedPaths() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return append([]string{}, p.paths...)
+}
+
+// testUnusedSender returns a Sender which fails the test when used, since the HTTPClient should be used instead
+func testUnusedSender(t *testing.T) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		t.Errorf("expected the HTTP Client to be used but the Sender was used for %q", r.URL.String())
+		return nil, fmt.Errorf("the Sender shouldn't be used")
+	})
+}
+
+// testAuthorizeRequest authorizes a request using the Authorizer, which obtains a token
+func testAuthorizeRequest(t *testing.T, authorizer autorest.Authorizer) {
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("authorizing request: %+v", err)
+	}
+
+	if actual := req.Header.Get("Authorization"); actual != "Bearer abc123" {
+		t.Fatalf("expected the Authorization header %q but got %q", "Bearer abc123", actual)
+	}
+}
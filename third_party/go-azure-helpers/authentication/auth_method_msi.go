@@ -0,0 +1,157 @@
+package authentication
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// defaultMsiProbeTimeout is the maximum amount of time to wait for the Instance Metadata Service to respond
+	// when detecting whether Managed Service Identity is available
+	defaultMsiProbeTimeout = 2 * time.Second
+)
+
+// imdsEndpoint is the Instance Metadata Service endpoint used for Managed Service Identity on Azure VM's
+var imdsEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+type managedServiceIdentityAuth struct {
+	msiEndpoint string
+	clientID    string
+	resourceID  string
+}
+
+func (a managedServiceIdentityAuth) build(b Builder) (authMethod, error) {
+	msiEndpoint := b.MsiEndpoint
+	if msiEndpoint == "" {
+		msiEndpoint = imdsEndpoint
+	}
+
+	log.Printf("[DEBUG] Using MSI msiEndpoint %q", msiEndpoint)
+
+	clientID := b.MsiClientID
+	if clientID == "" && b.MsiResourceID == "" {
+		// for backwards compatibility the Client ID is used to select the User Assigned Identity
+		clientID = b.ClientID
+	}
+
+	auth := managedServiceIdentityAuth{
+		msiEndpoint: msiEndpoint,
+		clientID:    clientID,
+		resourceID:  b.MsiResourceID,
+	}
+	return auth, nil
+}
+
+func (a managedServiceIdentityAuth) isApplicable(b Builder) bool {
+	// Per the Azure SDK: if the Endpoint and Sender are present this is App Service/Function Apps
+	// which we intentionally don't support at this time
+	isAppService := os.Getenv("MSI_ENDPOINT") != "" && os.Getenv("MSI_SECRET") != ""
+	if !b.SupportsManagedServiceIdentity || isAppService {
+		return false
+	}
+
+	// when the MSI Endpoint is specified explicitly there's no need to detect it
+	if b.MsiEndpoint != "" {
+		return true
+	}
+
+	timeout := b.MsiProbeTimeout
+	if timeout == 0 {
+		timeout = defaultMsiProbeTimeout
+	}
+	return isImdsAvailable(imdsEndpoint, timeout)
+}
+
+// isImdsAvailable determines whether the Instance Metadata Service is available - since this is a link-local
+// address a request to it on a host outside of Azure hangs, as such this is bounded by the specified timeout.
+func isImdsAvailable(endpoint string, timeout time.Duration) bool {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		log.Printf("[DEBUG] building the request to detect the Instance Metadata Service at %q: %+v", endpoint, err)
+		return false
+	}
+	req.Header.Set("Metadata", "true")
+
+	client := http.Client{
+		Timeout: timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Printf("[DEBUG] the Instance Metadata Service at %q is unavailable: %+v", endpoint, err)
+		return false
+	}
+	resp.Body.Close()
+
+	// any response (even an error, since no resource is specified) means the Instance Metadata Service is available
+	return true
+}
+
+func (a managedServiceIdentityAuth) name() string {
+	return "Managed Service Identity"
+}
+
+func (a managedServiceIdentityAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	log.Printf("[DEBUG] getAuthorizationToken with MSI msiEndpoint %q, ClientID %q, ResourceID %q for msiEndpoint %q", a.msiEndpoint, a.clientID, a.resourceID, endpoint)
+
+	if oauth.OAuth == nil {
+		return nil, fmt.Errorf("getting Authorization Token for MSI auth: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	var spt *adal.ServicePrincipalToken
+	var err error
+	switch {
+	case a.resourceID != "":
+		//nolint:SA1019
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithIdentityResourceID(a.msiEndpoint, endpoint, a.resourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get an oauth token from MSI for user assigned identity from MSI endpoint %q with resource ID %q for endpoint %q: %v", a.msiEndpoint, a.resourceID, endpoint, err)
+		}
+	case a.clientID != "":
+		//nolint:SA1019
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(a.msiEndpoint, endpoint, a.clientID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get an oauth token from MSI for user assigned identity from MSI endpoint %q with client ID %q for endpoint %q: %v", a.msiEndpoint, a.clientID, endpoint, err)
+		}
+	default:
+		//nolint:SA1019
+		spt, err = adal.NewServicePrincipalTokenFromMSI(a.msiEndpoint, endpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	spt.SetSender(sender)
+	auth := autorest.NewBearerAuthorizer(spt)
+	return auth, nil
+}
+
+func (a managedServiceIdentityAuth) tokenCacheIdentity() string {
+	// the User Assigned Identity (when specified) determines the identity which tokens are issued for
+	return fmt.Sprintf("%s|%s", a.clientID, a.resourceID)
+}
+
+func (a managedServiceIdentityAuth) populateConfig(c *Config) error {
+	// nothing to populate back
+	return nil
+}
+
+func (a managedServiceIdentityAuth) validate() error {
+	var err *multierror.Error
+
+	if a.msiEndpoint == "" {
+		err = multierror.Append(err, fmt.Errorf("An MSI Endpoint must be configured"))
+	}
+
+	if a.clientID != "" && a.resourceID != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of the MSI Client ID and the MSI Resource ID can be specified"))
+	}
+
+	return err.ErrorOrNil()
+}
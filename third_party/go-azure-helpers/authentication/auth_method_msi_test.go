@@ -0,0 +1,295 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestManagedServiceIdentity_builder(t *testing.T) {
+	builder := Builder{
+		MsiEndpoint: "https://hello-world",
+		ClientID:    "some-client-id",
+	}
+
+	method, err := managedServiceIdentityAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("Error building MSI Identity Auth: %+v", err)
+	}
+
+	authMethod := method.(managedServiceIdentityAuth)
+	if builder.MsiEndpoint != authMethod.msiEndpoint {
+		t.Fatalf("Expected MSI Endpoint to be %q but got %q", builder.MsiEndpoint, authMethod.msiEndpoint)
+	}
+	if builder.ClientID != authMethod.clientID {
+		t.Fatalf("Expected MSI Client ID to be %q but got %q", builder.ClientID, authMethod.clientID)
+	}
+}
+
+func TestManagedServiceIdentity_isApplicable(t *testing.T) {
+	cases := []struct {
+		Description string
+		Builder     Builder
+		Valid       bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Builder:     Builder{},
+			Valid:       false,
+		},
+		{
+			Description: "Feature Toggled off",
+			Builder: Builder{
+				SupportsManagedServiceIdentity: false,
+			},
+			Valid: false,
+		},
+		{
+			Description: "Feature Toggled on",
+			Builder: Builder{
+				SupportsManagedServiceIdentity: true,
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		applicable := servicePrincipalClientSecretAuth{}.isApplicable(v.Builder)
+		if v.Valid != applicable {
+			t.Fatalf("Expected %q to be %t but got %t", v.Description, v.Valid, applicable)
+		}
+	}
+}
+
+func TestManagedServiceIdentity_populateConfig(t *testing.T) {
+	config := &Config{}
+	err := servicePrincipalClientSecretAuth{}.populateConfig(config)
+	if err != nil {
+		t.Fatalf("Error populating config: %s", err)
+	}
+
+	// nothing to check since it's not doing anything
+}
+
+func TestManagedServiceIdentity_validate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      managedServiceIdentityAuth
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      managedServiceIdentityAuth{},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: managedServiceIdentityAuth{
+				msiEndpoint: "https://some-location",
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.validate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+func TestManagedServiceIdentityAuth_userAssignedIdentity(t *testing.T) {
+	// ADAL only uses a custom MSI Endpoint when it's able to detect the MSI environment, which (outside of a VM)
+	// requires the App Service environment variables - where the Client ID is sent as `clientid`
+	for k, v := range map[string]string{"MSI_ENDPOINT": "http://localhost", "MSI_SECRET": "secret"} {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	testData := []struct {
+		name               string
+		builder            Builder
+		expectedClientID   string
+		expectedResourceID string
+	}{
+		{
+			name:    "system assigned",
+			builder: Builder{},
+		},
+		{
+			name: "client id",
+			builder: Builder{
+				ClientID: "11111111-1111-1111-1111-111111111111",
+			},
+			expectedClientID: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name: "msi client id",
+			builder: Builder{
+				ClientID:    "11111111-1111-1111-1111-111111111111",
+				MsiClientID: "22222222-2222-2222-2222-222222222222",
+			},
+			expectedClientID: "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			name: "msi resource id",
+			builder: Builder{
+				ClientID:      "11111111-1111-1111-1111-111111111111",
+				MsiResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+			},
+			expectedResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		var query url.Values
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token":"access-token","expires_in":"3600","expires_on":"0","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`)
+		}))
+
+		v.builder.SupportsManagedServiceIdentity = true
+		v.builder.MsiEndpoint = server.URL
+		method, err := managedServiceIdentityAuth{}.build(v.builder)
+		if err != nil {
+			server.Close()
+			t.Fatalf("building auth method: %+v", err)
+		}
+		if err := method.validate(); err != nil {
+			server.Close()
+			t.Fatalf("validating auth method: %+v", err)
+		}
+
+		authorizer, err := method.getAuthorizationToken(server.Client(), &OAuthConfig{OAuth: &adal.OAuthConfig{}}, "https://management.azure.com/")
+		if err != nil {
+			server.Close()
+			t.Fatalf("obtaining authorizer: %+v", err)
+		}
+
+		spt := authorizer.(*autorest.BearerAuthorizer).TokenProvider().(*adal.ServicePrincipalToken)
+		err = spt.Refresh()
+		server.Close()
+		if err != nil {
+			t.Fatalf("obtaining token: %+v", err)
+		}
+
+		if actual := query.Get("clientid"); actual != v.expectedClientID {
+			t.Fatalf("expected the clientid to be %q but got %q", v.expectedClientID, actual)
+		}
+		if actual := query.Get("mi_res_id"); actual != v.expectedResourceID {
+			t.Fatalf("expected the mi_res_id to be %q but got %q", v.expectedResourceID, actual)
+		}
+	}
+}
+
+func TestManagedServiceIdentityAuth_validate(t *testing.T) {
+	builder := Builder{
+		SupportsManagedServiceIdentity: true,
+		MsiEndpoint:                    "http://169.254.169.254/metadata/identity/oauth2/token",
+		MsiClientID:                    "22222222-2222-2222-2222-222222222222",
+		MsiResourceID:                  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+	}
+	method, err := managedServiceIdentityAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("building auth method: %+v", err)
+	}
+
+	err = method.validate()
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "Only one of the MSI Client ID and the MSI Resource ID can be specified") {
+		t.Fatalf("expected an error about the MSI Client ID and Resource ID but got %q", err.Error())
+	}
+}
+
+func TestManagedServiceIdentityAuth_isApplicableExplicitEndpoint(t *testing.T) {
+	original := imdsEndpoint
+	imdsEndpoint = "http://127.0.0.1:0/metadata/identity/oauth2/token"
+	defer func() {
+		imdsEndpoint = original
+	}()
+
+	builder := Builder{
+		SupportsManagedServiceIdentity: true,
+		MsiEndpoint:                    "http://localhost:50342/oauth2/token",
+	}
+	if !(managedServiceIdentityAuth{}).isApplicable(builder) {
+		t.Fatalf("expected MSI to be applicable when the MSI Endpoint is specified")
+	}
+}
+
+func TestManagedServiceIdentityAuth_isApplicableProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			t.Errorf("expected the Metadata header to be set")
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	original := imdsEndpoint
+	imdsEndpoint = server.URL
+	defer func() {
+		imdsEndpoint = original
+	}()
+
+	builder := Builder{
+		SupportsManagedServiceIdentity: true,
+	}
+	if !(managedServiceIdentityAuth{}).isApplicable(builder) {
+		t.Fatalf("expected MSI to be applicable when the Instance Metadata Service responds")
+	}
+}
+
+func TestManagedServiceIdentityAuth_isApplicableProbeTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// never respond
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	original := imdsEndpoint
+	imdsEndpoint = server.URL
+	defer func() {
+		imdsEndpoint = original
+	}()
+
+	builder := Builder{
+		SupportsManagedServiceIdentity: true,
+		MsiProbeTimeout:                100 * time.Millisecond,
+	}
+
+	start := time.Now()
+	applicable := (managedServiceIdentityAuth{}).isApplicable(builder)
+	elapsed := time.Since(start)
+
+	if applicable {
+		t.Fatalf("expected MSI not to be applicable when the Instance Metadata Service doesn't respond")
+	}
+	if elapsed > 2*time.Second {
+		t.Fatalf("expected the probe to time out after %s but it took %s", builder.MsiProbeTimeout, elapsed)
+	}
+}
@@ -0,0 +1,221 @@
+package authentication
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
+)
+
+// oidcTokenRequestAudience is the audience requested when obtaining an ID token from the token request URL
+const oidcTokenRequestAudience = "api://AzureADTokenExchange"
+
+type servicePrincipalOIDCAuth struct {
+	clientId            string
+	subscriptionId      string
+	tenantId            string
+	tenantOnly          bool
+	oidcToken           string
+	oidcTokenFilePath   string
+	idTokenRequestUrl   string
+	idTokenRequestToken string
+}
+
+func (a servicePrincipalOIDCAuth) build(b Builder) (authMethod, error) {
+	method := servicePrincipalOIDCAuth{
+		clientId:            b.ClientID,
+		subscriptionId:      b.SubscriptionID,
+		tenantId:            b.TenantID,
+		tenantOnly:          b.TenantOnly,
+		oidcToken:           b.OIDCToken,
+		oidcTokenFilePath:   b.OIDCTokenFilePath,
+		idTokenRequestUrl:   b.IDTokenRequestURL,
+		idTokenRequestToken: b.IDTokenRequestToken,
+	}
+	return method, nil
+}
+
+func (a servicePrincipalOIDCAuth) isApplicable(b Builder) bool {
+	return b.SupportsOIDCAuth && (b.OIDCToken != "" || b.OIDCTokenFilePath != "" || b.IDTokenRequestURL != "")
+}
+
+func (a servicePrincipalOIDCAuth) name() string {
+	return "Service Principal / OIDC"
+}
+
+func (a servicePrincipalOIDCAuth) getAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauth.OAuth == nil {
+		return nil, fmt.Errorf("Error getting Authorization Token for OIDC auth: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	federatedToken := func() (string, error) {
+		return a.federatedToken(sender)
+	}
+
+	// fail fast if the token can't be obtained, rather than when the first request is made - the token
+	// is then used for the first access token, so that it's not requested twice
+	token, err := federatedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// the token is obtained each time a new access token is minted, since when using a token file
+	// the contents are rotated periodically (e.g. by the workload identity webhook) and tokens
+	// obtained from the token request URL are short-lived
+	secret := &servicePrincipalFederatedTokenSecret{
+		federatedToken: federatedToken,
+		initialToken:   token,
+	}
+	spt, err := adal.NewServicePrincipalTokenWithSecret(*oauth.OAuth, a.clientId, endpoint, secret)
+	if err != nil {
+		return nil, err
+	}
+	spt.SetSender(sender)
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// federatedToken returns the federated (OIDC) token which is exchanged for an access token, either
+// the token specified inline, the current contents of the token file or a token obtained from the
+// token request URL (e.g. when running in GitHub Actions)
+func (a servicePrincipalOIDCAuth) federatedToken(sender autorest.Sender) (string, error) {
+	if a.oidcToken != "" {
+		return a.oidcToken, nil
+	}
+
+	if a.oidcTokenFilePath != "" {
+		contents, err := ioutil.ReadFile(a.oidcTokenFilePath)
+		if err != nil {
+			return "", fmt.Errorf("reading OIDC token from %q: %w", a.oidcTokenFilePath, err)
+		}
+
+		token := strings.TrimSpace(string(contents))
+		if token == "" {
+			return "", fmt.Errorf("the OIDC Token file %q was empty", a.oidcTokenFilePath)
+		}
+
+		return token, nil
+	}
+
+	return a.requestIDToken(sender)
+}
+
+// requestIDToken obtains an ID token from the token request URL, authenticating using the token request token
+func (a servicePrincipalOIDCAuth) requestIDToken(sender autorest.Sender) (string, error) {
+	requestUrl, err := url.Parse(a.idTokenRequestUrl)
+	if err != nil {
+		return "", fmt.Errorf("parsing the ID Token Request URL: %+v", err)
+	}
+	query := requestUrl.Query()
+	query.Set("audience", oidcTokenRequestAudience)
+	requestUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("building the ID Token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.idTokenRequestToken))
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting an ID Token from %q: %+v", requestUrl.Host, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading the ID Token response from %q: %+v", requestUrl.Host, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("requesting an ID Token from %q: unexpected status %d: %s", requestUrl.Host, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResponse struct {
+		Value *string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", fmt.Errorf("parsing the ID Token response from %q: %+v", requestUrl.Host, err)
+	}
+	if tokenResponse.Value == nil || *tokenResponse.Value == "" {
+		return "", fmt.Errorf("parsing the ID Token response from %q: `value` was nil or empty", requestUrl.Host)
+	}
+
+	return *tokenResponse.Value, nil
+}
+
+func (a servicePrincipalOIDCAuth) populateConfig(c *Config) error {
+	c.AuthenticatedAsAServicePrincipal = true
+	c.GetAuthenticatedObjectID = buildServicePrincipalObjectIDFunc(c)
+	return nil
+}
+
+func (a servicePrincipalOIDCAuth) validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using OIDC."
+
+	if !a.tenantOnly && a.subscriptionId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Subscription ID"))
+	}
+	if a.clientId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+	if a.tenantId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+	if a.oidcToken == "" && a.oidcTokenFilePath == "" && a.idTokenRequestUrl == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "OIDC Token, OIDC Token File Path or ID Token Request URL"))
+	}
+	if a.oidcToken != "" && a.oidcTokenFilePath != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of an OIDC Token or an OIDC Token File Path can be configured when authenticating as a Service Principal using OIDC."))
+	}
+	if a.oidcToken == "" && a.oidcTokenFilePath == "" && a.idTokenRequestUrl != "" && a.idTokenRequestToken == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "ID Token Request Token"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+// servicePrincipalFederatedTokenSecret authenticates the Service Principal using a federated token
+// as the client assertion, rather than a client secret or a certificate
+type servicePrincipalFederatedTokenSecret struct {
+	federatedToken func() (string, error)
+
+	// initialToken is the token obtained when building the authorizer, which is used (once) for the
+	// first access token rather than obtaining another token
+	initialToken string
+	lock         sync.Mutex
+}
+
+func (s *servicePrincipalFederatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := s.token()
+	if err != nil {
+		return err
+	}
+
+	v.Set("client_assertion", token)
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+func (s *servicePrincipalFederatedTokenSecret) token() (string, error) {
+	s.lock.Lock()
+	token := s.initialToken
+	s.initialToken = ""
+	s.lock.Unlock()
+
+	if token != "" {
+		return token, nil
+	}
+
+	return s.federatedToken()
+}
@@ -0,0 +1,244 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestServicePrincipalOIDCAuth_tokenFileIsReadForEachToken(t *testing.T) {
+	assertions := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing token request: %+v", err)
+		}
+		assertions = append(assertions, r.PostForm.Get("client_assertion"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access-token-%d","expires_in":"3600","expires_on":"0","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`, len(assertions))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "oidc")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tokenFilePath := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFilePath, []byte("first-token\n"), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+
+	builder := Builder{
+		ClientID:          "00000000-0000-0000-0000-000000000000",
+		SubscriptionID:    "00000000-0000-0000-0000-000000000000",
+		TenantID:          "00000000-0000-0000-0000-000000000000",
+		SupportsOIDCAuth:  true,
+		OIDCTokenFilePath: tokenFilePath,
+	}
+	method, err := servicePrincipalOIDCAuth{}.build(builder)
+	if err != nil {
+		t.Fatalf("building auth method: %+v", err)
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig(server.URL, builder.TenantID)
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	authorizer, err := method.getAuthorizationToken(server.Client(), &OAuthConfig{OAuth: oauthConfig}, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+
+	spt, ok := authorizer.(*autorest.BearerAuthorizer).TokenProvider().(*adal.ServicePrincipalToken)
+	if !ok {
+		t.Fatalf("expected the token provider to be a ServicePrincipalToken")
+	}
+
+	if err := spt.Refresh(); err != nil {
+		t.Fatalf("obtaining first token: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(tokenFilePath, []byte("second-token\n"), 0600); err != nil {
+		t.Fatalf("overwriting token file: %+v", err)
+	}
+
+	if err := spt.Refresh(); err != nil {
+		t.Fatalf("obtaining second token: %+v", err)
+	}
+
+	expected := []string{"first-token", "second-token"}
+	if strings.Join(assertions, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the client assertions to be %q but got %q", expected, assertions)
+	}
+}
+
+func TestServicePrincipalOIDCAuth_tokenFileMissing(t *testing.T) {
+	tokenFilePath := filepath.Join(os.TempDir(), "does-not-exist", "token")
+	method := servicePrincipalOIDCAuth{
+		oidcTokenFilePath: tokenFilePath,
+	}
+
+	_, err := method.federatedToken(http.DefaultClient)
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	if !strings.Contains(err.Error(), tokenFilePath) {
+		t.Fatalf("expected the error to contain the token file path %q but got %q", tokenFilePath, err.Error())
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected the error to wrap a not exist error but got %+v", err)
+	}
+}
+
+func TestServicePrincipalOIDCAuth_idTokenRequest(t *testing.T) {
+	testData := []struct {
+		name          string
+		statusCode    int
+		body          string
+		expectedToken string
+		expectedError string
+	}{
+		{
+			name:          "valid",
+			statusCode:    http.StatusOK,
+			body:          `{"count":1,"value":"github-token"}`,
+			expectedToken: "github-token",
+		},
+		{
+			name:          "http failure",
+			statusCode:    http.StatusUnauthorized,
+			body:          `{"message":"unauthorized"}`,
+			expectedError: "unexpected status 401",
+		},
+		{
+			name:          "malformed json",
+			statusCode:    http.StatusOK,
+			body:          `{"value":`,
+			expectedError: "parsing the ID Token response",
+		},
+		{
+			name:          "missing value",
+			statusCode:    http.StatusOK,
+			body:          `{"count":0}`,
+			expectedError: "`value` was nil or empty",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer request-token" {
+				t.Errorf("expected the request token to be sent as a bearer token but got %q", r.Header.Get("Authorization"))
+			}
+			if r.URL.Query().Get("api-version") != "2.0" {
+				t.Errorf("expected the existing query string to be retained but got %q", r.URL.RawQuery)
+			}
+			if r.URL.Query().Get("audience") != oidcTokenRequestAudience {
+				t.Errorf("expected the audience to be %q but got %q", oidcTokenRequestAudience, r.URL.Query().Get("audience"))
+			}
+
+			w.WriteHeader(v.statusCode)
+			fmt.Fprint(w, v.body)
+		}))
+
+		method := servicePrincipalOIDCAuth{
+			idTokenRequestUrl:   fmt.Sprintf("%s/token?api-version=2.0", server.URL),
+			idTokenRequestToken: "request-token",
+		}
+		token, err := method.federatedToken(server.Client())
+		server.Close()
+
+		if v.expectedError != "" {
+			if err == nil {
+				t.Fatalf("expected an error containing %q but didn't get one", v.expectedError)
+			}
+			if !strings.Contains(err.Error(), v.expectedError) {
+				t.Fatalf("expected an error containing %q but got %q", v.expectedError, err.Error())
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if token != v.expectedToken {
+			t.Fatalf("expected the token to be %q but got %q", v.expectedToken, token)
+		}
+	}
+}
+
+func TestServicePrincipalOIDCAuth_idTokenIsRequestedOncePerToken(t *testing.T) {
+	idTokenRequests := 0
+	assertions := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/id-token") {
+			idTokenRequests++
+			fmt.Fprintf(w, `{"value":"id-token-%d"}`, idTokenRequests)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing token request: %+v", err)
+		}
+		assertions = append(assertions, r.PostForm.Get("client_assertion"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access-token-%d","expires_in":"3600","expires_on":"0","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`, len(assertions))
+	}))
+	defer server.Close()
+
+	method := servicePrincipalOIDCAuth{
+		clientId:            "00000000-0000-0000-0000-000000000000",
+		idTokenRequestUrl:   fmt.Sprintf("%s/id-token", server.URL),
+		idTokenRequestToken: "request-token",
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig(server.URL, "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	authorizer, err := method.getAuthorizationToken(server.Client(), &OAuthConfig{OAuth: oauthConfig}, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+	if idTokenRequests != 1 {
+		t.Fatalf("expected 1 ID Token request when obtaining the authorizer but got %d", idTokenRequests)
+	}
+
+	spt, ok := authorizer.(*autorest.BearerAuthorizer).TokenProvider().(*adal.ServicePrincipalToken)
+	if !ok {
+		t.Fatalf("expected the token provider to be a ServicePrincipalToken")
+	}
+
+	if err := spt.Refresh(); err != nil {
+		t.Fatalf("obtaining first token: %+v", err)
+	}
+	if idTokenRequests != 1 {
+		t.Fatalf("expected the ID Token obtained when building the authorizer to be used for the first token but got %d requests", idTokenRequests)
+	}
+
+	if err := spt.Refresh(); err != nil {
+		t.Fatalf("obtaining second token: %+v", err)
+	}
+
+	expected := []string{"id-token-1", "id-token-2"}
+	if strings.Join(assertions, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the client assertions to be %q but got %q", expected, assertions)
+	}
+}
@@ -0,0 +1,260 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	authenticatedObjectCache = ""
+)
+
+// Builder supports all of the possible Authentication values and feature toggles
+// required to build a working Config for Authentication purposes.
+type Builder struct {
+	// Core
+	ClientID       string
+	SubscriptionID string
+	TenantID       string
+	TenantOnly     bool
+	Environment    string
+
+	// The host of the Metadata Service (e.g. `management.azure.com`) used to resolve the Environment. When set the
+	// Environment's endpoints are retrieved (once) from `/metadata/endpoints` on this host rather than using the
+	// built-in definitions, which allows custom or air-gapped clouds to be used without any other network calls.
+	MetadataHost string
+
+	// The Authority Host (e.g. `https://login.microsoftonline.com/`) which should be used to obtain tokens, overriding
+	// the Active Directory Endpoint of the Environment - which allows sovereign clouds or private instances of Azure
+	// Active Directory to be used. This must be an HTTPS URL.
+	ActiveDirectoryEndpoint string
+
+	// An access token which has already been obtained (e.g. by an outer process), which is used verbatim rather than
+	// obtaining a token - taking precedence over the other authentication methods. Since the access token is issued
+	// for a single resource (e.g. Resource Manager) it's used for every endpoint, and can't be refreshed.
+	AccessToken string
+
+	// Auxiliary tenant IDs used for multi tenant auth
+	SupportsAuxiliaryTenants bool
+	AuxiliaryTenantIDs       []string
+
+	// The Client Secrets which should be used to obtain a token for specific Auxiliary Tenants when authenticating
+	// using a Multi Tenant Client Secret, keyed by the Auxiliary Tenant ID - where the Service Principal has a
+	// different Client Secret in each Tenant. Auxiliary Tenants not present in this map use the ClientSecret.
+	AuxiliaryTenantSecrets map[string]string
+
+	// The names of the authentication methods which should be tried (in order) when obtaining a token,
+	// falling back to the next method when one fails. Possible values are `azure_cli`, `client_certificate`,
+	// `client_secret`, `msi` and `oidc`. When unset the first applicable authentication method is used.
+	AuthMethodOrder []string
+
+	// The TokenCache used to cache the tokens obtained for each endpoint, so that these can be reused rather than
+	// obtaining a new token each time an Authorizer is requested. When nil tokens are not cached.
+	TokenCache TokenCache
+
+	// The Logger used to log which authentication method was selected and where its credentials were sourced from,
+	// with any secrets redacted. When nil nothing is logged.
+	Logger Logger
+
+	// The HTTP Client used to obtain tokens, which allows a proxy or custom root certificates to be configured
+	// for token requests. When nil the Sender passed to GetAuthorizationToken is used.
+	HTTPClient *http.Client
+
+	// The number of times a token request which fails with a transient error (a `429 Too Many Requests` or `5xx`
+	// status code) should be retried, honouring the `Retry-After` header when present. Other errors (e.g. a `400` or
+	// `401`) fail immediately. When unset token requests aren't retried.
+	TokenRetryMax int
+
+	// The initial delay between retries of a token request, which is doubled for each subsequent retry (up to a
+	// maximum of 1 minute). Defaults to 2 seconds when unset.
+	TokenRetryBackoff time.Duration
+
+	// The custom Resource Manager Endpoint which should be used
+	// only applicable for Azure Stack at this time.
+	CustomResourceManagerEndpoint string
+
+	// Azure CLI Tokens Auth
+	SupportsAzureCliToken bool
+
+	// Managed Service Identity Auth
+	SupportsManagedServiceIdentity bool
+	MsiEndpoint                    string
+
+	// The maximum amount of time to wait for the Instance Metadata Service to respond when
+	// detecting whether Managed Service Identity is available, which happens when no MsiEndpoint
+	// is specified. Defaults to 2 seconds when unset.
+	MsiProbeTimeout time.Duration
+
+	// The Client ID or Resource ID of the User Assigned Identity which should be used, which is
+	// required when multiple User Assigned Identities are attached - these are mutually exclusive.
+	// When neither is specified the ClientID is used to select the User Assigned Identity.
+	MsiClientID   string
+	MsiResourceID string
+
+	// Service Principal (Client Cert) Auth
+	SupportsClientCertAuth bool
+	ClientCertPath         string
+	ClientCertPassword     string
+
+	// The ID of a Key Vault Secret containing the Client Certificate (as a PFX), in the format
+	// `https://{vaultName}.vault.azure.net/secrets/{secretName}[/{version}]` - which can be
+	// used instead of the ClientCertPath
+	ClientCertificateVaultID string
+
+	// Whether the full certificate chain should be sent when authenticating using a Client Certificate, which is
+	// required for Subject Name + Issuer (SNI) authentication. When enabled the PFX may contain intermediate
+	// certificates, which are included in the `x5c` header of the client assertion alongside the Client Certificate.
+	ClientCertificateSendChain bool
+
+	// Service Principal (Client Secret) Auth
+	SupportsClientSecretAuth bool
+	ClientSecret             string
+	ClientSecretDocsLink     string
+
+	// The path to a file containing the Client Secret, which is used when the ClientSecret is
+	// empty - allowing the Client Secret to be provided without it being in the environment
+	ClientSecretFilePath string
+
+	// Service Principal (OIDC / Workload Identity Federation) Auth
+	SupportsOIDCAuth    bool
+	OIDCToken           string
+	OIDCTokenFilePath   string
+	IDTokenRequestURL   string
+	IDTokenRequestToken string
+}
+
+// Build takes the configuration from the Builder and builds up a validated Config
+// for authenticating with Azure
+func (b Builder) Build() (*Config, error) {
+	config := Config{
+		ClientID:                      b.ClientID,
+		SubscriptionID:                b.SubscriptionID,
+		TenantID:                      b.TenantID,
+		AuxiliaryTenantIDs:            b.AuxiliaryTenantIDs,
+		Environment:                   b.Environment,
+		MetadataHost:                  b.MetadataHost,
+		ActiveDirectoryEndpoint:       b.ActiveDirectoryEndpoint,
+		CustomResourceManagerEndpoint: b.CustomResourceManagerEndpoint,
+		issuedAuthorizers:             &issuedAuthorizers{},
+		tokenCache:                    b.TokenCache,
+		httpClient:                    b.HTTPClient,
+		tokenRetryMax:                 b.TokenRetryMax,
+		tokenRetryBackoff:             b.TokenRetryBackoff,
+	}
+
+	if err := b.validateOptions(); err != nil {
+		return nil, err
+	}
+
+	for _, method := range supportedAuthMethods() {
+		name := method.name()
+		log.Printf("Testing if %s is applicable for Authentication..", name)
+
+		// does not support it via validate?
+		if !method.isApplicable(b) {
+			continue
+		}
+
+		log.Printf("Using %s for Authentication", name)
+		auth, err := method.build(b)
+		if err != nil {
+			return nil, err
+		}
+		b.logSelectedAuthMethod(auth)
+
+		// populate authentication specific fields on the Config
+		// (e.g. is service principal, fields parsed from the azure cli)
+		err = auth.populateConfig(&config)
+		if err != nil {
+			return nil, err
+		}
+
+		config.authMethod = auth
+
+		// Authenticated Object ID Cache
+		if config.GetAuthenticatedObjectID != nil {
+			uncachedFunction := config.GetAuthenticatedObjectID
+			config.GetAuthenticatedObjectID = func(ctx context.Context) (string, error) {
+				if authenticatedObjectCache == "" {
+					authenticatedObjectCache, err = uncachedFunction(ctx)
+					if err != nil {
+						return "", err
+					}
+					log.Printf("authenticated object ID cache miss, populating with: %q", authenticatedObjectCache)
+				}
+
+				return authenticatedObjectCache, nil
+			}
+		}
+
+		return &config, config.authMethod.validate()
+	}
+
+	return nil, fmt.Errorf("No supported authentication methods were found!")
+}
+
+// supportedAuthMethods returns each of the supported authentication methods, in the order they're checked
+func supportedAuthMethods() []authMethod {
+	// NOTE: the ordering here is important
+	// since the Azure CLI Parsing should always be the last thing checked
+	return []authMethod{
+		chainedAuth{},
+		bearerTokenAuth{},
+		servicePrincipalClientCertificateAuth{},
+		servicePrincipalOIDCAuth{},
+		servicePrincipalClientSecretMultiTenantAuth{},
+		servicePrincipalClientSecretAuth{},
+		managedServiceIdentityAuth{},
+		azureCliTokenMultiTenantAuth{},
+		azureCliTokenAuth{},
+	}
+}
+
+// validateOptions validates the options which apply regardless of the authentication method being used
+func (b Builder) validateOptions() error {
+	if b.TokenRetryMax < 0 {
+		return fmt.Errorf("the Token Retry Max must be zero or greater but got %d", b.TokenRetryMax)
+	}
+	if b.TokenRetryBackoff < 0 {
+		return fmt.Errorf("the Token Retry Backoff must be zero or greater but got %s", b.TokenRetryBackoff)
+	}
+
+	if b.ActiveDirectoryEndpoint != "" {
+		if err := validateActiveDirectoryEndpoint(b.ActiveDirectoryEndpoint); err != nil {
+			return err
+		}
+	}
+
+	if b.ClientCertificateSendChain && !(b.SupportsClientCertAuth && (b.ClientCertPath != "" || b.ClientCertificateVaultID != "")) {
+		return fmt.Errorf("the Client Certificate chain can only be sent when authenticating using a Client Certificate - either a Client Certificate Path or a Client Certificate Key Vault ID must be configured")
+	}
+
+	return nil
+}
+
+// validateActiveDirectoryEndpoint validates that the specified Active Directory Endpoint is a well-formed HTTPS URL
+func validateActiveDirectoryEndpoint(input string) error {
+	endpoint, err := url.Parse(input)
+	if err != nil {
+		return fmt.Errorf("parsing the Active Directory Endpoint %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(endpoint.Scheme, "https") {
+		return fmt.Errorf("the Active Directory Endpoint %q must use the `https` scheme", input)
+	}
+
+	if endpoint.Host == "" {
+		return fmt.Errorf("the Active Directory Endpoint %q must contain a host", input)
+	}
+
+	if endpoint.RawQuery != "" || endpoint.Fragment != "" {
+		return fmt.Errorf("the Active Directory Endpoint %q must not contain a query string or fragment", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBuilder_activeDirectoryEndpointValidation(t *testing.T) {
+	testData := []struct {
+		name          string
+		endpoint      string
+		expectedError string
+	}{
+		{
+			name:     "unset",
+			endpoint: "",
+		},
+		{
+			name:     "valid",
+			endpoint: "https://login.example.com/",
+		},
+		{
+			name:     "valid with a path",
+			endpoint: "https://login.example.com/adfs",
+		},
+		{
+			name:          "http",
+			endpoint:      "http://login.example.com/",
+			expectedError: "must use the `https` scheme",
+		},
+		{
+			name:          "missing scheme",
+			endpoint:      "login.example.com",
+			expectedError: "must use the `https` scheme",
+		},
+		{
+			name:          "missing host",
+			endpoint:      "https:///tenant",
+			expectedError: "must contain a host",
+		},
+		{
+			name:          "query string",
+			endpoint:      "https://login.example.com/?foo=bar",
+			expectedError: "must not contain a query string or fragment",
+		},
+		{
+			name:          "malformed",
+			endpoint:      "https://login example.com/",
+			expectedError: "parsing the Active Directory Endpoint",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		builder := Builder{
+			ClientID:                 "00000000-0000-0000-0000-000000000000",
+			ClientSecret:             "secret",
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			TenantID:                 "11111111-1111-1111-1111-111111111111",
+			SupportsClientSecretAuth: true,
+			ActiveDirectoryEndpoint:  v.endpoint,
+		}
+		_, err := builder.Build()
+		if v.expectedError == "" {
+			if err != nil {
+				t.Fatalf("expected the Active Directory Endpoint %q to be valid but got %+v", v.endpoint, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+			t.Fatalf("expected the Active Directory Endpoint %q to fail validation with %q but got %+v", v.endpoint, v.expectedError, err)
+		}
+	}
+}
+
+func TestBuilder_activeDirectoryEndpointTokenRequest(t *testing.T) {
+	var lock sync.Mutex
+	var paths []string
+	authority := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		paths = append(paths, r.URL.Path)
+		lock.Unlock()
+
+		expiresOn := time.Now().Add(time.Hour).Unix()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"abc123","expires_in":"3600","expires_on":"%d","not_before":"%d","resource":"https://management.azure.com/","token_type":"Bearer"}`, expiresOn, time.Now().Unix())
+	}))
+	defer authority.Close()
+
+	builder := Builder{
+		ClientID:                 "00000000-0000-0000-0000-000000000000",
+		ClientSecret:             "secret",
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		TenantID:                 "11111111-1111-1111-1111-111111111111",
+		SupportsClientSecretAuth: true,
+		ActiveDirectoryEndpoint:  authority.URL + "/",
+		HTTPClient:               authority.Client(),
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building: %+v", err)
+	}
+
+	// the Environment's Active Directory Endpoint should be overridden by the custom authority
+	oauth, err := config.BuildOAuthConfig("https://login.microsoftonline.com/")
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+	if actual := oauth.OAuth.TokenEndpoint.Host; actual != authority.Listener.Addr().String() {
+		t.Fatalf("expected the Token Endpoint to use the custom authority %q but got %q", authority.Listener.Addr().String(), actual)
+	}
+
+	authorizer, err := config.GetAuthorizationToken(testUnusedSender(t), oauth, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+	testAuthorizeRequest(t, authorizer)
+
+	lock.Lock()
+	defer lock.Unlock()
+	expected := []string{"/11111111-1111-1111-1111-111111111111/oauth2/token"}
+	if strings.Join(paths, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the token requests %+v to be made to the custom authority but got %+v", expected, paths)
+	}
+}
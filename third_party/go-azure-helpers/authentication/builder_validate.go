@@ -0,0 +1,119 @@
+package authentication
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Validate checks the configuration of every authentication method which is configured, without obtaining any
+// tokens - which allows configuration errors to be surfaced up-front, rather than when a token is first requested.
+//
+// When any of the configured authentication methods are missing required fields (or these are invalid) a
+// *multierror.Error is returned, where each error identifies the authentication method it relates to and whether
+// that method would be selected. Since Managed Service Identity and the Azure CLI are configured by the environment
+// rather than the Builder, these are considered to be configured when supported but aren't otherwise checked.
+func (b Builder) Validate() error {
+	var errs *multierror.Error
+
+	if err := b.validateOptions(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	methods := make([]authMethod, 0)
+	if len(b.AuthMethodOrder) > 0 {
+		for _, name := range b.AuthMethodOrder {
+			candidates, ok := authMethodsByName[name]
+			if !ok {
+				errs = multierror.Append(errs, fmt.Errorf("%q is not a supported authentication method - supported values are %s", name, supportedAuthMethodNames()))
+				continue
+			}
+			methods = append(methods, candidates...)
+		}
+	} else {
+		for _, method := range supportedAuthMethods() {
+			if _, ok := method.(chainedAuth); !ok {
+				methods = append(methods, method)
+			}
+		}
+	}
+
+	var selected authMethod
+	configured := make(map[string]struct{})
+	for _, method := range methods {
+		if !b.isConfigured(method) {
+			continue
+		}
+
+		// where there's both a multi-tenant and single-tenant variant of a method only the first is used
+		group := authMethodGroup(method)
+		if _, ok := configured[group]; ok {
+			continue
+		}
+		configured[group] = struct{}{}
+
+		description := fmt.Sprintf("%s (not selected)", method.name())
+		if selected == nil {
+			selected = method
+			description = fmt.Sprintf("%s (selected)", method.name())
+			log.Printf("[DEBUG] %s would be used for Authentication", method.name())
+		}
+
+		if err := b.validateAuthMethod(method); err != nil {
+			if merr, ok := err.(*multierror.Error); ok {
+				for _, e := range merr.Errors {
+					errs = multierror.Append(errs, fmt.Errorf("%s: %v", description, e))
+				}
+				continue
+			}
+			errs = multierror.Append(errs, fmt.Errorf("%s: %v", description, err))
+		}
+	}
+
+	if selected == nil {
+		errs = multierror.Append(errs, fmt.Errorf("No supported authentication methods were found - at least one of a Client Certificate, OIDC Token, Client Secret, Managed Service Identity or the Azure CLI must be configured"))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// isConfigured returns whether the authentication method is configured, without making any network calls
+func (b Builder) isConfigured(method authMethod) bool {
+	switch method.(type) {
+	case managedServiceIdentityAuth:
+		// detecting whether MSI is available requires calling the Instance Metadata Service
+		return b.SupportsManagedServiceIdentity
+	}
+
+	return method.isApplicable(b)
+}
+
+// validateAuthMethod builds and validates the authentication method, skipping those methods which are configured
+// by the environment since validating these requires calling the Azure CLI or Instance Metadata Service
+func (b Builder) validateAuthMethod(method authMethod) error {
+	switch method.(type) {
+	case managedServiceIdentityAuth, azureCliTokenAuth, azureCliTokenMultiTenantAuth:
+		return nil
+	}
+
+	auth, err := method.build(b)
+	if err != nil {
+		return err
+	}
+
+	return auth.validate()
+}
+
+// authMethodGroup returns the name which the authentication method can be specified as in the `AuthMethodOrder`
+func authMethodGroup(method authMethod) string {
+	for name, candidates := range authMethodsByName {
+		for _, candidate := range candidates {
+			if candidate.name() == method.name() {
+				return name
+			}
+		}
+	}
+
+	return method.name()
+}
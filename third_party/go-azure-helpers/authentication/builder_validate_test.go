@@ -0,0 +1,133 @@
+package authentication
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+func TestBuilder_ValidatePartiallyConfigured(t *testing.T) {
+	builder := Builder{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		TenantID:       "00000000-0000-0000-0000-000000000000",
+
+		// the Client ID is required by both methods, but hasn't been specified
+		SupportsClientCertAuth:   true,
+		ClientCertPath:           "./testdata/does-not-exist.pfx",
+		SupportsClientSecretAuth: true,
+		ClientSecret:             "secret",
+	}
+
+	err := builder.Validate()
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	merr, ok := err.(*multierror.Error)
+	if !ok {
+		t.Fatalf("expected a *multierror.Error but got %T", err)
+	}
+
+	expected := []string{
+		"Service Principal / Client Certificate (selected): A Client ID must be configured when authenticating as a Service Principal using a Client Certificate.",
+		"Service Principal / Client Certificate (selected): The Client Certificate Path is not a valid pfx file",
+		"Service Principal / Client Secret (not selected): A Client ID must be configured when authenticating as a Service Principal using a Client Secret.",
+	}
+	if len(merr.Errors) != len(expected) {
+		t.Fatalf("expected %d errors but got %d: %+v", len(expected), len(merr.Errors), merr)
+	}
+	for i, v := range expected {
+		if !strings.HasPrefix(merr.Errors[i].Error(), v) {
+			t.Fatalf("expected error %d to start with %q but got %q", i, v, merr.Errors[i].Error())
+		}
+	}
+}
+
+func TestBuilder_Validate(t *testing.T) {
+	testData := []struct {
+		name          string
+		builder       Builder
+		expectedError string
+	}{
+		{
+			name: "valid client secret",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "secret",
+			},
+		},
+		{
+			name: "azure cli isn't checked",
+			builder: Builder{
+				SupportsAzureCliToken: true,
+			},
+		},
+		{
+			name:          "nothing configured",
+			builder:       Builder{},
+			expectedError: "No supported authentication methods were found",
+		},
+		{
+			name: "multi-tenant client secret is only reported once",
+			builder: Builder{
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "secret",
+				SupportsAuxiliaryTenants: true,
+				AuxiliaryTenantIDs:       []string{"11111111-1111-1111-1111-111111111111"},
+			},
+			expectedError: "1 error occurred",
+		},
+		{
+			name: "unsupported method in the order",
+			builder: Builder{
+				AuthMethodOrder:       []string{"carrier_pigeon", "azure_cli"},
+				SupportsAzureCliToken: true,
+			},
+			expectedError: `"carrier_pigeon" is not a supported authentication method`,
+		},
+		{
+			name: "method in the order isn't configured",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				AuthMethodOrder:          []string{"client_certificate"},
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "secret",
+			},
+			expectedError: "No supported authentication methods were found",
+		},
+		{
+			name: "invalid options",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "secret",
+				TokenRetryMax:            -1,
+			},
+			expectedError: "the Token Retry Max must be zero or greater",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		v.builder.SubscriptionID = "00000000-0000-0000-0000-000000000000"
+		v.builder.TenantID = "00000000-0000-0000-0000-000000000000"
+
+		err := v.builder.Validate()
+		if v.expectedError == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !strings.Contains(err.Error(), v.expectedError) {
+			t.Fatalf("expected the error to contain %q but got: %+v", v.expectedError, err)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/form3tech-oss/jwt-go"
+	"golang.org/x/crypto/pkcs12"
+)
+
+var _ adal.ServicePrincipalSecret = &clientCertificateChainSecret{}
+
+// clientCertificateChainSecret implements adal.ServicePrincipalSecret, signing the client assertion with the
+// Client Certificate and including the full certificate chain within the `x5c` header - which is required for
+// Subject Name + Issuer (SNI) authentication, where Azure Active Directory matches the certificate using its
+// subject and issuer rather than its thumbprint.
+//
+// adal.ServicePrincipalCertificateSecret only includes the leaf certificate in the `x5c` header, hence this.
+type clientCertificateChainSecret struct {
+	clientId      string
+	tokenEndpoint string
+
+	// chain contains the Client Certificate, followed by any intermediate certificates
+	chain      []*x509.Certificate
+	privateKey *rsa.PrivateKey
+}
+
+// SetAuthenticationValues is a method of the interface adal.ServicePrincipalSecret.
+// It will populate the form submitted during oAuth Token Acquisition using a JWT signed with the certificate.
+func (s *clientCertificateChainSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	assertion, err := s.signJwt()
+	if err != nil {
+		return fmt.Errorf("signing the client assertion: %+v", err)
+	}
+
+	v.Set("client_assertion", assertion)
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, so that the private key isn't serialized alongside the token.
+func (s clientCertificateChainSecret) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("marshalling clientCertificateChainSecret is not supported")
+}
+
+func (s *clientCertificateChainSecret) signJwt() (string, error) {
+	if len(s.chain) == 0 {
+		return "", fmt.Errorf("the certificate chain was empty")
+	}
+
+	thumbprint := sha1.Sum(s.chain[0].Raw)
+
+	x5c := make([]string, 0, len(s.chain))
+	for _, certificate := range s.chain {
+		x5c = append(x5c, base64.StdEncoding.EncodeToString(certificate.Raw))
+	}
+
+	// The jti (JWT ID) claim provides a unique identifier for the JWT.
+	jti := make([]byte, 20)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	token := jwt.New(jwt.SigningMethodRS256)
+	token.Header["x5t"] = base64.URLEncoding.EncodeToString(thumbprint[:])
+	token.Header["x5c"] = x5c
+	token.Claims = jwt.MapClaims{
+		"aud": s.tokenEndpoint,
+		"iss": s.clientId,
+		"sub": s.clientId,
+		"jti": base64.URLEncoding.EncodeToString(jti),
+		"nbf": time.Now().Unix(),
+		"exp": time.Now().Add(24 * time.Hour).Unix(),
+	}
+
+	return token.SignedString(s.privateKey)
+}
+
+func decodePkcs12ChainFile(f string, password string) ([]*x509.Certificate, *rsa.PrivateKey, error) {
+	certificateData, err := ioutil.ReadFile(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %v", f, err)
+	}
+
+	return decodePkcs12Chain(certificateData, password)
+}
+
+// decodePkcs12Chain decodes a PFX containing an RSA private key, the matching certificate and any intermediate
+// certificates - returning the certificate matching the private key first, followed by the remaining certificates
+func decodePkcs12Chain(certificateData []byte, password string) ([]*x509.Certificate, *rsa.PrivateKey, error) {
+	blocks, err := pkcs12.ToPEM(certificateData, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rsaPrivateKey *rsa.PrivateKey
+	certificates := make([]*x509.Certificate, 0)
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parsing certificate: %v", err)
+			}
+			certificates = append(certificates, certificate)
+
+		case "PRIVATE KEY":
+			if rsaPrivateKey != nil {
+				return nil, nil, fmt.Errorf("PKCS#12 certificate must contain a single private key")
+			}
+			// pkcs12.ToPEM encodes RSA private keys using PKCS#1, other key types fail to parse here
+			key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("PKCS#12 certificate must contain an RSA private key")
+			}
+			rsaPrivateKey = key
+		}
+	}
+
+	if rsaPrivateKey == nil {
+		return nil, nil, fmt.Errorf("PKCS#12 certificate must contain an RSA private key")
+	}
+
+	chain := make([]*x509.Certificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		if publicKey, ok := certificate.PublicKey.(*rsa.PublicKey); ok && publicKey.Equal(&rsaPrivateKey.PublicKey) {
+			chain = append(chain, certificate)
+			break
+		}
+	}
+	if len(chain) == 0 {
+		return nil, nil, fmt.Errorf("PKCS#12 certificate must contain a certificate matching the private key")
+	}
+
+	for _, certificate := range certificates {
+		if certificate != chain[0] {
+			chain = append(chain, certificate)
+		}
+	}
+
+	return chain, rsaPrivateKey, nil
+}
@@ -0,0 +1,164 @@
+package authentication
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// keyVaultApiVersion is the version of the Key Vault Data Plane API used to retrieve the Client Certificate
+	keyVaultApiVersion = "7.3"
+
+	// keyVaultPkcs12ContentType is the Content Type of a Key Vault Secret backing a PFX Certificate
+	keyVaultPkcs12ContentType = "application/x-pkcs12"
+)
+
+type clientCertificateVaultId struct {
+	vaultBaseUrl string
+	name         string
+	version      string
+}
+
+// parseClientCertificateVaultID parses a Key Vault Secret ID in the format
+// `https://{vaultName}.vault.azure.net/secrets/{secretName}[/{version}]`
+func parseClientCertificateVaultID(input string) (*clientCertificateVaultId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URI: %+v", input, err)
+	}
+
+	if uri.Scheme != "https" {
+		return nil, fmt.Errorf("expected the scheme of %q to be `https` but got %q", input, uri.Scheme)
+	}
+
+	if !strings.Contains(uri.Host, ".") {
+		return nil, fmt.Errorf("expected the host of %q to be a Key Vault URI but got %q", input, uri.Host)
+	}
+
+	segments := strings.Split(strings.Trim(uri.Path, "/"), "/")
+	if len(segments) != 2 && len(segments) != 3 {
+		return nil, fmt.Errorf("expected the path of %q to be in the format `/secrets/{secretName}[/{version}]` but got %q", input, uri.Path)
+	}
+
+	if segments[0] != "secrets" || segments[1] == "" {
+		return nil, fmt.Errorf("expected the path of %q to be in the format `/secrets/{secretName}[/{version}]` but got %q", input, uri.Path)
+	}
+
+	id := clientCertificateVaultId{
+		vaultBaseUrl: fmt.Sprintf("%s://%s", uri.Scheme, uri.Host),
+		name:         segments[1],
+	}
+	if len(segments) == 3 {
+		id.version = segments[2]
+	}
+
+	return &id, nil
+}
+
+// resource returns the resource which an access token must be requested for to access this Key Vault,
+// which is the domain suffix of the Key Vault (e.g. `https://vault.azure.net`)
+func (id clientCertificateVaultId) resource() string {
+	host := strings.TrimPrefix(id.vaultBaseUrl, "https://")
+	return fmt.Sprintf("https://%s", host[strings.Index(host, ".")+1:])
+}
+
+// buildClientCertificateVaultBootstrap builds the authentication method used to retrieve the Client Certificate
+// from Key Vault, which is either the Client Secret for this Service Principal or a System Assigned Identity
+func buildClientCertificateVaultBootstrap(b Builder) (authMethod, error) {
+	if (servicePrincipalClientSecretAuth{}).isApplicable(b) {
+		return servicePrincipalClientSecretAuth{}.build(b)
+	}
+
+	if (managedServiceIdentityAuth{}).isApplicable(b) {
+		// the Client ID is for the Service Principal being authenticated, rather than a User Assigned Identity
+		bootstrapBuilder := b
+		bootstrapBuilder.ClientID = ""
+		return managedServiceIdentityAuth{}.build(bootstrapBuilder)
+	}
+
+	return nil, nil
+}
+
+type keyVaultSecretBundle struct {
+	Value       *string `json:"value"`
+	ContentType *string `json:"contentType"`
+}
+
+func (a servicePrincipalClientCertificateAuth) getCertificateFromKeyVault(sender autorest.Sender, oauth *OAuthConfig) ([]byte, error) {
+	id, err := parseClientCertificateVaultID(a.clientCertVaultId)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.bootstrap == nil {
+		return nil, fmt.Errorf("retrieving the Client Certificate from Key Vault: a Client Secret or Managed Service Identity must be configured")
+	}
+
+	authorizer, err := a.bootstrap.getAuthorizationToken(sender, oauth, id.resource())
+	if err != nil {
+		return nil, fmt.Errorf("obtaining an Authorization Token for Key Vault using %s: %v", a.bootstrap.name(), err)
+	}
+
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", id.name),
+	}
+	path := "/secrets/{name}"
+	if id.version != "" {
+		pathParameters["version"] = autorest.Encode("path", id.version)
+		path = "/secrets/{name}/{version}"
+	}
+
+	req, err := autorest.Prepare(&http.Request{},
+		autorest.AsGet(),
+		autorest.WithBaseURL(id.vaultBaseUrl),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": keyVaultApiVersion,
+		}),
+		authorizer.WithAuthorization())
+	if err != nil {
+		return nil, fmt.Errorf("preparing request to retrieve the Client Certificate %q from Key Vault: %v", a.clientCertVaultId, err)
+	}
+
+	resp, err := autorest.SendWithSender(sender, req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Client Certificate %q from Key Vault: %v", a.clientCertVaultId, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the Client Certificate %q from Key Vault: %v", a.clientCertVaultId, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving the Client Certificate %q from Key Vault: unexpected status %d with body: %s", a.clientCertVaultId, resp.StatusCode, string(body))
+	}
+
+	var bundle keyVaultSecretBundle
+	if err := json.Unmarshal(body, &bundle); err != nil {
+		return nil, fmt.Errorf("parsing the Client Certificate %q from Key Vault: %v", a.clientCertVaultId, err)
+	}
+
+	if bundle.ContentType != nil && *bundle.ContentType != "" && !strings.EqualFold(*bundle.ContentType, keyVaultPkcs12ContentType) {
+		return nil, fmt.Errorf("the Client Certificate %q must be a PFX (with the content type %q) but got %q", a.clientCertVaultId, keyVaultPkcs12ContentType, *bundle.ContentType)
+	}
+
+	if bundle.Value == nil || *bundle.Value == "" {
+		return nil, fmt.Errorf("the Client Certificate %q retrieved from Key Vault was empty", a.clientCertVaultId)
+	}
+
+	certificateData, err := base64.StdEncoding.DecodeString(*bundle.Value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the Client Certificate %q retrieved from Key Vault: %v", a.clientCertVaultId, err)
+	}
+
+	return certificateData, nil
+}
@@ -0,0 +1,211 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+// Config is the configuration structure used to instantiate a
+// new Azure management client.
+type Config struct {
+	ClientID           string
+	SubscriptionID     string
+	TenantID           string
+	AuxiliaryTenantIDs []string
+	Environment        string
+	MetadataHost       string
+
+	// ActiveDirectoryEndpoint (when set) overrides the Active Directory Endpoint used to obtain tokens
+	ActiveDirectoryEndpoint string
+
+	GetAuthenticatedObjectID         func(context.Context) (string, error)
+	AuthenticatedAsAServicePrincipal bool
+
+	// A Custom Resource Manager Endpoint
+	// at this time this should only be applicable for Azure Stack.
+	CustomResourceManagerEndpoint string
+
+	authMethod authMethod
+
+	// issuedAuthorizers tracks the most recent Authorizer obtained for each endpoint,
+	// so that the expiry of the underlying token can be looked up via TokenExpiry
+	issuedAuthorizers *issuedAuthorizers
+
+	// tokenCache (when set) is used to reuse the tokens obtained for each endpoint
+	tokenCache TokenCache
+
+	// httpClient (when set) is used to obtain tokens in place of the Sender passed to GetAuthorizationToken
+	httpClient *http.Client
+
+	// tokenRetryMax and tokenRetryBackoff configure how token requests which fail with a transient error are retried
+	tokenRetryMax     int
+	tokenRetryBackoff time.Duration
+}
+
+type OAuthConfig struct {
+	OAuth            *adal.OAuthConfig
+	MultiTenantOauth *adal.MultiTenantOAuthConfig
+}
+
+// GetAuthorizationToken returns an authorization token for the authentication method defined in the Config
+func (c Config) GetOAuthConfig(activeDirectoryEndpoint string) (*adal.OAuthConfig, error) {
+	activeDirectoryEndpoint = c.activeDirectoryEndpoint(activeDirectoryEndpoint)
+	log.Printf("Getting OAuth config for endpoint %s with  tenant %s", activeDirectoryEndpoint, c.TenantID)
+
+	// fix for ADFS environments, if the login endpoint ends in `/adfs` it's an adfs environment
+	// the login endpoint ends up residing in `ActiveDirectoryEndpoint`
+	oAuthTenant := c.TenantID
+	if strings.HasSuffix(strings.ToLower(activeDirectoryEndpoint), "/adfs") {
+		log.Printf("[DEBUG] ADFS environment detected - overriding Tenant ID to `adfs`!")
+		oAuthTenant = "adfs"
+	}
+
+	oauth, err := adal.NewOAuthConfig(activeDirectoryEndpoint, oAuthTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	// OAuthConfigForTenant returns a pointer, which can be nil.
+	if oauth == nil {
+		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
+	}
+
+	return oauth, nil
+}
+
+// GetMultiTenantOAuthConfig returns a multi-tenant authorization token for the authentication method defined in the Config
+func (c Config) GetMultiTenantOAuthConfig(activeDirectoryEndpoint string) (*adal.MultiTenantOAuthConfig, error) {
+	activeDirectoryEndpoint = c.activeDirectoryEndpoint(activeDirectoryEndpoint)
+	log.Printf("Getting multi OAuth config for endpoint %s with  tenant %s (aux tenants: %v)", activeDirectoryEndpoint, c.TenantID, c.AuxiliaryTenantIDs)
+	oauth, err := adal.NewMultiTenantOAuthConfig(activeDirectoryEndpoint, c.TenantID, c.AuxiliaryTenantIDs, adal.OAuthOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// OAuthConfigForTenant returns a pointer, which can be nil.
+	if oauth == nil {
+		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s (auxiliary tenants %v)", c.TenantID, c.AuxiliaryTenantIDs)
+	}
+
+	return &oauth, nil
+}
+
+// activeDirectoryEndpoint returns the Active Directory Endpoint which should be used to obtain tokens, which is the
+// custom Active Directory Endpoint when one is configured, otherwise the specified (Environment's) endpoint
+func (c Config) activeDirectoryEndpoint(environmentEndpoint string) string {
+	if c.ActiveDirectoryEndpoint != "" {
+		if !strings.EqualFold(c.ActiveDirectoryEndpoint, environmentEndpoint) {
+			log.Printf("[DEBUG] Overriding the Active Directory Endpoint %q with %q", environmentEndpoint, c.ActiveDirectoryEndpoint)
+		}
+		return c.ActiveDirectoryEndpoint
+	}
+
+	return environmentEndpoint
+}
+
+// BuildOAuthConfig builds the authorization configuration for the specified Active Directory Endpoint
+func (c Config) BuildOAuthConfig(activeDirectoryEndpoint string) (*OAuthConfig, error) {
+	multiAuth := OAuthConfig{}
+	var err error
+
+	multiAuth.OAuth, err = c.GetOAuthConfig(activeDirectoryEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.AuxiliaryTenantIDs) > 0 {
+		multiAuth.MultiTenantOauth, err = c.GetMultiTenantOAuthConfig(activeDirectoryEndpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &multiAuth, nil
+}
+
+// BearerAuthorizerCallback returns a BearerAuthorizer valid only for the Primary Tenant
+// this signs a request using the AccessToken returned from the primary Resource Manager authorizer
+func (c Config) BearerAuthorizerCallback(sender autorest.Sender, oauthConfig *OAuthConfig) *autorest.BearerAuthorizerCallback {
+	return autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		return c.bearerAuthorizer(sender, oauthConfig, resource)
+	})
+}
+
+func (c Config) bearerAuthorizer(sender autorest.Sender, oauthConfig *OAuthConfig, resource string) (*autorest.BearerAuthorizer, error) {
+	// a BearerAuthorizer is only valid for the primary tenant
+	newAuthConfig := &OAuthConfig{
+		OAuth: oauthConfig.OAuth,
+	}
+
+	storageSpt, err := c.GetAuthorizationToken(sender, newAuthConfig, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	cast, ok := storageSpt.(*autorest.BearerAuthorizer)
+	if !ok {
+		return nil, newUnsupportedAuthorizerError("Error converting %+v (%T) to a BearerAuthorizer", storageSpt, storageSpt)
+	}
+
+	return cast, nil
+}
+
+// GetAuthorizationToken returns an authorization token for the authentication method defined in the Config
+func (c Config) GetAuthorizationToken(sender autorest.Sender, oauth *OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if c.httpClient != nil {
+		sender = c.httpClient
+	}
+	if c.tokenRetryMax > 0 {
+		sender = newTokenRetrySender(sender, c.tokenRetryMax, c.tokenRetryBackoff)
+	}
+
+	authorizer, err := c.authMethod.getAuthorizationToken(sender, oauth, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.tokenCache != nil {
+		authorizer = withTokenCache(authorizer, c.tokenCache, c.tokenCacheScope(endpoint))
+	}
+
+	if c.issuedAuthorizers != nil {
+		c.issuedAuthorizers.set(endpoint, authorizer)
+	}
+
+	return authorizer, nil
+}
+
+// tokenCacheScope returns the key used to cache tokens for the specified endpoint, which includes the identity
+// being authenticated as, so that tokens for one principal are never returned for another when a TokenCache is
+// shared between Configs
+func (c Config) tokenCacheScope(endpoint string) string {
+	identity := ""
+	if v, ok := c.authMethod.(tokenCacheIdentifier); ok {
+		identity = v.tokenCacheIdentity()
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s|%s", c.authMethod.name(), c.TenantID, c.ClientID, identity, endpoint)
+}
+
+// TokenExpiry returns the time at which the current token for the Authorizer most recently obtained for the
+// specified endpoint (via GetAuthorizationToken) expires - which allows callers to log the remaining lifetime
+// and refresh the token ahead of time. A zero time is returned when no token has been acquired yet.
+func (c Config) TokenExpiry(endpoint string) (time.Time, error) {
+	if c.issuedAuthorizers == nil {
+		return time.Time{}, fmt.Errorf("no Authorizer has been obtained for %q", endpoint)
+	}
+
+	authorizer, ok := c.issuedAuthorizers.get(endpoint)
+	if !ok {
+		return time.Time{}, fmt.Errorf("no Authorizer has been obtained for %q", endpoint)
+	}
+
+	return TokenExpiryForAuthorizer(authorizer)
+}
@@ -0,0 +1,64 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/sender"
+)
+
+// defaultAuthMethodOrder is the order in which NewDefaultAuthorizer tries each of the authentication methods:
+// first the Service Principal credentials (a Client Certificate, an OIDC Token or a Client Secret) which are
+// typically sourced from the environment, then the Azure CLI and finally Managed Service Identity
+var defaultAuthMethodOrder = []string{
+	"client_certificate",
+	"oidc",
+	"client_secret",
+	"azure_cli",
+	"msi",
+}
+
+// NewDefaultAuthorizer returns an Authorizer for the specified endpoint using the first authentication method
+// which is able to obtain a token, in a similar manner to `DefaultAzureCredential` in the Azure SDK.
+//
+// The authentication methods are tried in the following order:
+//
+//  1. Service Principal with a Client Certificate
+//  2. Service Principal with an OIDC Token
+//  3. Service Principal with a Client Secret
+//  4. Azure CLI
+//  5. Managed Service Identity
+//
+// Only the authentication methods enabled in the Builder (using the `Supports*` fields) and for which the
+// required values are set are tried, so specific methods can be disabled by the caller. Any `AuthMethodOrder`
+// specified in the Builder is replaced by the order above.
+func NewDefaultAuthorizer(ctx context.Context, b Builder, endpoint string) (autorest.Authorizer, error) {
+	environmentName := b.Environment
+	if environmentName == "" {
+		environmentName = "public"
+	}
+
+	env, err := AzureEnvironmentByNameFromEndpoint(ctx, b.MetadataHost, environmentName)
+	if err != nil {
+		return nil, fmt.Errorf("determining the environment %q: %+v", environmentName, err)
+	}
+
+	return newDefaultAuthorizer(b, env.ActiveDirectoryEndpoint, endpoint)
+}
+
+func newDefaultAuthorizer(b Builder, activeDirectoryEndpoint string, endpoint string) (autorest.Authorizer, error) {
+	b.AuthMethodOrder = defaultAuthMethodOrder
+
+	config, err := b.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building the authentication configuration: %+v", err)
+	}
+
+	oauthConfig, err := config.BuildOAuthConfig(activeDirectoryEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building the OAuth configuration: %+v", err)
+	}
+
+	return config.GetAuthorizationToken(sender.BuildSender("GoAzureHelpers"), oauthConfig, endpoint)
+}
@@ -0,0 +1,67 @@
+package authentication
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewDefaultAuthorizer_environmentOverAzureCli(t *testing.T) {
+	proxy := newTestTokenProxy(t)
+	defer proxy.Close()
+
+	builder := Builder{
+		ClientID:                 "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+		SubscriptionID:           "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+		TenantID:                 "11111111-1111-1111-1111-111111111111",
+		Environment:              "public",
+		SupportsClientSecretAuth: true,
+		ClientSecret:             "Does Hammer Time have Daylight Savings Time?",
+		SupportsAzureCliToken:    true,
+		HTTPClient:               proxy.client(t),
+	}
+
+	authorizer, err := newDefaultAuthorizer(builder, testTokenProxyActiveDirectoryEndpoint, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+	testAuthorizeRequest(t, authorizer)
+
+	// the token should have been obtained for the Service Principal, rather than using the Azure CLI
+	expected := []string{"/11111111-1111-1111-1111-111111111111/oauth2/token"}
+	if actual := proxy.requestedPaths(); strings.Join(actual, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the token requests %+v but got %+v", expected, actual)
+	}
+}
+
+func TestNewDefaultAuthorizer_respectsSupportsFlags(t *testing.T) {
+	builder := Builder{
+		ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+		SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+		TenantID:       "11111111-1111-1111-1111-111111111111",
+		Environment:    "public",
+		// a Client Secret is specified but Client Secret authentication is disabled
+		SupportsClientSecretAuth: false,
+		ClientSecret:             "Does Hammer Time have Daylight Savings Time?",
+	}
+
+	_, err := newDefaultAuthorizer(builder, testTokenProxyActiveDirectoryEndpoint, "https://management.azure.com/")
+	if err == nil {
+		t.Fatalf("expected an error when no authentication methods are enabled but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "none of the authentication methods specified in the order were applicable") {
+		t.Fatalf("expected an error about no authentication methods being applicable but got %q", err.Error())
+	}
+}
+
+func TestNewDefaultAuthorizer_order(t *testing.T) {
+	expected := "client_certificate,oidc,client_secret,azure_cli,msi"
+	if actual := strings.Join(defaultAuthMethodOrder, ","); actual != expected {
+		t.Fatalf("expected the default order %q but got %q", expected, actual)
+	}
+
+	for _, name := range defaultAuthMethodOrder {
+		if _, ok := authMethodsByName[name]; !ok {
+			t.Fatalf("%q is not a supported authentication method", name)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+var sdkEnvironmentLookupMap = map[string]azure.Environment{
+	"public":       azure.PublicCloud,
+	"usgovernment": azure.USGovernmentCloud,
+	"german":       azure.GermanCloud,
+	"china":        azure.ChinaCloud,
+}
+
+// metadataClient is the HTTP Client used to retrieve the Environments from a Metadata Host
+var metadataClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	},
+}
+
+// metadataEnvironmentsCache caches the Environments returned from each Metadata Host, so that these are only
+// retrieved once rather than each time an Environment is resolved
+var metadataEnvironmentsCache = struct {
+	sync.RWMutex
+	environments map[string][]Environment
+}{
+	environments: make(map[string][]Environment),
+}
+
+type Environment struct {
+	Portal                     string         `json:"portal"`
+	Authentication             Authentication `json:"authentication"`
+	Media                      string         `json:"media"`
+	GraphAudience              string         `json:"graphAudience"`
+	Graph                      string         `json:"graph"`
+	Name                       string         `json:"name"`
+	Suffixes                   Suffixes       `json:"suffixes"`
+	Batch                      string         `json:"batch"`
+	ResourceManager            string         `json:"resourceManager"`
+	VmImageAliasDoc            string         `json:"vmImageAliasDoc"`
+	ActiveDirectoryDataLake    string         `json:"activeDirectoryDataLake"`
+	SqlManagement              string         `json:"sqlManagement"`
+	Gallery                    string         `json:"gallery"`
+	LogAnalyticsResourceId     string         `json:"logAnalyticsResourceId"`
+	SynapseAnalyticsResourceId string         `json:"synapseAnalyticsResourceId"`
+}
+
+type Authentication struct {
+	LoginEndpoint    string   `json:"loginEndpoint"`
+	Audiences        []string `json:"audiences"`
+	Tenant           string   `json:"tenant"`
+	IdentityProvider string   `json:"identityProvider"`
+}
+
+type Suffixes struct {
+	AzureDataLakeStoreFileSystem        string `json:"azureDataLakeStoreFileSystem"`
+	AcrLoginServer                      string `json:"acrLoginServer"`
+	SqlServerHostname                   string `json:"sqlServerHostname"`
+	AzureDataLakeAnalyticsCatalogAndJob string `json:"azureDataLakeAnalyticsCatalogAndJob"`
+	KeyVaultDns                         string `json:"keyVaultDns"`
+	Storage                             string `json:"storage"`
+	AzureFrontDoorEndpointSuffix        string `json:"azureFrontDoorEndpointSuffix"`
+	SynapseAnalytics                    string `json:"synapseAnalytics"`
+}
+
+// DetermineEnvironment determines what the Environment name is within
+// the Azure SDK for Go and then returns the association environment, if it exists.
+func DetermineEnvironment(name string) (*azure.Environment, error) {
+	// detect cloud from environment
+	env, envErr := azure.EnvironmentFromName(name)
+
+	if envErr != nil {
+		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
+		wrapped := fmt.Sprintf("AZURE%sCLOUD", name)
+		env, envErr = azure.EnvironmentFromName(wrapped)
+		if envErr != nil {
+			return nil, fmt.Errorf("An Azure Environment with name %q was not found: %+v", name, envErr)
+		}
+	}
+
+	return &env, nil
+}
+
+// LoadEnvironmentFromUrl attempts to load the specified environment from the endpoint.
+// if the endpoint is an empty string, or an environment can't be
+// found at the endpoint url then an error is returned
+func LoadEnvironmentFromUrl(endpoint string) (*azure.Environment, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("Endpoint was not set!")
+	}
+
+	env, err := azure.EnvironmentFromURL(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Environment from Endpoint %q: %+v", endpoint, err)
+	}
+
+	return &env, nil
+}
+
+func normalizeEnvironmentName(input string) string {
+	// Environment is stored as `Azure{Environment}Cloud`
+	output := strings.ToLower(input)
+	output = strings.TrimPrefix(output, "azure")
+	output = strings.TrimSuffix(output, "cloud")
+
+	// however Azure Public is `AzureCloud` in the CLI Profile and not `AzurePublicCloud`.
+	if output == "" {
+		return "public"
+	}
+	return output
+}
+
+// AzureEnvironmentByName returns a specific Azure Environment from the specified endpoint
+//
+// The built-in Environments (`public`, `usgovernment` and `china`) are always resolved locally, regardless of
+// whether an endpoint is specified. Any other Environment (including its endpoints, resource identifiers and token
+// audience) is resolved from the Metadata Host, so that the Metadata Host is the only thing which needs to be
+// reachable (e.g. in air-gapped clouds).
+func AzureEnvironmentByNameFromEndpoint(ctx context.Context, endpoint string, environmentName string) (*azure.Environment, error) {
+	if env, ok := sdkEnvironmentLookupMap[strings.ToLower(environmentName)]; ok {
+		return &env, nil
+	}
+
+	if endpoint == "" {
+		return nil, fmt.Errorf("unable to locate metadata for environment %q from the built in `public`, `usgoverment`, `china` and no custom metadata host has been specified", environmentName)
+	}
+
+	env, err := findEnvironmentFromEndpoint(ctx, endpoint, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAzureEnvironment(*env)
+}
+
+// IsEnvironmentAzureStack returns whether a specific Azure Environment is an Azure Stack environment
+func IsEnvironmentAzureStack(ctx context.Context, endpoint string, environmentName string) (bool, error) {
+	if _, ok := sdkEnvironmentLookupMap[strings.ToLower(environmentName)]; ok {
+		return false, nil
+	}
+
+	if endpoint == "" {
+		return false, fmt.Errorf("unable to locate metadata for environment %q from the built in `public`, `usgoverment`, `china` and no custom metadata host has been specified", environmentName)
+	}
+
+	env, err := findEnvironmentFromEndpoint(ctx, endpoint, environmentName)
+	if err != nil {
+		return false, err
+	}
+
+	if !strings.EqualFold(env.Authentication.IdentityProvider, "AAD") || !strings.EqualFold(env.Authentication.Tenant, "common") {
+		return true, nil
+	}
+	return false, nil
+}
+
+// findEnvironmentFromEndpoint returns the Environment with the specified name from the Metadata Host, where the
+// name can either be the name returned from the Metadata Host (e.g. `AzureCloud`) or the short name (e.g. `public`)
+func findEnvironmentFromEndpoint(ctx context.Context, endpoint string, environmentName string) (*Environment, error) {
+	environments, err := getSupportedEnvironments(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, env := range environments {
+		if strings.EqualFold(env.Name, environmentName) || normalizeEnvironmentName(env.Name) == normalizeEnvironmentName(environmentName) {
+			return &env, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to locate metadata for environment %q from custom metadata host %q", environmentName, endpoint)
+}
+
+func getSupportedEnvironments(ctx context.Context, endpoint string) ([]Environment, error) {
+	metadataEnvironmentsCache.RLock()
+	cached, ok := metadataEnvironmentsCache.environments[endpoint]
+	metadataEnvironmentsCache.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	uri := fmt.Sprintf("https://%s/metadata/endpoints?api-version=2020-06-01", endpoint)
+	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := metadataClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving environments from Azure MetaData service: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving environments from Azure MetaData service %q: unexpected status %d", endpoint, resp.StatusCode)
+	}
+
+	var environments []Environment
+	if err := json.NewDecoder(resp.Body).Decode(&environments); err != nil {
+		return nil, fmt.Errorf("decoding environments from Azure MetaData service %q: %+v", endpoint, err)
+	}
+
+	metadataEnvironmentsCache.Lock()
+	metadataEnvironmentsCache.environments[endpoint] = environments
+	metadataEnvironmentsCache.Unlock()
+
+	return environments, nil
+}
+
+func buildAzureEnvironment(env Environment) (*azure.Environment, error) {
+	aEnv := &azure.Environment{
+		Name:                       env.Name,
+		ManagementPortalURL:        env.Portal,
+		ResourceManagerEndpoint:    env.ResourceManager,
+		StorageEndpointSuffix:      env.Suffixes.Storage,
+		ActiveDirectoryEndpoint:    env.Authentication.LoginEndpoint,
+		GraphEndpoint:              env.Graph,
+		KeyVaultEndpoint:           fmt.Sprintf("https://%s/", env.Suffixes.KeyVaultDns),
+		GalleryEndpoint:            env.Gallery,
+		BatchManagementEndpoint:    env.Batch,
+		SQLDatabaseDNSSuffix:       env.Suffixes.SqlServerHostname,
+		KeyVaultDNSSuffix:          env.Suffixes.KeyVaultDns,
+		ContainerRegistryDNSSuffix: env.Suffixes.AcrLoginServer,
+		SynapseEndpointSuffix:      env.Suffixes.SynapseAnalytics,
+		ResourceIdentifiers: azure.ResourceIdentifier{
+			// This isn't returned from the metadata url and is universal across all environments
+			Storage:             "https://storage.azure.com/",
+			Graph:               env.Graph,
+			KeyVault:            fmt.Sprintf("https://%s/", env.Suffixes.KeyVaultDns),
+			Datalake:            env.ActiveDirectoryDataLake,
+			Batch:               env.Batch,
+			Synapse:             azure.NotAvailable,
+			ServiceBus:          azure.NotAvailable,
+			OperationalInsights: azure.NotAvailable,
+		},
+	}
+
+	if env.LogAnalyticsResourceId != "" {
+		aEnv.ResourceIdentifiers.OperationalInsights = env.LogAnalyticsResourceId
+	}
+	if env.SynapseAnalyticsResourceId != "" {
+		aEnv.ResourceIdentifiers.Synapse = env.SynapseAnalyticsResourceId
+	}
+
+	if len(env.Authentication.Audiences) > 0 {
+		aEnv.TokenAudience = env.Authentication.Audiences[0]
+	} else {
+		return nil, fmt.Errorf("unable to find token audience for environment %q", env.Name)
+	}
+
+	return aEnv, nil
+}
@@ -0,0 +1,324 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestAzureEnvironmentNames(t *testing.T) {
+	testData := map[string]string{
+		"":                       "public",
+		"AzureChinaCloud":        "china",
+		"AzureCloud":             "public",
+		"AzureGermanCloud":       "german",
+		"AZUREUSGOVERNMENTCLOUD": "usgovernment",
+		"AzurePublicCloud":       "public",
+	}
+
+	for input, expected := range testData {
+		actual := normalizeEnvironmentName(input)
+		if actual != expected {
+			t.Fatalf("Expected %q for input %q: got %q!", expected, input, actual)
+		}
+	}
+}
+
+func TestAccAzureEnvironmentByName(t *testing.T) {
+	env, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "public")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzurePublicCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzurePublicCloud", env.Name)
+	}
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "usgovernment")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureUSGovernmentCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureUSGovernmentCloud", env.Name)
+	}
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "german")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureGermanCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureGermanCloud", env.Name)
+	}
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "china")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureChinaCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureChinaCloud", env.Name)
+	}
+
+}
+
+func TestAccAzureEnvironmentByNameFromEndpoint(t *testing.T) {
+	env, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "AzureCloud")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureCloud", env.Name)
+	}
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "AzureChinaCloud")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureChinaCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureChinaCloud", env.Name)
+	}
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "AzureUSGovernment")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureUSGovernment") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureUSGovernment", env.Name)
+	}
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "AzureGermanCloud")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if !strings.EqualFold(env.Name, "AzureGermanCloud") {
+		t.Fatalf("Incorrect environment name returned. Expected: %q. Received: %q", "AzureGermanCloud", env.Name)
+	}
+	_, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "badurl", "AzureGermanCloud")
+	if err == nil {
+		t.Fatal("Expected error from bad endpoint")
+	}
+	_, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), "management.azure.com", "badEnvironment")
+	if err == nil {
+		t.Fatal("Expected error from bad environment")
+	}
+}
+
+func TestAccIsEnvironmentAzureStack(t *testing.T) {
+	ok, err := IsEnvironmentAzureStack(context.TODO(), "management.azure.com", "public")
+	if err != nil {
+		t.Fatalf("Error getting Endpoint: %s", err)
+	}
+	if ok {
+		t.Fatal("Expected `public` environment to not be Azure Stack")
+	}
+}
+
+const testMetadataEndpointsResponse = `[
+  {
+    "portal": "https://portal.contoso.example",
+    "authentication": {
+      "loginEndpoint": "https://login.contoso.example",
+      "audiences": [
+        "https://management.core.contoso.example/",
+        "https://management.contoso.example/"
+      ],
+      "tenant": "common",
+      "identityProvider": "AAD"
+    },
+    "graph": "https://graph.contoso.example/",
+    "name": "ContosoCloud",
+    "suffixes": {
+      "keyVaultDns": "vault.contoso.example",
+      "storage": "core.contoso.example",
+      "sqlServerHostname": "database.contoso.example",
+      "acrLoginServer": "azurecr.contoso.example",
+      "synapseAnalytics": "dev.azuresynapse.contoso.example"
+    },
+    "batch": "https://batch.core.contoso.example/",
+    "resourceManager": "https://management.contoso.example/",
+    "activeDirectoryDataLake": "https://datalake.contoso.example/",
+    "gallery": "https://gallery.contoso.example/",
+    "logAnalyticsResourceId": "https://api.loganalytics.contoso.example",
+    "synapseAnalyticsResourceId": "https://dev.azuresynapse.contoso.example"
+  },
+  {
+    "authentication": {
+      "loginEndpoint": "https://login.public.contoso.example",
+      "audiences": [
+        "https://management.core.public.contoso.example/"
+      ],
+      "tenant": "common",
+      "identityProvider": "AAD"
+    },
+    "name": "AzureCloud",
+    "suffixes": {
+      "keyVaultDns": "vault.public.contoso.example"
+    },
+    "resourceManager": "https://management.public.contoso.example/"
+  },
+  {
+    "authentication": {
+      "loginEndpoint": "https://adfs.stack.contoso.example/adfs",
+      "audiences": [
+        "https://management.stack.contoso.example/"
+      ],
+      "tenant": "adfs",
+      "identityProvider": "ADFS"
+    },
+    "name": "StackCloud",
+    "suffixes": {
+      "keyVaultDns": "vault.stack.contoso.example"
+    },
+    "resourceManager": "https://management.stack.contoso.example/"
+  }
+]`
+
+func TestAzureEnvironmentByNameFromEndpoint_metadataHost(t *testing.T) {
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/metadata/endpoints" {
+			t.Errorf("expected the path %q but got %q", "/metadata/endpoints", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testMetadataEndpointsResponse))
+	}))
+	defer server.Close()
+	metadataHost := testMetadataHost(t, server)
+
+	env, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataHost, "ContosoCloud")
+	if err != nil {
+		t.Fatalf("resolving the environment: %+v", err)
+	}
+
+	expected := map[string][]string{
+		"ActiveDirectoryEndpoint":                 {env.ActiveDirectoryEndpoint, "https://login.contoso.example"},
+		"ResourceManagerEndpoint":                 {env.ResourceManagerEndpoint, "https://management.contoso.example/"},
+		"TokenAudience":                           {env.TokenAudience, "https://management.core.contoso.example/"},
+		"ManagementPortalURL":                     {env.ManagementPortalURL, "https://portal.contoso.example"},
+		"KeyVaultEndpoint":                        {env.KeyVaultEndpoint, "https://vault.contoso.example/"},
+		"StorageEndpointSuffix":                   {env.StorageEndpointSuffix, "core.contoso.example"},
+		"SynapseEndpointSuffix":                   {env.SynapseEndpointSuffix, "dev.azuresynapse.contoso.example"},
+		"ResourceIdentifiers.Graph":               {env.ResourceIdentifiers.Graph, "https://graph.contoso.example/"},
+		"ResourceIdentifiers.Datalake":            {env.ResourceIdentifiers.Datalake, "https://datalake.contoso.example/"},
+		"ResourceIdentifiers.Synapse":             {env.ResourceIdentifiers.Synapse, "https://dev.azuresynapse.contoso.example"},
+		"ResourceIdentifiers.OperationalInsights": {env.ResourceIdentifiers.OperationalInsights, "https://api.loganalytics.contoso.example"},
+	}
+	for name, v := range expected {
+		if v[0] != v[1] {
+			t.Errorf("expected %s to be %q but got %q", name, v[1], v[0])
+		}
+	}
+
+	// the built-in Environments are resolved locally even when a Metadata Host is specified
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataHost, "public")
+	if err != nil {
+		t.Fatalf("resolving the environment: %+v", err)
+	}
+	if env.ResourceManagerEndpoint != azure.PublicCloud.ResourceManagerEndpoint {
+		t.Fatalf("expected the built-in Resource Manager Endpoint %q but got %q", azure.PublicCloud.ResourceManagerEndpoint, env.ResourceManagerEndpoint)
+	}
+
+	// whereas the name returned from the Metadata Host resolves the Environment from the Metadata Host
+	env, err = AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataHost, "AzureCloud")
+	if err != nil {
+		t.Fatalf("resolving the environment: %+v", err)
+	}
+	if env.ResourceManagerEndpoint != "https://management.public.contoso.example/" {
+		t.Fatalf("expected the Resource Manager Endpoint from the Metadata Host but got %q", env.ResourceManagerEndpoint)
+	}
+
+	isAzureStack, err := IsEnvironmentAzureStack(context.TODO(), metadataHost, "ContosoCloud")
+	if err != nil {
+		t.Fatalf("determining whether the environment is Azure Stack: %+v", err)
+	}
+	if isAzureStack {
+		t.Fatalf("expected %q not to be an Azure Stack environment", "ContosoCloud")
+	}
+
+	isAzureStack, err = IsEnvironmentAzureStack(context.TODO(), metadataHost, "StackCloud")
+	if err != nil {
+		t.Fatalf("determining whether the environment is Azure Stack: %+v", err)
+	}
+	if !isAzureStack {
+		t.Fatalf("expected %q to be an Azure Stack environment", "StackCloud")
+	}
+
+	if _, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataHost, "unknown"); err == nil {
+		t.Fatalf("expected an error for an environment not returned from the Metadata Host")
+	}
+
+	if actual := atomic.LoadInt32(&requests); actual != 1 {
+		t.Fatalf("expected the Metadata Host to be queried once but it was queried %d times", actual)
+	}
+}
+
+func TestAzureEnvironmentByNameFromEndpoint_metadataHostError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	metadataHost := testMetadataHost(t, server)
+
+	if _, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataHost, "ContosoCloud"); err == nil {
+		t.Fatalf("expected an error when the Metadata Host returns an error")
+	}
+}
+
+func TestAzureEnvironmentByNameFromEndpoint_builtInWithMetadataHost(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected the Metadata Host not to be queried for a built-in Environment but got a request to %q", r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	metadataHost := testMetadataHost(t, server)
+
+	for _, name := range []string{"public", "usgovernment", "china"} {
+		t.Logf("[DEBUG] Testing %q..", name)
+
+		env, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), metadataHost, name)
+		if err != nil {
+			t.Fatalf("resolving the environment: %+v", err)
+		}
+		if expected := sdkEnvironmentLookupMap[name]; env.ResourceManagerEndpoint != expected.ResourceManagerEndpoint {
+			t.Fatalf("expected the Resource Manager Endpoint %q but got %q", expected.ResourceManagerEndpoint, env.ResourceManagerEndpoint)
+		}
+
+		isAzureStack, err := IsEnvironmentAzureStack(context.TODO(), metadataHost, name)
+		if err != nil {
+			t.Fatalf("determining whether the environment is Azure Stack: %+v", err)
+		}
+		if isAzureStack {
+			t.Fatalf("expected %q not to be an Azure Stack environment", name)
+		}
+	}
+}
+
+func TestAzureEnvironmentByNameFromEndpoint_noMetadataHost(t *testing.T) {
+	env, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), "", "public")
+	if err != nil {
+		t.Fatalf("resolving the environment: %+v", err)
+	}
+	if env.ResourceManagerEndpoint != azure.PublicCloud.ResourceManagerEndpoint {
+		t.Fatalf("expected the Resource Manager Endpoint %q but got %q", azure.PublicCloud.ResourceManagerEndpoint, env.ResourceManagerEndpoint)
+	}
+
+	if _, err := AzureEnvironmentByNameFromEndpoint(context.TODO(), "", "ContosoCloud"); err == nil {
+		t.Fatalf("expected an error for a custom environment when no Metadata Host is specified")
+	}
+}
+
+// testMetadataHost configures the Metadata Client to trust the specified server, returning the host to use for it
+func testMetadataHost(t *testing.T, server *httptest.Server) string {
+	originalClient := metadataClient
+	metadataClient = server.Client()
+	t.Cleanup(func() {
+		metadataClient = originalClient
+	})
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	t.Cleanup(func() {
+		metadataEnvironmentsCache.Lock()
+		delete(metadataEnvironmentsCache.environments, host)
+		metadataEnvironmentsCache.Unlock()
+	})
+
+	return host
+}
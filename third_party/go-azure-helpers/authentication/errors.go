@@ -0,0 +1,30 @@
+package authentication
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedAuthorizer is returned (wrapped) when an Authorizer isn't of a type which is supported by the caller,
+// so that this can be checked for using `errors.Is`
+var ErrUnsupportedAuthorizer = errors.New("unsupported authorizer")
+
+// unsupportedAuthorizerError wraps ErrUnsupportedAuthorizer whilst retaining the existing error message, so that the
+// output of Error() is unchanged for callers matching on the message
+type unsupportedAuthorizerError struct {
+	message string
+}
+
+func newUnsupportedAuthorizerError(format string, a ...interface{}) error {
+	return unsupportedAuthorizerError{
+		message: fmt.Sprintf(format, a...),
+	}
+}
+
+func (e unsupportedAuthorizerError) Error() string {
+	return e.message
+}
+
+func (e unsupportedAuthorizerError) Unwrap() error {
+	return ErrUnsupportedAuthorizer
+}
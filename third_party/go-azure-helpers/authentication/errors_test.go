@@ -0,0 +1,40 @@
+package authentication
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestConfig_bearerAuthorizerUnsupportedAuthorizer(t *testing.T) {
+	attempts := 0
+	config := Config{
+		authMethod: testAuthMethod{methodName: "Test", attempts: &attempts},
+	}
+
+	_, err := config.bearerAuthorizer(nil, &OAuthConfig{}, "https://storage.azure.com/")
+	if err == nil {
+		t.Fatalf("expected an error for an Authorizer which isn't a BearerAuthorizer but didn't get one")
+	}
+	if !errors.Is(err, ErrUnsupportedAuthorizer) {
+		t.Fatalf("expected the error to be an ErrUnsupportedAuthorizer but got %+v", err)
+	}
+	if !strings.Contains(err.Error(), "autorest.NullAuthorizer") {
+		t.Fatalf("expected the error to contain the type of the Authorizer but got %q", err.Error())
+	}
+}
+
+func TestTokenExpiryForAuthorizer_unsupportedAuthorizer(t *testing.T) {
+	_, err := TokenExpiryForAuthorizer(autorest.NullAuthorizer{})
+	if err == nil {
+		t.Fatalf("expected an error for an unsupported Authorizer but didn't get one")
+	}
+	if !errors.Is(err, ErrUnsupportedAuthorizer) {
+		t.Fatalf("expected the error to be an ErrUnsupportedAuthorizer but got %+v", err)
+	}
+	if expected := "determining the token expiry: unsupported authorizer autorest.NullAuthorizer"; err.Error() != expected {
+		t.Fatalf("expected the error %q but got %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,96 @@
+package authentication
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Logger is used to log which authentication method was selected by the Builder and how it was configured.
+// Secrets (Client Secrets, Certificate Passwords, OIDC Tokens and Access Tokens) are never passed to the Logger,
+// only whether they were configured and where they were sourced from.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+}
+
+// redactedValue is logged in place of any secret which is configured, regardless of the length of the secret
+const redactedValue = "********"
+
+// debugf logs the message using the Builder's Logger, when one is configured
+func (b Builder) debugf(format string, args ...interface{}) {
+	if b.Logger == nil {
+		return
+	}
+
+	b.Logger.Debugf(format, args...)
+}
+
+// logSelectedAuthMethod logs the authentication method which was selected, along with the identifiers it uses
+// and the source of each credential - with any secrets redacted
+func (b Builder) logSelectedAuthMethod(method authMethod) {
+	if b.Logger == nil {
+		return
+	}
+
+	b.debugf("Using %s for Authentication", method.name())
+	b.debugf("Tenant ID: %q, Subscription ID: %q, Client ID: %q, Tenant Only: %t", b.TenantID, b.SubscriptionID, b.ClientID, b.TenantOnly)
+	if b.SupportsAuxiliaryTenants && len(b.AuxiliaryTenantIDs) > 0 {
+		b.debugf("Auxiliary Tenant IDs: %s", strings.Join(b.AuxiliaryTenantIDs, ", "))
+	}
+
+	if chained, ok := method.(chainedAuth); ok {
+		for i, v := range chained.methods {
+			b.debugf("Authentication Method %d: %s", i+1, v.name())
+			b.logCredentialSources(v)
+		}
+		return
+	}
+
+	b.logCredentialSources(method)
+}
+
+// logCredentialSources logs the source of each credential used by the authentication method, with any secrets redacted
+func (b Builder) logCredentialSources(method authMethod) {
+	switch method.(type) {
+	case bearerTokenAuth:
+		b.debugf("Access Token: %s", credentialSource(b.AccessToken, ""))
+
+	case servicePrincipalClientCertificateAuth:
+		if b.ClientCertificateVaultID != "" {
+			b.debugf("Client Certificate: sourced from the Key Vault Secret %q", b.ClientCertificateVaultID)
+		} else {
+			b.debugf("Client Certificate: sourced from the file %q", b.ClientCertPath)
+		}
+		b.debugf("Client Certificate Password: %s", credentialSource(b.ClientCertPassword, ""))
+
+	case servicePrincipalOIDCAuth:
+		if b.OIDCToken == "" && b.OIDCTokenFilePath == "" && b.IDTokenRequestURL != "" {
+			b.debugf("OIDC Token: requested from %q, using the ID Token Request Token %s", b.IDTokenRequestURL, credentialSource(b.IDTokenRequestToken, ""))
+		} else {
+			b.debugf("OIDC Token: %s", credentialSource(b.OIDCToken, b.OIDCTokenFilePath))
+		}
+
+	case servicePrincipalClientSecretAuth, servicePrincipalClientSecretMultiTenantAuth:
+		b.debugf("Client Secret: %s", credentialSource(b.ClientSecret, b.ClientSecretFilePath))
+		for tenantId, clientSecret := range b.AuxiliaryTenantSecrets {
+			b.debugf("Client Secret for the Auxiliary Tenant %q: %s", tenantId, credentialSource(clientSecret, ""))
+		}
+
+	case managedServiceIdentityAuth:
+		if b.MsiEndpoint != "" {
+			b.debugf("Managed Service Identity Endpoint: %q", b.MsiEndpoint)
+		}
+	}
+}
+
+// credentialSource describes where a credential was sourced from, which is either the value configured in the
+// Builder (which is redacted) or a file - since files are only read when no value is configured
+func credentialSource(value, filePath string) string {
+	if value != "" {
+		return fmt.Sprintf("%s (configured)", redactedValue)
+	}
+	if filePath != "" {
+		return fmt.Sprintf("%s (sourced from the file %q)", redactedValue, filePath)
+	}
+
+	return "(not configured)"
+}
@@ -0,0 +1,158 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Debugf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) output() string {
+	return strings.Join(l.lines, "\n")
+}
+
+func TestBuilder_Logger(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "logger")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	secretFilePath := filepath.Join(tempDir, "client-secret")
+	if err := ioutil.WriteFile(secretFilePath, []byte("file-client-secret"), 0600); err != nil {
+		t.Fatalf("writing client secret: %+v", err)
+	}
+
+	accessToken := testAccessToken(t, jwt.MapClaims{
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	testData := []struct {
+		name     string
+		builder  Builder
+		secrets  []string
+		expected []string
+	}{
+		{
+			name: "client secret",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "inline-client-secret",
+			},
+			secrets: []string{"inline-client-secret"},
+			expected: []string{
+				"Using Service Principal / Client Secret for Authentication",
+				`Tenant ID: "11111111-1111-1111-1111-111111111111", Subscription ID: "22222222-2222-2222-2222-222222222222"`,
+				"Client Secret: ******** (configured)",
+			},
+		},
+		{
+			name: "client secret from a file",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				SupportsClientSecretAuth: true,
+				ClientSecretFilePath:     secretFilePath,
+			},
+			secrets: []string{"file-client-secret"},
+			expected: []string{
+				fmt.Sprintf("Client Secret: ******** (sourced from the file %q)", secretFilePath),
+			},
+		},
+		{
+			name: "multi-tenant client secret",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "inline-client-secret",
+				SupportsAuxiliaryTenants: true,
+				AuxiliaryTenantIDs:       []string{"33333333-3333-3333-3333-333333333333"},
+				AuxiliaryTenantSecrets: map[string]string{
+					"33333333-3333-3333-3333-333333333333": "auxiliary-client-secret",
+				},
+			},
+			secrets: []string{"inline-client-secret", "auxiliary-client-secret"},
+			expected: []string{
+				"Using Multi Tenant Service Principal / Client Secret for Authentication",
+				"Auxiliary Tenant IDs: 33333333-3333-3333-3333-333333333333",
+				`Client Secret for the Auxiliary Tenant "33333333-3333-3333-3333-333333333333": ******** (configured)`,
+			},
+		},
+		{
+			name: "oidc token",
+			builder: Builder{
+				ClientID:         "00000000-0000-0000-0000-000000000000",
+				SupportsOIDCAuth: true,
+				OIDCToken:        "inline-oidc-token",
+			},
+			secrets: []string{"inline-oidc-token"},
+			expected: []string{
+				"Using Service Principal / OIDC for Authentication",
+				"OIDC Token: ******** (configured)",
+			},
+		},
+		{
+			name: "access token",
+			builder: Builder{
+				AccessToken: accessToken,
+			},
+			secrets: []string{accessToken},
+			expected: []string{
+				"Using Access Token for Authentication",
+				"Access Token: ******** (configured)",
+			},
+		},
+		{
+			name: "authentication method order",
+			builder: Builder{
+				ClientID:                 "00000000-0000-0000-0000-000000000000",
+				AuthMethodOrder:          []string{"client_secret", "azure_cli"},
+				SupportsClientSecretAuth: true,
+				ClientSecret:             "inline-client-secret",
+			},
+			secrets: []string{"inline-client-secret"},
+			expected: []string{
+				"Authentication Method 1: Service Principal / Client Secret",
+				"Client Secret: ******** (configured)",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		logger := &testLogger{}
+		v.builder.Logger = logger
+		v.builder.TenantID = "11111111-1111-1111-1111-111111111111"
+		v.builder.SubscriptionID = "22222222-2222-2222-2222-222222222222"
+
+		if _, err := v.builder.Build(); err != nil {
+			t.Fatalf("building config: %+v", err)
+		}
+
+		output := logger.output()
+		for _, secret := range v.secrets {
+			if strings.Contains(output, secret) {
+				t.Fatalf("expected the secret %q to be redacted but the log output was:\n%s", secret, output)
+			}
+		}
+		for _, expected := range v.expected {
+			if !strings.Contains(output, expected) {
+				t.Fatalf("expected the log output to contain %q but got:\n%s", expected, output)
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package authentication
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+// tokenCacheExpiryMargin is how long before a cached token expires that it's no longer returned from the cache,
+// so that a token obtained from the cache remains valid for the lifetime of the request(s) it's used for
+const tokenCacheExpiryMargin = 5 * time.Minute
+
+// TokenCache caches access tokens by the scope they were issued for, allowing a token which has already been
+// obtained to be reused rather than requesting a new token from Azure Active Directory.
+//
+// The scope used by a Config includes the authentication method, Tenant ID, Client ID and (where applicable) the
+// Managed Identity or a fingerprint of the credential as well as the endpoint, so tokens obtained by one principal
+// aren't returned for another when a TokenCache is shared between Configs.
+type TokenCache interface {
+	// Get returns the cached token for the specified scope, if there is one which hasn't expired
+	Get(scope string) (adal.Token, bool)
+
+	// Set caches the specified token for the specified scope until the specified expiry
+	Set(scope string, token adal.Token, expiry time.Time)
+}
+
+// tokenCacheIdentifier is implemented by authentication methods where the identity which tokens are issued for
+// isn't determined by the Tenant ID and Client ID alone - for example the User Assigned Identity used for MSI.
+type tokenCacheIdentifier interface {
+	// tokenCacheIdentity returns a value identifying the credential/identity used to obtain tokens, which
+	// mustn't contain any secrets since this forms part of the cache key
+	tokenCacheIdentity() string
+}
+
+// credentialFingerprint returns a fingerprint of the specified credential, which can be used to distinguish
+// between credentials without including the credential itself
+func credentialFingerprint(credential []byte) string {
+	if len(credential) == 0 {
+		return ""
+	}
+
+	hash := sha256.Sum256(credential)
+	return hex.EncodeToString(hash[:])
+}
+
+type cachedToken struct {
+	token  adal.Token
+	expiry time.Time
+}
+
+type inMemoryTokenCache struct {
+	lock   sync.RWMutex
+	tokens map[string]cachedToken
+}
+
+// NewInMemoryTokenCache returns a TokenCache which caches tokens in memory, tokens are no longer returned from
+// the cache 5 minutes before they expire.
+func NewInMemoryTokenCache() TokenCache {
+	return &inMemoryTokenCache{
+		tokens: make(map[string]cachedToken),
+	}
+}
+
+func (c *inMemoryTokenCache) Get(scope string) (adal.Token, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cached, ok := c.tokens[scope]
+	if !ok || !time.Now().Add(tokenCacheExpiryMargin).Before(cached.expiry) {
+		return adal.Token{}, false
+	}
+
+	return cached.token, true
+}
+
+func (c *inMemoryTokenCache) Set(scope string, token adal.Token, expiry time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.tokens[scope] = cachedToken{
+		token:  token,
+		expiry: expiry,
+	}
+}
+
+// cachingTokenProvider wraps a ServicePrincipalToken, returning a token from the TokenCache when there's a valid
+// token cached for the scope and otherwise obtaining (and caching) a token using the ServicePrincipalToken.
+type cachingTokenProvider struct {
+	cache TokenCache
+	scope string
+	spt   *adal.ServicePrincipalToken
+}
+
+var _ adal.OAuthTokenProvider = &cachingTokenProvider{}
+var _ adal.Refresher = &cachingTokenProvider{}
+var _ adal.RefresherWithContext = &cachingTokenProvider{}
+
+// withTokenCache returns an Authorizer which uses the specified TokenCache when the Authorizer supports caching,
+// otherwise the Authorizer is returned as-is. Multi-tenant Authorizers aren't cached since these also require
+// tokens for each of the auxiliary tenants.
+func withTokenCache(authorizer autorest.Authorizer, cache TokenCache, scope string) autorest.Authorizer {
+	bearer, ok := authorizer.(*autorest.BearerAuthorizer)
+	if !ok {
+		return authorizer
+	}
+
+	spt, ok := bearer.TokenProvider().(*adal.ServicePrincipalToken)
+	if !ok {
+		return authorizer
+	}
+
+	return autorest.NewBearerAuthorizer(&cachingTokenProvider{
+		cache: cache,
+		scope: scope,
+		spt:   spt,
+	})
+}
+
+func (p *cachingTokenProvider) OAuthToken() string {
+	return p.token().AccessToken
+}
+
+// token returns the cached token for the scope if one is available, otherwise the current token
+func (p *cachingTokenProvider) token() adal.Token {
+	if token, ok := p.cache.Get(p.scope); ok {
+		return token
+	}
+
+	return p.spt.Token()
+}
+
+func (p *cachingTokenProvider) EnsureFresh() error {
+	return p.EnsureFreshWithContext(context.Background())
+}
+
+func (p *cachingTokenProvider) EnsureFreshWithContext(ctx context.Context) error {
+	if _, ok := p.cache.Get(p.scope); ok {
+		return nil
+	}
+
+	if err := p.spt.EnsureFreshWithContext(ctx); err != nil {
+		return err
+	}
+
+	p.cacheCurrentToken()
+	return nil
+}
+
+func (p *cachingTokenProvider) Refresh() error {
+	return p.RefreshWithContext(context.Background())
+}
+
+func (p *cachingTokenProvider) RefreshWithContext(ctx context.Context) error {
+	if err := p.spt.RefreshWithContext(ctx); err != nil {
+		return err
+	}
+
+	p.cacheCurrentToken()
+	return nil
+}
+
+func (p *cachingTokenProvider) RefreshExchange(resource string) error {
+	return p.spt.RefreshExchange(resource)
+}
+
+func (p *cachingTokenProvider) RefreshExchangeWithContext(ctx context.Context, resource string) error {
+	return p.spt.RefreshExchangeWithContext(ctx, resource)
+}
+
+func (p *cachingTokenProvider) cacheCurrentToken() {
+	token := p.spt.Token()
+	if token.AccessToken == "" {
+		return
+	}
+
+	p.cache.Set(p.scope, token, token.Expires())
+}
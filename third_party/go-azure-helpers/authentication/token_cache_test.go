@@ -0,0 +1,325 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestInMemoryTokenCache_expiry(t *testing.T) {
+	testData := []struct {
+		name     string
+		expiry   time.Duration
+		expected bool
+	}{
+		{
+			name:     "valid",
+			expiry:   time.Hour,
+			expected: true,
+		},
+		{
+			name:     "within the expiry margin",
+			expiry:   4 * time.Minute,
+			expected: false,
+		},
+		{
+			name:     "expired",
+			expiry:   -time.Minute,
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		cache := NewInMemoryTokenCache()
+		cache.Set("https://management.azure.com/", adal.Token{AccessToken: "abc123"}, time.Now().Add(v.expiry))
+
+		token, ok := cache.Get("https://management.azure.com/")
+		if ok != v.expected {
+			t.Fatalf("expected the token to be returned to be %t but got %t", v.expected, ok)
+		}
+		if ok && token.AccessToken != "abc123" {
+			t.Fatalf("expected the access token %q but got %q", "abc123", token.AccessToken)
+		}
+	}
+
+	cache := NewInMemoryTokenCache()
+	if _, ok := cache.Get("https://graph.microsoft.com/"); ok {
+		t.Fatalf("expected no token to be returned for an unknown scope")
+	}
+}
+
+func TestInMemoryTokenCache_concurrency(t *testing.T) {
+	cache := NewInMemoryTokenCache()
+	scopes := []string{
+		"https://management.azure.com/",
+		"https://graph.microsoft.com/",
+		"https://vault.azure.net",
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scope := scopes[i%len(scopes)]
+			cache.Set(scope, adal.Token{AccessToken: fmt.Sprintf("token-%d", i)}, time.Now().Add(time.Hour))
+			if _, ok := cache.Get(scope); !ok {
+				t.Errorf("expected a token to be cached for %q", scope)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestCachingTokenProvider_reusesCachedToken(t *testing.T) {
+	cache := NewInMemoryTokenCache()
+	var refreshes int32
+
+	firstProvider := testCachingTokenProvider(t, cache, &refreshes)
+	secondProvider := testCachingTokenProvider(t, cache, &refreshes)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := firstProvider.EnsureFreshWithContext(context.TODO()); err != nil {
+				t.Errorf("ensuring the token is fresh: %+v", err)
+			}
+			if token := firstProvider.OAuthToken(); token != "refreshed" {
+				t.Errorf("expected the token %q but got %q", "refreshed", token)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&refreshes) != 1 {
+		t.Fatalf("expected the token to be refreshed once but it was refreshed %d times", refreshes)
+	}
+
+	// the second provider's own token has expired, so this should be obtained from the cache rather than refreshed
+	if err := secondProvider.EnsureFreshWithContext(context.TODO()); err != nil {
+		t.Fatalf("ensuring the token is fresh: %+v", err)
+	}
+	if token := secondProvider.OAuthToken(); token != "refreshed" {
+		t.Fatalf("expected the token %q but got %q", "refreshed", token)
+	}
+	if atomic.LoadInt32(&refreshes) != 1 {
+		t.Fatalf("expected the cached token to be used but the token was refreshed %d times", refreshes)
+	}
+}
+
+func TestWithTokenCache_unsupportedAuthorizer(t *testing.T) {
+	authorizer := autorest.NullAuthorizer{}
+	if actual := withTokenCache(authorizer, NewInMemoryTokenCache(), "https://management.azure.com/"); actual != authorizer {
+		t.Fatalf("expected unsupported Authorizers to be returned as-is but got %T", actual)
+	}
+}
+
+func testCachingTokenProvider(t *testing.T, cache TokenCache, refreshes *int32) *cachingTokenProvider {
+	oauthConfig, err := adal.NewOAuthConfig("https://login.microsoftonline.com/", "11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	expired := adal.Token{
+		AccessToken: "expired",
+		ExpiresOn:   json.Number(strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)),
+		Type:        "Bearer",
+	}
+	spt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, "00000000-0000-0000-0000-000000000000", "https://management.azure.com/", expired)
+	if err != nil {
+		t.Fatalf("building token: %+v", err)
+	}
+	spt.SetCustomRefreshFunc(func(ctx context.Context, resource string) (*adal.Token, error) {
+		atomic.AddInt32(refreshes, 1)
+		return &adal.Token{
+			AccessToken: "refreshed",
+			ExpiresOn:   json.Number(strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)),
+			Type:        "Bearer",
+		}, nil
+	})
+
+	authorizer := withTokenCache(autorest.NewBearerAuthorizer(spt), cache, "https://management.azure.com/")
+	bearer, ok := authorizer.(*autorest.BearerAuthorizer)
+	if !ok {
+		t.Fatalf("expected a BearerAuthorizer but got %T", authorizer)
+	}
+	provider, ok := bearer.TokenProvider().(*cachingTokenProvider)
+	if !ok {
+		t.Fatalf("expected a cachingTokenProvider but got %T", bearer.TokenProvider())
+	}
+
+	return provider
+}
+
+func TestConfig_tokenCacheIsScopedToIdentity(t *testing.T) {
+	cache := NewInMemoryTokenCache()
+	endpoint := "https://management.azure.com/"
+
+	first := Config{
+		ClientID:   "00000000-0000-0000-0000-000000000000",
+		TenantID:   "11111111-1111-1111-1111-111111111111",
+		authMethod: servicePrincipalClientSecretAuth{clientSecret: "first-secret"},
+		tokenCache: cache,
+	}
+	cache.Set(first.tokenCacheScope(endpoint), adal.Token{AccessToken: "first"}, time.Now().Add(time.Hour))
+
+	testData := []struct {
+		name     string
+		config   Config
+		expected bool
+	}{
+		{
+			name:     "same identity",
+			config:   first,
+			expected: true,
+		},
+		{
+			name: "different client",
+			config: Config{
+				ClientID:   "22222222-2222-2222-2222-222222222222",
+				TenantID:   first.TenantID,
+				authMethod: first.authMethod,
+			},
+		},
+		{
+			name: "different tenant",
+			config: Config{
+				ClientID:   first.ClientID,
+				TenantID:   "22222222-2222-2222-2222-222222222222",
+				authMethod: first.authMethod,
+			},
+		},
+		{
+			name: "different client secret",
+			config: Config{
+				ClientID:   first.ClientID,
+				TenantID:   first.TenantID,
+				authMethod: servicePrincipalClientSecretAuth{clientSecret: "second-secret"},
+			},
+		},
+		{
+			name: "different authentication method",
+			config: Config{
+				ClientID:   first.ClientID,
+				TenantID:   first.TenantID,
+				authMethod: servicePrincipalClientCertificateAuth{},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if _, ok := cache.Get(v.config.tokenCacheScope(endpoint)); ok != v.expected {
+			t.Fatalf("expected a cached token to be found to be %t but got %t", v.expected, ok)
+		}
+	}
+}
+
+func TestConfig_tokenCacheIsScopedToManagedIdentity(t *testing.T) {
+	cache := NewInMemoryTokenCache()
+	endpoint := "https://management.azure.com/"
+
+	first := Config{
+		TenantID: "11111111-1111-1111-1111-111111111111",
+		authMethod: managedServiceIdentityAuth{
+			msiEndpoint: imdsEndpoint,
+			clientID:    "00000000-0000-0000-0000-000000000000",
+		},
+		tokenCache: cache,
+	}
+	cache.Set(first.tokenCacheScope(endpoint), adal.Token{AccessToken: "first"}, time.Now().Add(time.Hour))
+
+	testData := []struct {
+		name     string
+		config   Config
+		expected bool
+	}{
+		{
+			name:     "same identity",
+			config:   first,
+			expected: true,
+		},
+		{
+			name: "different msi client id",
+			config: Config{
+				TenantID: first.TenantID,
+				authMethod: managedServiceIdentityAuth{
+					msiEndpoint: imdsEndpoint,
+					clientID:    "22222222-2222-2222-2222-222222222222",
+				},
+			},
+		},
+		{
+			name: "msi resource id rather than client id",
+			config: Config{
+				TenantID: first.TenantID,
+				authMethod: managedServiceIdentityAuth{
+					msiEndpoint: imdsEndpoint,
+					resourceID:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+				},
+			},
+		},
+		{
+			name: "system assigned identity",
+			config: Config{
+				TenantID: first.TenantID,
+				authMethod: managedServiceIdentityAuth{
+					msiEndpoint: imdsEndpoint,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if _, ok := cache.Get(v.config.tokenCacheScope(endpoint)); ok != v.expected {
+			t.Fatalf("expected a cached token to be found to be %t but got %t", v.expected, ok)
+		}
+	}
+
+	// two User Assigned Identities which differ only by their Resource ID
+	second := Config{
+		TenantID: first.TenantID,
+		authMethod: managedServiceIdentityAuth{
+			msiEndpoint: imdsEndpoint,
+			resourceID:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+		},
+	}
+	third := Config{
+		TenantID: first.TenantID,
+		authMethod: managedServiceIdentityAuth{
+			msiEndpoint: imdsEndpoint,
+			resourceID:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity2",
+		},
+	}
+	if second.tokenCacheScope(endpoint) == third.tokenCacheScope(endpoint) {
+		t.Fatalf("expected the scopes for different User Assigned Identities to differ but both were %q", second.tokenCacheScope(endpoint))
+	}
+}
+
+func TestConfig_tokenCacheScopeDoesNotContainSecret(t *testing.T) {
+	config := Config{
+		ClientID:   "00000000-0000-0000-0000-000000000000",
+		TenantID:   "11111111-1111-1111-1111-111111111111",
+		authMethod: servicePrincipalClientSecretAuth{clientSecret: "super-secret"},
+	}
+
+	if scope := config.tokenCacheScope("https://management.azure.com/"); strings.Contains(scope, "super-secret") {
+		t.Fatalf("expected the scope not to contain the Client Secret but got %q", scope)
+	}
+}
@@ -0,0 +1,81 @@
+package authentication
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+type issuedAuthorizers struct {
+	lock        sync.RWMutex
+	authorizers map[string]autorest.Authorizer
+}
+
+func (i *issuedAuthorizers) get(endpoint string) (autorest.Authorizer, bool) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	authorizer, ok := i.authorizers[endpoint]
+	return authorizer, ok
+}
+
+func (i *issuedAuthorizers) set(endpoint string, authorizer autorest.Authorizer) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.authorizers == nil {
+		i.authorizers = make(map[string]autorest.Authorizer)
+	}
+	i.authorizers[endpoint] = authorizer
+}
+
+// TokenExpiryForAuthorizer returns the time at which the current token used by the specified Authorizer expires,
+// for multi-tenant Authorizers this is the expiry of the token for the primary tenant. A zero time is returned
+// when no token has been acquired yet.
+func TokenExpiryForAuthorizer(authorizer autorest.Authorizer) (time.Time, error) {
+	var spt *adal.ServicePrincipalToken
+	switch v := authorizer.(type) {
+	case *autorest.BearerAuthorizer:
+		if cached, ok := v.TokenProvider().(*cachingTokenProvider); ok {
+			token := cached.token()
+			if token.AccessToken == "" {
+				return time.Time{}, nil
+			}
+			return token.Expires(), nil
+		}
+
+		if static, ok := v.TokenProvider().(*staticTokenProvider); ok {
+			expiry, ok := accessTokenExpiry(static.accessToken)
+			if !ok {
+				return time.Time{}, fmt.Errorf("determining the token expiry: the Access Token doesn't contain an `exp` claim")
+			}
+			return expiry, nil
+		}
+
+		token, ok := v.TokenProvider().(*adal.ServicePrincipalToken)
+		if !ok {
+			return time.Time{}, fmt.Errorf("determining the token expiry: unsupported token provider %T", v.TokenProvider())
+		}
+		spt = token
+
+	case *autorest.MultiTenantBearerAuthorizer:
+		token, ok := v.TokenProvider().(*adal.MultiTenantServicePrincipalToken)
+		if !ok || token.PrimaryToken == nil {
+			return time.Time{}, fmt.Errorf("determining the token expiry: unsupported token provider %T", v.TokenProvider())
+		}
+		spt = token.PrimaryToken
+
+	default:
+		return time.Time{}, newUnsupportedAuthorizerError("determining the token expiry: unsupported authorizer %T", authorizer)
+	}
+
+	token := spt.Token()
+	if token.AccessToken == "" {
+		return time.Time{}, nil
+	}
+
+	return token.Expires(), nil
+}
@@ -0,0 +1,68 @@
+package authentication
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestConfig_TokenExpiry(t *testing.T) {
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return testResponse(r, http.StatusOK, `{"access_token":"access-token","expires_in":"3600","expires_on":"4102444800","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`), nil
+	})
+
+	builder := Builder{
+		ClientID:                 "00000000-0000-0000-0000-000000000000",
+		SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+		TenantID:                 "00000000-0000-0000-0000-000000000000",
+		SupportsClientSecretAuth: true,
+		ClientSecret:             "secret",
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building config: %+v", err)
+	}
+
+	endpoint := "https://management.azure.com/"
+	if _, err := config.TokenExpiry(endpoint); err == nil {
+		t.Fatalf("expected an error when no Authorizer has been obtained but didn't get one")
+	}
+
+	oauthConfig, err := config.BuildOAuthConfig("https://login.example.com/")
+	if err != nil {
+		t.Fatalf("building OAuth config: %+v", err)
+	}
+
+	authorizer, err := config.GetAuthorizationToken(sender, oauthConfig, endpoint)
+	if err != nil {
+		t.Fatalf("obtaining authorizer: %+v", err)
+	}
+
+	// the Client Secret token is only acquired when the first request is made
+	expiry, err := config.TokenExpiry(endpoint)
+	if err != nil {
+		t.Fatalf("retrieving token expiry: %+v", err)
+	}
+	if !expiry.IsZero() {
+		t.Fatalf("expected the token expiry to be zero before a token was acquired but got %s", expiry)
+	}
+
+	if _, err := autorest.Prepare(&http.Request{}, authorizer.WithAuthorization()); err != nil {
+		t.Fatalf("authorizing request: %+v", err)
+	}
+
+	expiry, err = config.TokenExpiry(endpoint)
+	if err != nil {
+		t.Fatalf("retrieving token expiry: %+v", err)
+	}
+	expected := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !expiry.Equal(expected) {
+		t.Fatalf("expected the token expiry to be %s but got %s", expected, expiry)
+	}
+
+	if _, err := config.TokenExpiry("https://graph.windows.net/"); err == nil {
+		t.Fatalf("expected an error for an endpoint without an Authorizer but didn't get one")
+	}
+}
@@ -0,0 +1,106 @@
+package authentication
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// defaultTokenRetryBackoff is the initial delay between token requests when no TokenRetryBackoff is specified
+const defaultTokenRetryBackoff = 2 * time.Second
+
+// tokenRetryMaxBackoff caps the delay between token requests, both when backing off and when honouring the
+// `Retry-After` header - so that a single token request can't block for an unreasonable amount of time
+const tokenRetryMaxBackoff = 1 * time.Minute
+
+// tokenRetrySender wraps a Sender, retrying token requests which fail with a transient error (either a
+// `429 Too Many Requests` or a `5xx` status code) using an exponential backoff - honouring the `Retry-After`
+// header when one is returned. Other status codes (e.g. `400 Bad Request` or `401 Unauthorized`) are
+// returned immediately, since retrying these won't succeed.
+type tokenRetrySender struct {
+	sender     autorest.Sender
+	maxRetries int
+	backoff    time.Duration
+}
+
+var _ autorest.Sender = tokenRetrySender{}
+
+func newTokenRetrySender(sender autorest.Sender, maxRetries int, backoff time.Duration) autorest.Sender {
+	if backoff <= 0 {
+		backoff = defaultTokenRetryBackoff
+	}
+
+	return tokenRetrySender{
+		sender:     sender,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+	}
+}
+
+func (s tokenRetrySender) Do(r *http.Request) (*http.Response, error) {
+	// the body of the token request has to be re-sent on each attempt
+	rr := autorest.NewRetriableRequest(r)
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = rr.Prepare(); err != nil {
+			return resp, err
+		}
+
+		autorest.DrainResponseBody(resp)
+		resp, err = s.sender.Do(rr.Request())
+		if err != nil || !isRetryableTokenResponse(resp) || attempt >= s.maxRetries {
+			return resp, err
+		}
+
+		delay := tokenRetryDelay(resp, s.backoff, attempt)
+		log.Printf("[DEBUG] Token request to %q returned %d - retrying in %s (attempt %d of %d)", r.URL.Host, resp.StatusCode, delay, attempt+1, s.maxRetries)
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			autorest.DrainResponseBody(resp)
+			return nil, fmt.Errorf("waiting to retry the token request: %+v", r.Context().Err())
+		}
+	}
+}
+
+// isRetryableTokenResponse returns whether the token request failed with a transient error which should be retried
+func isRetryableTokenResponse(resp *http.Response) bool {
+	if resp == nil {
+		return false
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode >= 500 && resp.StatusCode <= 599)
+}
+
+// tokenRetryDelay returns how long to wait before retrying the token request, which is the duration specified
+// in the `Retry-After` header when present - otherwise the backoff doubled for each previous attempt
+func tokenRetryDelay(resp *http.Response, backoff time.Duration, attempt int) time.Duration {
+	delay := backoff
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			delay = time.Until(t)
+		}
+		if delay < 0 {
+			delay = 0
+		}
+	} else {
+		for i := 0; i < attempt && delay < tokenRetryMaxBackoff; i++ {
+			delay *= 2
+		}
+	}
+
+	if delay > tokenRetryMaxBackoff {
+		delay = tokenRetryMaxBackoff
+	}
+
+	return delay
+}
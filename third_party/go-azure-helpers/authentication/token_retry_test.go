@@ -0,0 +1,235 @@
+package authentication
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestConfig_GetAuthorizationTokenRetries(t *testing.T) {
+	testData := []struct {
+		name             string
+		tokenRetryMax    int
+		statusCodes      []int
+		expectedRequests int
+		expectedError    bool
+	}{
+		{
+			name:             "retried until successful",
+			tokenRetryMax:    3,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			expectedRequests: 3,
+		},
+		{
+			name:             "server errors are retried",
+			tokenRetryMax:    3,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusOK},
+			expectedRequests: 3,
+		},
+		{
+			name:             "retries exhausted",
+			tokenRetryMax:    1,
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			expectedRequests: 2,
+			expectedError:    true,
+		},
+		{
+			name:             "retries disabled",
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectedRequests: 1,
+			expectedError:    true,
+		},
+		{
+			name:             "bad request fails fast",
+			tokenRetryMax:    3,
+			statusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			expectedRequests: 1,
+			expectedError:    true,
+		},
+		{
+			name:             "unauthorized fails fast",
+			tokenRetryMax:    3,
+			statusCodes:      []int{http.StatusUnauthorized, http.StatusOK},
+			expectedRequests: 1,
+			expectedError:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		bodies := make([]string, 0)
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if err := r.ParseForm(); err != nil {
+				t.Fatalf("parsing token request: %+v", err)
+			}
+			bodies = append(bodies, r.PostForm.Encode())
+
+			statusCode := v.statusCodes[len(bodies)-1]
+			if statusCode != http.StatusOK {
+				resp := testResponse(r, statusCode, `{"error":"temporarily_unavailable"}`)
+				resp.Header = http.Header{"Retry-After": []string{"0"}}
+				return resp, nil
+			}
+			return testResponse(r, http.StatusOK, `{"access_token":"abc123","expires_in":"3600","expires_on":"4102444800","not_before":"0","resource":"https://management.azure.com/","token_type":"Bearer"}`), nil
+		})
+
+		builder := Builder{
+			ClientID:                 "00000000-0000-0000-0000-000000000000",
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			TenantID:                 "00000000-0000-0000-0000-000000000000",
+			SupportsClientSecretAuth: true,
+			ClientSecret:             "secret",
+			TokenRetryMax:            v.tokenRetryMax,
+			TokenRetryBackoff:        time.Millisecond,
+		}
+		config, err := builder.Build()
+		if err != nil {
+			t.Fatalf("building config: %+v", err)
+		}
+
+		oauthConfig, err := config.BuildOAuthConfig("https://login.example.com/")
+		if err != nil {
+			t.Fatalf("building OAuth config: %+v", err)
+		}
+
+		authorizer, err := config.GetAuthorizationToken(sender, oauthConfig, "https://management.azure.com/")
+		if err != nil {
+			t.Fatalf("obtaining authorizer: %+v", err)
+		}
+
+		_, err = autorest.Prepare(&http.Request{}, authorizer.WithAuthorization())
+		if v.expectedError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectedError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if len(bodies) != v.expectedRequests {
+			t.Fatalf("expected %d token requests but got %d", v.expectedRequests, len(bodies))
+		}
+		for i, body := range bodies {
+			if !strings.Contains(body, "client_secret=secret") {
+				t.Fatalf("expected token request %d to contain the Client Secret but got %q", i, body)
+			}
+		}
+	}
+}
+
+func TestTokenRetryDelay(t *testing.T) {
+	testData := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		expected   time.Duration
+	}{
+		{
+			name:     "first attempt",
+			attempt:  0,
+			expected: 2 * time.Second,
+		},
+		{
+			name:     "exponential backoff",
+			attempt:  2,
+			expected: 8 * time.Second,
+		},
+		{
+			name:     "capped backoff",
+			attempt:  10,
+			expected: tokenRetryMaxBackoff,
+		},
+		{
+			name:       "retry after seconds",
+			retryAfter: "5",
+			attempt:    2,
+			expected:   5 * time.Second,
+		},
+		{
+			name:       "retry after in the past",
+			retryAfter: "Mon, 01 Jan 2001 00:00:00 GMT",
+			expected:   0,
+		},
+		{
+			name:       "retry after capped",
+			retryAfter: "3600",
+			expected:   tokenRetryMaxBackoff,
+		},
+		{
+			name:       "invalid retry after",
+			retryAfter: "soon",
+			attempt:    1,
+			expected:   2 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.retryAfter != "" {
+			resp.Header.Set("Retry-After", v.retryAfter)
+		}
+
+		actual := tokenRetryDelay(resp, 2*time.Second, v.attempt)
+		if actual != v.expected {
+			t.Fatalf("expected a delay of %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestBuilder_validateTokenRetry(t *testing.T) {
+	testData := []struct {
+		name          string
+		retryMax      int
+		retryBackoff  time.Duration
+		expectedError bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:         "valid",
+			retryMax:     3,
+			retryBackoff: time.Second,
+		},
+		{
+			name:          "negative retries",
+			retryMax:      -1,
+			expectedError: true,
+		},
+		{
+			name:          "negative backoff",
+			retryMax:      3,
+			retryBackoff:  -time.Second,
+			expectedError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		builder := Builder{
+			ClientID:                 "00000000-0000-0000-0000-000000000000",
+			SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+			TenantID:                 "00000000-0000-0000-0000-000000000000",
+			SupportsClientSecretAuth: true,
+			ClientSecret:             "secret",
+			TokenRetryMax:            v.retryMax,
+			TokenRetryBackoff:        v.retryBackoff,
+		}
+
+		_, err := builder.Build()
+		if v.expectedError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectedError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
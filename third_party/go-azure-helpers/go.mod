@@ -0,0 +1,18 @@
+module github.com/hashicorp/go-azure-helpers
+
+require (
+	github.com/Azure/azure-sdk-for-go v51.2.0+incompatible
+	github.com/Azure/go-autorest/autorest v0.11.18
+	github.com/Azure/go-autorest/autorest/adal v0.9.13
+	github.com/Azure/go-autorest/autorest/azure/cli v0.4.2
+	github.com/Azure/go-autorest/autorest/to v0.4.0 // indirect
+	github.com/Azure/go-autorest/autorest/validation v0.3.1 // indirect
+	github.com/dimchansky/utfbom v1.1.1 // indirect
+	github.com/form3tech-oss/jwt-go v3.2.2+incompatible
+	github.com/hashicorp/go-multierror v1.0.0
+	github.com/hashicorp/go-version v1.3.0
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.8.0
+	golang.org/x/crypto v0.0.0-20210421170649-83a5a9bb288b
+)
+
+go 1.16